@@ -16,6 +16,18 @@ type GenerateStruct struct {
 	Enums []*GenerateEnums
 	// 所有待生成的消息
 	Messages []*GenerateMessage
+	// 所有待生成的扩展字段
+	Extensions []*GenerateExtension
+	// 注册消息的函数(gopb.RegisterType)及消息接口(gopb.Message). 为空时不注册
+	RegisterType   string
+	RuntimeMessage string
+	// 反射支持(gopb.RegisterFile). RawDesc 不为空时内嵌描述符
+	FileVar            string   // 文件描述变量名
+	FilePath           string   // proto文件路径
+	RawDesc            []string // 序列化的 FileDescriptorProto, 每行16字节
+	RegisterFile       string
+	ReflectMessageType string // *gopb.MessageType
+	ReflectMessage     string // protoreflect.Message
 	// 导入包函数,
 	improt func(pkg, name string) string
 }
@@ -61,8 +73,39 @@ type GenerateMessage struct {
 	GoName string
 	// 字段
 	Fields []*GenerateField
+	// oneof 定义
+	Oneofs []*GenerateOneof
+	// 默认值定义. 常量和变量(bytes,NaN,Inf)
+	DefaultConsts []string
+	DefaultVars   []string
 	// 生成get方法
 	GenGetter bool
+	// 保留未知字段
+	Unknown bool
+	// 扩展字段范围. [start,end)
+	ExtensionRanges [][2]int
+	// 包含 required 字段(包括嵌套消息), 生成 IsInitialized 检查
+	CheckRequired bool
+	// 生成 Acquire/Release, 使用 sync.Pool 复用消息
+	Pool bool
+	// 缓存 MarshalSize 的结果, 序列化时子消息使用缓存的大小
+	SizeCache bool
+	// 生成 MarshalObjectDeterministic, map 按键排序序列化
+	Deterministic bool
+	// 生成 UnmarshalObjectWith, 解析时检查 gopb.UnmarshalOptions 的限制
+	Limit bool
+	// proto中的名字
+	DescName string
+	// proto中的全名. 解析错误(gopb.DecodeError)中使用
+	ProtoName string
+	// proto中的全名. 不为空时生成 XXX_MessageName 方法, 并注册到 gopb
+	FullName string
+	// 反射类型变量. 不为空时生成 ProtoReflect 方法
+	ReflectType string
+	// 字段的go名字, 按描述符中的顺序. oneof 成员为包装类型中的字段名
+	ReflectFields []string
+	// oneof 成员的包装类型
+	ReflectWrappers []string
 	// 自定义模板列表
 	CustomTemplates []string
 }
@@ -77,6 +120,8 @@ type GenerateField struct {
 	GoType string
 	// 错误提示. 消息.字段
 	Tip string
+	// 所在消息的proto全名(扩展字段为被扩展的消息). 解析错误中使用
+	Message string
 
 	// tags
 	tags string
@@ -88,6 +133,10 @@ type GenerateField struct {
 	DescNum int
 	// PB 中的名字
 	DescName string
+	// protojson 使用的名字. json_name 选项或 lowerCamel
+	JSONName string
+	// prototext 使用的名字. group 为消息名
+	TextName string
 	// proto-wire 类型 -string
 	WireType string // protowire.VarintType
 	// proto-wire 类型
@@ -96,6 +145,34 @@ type GenerateField struct {
 	IsMap  bool
 	IsList bool
 	Kind   protoreflect.Kind
+	// 显式存在性(proto2 optional/proto3 optional)
+	HasPresence bool
+	// 标量字段使用指针表示存在性
+	Pointer bool
+	// 字符串解析时校验utf8 (utf8_validation = VERIFY, 未关闭 utf8 参数)
+	ValidateUTF8 bool
+	// 字符串序列化时校验utf8 (utf8_marshal 参数)
+	ValidateUTF8Marshal bool
+	// 封闭枚举(proto2, enum_type = CLOSED)的名字表(Xxx_name). 解析时未知的值保存在未知字段中
+	ClosedEnum string
+	// 所在消息保留未知字段
+	Unknown bool
+	// 解析时 string/bytes 引用输入数据, 不复制 (alias 参数或 (gopb.alias) 选项)
+	Alias bool
+	// required 字段
+	Required bool
+	// 字段消息类型(包括map的值类型)包含 required 字段, 需要递归检查
+	CheckRequired bool
+	// 字段消息类型有 Acquire 函数(同一个go包). 解析时从池中获取, Release 时递归归还
+	Pool bool
+	// 字段消息类型缓存了大小(同一个go包). 序列化时使用 cachedSize/marshalCachedTo
+	SizeCache bool
+	// 序列化时传递 deterministic 参数: map 按键排序, 子消息及oneof递归传递
+	Deterministic bool
+	// 字段消息类型由 gopb 生成在同一个go包中, 可以调用未导出的方法
+	Local bool
+	// 解析时传递 gopb.UnmarshalState, 检查列表及 map 的长度, 子消息的深度
+	Limit bool
 
 	// marshal 辅助
 	CheckNotEmpty func(vname string) string // 检测是否为空的条件. 是否需要序列化
@@ -103,9 +180,58 @@ type GenerateField struct {
 	TemplateEncode string
 	TemplateSize   string
 	TemplateDecode string
+	TemplateCopy   string
+	TemplateEqual  string
+	TemplateMerge  string
+	// 严格模式(strict 参数)解析前校验 wire type 的模板. 为空时不校验
+	TemplateCheck string
+	// 指针字段解引用后使用的模板(well-known types 列表为元素模板)
+	ElemTemplateEncode string
+	ElemTemplateSize   string
+	ElemTemplateDecode string
+	// 列表元素的复制模板
+	ElemTemplateCopy string
+	// 列表元素及指针解引用后的比较模板
+	ElemTemplateEqual string
 	//
 	MapKey   *GenerateField
 	MapValue *GenerateField
+
+	// 映射为go原生类型的 well-known types: timestamp, duration, wrapper
+	WKT string
+	// wrapper 类型的 value 字段
+	WrapperValue *GenerateField
+	// google.protobuf.Any 映射为 gopb.Any
+	Any bool
+
+	// oneof 字段. 不为空时表示当前字段为oneof的接口字段
+	Oneof *GenerateOneof
+	// oneof 成员的包装类型名
+	OneofWrapper string
+}
+
+type GenerateExtension struct {
+	GenerateDoc
+	// 扩展描述类型名
+	TypeName string
+	// 扩展描述变量名
+	VarName string
+	// 被扩展的消息类型名
+	Extendee string
+	// 扩展的完整名字
+	FullName string
+	// 扩展字段
+	Field *GenerateField
+}
+
+type GenerateOneof struct {
+	GenerateDoc
+	// 接口类型名
+	TypeName string
+	// 消息中的字段名
+	GoName string
+	// oneof 成员字段
+	Fields []*GenerateField
 }
 
 func (f *GenerateField) AddTag(tag, usefun string, vals ...string) (_ string) {
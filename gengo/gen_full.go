@@ -40,78 +40,524 @@ func (x {{ .TypeName }}) String() string {
 {{ range .Messages }} {{ $msg := .}}
 
 {{ .LeadingComments }} type {{.TypeName}} struct { {{ range $i,$field := .Fields }} {{ $tag:= $field.AddTag "json" ""}}
-	{{ $field.LeadingComments }} {{ $field.GoName }} {{ $field.TypeName }} {{ $field.Tags }} {{ $field.TrailingComment }} {{ end }}
+	{{ $field.LeadingComments }} {{ $field.GoName }} {{ $field.TypeName }} {{ $field.Tags }} {{ $field.TrailingComment }} {{ end }} {{ if .ExtensionRanges }}
+	extensionFields []byte {{ end }} {{ if .Unknown }}
+	unknownFields []byte {{ end }} {{ if .SizeCache }}
+	sizeCache int32 {{ end }}
 }
 func (x *{{.TypeName}}) Reset() {
 	*x = {{.TypeName}}{}
 }
 
+{{ if .Pool }}
+var pool{{ .GoName }} = sync.Pool{New: func() any { return &{{ .TypeName }}{} }}
 
-{{ if .GenGetter }} {{ range $i,$field := .Fields }}
+// Acquire{{ .GoName }} returns an empty {{ .TypeName }} from the pool. call Release to put it back
+func Acquire{{ .GoName }}() *{{ .TypeName }} {
+	return pool{{ .GoName }}.Get().(*{{ .TypeName }})
+}
+
+// Release resets x, releases its sub-messages and puts x back to the pool. x must not be used after Release.
+// 列表截断保留容量, map 清空后保留
+func (x *{{ .TypeName }}) Release() {
+	if x == nil {
+		return
+	} {{ range $i,$field := .Fields }} {{ $vname := ValueName "x." $field.GoName }} {{ if $field.Oneof }} {{ range $j,$of := $field.Oneof.Fields }} {{ if $of.Pool }}
+	if ov, ok := {{ $vname }}.(*{{ $of.OneofWrapper }}); ok {
+		ov.{{ $of.GoName }}.Release()
+	} {{ end }} {{ end }} {{ else if $field.IsMap }}
+	for mk{{ if $field.MapValue.Pool }}, mv{{ end }} := range {{ $vname }} { {{ if $field.MapValue.Pool }}
+		mv.Release() {{ end }}
+		delete({{ $vname }}, mk)
+	} {{ else if $field.IsList }} {{ if or (eq $field.Kind.String "message") (eq $field.Kind.String "group") }}
+	for i{{ if $field.Pool }}, v{{ end }} := range {{ $vname }} { {{ if $field.Pool }}
+		v.Release() {{ end }}
+		{{ $vname }}[i] = nil
+	} {{ end }} {{ else if $field.Pool }}
+	{{ $vname }}.Release() {{ end }} {{ end }}
+	*x = {{ .TypeName }}{ {{ range $i,$field := .Fields }} {{ if $field.IsMap }}
+		{{ $field.GoName }}: {{ ValueName "x." $field.GoName }}, {{ else if $field.IsList }}
+		{{ $field.GoName }}: {{ ValueName "x." $field.GoName }}[:0], {{ end }} {{ end }} {{ if .ExtensionRanges }}
+		extensionFields: x.extensionFields[:0], {{ end }} {{ if .Unknown }}
+		unknownFields: x.unknownFields[:0], {{ end }}
+	}
+	pool{{ .GoName }}.Put(x)
+}
+{{ end }}
+
+{{ if .DefaultConsts }}
+// Default values for {{.TypeName}} fields.
+const ( {{ range .DefaultConsts }}
+	{{ . }} {{ end }}
+)
+{{ end }}{{ if .DefaultVars }}
+// Default values for {{.TypeName}} fields.
+var ( {{ range .DefaultVars }}
+	{{ . }} {{ end }}
+)
+{{ end }}
+
+{{ range .Oneofs }} {{ $oneof := . }}
+type {{ .TypeName }} interface {
+	{{ .TypeName }}()
+	marshalOneofSize() int
+	marshalOneofTo(buf []byte{{ if $msg.Deterministic }}, deterministic bool{{ end }}) ([]byte, error)
+	copyOneof() {{ .TypeName }}
+	equalOneof(other {{ .TypeName }}) bool
+	mergeOneof(dst {{ .TypeName }}) {{ .TypeName }}
+}
+{{ range $i,$field := .Fields }} {{ $tag:= $field.AddTag "json" ""}} {{ $vname := ValueName "x." $field.GoName }}
+{{ $field.LeadingComments }} type {{ $field.OneofWrapper }} struct {
+	{{ $field.GoName }} {{ $field.TypeName }} {{ $field.Tags }} {{ $field.TrailingComment }}
+}
+
+func (*{{ $field.OneofWrapper }}) {{ $oneof.TypeName }}() {}
+
+func (x *{{ $field.OneofWrapper }}) marshalOneofSize() (size int) { {{ if or (eq $field.Kind.String "message") (eq $field.Kind.String "group") }}
+	if {{ call $field.CheckNotEmpty $vname }} {
+		{{GenTemplate $field.TemplateSize $field "Size" "size" "VName" $vname}}
+	} {{ else }}
+	{{GenTemplate $field.TemplateSize $field "Size" "size" "VName" $vname}} {{ end }}
+	return
+}
+
+func (x *{{ $field.OneofWrapper }}) marshalOneofTo(buf []byte{{ if $msg.Deterministic }}, deterministic bool{{ end }}) (data []byte, err error) {
+	data = buf {{ if or (eq $field.Kind.String "message") (eq $field.Kind.String "group") }}
+	if {{ call $field.CheckNotEmpty $vname }} {
+		{{GenTemplate $field.TemplateEncode $field "Buffer" "data" "VName" $vname}}
+	} {{ else }}
+	{{GenTemplate $field.TemplateEncode $field "Buffer" "data" "VName" $vname}} {{ end }}
+	return
+}
+
+func (x *{{ $field.OneofWrapper }}) copyOneof() {{ $oneof.TypeName }} {
+	y := &{{ $field.OneofWrapper }}{}
+	{{GenTemplate $field.TemplateCopy $field "Dst" (ValueName "y." $field.GoName) "Src" $vname}}
+	return y
+}
+
+func (x *{{ $field.OneofWrapper }}) equalOneof(other {{ $oneof.TypeName }}) bool {
+	y, ok := other.(*{{ $field.OneofWrapper }})
+	if !ok {
+		return false
+	}
+	{{GenTemplate $field.TemplateEqual $field "X" $vname "Y" (ValueName "y." $field.GoName)}}
+	return true
+}
+
+func (x *{{ $field.OneofWrapper }}) mergeOneof(dst {{ $oneof.TypeName }}) {{ $oneof.TypeName }} {
+	y, ok := dst.(*{{ $field.OneofWrapper }})
+	if !ok {
+		y = &{{ $field.OneofWrapper }}{}
+	}
+	{{GenTemplate $field.TemplateMerge $field "Dst" (ValueName "y." $field.GoName) "Src" $vname}}
+	return y
+}
+{{ end }}{{ end }}
+
+
+{{ if .GenGetter }} {{ range $i,$field := .Fields }} {{ if $field.Oneof }}
+func (x *{{ $msg.TypeName }}) Get{{ $field.GoName}}() {{$field.TypeName}} {
+	if x != nil {
+		return x.{{ $field.GoName }}
+	}
+	return nil
+}
+{{ range $j,$of := $field.Oneof.Fields }}
+{{ $of.LeadingComments }} func (x *{{ $msg.TypeName }}) Get{{ $of.GoName}}() {{$of.TypeName}} {
+	if x, ok := x.Get{{ $field.GoName }}().(*{{ $of.OneofWrapper }}); ok {
+		return x.{{ $of.GoName }}
+	}
+	return {{ $of.DefaultValue }}
+}
+{{ end }}{{ else if $field.Pointer }}
+{{ $field.LeadingComments }} func (x *{{ $msg.TypeName }}) Get{{ $field.GoName}}() {{$field.GoType}} {
+	if x != nil && x.{{ $field.GoName }} != nil {
+		return *x.{{ $field.GoName }}
+	}
+	return {{ $field.DefaultValue }}
+}
+{{ else }}
 {{ $field.LeadingComments }} func (x *{{ $msg.TypeName }}) Get{{ $field.GoName}}() {{$field.TypeName}} {
 	{{ if $field.GetNilCheck }} if x != nil {{ else }} if x != nil && x.{{ $field.GoName }} != nil {{end}} {
 		return x.{{ $field.GoName }}
 	}
-	return x.{{ $field.GoName }}
+	return {{ $field.DefaultValue }}
+}
+{{ end }}{{ if $field.HasPresence }}
+// Has{{ $field.GoName }} report whether the field is set
+func (x *{{ $msg.TypeName }}) Has{{ $field.GoName}}() bool {
+	return x != nil && x.{{ $field.GoName }} != nil
+}
+
+// Clear{{ $field.GoName }} clear the field
+func (x *{{ $msg.TypeName }}) Clear{{ $field.GoName}}() {
+	x.{{ $field.GoName }} = nil
+}
+{{ end }}{{ end }}{{ end }}
+
+// Clone returns a deep copy of x
+func (x *{{ .TypeName }}) Clone() *{{ .TypeName }} {
+	if x == nil {
+		return nil
+	}
+	y := &{{ .TypeName }}{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *{{ .TypeName }}) CopyFrom(src *{{ .TypeName }}) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	} {{ range $i,$field := .Fields }}
+	{{GenTemplate $field.TemplateCopy $field "Dst" (ValueName "x." $field.GoName) "Src" (ValueName "src." $field.GoName)}} {{ end }} {{ if .ExtensionRanges }}
+	x.extensionFields = append(x.extensionFields[:0], src.extensionFields...) {{ end }} {{ if .Unknown }}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...) {{ end }}
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *{{ .TypeName }}) Equal(other *{{ .TypeName }}) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	} {{ range $i,$field := .Fields }}
+	{{GenTemplate $field.TemplateEqual $field "X" (ValueName "x." $field.GoName) "Y" (ValueName "other." $field.GoName)}} {{ end }} {{ if .ExtensionRanges }}
+	if !gopb.EqualExtensions(x.extensionFields, other.extensionFields) {
+		return false
+	} {{ end }} {{ if .Unknown }}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	} {{ end }}
+	return true
 }
-{{ end }}{{ end }}
 
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *{{ .TypeName }}) Merge(src *{{ .TypeName }}) {
+	if src == nil {
+		return
+	} {{ range $i,$field := .Fields }} {{ $vname := ValueName "src." $field.GoName }}
+	if {{ call $field.CheckNotEmpty $vname }} {
+		{{GenTemplate $field.TemplateMerge $field "Dst" (ValueName "x." $field.GoName) "Src" $vname "Checked" "true"}}
+	} {{ end }} {{ if .ExtensionRanges }}
+	x.extensionFields = append(x.extensionFields, src.extensionFields...) {{ end }} {{ if .Unknown }}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...) {{ end }}
+}
+
+{{ if .FullName }}
+// XXX_MessageName returns the full name of {{ .TypeName }}
+func (x *{{ .TypeName }}) XXX_MessageName() string {
+	return "{{ .FullName }}"
+}
+{{ end }}
 
+{{ if .ReflectType }}
+// ProtoReflect returns a protoreflect.Message view of x, which implements proto.Message.
+// proto.Marshal/proto.Unmarshal use MarshalObjectTo/UnmarshalObject
+func (x *{{ .TypeName }}) ProtoReflect() {{ $.ReflectMessage }} {
+	return {{ .ReflectType }}.MessageOf(x)
+}
+{{ end }}
+
+{{ $to := "MarshalObjectTo" }}{{ if .SizeCache }}{{ $to = "marshalCachedTo" }}{{ else if .Deterministic }}{{ $to = "marshalTo" }}{{ end }}
 // MarshalObject marshal data to []byte
 func (x *{{ .TypeName }}) MarshalObject() (data []byte, err error) {
 	data = make([]byte, 0, x.MarshalSize())
-	return x.MarshalObjectTo(data)
+	return x.{{ $to }}(data{{ if .Deterministic }}, false{{ end }})
 }
 
 // MarshalSize calc marshal data need space
 func (x *{{ .TypeName }}) MarshalSize() (size int) {  {{ range $i,$field := .Fields }} {{ $vname := ValueName "x." $field.GoName }}
 	if {{ call $field.CheckNotEmpty $vname }} {
 		{{GenTemplate $field.TemplateSize $field "Size" "size" "VName" $vname}}
-	}{{ end }}
+	}{{ end }} {{ if .ExtensionRanges }}
+	size += len(x.extensionFields) {{ end }} {{ if .Unknown }}
+	size += len(x.unknownFields) {{ end }} {{ if .SizeCache }}
+	atomic.StoreInt32(&x.sizeCache, int32(size)) {{ end }}
 	return
 }
+{{ if .SizeCache }}
+// cachedSize returns the size computed by the last MarshalSize
+func (x *{{ .TypeName }}) cachedSize() int {
+	return int(atomic.LoadInt32(&x.sizeCache))
+}
+{{ end }}
+{{ if ne $to "MarshalObjectTo" }}
+// MarshalObjectTo marshal data to []byte
+func (x *{{ .TypeName }}) MarshalObjectTo(buf []byte) (data []byte, err error) { {{ if .SizeCache }}
+	// 刷新子消息缓存的大小
+	x.MarshalSize() {{ end }}
+	return x.{{ $to }}(buf{{ if .Deterministic }}, false{{ end }})
+}
+{{ end }}
+{{ if .Deterministic }}
+// MarshalObjectDeterministic marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *{{ .TypeName }}) MarshalObjectDeterministic() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.{{ $to }}(data, true)
+}
 
-
+// MarshalObjectDeterministicTo marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *{{ .TypeName }}) MarshalObjectDeterministicTo(buf []byte) (data []byte, err error) { {{ if .SizeCache }}
+	x.MarshalSize() {{ end }}
+	return x.{{ $to }}(buf, true)
+}
+{{ end }}
+{{ if .SizeCache }}
+// marshalCachedTo marshal data to []byte. 子消息使用 MarshalSize 缓存的大小{{ if .Deterministic }}, deterministic 时 map 按键排序{{ end }}
+{{ else if .Deterministic }}
+// marshalTo marshal data to []byte. deterministic 时 map 按键排序
+{{ else }}
 // MarshalObjectTo marshal data to []byte
-func (x *{{ .TypeName }}) MarshalObjectTo(buf []byte) (data []byte, err error) {	
+{{ end }}func (x *{{ .TypeName }}) {{ $to }}(buf []byte{{ if .Deterministic }}, deterministic bool{{ end }}) (data []byte, err error) {
 	data = buf  {{ range $i,$field := .Fields }} {{ $vname := ValueName "x." $field.GoName }}
 	if {{ call $field.CheckNotEmpty $vname }} {
 		{{GenTemplate $field.TemplateEncode $field "Buffer" "data" "VName" $vname}}
-	}{{ end }}
+	}{{ end }} {{ if .ExtensionRanges }}
+	data = append(data, x.extensionFields...) {{ end }} {{ if .Unknown }}
+	data = append(data, x.unknownFields...) {{ end }}
 	return
 }
 
 
+{{ if .Limit }}
+// UnmarshalObject unmarshal data from []byte. 只限制嵌套深度(gopb.DefaultMaxDepth)
+func (x *{{ .TypeName }}) UnmarshalObject(data []byte) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{})
+}
+
+// UnmarshalObjectWith unmarshal data from []byte with the limits of opts
+func (x *{{ .TypeName }}) UnmarshalObjectWith(data []byte, opts gopb.UnmarshalOptions) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{UnmarshalOptions: opts})
+}
+
+// UnmarshalObjectState unmarshal data from []byte. st 在嵌套消息间传递, 由生成的代码使用
+func (x *{{ .TypeName }}) UnmarshalObjectState(data []byte, st *gopb.UnmarshalState) (err error) {
+	if !st.Enter() {
+		return gopb.LimitError("{{ .ProtoName }}", "", 0, 0)
+	}
+{{ else }}
 // UnmarshalObject unmarshal data from []byte
-func (x *{{ .TypeName }}) UnmarshalObject(data []byte) (err error) {	
+func (x *{{ .TypeName }}) UnmarshalObject(data []byte) (err error) { {{ end }}
 	index := 0
 	for index < len(data) {
 		num, typ, cnt := protowire.ConsumeTag(data[index:])
 		if num == 0 {
-			err = errors.New("invalid tag")
+			err = gopb.ParseError("{{ $msg.ProtoName }}", "", 0, index, cnt)
 			return
 		}
 
 		index += cnt
-		switch num { {{ range $i,$field := .Fields }} {{ $vname := ValueName "x." $field.GoName }}
-		case {{$field.DescNum}}:
-			{{GenTemplate $field.TemplateDecode $field "Buffer" "data[index:]" "VName" $vname "Index" "index"}} {{end}}
+		switch num { {{ range $i,$field := .Fields }} {{ if $field.Oneof }} {{ range $j,$of := $field.Oneof.Fields }} {{ $ovname := ValueName "ov." $of.GoName }}
+		case {{$of.DescNum}}: {{ if $of.TemplateCheck }}
+			{{GenTemplate $of.TemplateCheck $of "Buffer" "data[index:]"}} {{ end }}
+			ov, ok := x.{{ $field.GoName }}.(*{{ $of.OneofWrapper }})
+			if !ok {
+				ov = &{{ $of.OneofWrapper }}{}
+			}
+			{{GenTemplate $of.TemplateDecode $of "Buffer" "data[index:]" "VName" $ovname "Index" "index"}}
+			x.{{ $field.GoName }} = ov {{ end }} {{ else }} {{ $vname := ValueName "x." $field.GoName }}
+		case {{$field.DescNum}}: {{ if $field.TemplateCheck }}
+			{{GenTemplate $field.TemplateCheck $field "Buffer" "data[index:]"}} {{ end }}
+			{{GenTemplate $field.TemplateDecode $field "Buffer" "data[index:]" "VName" $vname "Index" "index"}} {{ end }} {{end}}
 		default: // skip fields
 			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
 			if cnt < 0 {
-				return protowire.ParseError(cnt)
-			}
+				return gopb.ParseError("{{ $msg.ProtoName }}", "", num, index, cnt)
+			} {{ if $msg.ExtensionRanges }}
+			if {{ range $i, $r := $msg.ExtensionRanges }}{{ if $i }} || {{ end }}({{ index $r 0 }} <= num && num < {{ index $r 1 }}){{ end }} {
+				x.extensionFields = protowire.AppendTag(x.extensionFields, num, typ)
+				x.extensionFields = append(x.extensionFields, data[index:index+cnt]...)
+				index += cnt
+				continue
+			} {{ end }} {{ if $msg.Unknown }}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...) {{ end }}
 			index += cnt
 		}
+	} {{ if .Limit }}
+	st.Leave() {{ end }}
+
+	return
+}
+
+{{ if .CheckRequired }}
+// IsInitialized check all required fields are set, including nested messages
+func (x *{{ .TypeName }}) IsInitialized() error {
+	if missing := x.AppendMissingRequired(nil, "{{ .DescName }}"); len(missing) > 0 {
+		return errors.New("required fields not set: " + strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// AppendMissingRequired append the path of required fields not set to missing. path is the path of x
+func (x *{{ .TypeName }}) AppendMissingRequired(missing []string, path string) []string { {{ range $i,$field := .Fields }} {{ $vname := ValueName "x." $field.GoName }} {{ if $field.Oneof }} {{ range $j,$of := $field.Oneof.Fields }} {{ if $of.CheckRequired }}
+	if ov, ok := {{ $vname }}.(*{{ $of.OneofWrapper }}); ok {
+		{{GenTemplate "check.required.message" $of "VName" (ValueName "ov." $of.GoName) "Path" "path"}}
+	} {{ end }} {{ end }} {{ else }} {{ if $field.Required }}
+	if {{ $vname }} == nil {
+		missing = append(missing, path+".{{ $field.DescName }}")
+	} {{ end }} {{ if $field.CheckRequired }} {{ if $field.IsMap }}
+	{{GenTemplate "check.required.map" $field "VName" $vname "Path" "path"}} {{ else if $field.IsList }}
+	{{GenTemplate "check.required.list" $field "VName" $vname "Path" "path"}} {{ else }}
+	{{GenTemplate "check.required.message" $field "VName" $vname "Path" "path"}} {{ end }} {{ end }} {{ end }} {{ end }}
+	return missing
+}
+
+// MarshalObjectChecked marshal data to []byte. return error if any required field is not set
+func (x *{{ .TypeName }}) MarshalObjectChecked() (data []byte, err error) {
+	if err = x.IsInitialized(); err != nil {
+		return
+	}
+	return x.MarshalObject()
+}
+
+// UnmarshalObjectChecked unmarshal data from []byte. return error if any required field is not set
+func (x *{{ .TypeName }}) UnmarshalObjectChecked(data []byte) (err error) {
+	if err = x.UnmarshalObject(data); err != nil {
+		return
 	}
+	return x.IsInitialized()
+}
+{{ end }}
+
+{{ if .ExtensionRanges }}
+// HasExtension report whether the extension field num is set
+func (x *{{ .TypeName }}) HasExtension(num protowire.Number) bool {
+	return len(x.GetExtension(num)) > 0
+}
 
+// GetExtension return the encoded records(tag and value) of the extension field num
+func (x *{{ .TypeName }}) GetExtension(num protowire.Number) (raw []byte) {
+	if x == nil {
+		return
+	}
+	for index := 0; index < len(x.extensionFields); {
+		n, typ, cnt := protowire.ConsumeTag(x.extensionFields[index:])
+		if cnt < 0 {
+			return
+		}
+		vcnt := protowire.ConsumeFieldValue(n, typ, x.extensionFields[index+cnt:])
+		if vcnt < 0 {
+			return
+		}
+		if n == num {
+			raw = append(raw, x.extensionFields[index:index+cnt+vcnt]...)
+		}
+		index += cnt + vcnt
+	}
 	return
 }
 
+// SetExtension replace the encoded records(tag and value) of the extension field num. nil raw clear the field
+func (x *{{ .TypeName }}) SetExtension(num protowire.Number, raw []byte) {
+	var fields []byte
+	for index := 0; index < len(x.extensionFields); {
+		n, typ, cnt := protowire.ConsumeTag(x.extensionFields[index:])
+		if cnt < 0 {
+			break
+		}
+		vcnt := protowire.ConsumeFieldValue(n, typ, x.extensionFields[index+cnt:])
+		if vcnt < 0 {
+			break
+		}
+		if n != num {
+			fields = append(fields, x.extensionFields[index:index+cnt+vcnt]...)
+		}
+		index += cnt + vcnt
+	}
+	x.extensionFields = append(fields, raw...)
+}
+{{ end }}
+
 {{ range $i, $tpl := .CustomTemplates }}
 	{{GenCustomTemplate $tpl $msg }}
 {{ end }}
 
 {{ end }}
 
+{{ range .Extensions }} {{ $ext := . }} {{ $field := .Field }}
+// {{ .TypeName }} is the descriptor type of extension {{ .FullName }}
+type {{ .TypeName }} struct{}
+
+{{ .LeadingComments }} var {{ .VarName }} {{ .TypeName }}
+
+// Number return the field number of extension
+func ({{ .TypeName }}) Number() protowire.Number {
+	return {{ $field.DescNum }}
+}
+
+// Name return the full name of extension
+func ({{ .TypeName }}) Name() string {
+	return "{{ .FullName }}"
+}
+
+// Has report whether the extension is set in x
+func ({{ .TypeName }}) Has(x *{{ .Extendee }}) bool {
+	return x.HasExtension({{ $field.DescNum }})
+}
+
+// Clear clear the extension in x
+func ({{ .TypeName }}) Clear(x *{{ .Extendee }}) {
+	x.SetExtension({{ $field.DescNum }}, nil)
+}
+
+// Get decode the extension value from x
+func ({{ .TypeName }}) Get(x *{{ .Extendee }}) (val {{ $field.TypeName }}, err error) {
+	data := x.GetExtension({{ $field.DescNum }}) {{ if $field.Limit }}
+	st := &gopb.UnmarshalState{}
+	_ = st {{ end }}
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("{{ $field.Message }}", "[{{ .FullName }}]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		{{GenTemplate $field.TemplateCheck $field "Buffer" "data[index:]" "Path" (print "[" .FullName "]")}}
+		{{GenTemplate $field.TemplateDecode $field "Buffer" "data[index:]" "VName" "val" "Index" "index" "Path" (print "[" .FullName "]")}}
+	}
+	return
+}
+
+// Set encode the extension value to x
+func ({{ .TypeName }}) Set(x *{{ .Extendee }}, val {{ $field.TypeName }}) (err error) {
+	var data []byte {{ if $field.CheckNotEmpty }}
+	if {{ call $field.CheckNotEmpty "val" }} {
+		{{GenTemplate $field.TemplateEncode $field "Buffer" "data" "VName" "val"}}
+	} {{ else }}
+	{{GenTemplate $field.TemplateEncode $field "Buffer" "data" "VName" "val"}} {{ end }}
+	x.SetExtension({{ $field.DescNum }}, data)
+	return
+}
+{{ end }}
+
+{{ if .RawDesc }}
+var {{ .FileVar }}_rawDesc = []byte{ {{ range .RawDesc }}
+	{{ . }} {{ end }}
+}
+
+var {{ .FileVar }} = {{ .RegisterFile }}("{{ .FilePath }}", {{ .FileVar }}_rawDesc)
+
+var {{ .FileVar }}_msgTypes = [...]{{ .ReflectMessageType }}{ {{ range .Messages }}
+	{{ $.FileVar }}.NewMessageType("{{ .FullName }}", (*{{ .TypeName }})(nil), []string{ {{- range $i, $name := .ReflectFields }}{{ if $i }}, {{ end }}"{{ $name }}"{{ end -}} } {{- range .ReflectWrappers }},
+		(*{{ . }})(nil){{ end }}), {{ end }}
+}
+{{ end }}
+
+{{ if .RegisterType }}
+func init() { {{ range .Messages }}
+	{{ $.RegisterType }}("{{ .FullName }}", func() {{ $.RuntimeMessage }} { return &{{ .TypeName }}{} }) {{ end }}
+}
+{{ end }}
+
 `
@@ -45,6 +45,10 @@ var SwitchTagType = func(typ string) protowire.Type {
 		return protowire.Fixed64Type
 	case "protowire.BytesType", "BytesType":
 		return protowire.BytesType
+	case "protowire.StartGroupType", "StartGroupType":
+		return protowire.StartGroupType
+	case "protowire.EndGroupType", "EndGroupType":
+		return protowire.EndGroupType
 	default:
 		return -1
 	}
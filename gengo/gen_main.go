@@ -9,7 +9,8 @@ import (
 )
 
 func GenExec(data *GenerateStruct) (_ []byte, err error) {
-	tpl := template.New("").Funcs(UseFuncMap)
+	// GenTemplate 的参数中不存在的键为空字符串, 可以继续传递给下一层模板
+	tpl := template.New("").Option("missingkey=zero").Funcs(UseFuncMap)
 	// 基础导入go包函数
 	if data.improt != nil {
 		tpl.Funcs(template.FuncMap{
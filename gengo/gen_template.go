@@ -3,64 +3,96 @@ package gengo
 var GenProtobufTemplate = map[string]string{
 	"check.bool": `
 		if typ != protowire.VarintType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : not varint type")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.VarintType"}}
 			return
 		}
 	`,
 	"check.varint": `
 		if typ != protowire.VarintType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : not varint type")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.VarintType"}}
 			return
 		}
 	`,
 	"check.sint": `
 		if typ != protowire.VarintType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : not varint zigzag type")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.VarintType"}}
 			return
 		}
 	`,
 	"check.fix32": `
 		if typ != protowire.Fixed32Type {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : not i32 type")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.Fixed32Type"}}
 			return
 		}
 	`,
 	"check.float": `
 		if typ != protowire.Fixed32Type {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : not i32 type")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.Fixed32Type"}}
 			return
 		}
 	`,
 	"check.fix64": `
 		if typ != protowire.Fixed64Type {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : not i64 type")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.Fixed64Type"}}
 			return
 		}
 	`,
 	"check.double": `
 		if typ != protowire.Fixed64Type {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : not i64 type")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.Fixed64Type"}}
 			return
 		}
 	`,
 	"check.string": `
 		if typ != protowire.BytesType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : not len type")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.BytesType"}}
 			return
 		}
 	`,
 	"check.bytes": `
 		if typ != protowire.BytesType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : not len type")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.BytesType"}}
 			return
 		}
 	`,
 	"check.message": `
 		if typ != protowire.BytesType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : not len type")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.BytesType"}}
 			return
 		}
 	`,
+	"check.group": `
+		if typ != protowire.StartGroupType {
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.StartGroupType"}}
+			return
+		}
+	`,
+	// packed 及非 packed 的标量列表都可以解析
+	"check.packed": `
+		if typ != protowire.BytesType && typ != {{.Field.WireType}} {
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" .Field.WireType}}
+			return
+		}
+	`,
+	"check.required.message": `
+		if {{.V.VName}} != nil {
+			missing = {{.V.VName}}.AppendMissingRequired(missing, {{.V.Path}}+".{{.Field.DescName}}")
+		}
+	`,
+	"check.required.list": `
+		for k, item := range {{.V.VName}} {
+			if item != nil {
+				missing = item.AppendMissingRequired(missing, {{.V.Path}}+".{{.Field.DescName}}["+strconv.Itoa(k)+"]")
+			}
+		}
+	`,
+	"check.required.map": `
+		for k, item := range {{.V.VName}} {
+			if item != nil {
+				missing = item.AppendMissingRequired(missing, {{.V.Path}}+".{{.Field.DescName}}["+fmt.Sprint(k)+"]")
+			}
+		}
+	`,
 
 	"encode.bool": `
 		// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, {{ .Field.WireType }}) => {{ TagBinary .Field.DescNum .Field.WireType }} 
@@ -97,7 +129,11 @@ var GenProtobufTemplate = map[string]string{
 		{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum .Field.WireType }})
 		{{.V.Buffer}} = protowire.AppendFixed64({{.V.Buffer}}, math.Float64bits({{.V.VName}}))
 	`,
-	"encode.string": `
+	"encode.string": `{{ if .Field.ValidateUTF8Marshal }}
+		if !utf8.ValidString({{.V.VName}}) {
+			err = gopb.MarshalUTF8Error("{{.Field.Message}}", "{{ or .V.Path .Field.DescName }}")
+			return
+		} {{ end }}
 		// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, {{ .Field.WireType }}) => {{ TagBinary .Field.DescNum .Field.WireType }}
 		{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum .Field.WireType }})
 		{{.V.Buffer}} = protowire.AppendString({{.V.Buffer}}, {{.V.VName}})
@@ -110,11 +146,86 @@ var GenProtobufTemplate = map[string]string{
 	"encode.message": `
 		// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, {{ .Field.WireType }}) => {{ TagBinary .Field.DescNum .Field.WireType }}
 		{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum .Field.WireType }})
-		{{.V.Buffer}} = protowire.AppendVarint({{.V.Buffer}}, uint64({{.V.VName}}.MarshalSize()))
-		{{.V.Buffer}}, err = {{.V.VName}}.MarshalObjectTo({{.V.Buffer}})
+		{{.V.Buffer}} = protowire.AppendVarint({{.V.Buffer}}, uint64({{.V.VName}}.{{ if .Field.SizeCache }}cachedSize{{ else }}MarshalSize{{ end }}()))
+		{{.V.Buffer}}, err = {{GenTemplate "encode.message.to" .Field "Buffer" .V.Buffer "VName" .V.VName}}
+		if err != nil {
+			return
+		}
+	`,
+
+	// 序列化子消息的表达式. 同一个go包中的消息调用未导出的方法, 传递 deterministic 参数
+	"encode.message.to": `
+		{{- if .Field.SizeCache }}{{.V.VName}}.marshalCachedTo({{.V.Buffer}}{{ if .Field.Deterministic }}, deterministic{{ end }})
+		{{- else if and .Field.Deterministic .Field.Local }}{{.V.VName}}.marshalTo({{.V.Buffer}}, deterministic)
+		{{- else if .Field.Deterministic }}gopb.MarshalTo({{.V.VName}}, {{.V.Buffer}}, deterministic)
+		{{- else }}{{.V.VName}}.MarshalObjectTo({{.V.Buffer}}){{ end -}}
+	`,
+	"encode.group": `
+		// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, {{ .Field.WireType }}) => {{ TagBinary .Field.DescNum .Field.WireType }}
+		{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum .Field.WireType }})
+		{{.V.Buffer}}, err = {{GenTemplate "encode.message.to" .Field "Buffer" .V.Buffer "VName" .V.VName}}
 		if err != nil {
 			return
 		}
+		// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, protowire.EndGroupType) => {{ TagBinary .Field.DescNum "protowire.EndGroupType" }}
+		{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum "protowire.EndGroupType" }})
+	`,
+	"encode.pointer": `
+		{{GenTemplate .Field.ElemTemplateEncode .Field "Buffer" .V.Buffer "VName" (ValueName "*" .V.VName)}}
+	`,
+	"encode.oneof": `
+		{{.V.Buffer}}, err = {{.V.VName}}.marshalOneofTo({{.V.Buffer}}{{ if .Field.Deterministic }}, deterministic{{ end }})
+		if err != nil {
+			return
+		}
+	`,
+
+	"encode.timestamp": `
+		{
+			wsecs, wnanos := {{.V.VName}}.Unix(), int64({{.V.VName}}.Nanosecond())
+			{{GenTemplate "encode.wkt.seconds" .Field "Buffer" .V.Buffer}}
+		}
+	`,
+	"encode.duration": `
+		{
+			wsecs, wnanos := int64(*{{.V.VName}}/time.Second), int64(*{{.V.VName}}%time.Second)
+			{{GenTemplate "encode.wkt.seconds" .Field "Buffer" .V.Buffer}}
+		}
+	`,
+	// Timestamp/Duration 共用: {int64 seconds = 1; int32 nanos = 2;}
+	"encode.wkt.seconds": `
+		wsize := 0
+		if wsecs != 0 {
+			wsize += 1 + protowire.SizeVarint(uint64(wsecs))
+		}
+		if wnanos != 0 {
+			wsize += 1 + protowire.SizeVarint(uint64(wnanos))
+		}
+		// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, {{ .Field.WireType }}) => {{ TagBinary .Field.DescNum .Field.WireType }}
+		{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum .Field.WireType }})
+		{{.V.Buffer}} = protowire.AppendVarint({{.V.Buffer}}, uint64(wsize))
+		if wsecs != 0 {
+			{{.V.Buffer}} = append({{.V.Buffer}}, 0x8)
+			{{.V.Buffer}} = protowire.AppendVarint({{.V.Buffer}}, uint64(wsecs))
+		}
+		if wnanos != 0 {
+			{{.V.Buffer}} = append({{.V.Buffer}}, 0x10)
+			{{.V.Buffer}} = protowire.AppendVarint({{.V.Buffer}}, uint64(wnanos))
+		}
+	`,
+	"encode.wrapper": `
+		{ {{ $wv := .Field.WrapperValue }} {{ $vname := ValueName "*" .V.VName }} {{ if eq $wv.Kind.String "bytes" }} {{ $vname = .V.VName }} {{ end }}
+			wsize := 0
+			if {{ call $wv.CheckNotEmpty $vname }} {
+				{{GenTemplate $wv.TemplateSize $wv "Size" "wsize" "VName" $vname}}
+			}
+			// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, {{ .Field.WireType }}) => {{ TagBinary .Field.DescNum .Field.WireType }}
+			{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum .Field.WireType }})
+			{{.V.Buffer}} = protowire.AppendVarint({{.V.Buffer}}, uint64(wsize))
+			if {{ call $wv.CheckNotEmpty $vname }} {
+				{{GenTemplate $wv.TemplateEncode $wv "Buffer" .V.Buffer "VName" $vname "Path" (print .Field.DescName ".value")}}
+			}
+		}
 	`,
 
 	"encode.packed.bool": `
@@ -183,6 +294,11 @@ var GenProtobufTemplate = map[string]string{
 	`,
 	"encode.packed.string": `
 		for k:=0; k<len({{.V.VName}}); k++ {
+			{{ if .Field.ValidateUTF8Marshal }}
+			if !utf8.ValidString({{.V.VName}}[k]) {
+				err = gopb.MarshalUTF8Error("{{.Field.Message}}", "{{ or .V.Path .Field.DescName }}")
+				return
+			} {{ end }}
 			// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, protowire.BytesType) => {{ TagBinary .Field.DescNum "protowire.BytesType" }}
 			{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum "protowire.BytesType" }})
 			{{.V.Buffer}} = protowire.AppendString({{.V.Buffer}}, {{.V.VName}}[k])
@@ -199,8 +315,8 @@ var GenProtobufTemplate = map[string]string{
 		for _, item := range {{.V.VName}} {
 			// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, protowire.BytesType) => {{ TagBinary .Field.DescNum "protowire.BytesType" }}
 			{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum "protowire.BytesType" }})
-			{{.V.Buffer}} = protowire.AppendVarint({{.V.Buffer}}, uint64(item.MarshalSize()))
-			{{.V.Buffer}}, err = item.MarshalObjectTo({{.V.Buffer}})
+			{{.V.Buffer}} = protowire.AppendVarint({{.V.Buffer}}, uint64(item.{{ if .Field.SizeCache }}cachedSize{{ else }}MarshalSize{{ end }}()))
+			{{.V.Buffer}}, err = {{GenTemplate "encode.message.to" .Field "Buffer" .V.Buffer "VName" "item"}}
 			if err != nil {
 				return
 			}
@@ -258,6 +374,11 @@ var GenProtobufTemplate = map[string]string{
 	`,
 	"encode.nopack.string": `
 		for k:=0; k<len ({{.V.VName}}); k++ {
+			{{ if .Field.ValidateUTF8Marshal }}
+			if !utf8.ValidString({{.V.VName}}[k]) {
+				err = gopb.MarshalUTF8Error("{{.Field.Message}}", "{{ or .V.Path .Field.DescName }}")
+				return
+			} {{ end }}
 			// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, {{ .Field.WireType }}) => {{ TagBinary .Field.DescNum .Field.WireType }}
 			{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum .Field.WireType }})
 			{{.V.Buffer}} = protowire.AppendString({{.V.Buffer}}, {{.V.VName}}[k])
@@ -274,18 +395,46 @@ var GenProtobufTemplate = map[string]string{
 		for _,item := range {{.V.VName}} {
 			// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, {{ .Field.WireType }}) => {{ TagBinary .Field.DescNum .Field.WireType }}
 			{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum .Field.WireType }})
-			{{.V.Buffer}} = protowire.AppendVarint({{.V.Buffer}}, uint64(item.MarshalSize()))
-			{{.V.Buffer}}, err = item.MarshalObjectTo({{.V.Buffer}})
+			{{.V.Buffer}} = protowire.AppendVarint({{.V.Buffer}}, uint64(item.{{ if .Field.SizeCache }}cachedSize{{ else }}MarshalSize{{ end }}()))
+			{{.V.Buffer}}, err = {{GenTemplate "encode.message.to" .Field "Buffer" .V.Buffer "VName" "item"}}
 			if err != nil {
 				return
 			}
 		}
 	`,
 
+	"encode.nopack.group": `
+		for _,item := range {{.V.VName}} {
+			// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, {{ .Field.WireType }}) => {{ TagBinary .Field.DescNum .Field.WireType }}
+			{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum .Field.WireType }})
+			{{.V.Buffer}}, err = {{GenTemplate "encode.message.to" .Field "Buffer" .V.Buffer "VName" "item"}}
+			if err != nil {
+				return
+			}
+			// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, protowire.EndGroupType) => {{ TagBinary .Field.DescNum "protowire.EndGroupType" }}
+			{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum "protowire.EndGroupType" }})
+		}
+	`,
+	"encode.nopack.wkt": `
+		for _, item := range {{.V.VName}} {
+			{{GenTemplate .Field.ElemTemplateEncode .Field "Buffer" .V.Buffer "VName" "item"}}
+		}
+	`,
+
+	// 解析错误. Code 为 protowire Consume 系列函数返回的长度, Path 为字段路径(默认为字段名)
+	"decode.error": `
+		gopb.ParseError("{{.Field.Message}}", "{{ or .V.Path .Field.DescName }}", {{.Field.DescNum}}, cap(data)-cap({{.V.Buffer}}), {{.V.Code}})
+	`,
+	"decode.error.wiretype": `
+		gopb.WireTypeMismatch("{{.Field.Message}}", "{{ or .V.Path .Field.DescName }}", {{.Field.DescNum}}, cap(data)-cap({{.V.Buffer}}), typ, {{.V.Want}})
+	`,
+	"decode.error.utf8": `
+		gopb.UTF8Error("{{.Field.Message}}", "{{ or .V.Path .Field.DescName }}", {{.Field.DescNum}}, cap(data)-cap({{.V.Buffer}}))
+	`,
 	"decode.bool": `
 		v, cnt := protowire.ConsumeVarint({{.V.Buffer}})
 		if cnt < 1 {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid varint value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
@@ -294,16 +443,25 @@ var GenProtobufTemplate = map[string]string{
 	"decode.varint": `
 		v, cnt := protowire.ConsumeVarint({{.V.Buffer}})
 		if cnt < 1 {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid varint value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
-		{{.V.Index}} += cnt
+		{{.V.Index}} += cnt {{ GenTemplate "decode.enum.closed" .Field "InMap" .V.InMap }}
 		{{.V.VName}} = {{.Field.GoType}}(v)
 	`,
+	// 封闭枚举(proto2, enum_type = CLOSED)的未知值保存在未知字段中, 不修改字段. map 的值由 decode.map 处理
+	"decode.enum.closed": `{{ if and .Field.ClosedEnum (not .V.InMap) }}
+		if _, ok := {{.Field.ClosedEnum}}[int32(v)]; !ok { {{ if .Field.Unknown }}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, {{.Field.DescNum}}, protowire.VarintType)
+			x.unknownFields = protowire.AppendVarint(x.unknownFields, v) {{ end }} {{ if .V.Index }}
+			{{.V.Index}} += cnt {{ end }}
+			continue
+		} {{ end }}
+	`,
 	"decode.sint": `
 		v, cnt := protowire.ConsumeVarint({{.V.Buffer}})
 		if cnt < 1 {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid varint zigzag value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
@@ -312,7 +470,7 @@ var GenProtobufTemplate = map[string]string{
 	"decode.fix32": `
 		v, cnt := protowire.ConsumeFixed32({{.V.Buffer}})
 		if cnt < 1 {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid i32 value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
@@ -321,7 +479,7 @@ var GenProtobufTemplate = map[string]string{
 	"decode.float": `
 		v, cnt := protowire.ConsumeFixed32({{.V.Buffer}})
 		if cnt < 1 {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid i32 value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
@@ -330,7 +488,7 @@ var GenProtobufTemplate = map[string]string{
 	"decode.fix64": `
 		v, cnt := protowire.ConsumeFixed64({{.V.Buffer}})
 		if cnt < 1 {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid i64 value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
@@ -339,43 +497,187 @@ var GenProtobufTemplate = map[string]string{
 	"decode.double": `
 		v, cnt := protowire.ConsumeFixed64({{.V.Buffer}})
 		if cnt < 1 {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid i64 value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
 		{{.V.VName}} = math.Float64frombits(v)
 	`,
-	"decode.string": `
+	"decode.string": `{{ if .Field.Alias }}
+		// alias: 引用输入数据
+		v, cnt := protowire.ConsumeBytes({{.V.Buffer}})
+		if v == nil {
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
+			return
+		}
+		{{ if .Field.ValidateUTF8 }}
+		if !utf8.Valid(v) {
+			err = {{GenTemplate "decode.error.utf8" .Field "Path" .V.Path "Buffer" .V.Buffer}}
+			return
+		} {{ end }}
+		{{.V.Index}} += cnt
+		{{.V.VName}} = unsafe.String(unsafe.SliceData(v), len(v)) {{ else }}
 		v, cnt := protowire.ConsumeString({{.V.Buffer}})
 		if cnt < 1 {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid len value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
-		}
+		} {{ if .Field.ValidateUTF8 }}
+		if !utf8.ValidString(v) {
+			err = {{GenTemplate "decode.error.utf8" .Field "Path" .V.Path "Buffer" .V.Buffer}}
+			return
+		} {{ end }}
 		{{.V.Index}} += cnt
-		{{.V.VName}} = v
+		{{.V.VName}} = v {{ end }}
 	`,
 	"decode.bytes": `
 		v, cnt := protowire.ConsumeBytes({{.V.Buffer}})
 		if v == nil {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid len value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
-		{{.V.Index}} += cnt
+		{{.V.Index}} += cnt {{ if .Field.Alias }}
+		// alias: 引用输入数据, 限制容量避免 append 覆盖后面的数据
+		{{.V.VName}} = v[:len(v):len(v)] {{ else }}
 		{{.V.VName}} = make([]byte, len(v))
-		copy({{.V.VName}}, v)
+		copy({{.V.VName}}, v) {{ end }}
 	`,
 	"decode.message": `
 		v, cnt := protowire.ConsumeBytes({{.V.Buffer}})
 		if v == nil {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid message value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
-		{{.V.VName}} = &{{.Field.GoType}}{}
-		err = {{.V.VName}}.UnmarshalObject(v)
+		// 多次出现时合并
+		if {{.V.VName}} == nil {
+			{{.V.VName}} = {{ if .Field.Pool }}Acquire{{.Field.GoType}}(){{ else }}&{{.Field.GoType}}{}{{ end }}
+		}
+		err = {{GenTemplate "decode.message.from" .Field "Buffer" "v" "VName" .V.VName}}
 		if err != nil {
+			err = gopb.WrapDecodeError(err, "{{.Field.Message}}", "{{ or .V.Path .Field.DescName }}", -1, cap(data)-cap(v))
+			return
+		}
+	`,
+	"decode.group": `
+		v, cnt := protowire.ConsumeGroup({{.Field.DescNum}}, {{.V.Buffer}})
+		if cnt < 0 {
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
+		{{.V.Index}} += cnt
+		// 多次出现时合并
+		if {{.V.VName}} == nil {
+			{{.V.VName}} = {{ if .Field.Pool }}Acquire{{.Field.GoType}}(){{ else }}&{{.Field.GoType}}{}{{ end }}
+		}
+		err = {{GenTemplate "decode.message.from" .Field "Buffer" "v" "VName" .V.VName}}
+		if err != nil {
+			err = gopb.WrapDecodeError(err, "{{.Field.Message}}", "{{ or .V.Path .Field.DescName }}", -1, cap(data)-cap(v))
+			return
+		}
+	`,
+	// 解析子消息的表达式. limit 参数传递解析状态, 同一个go包中的消息直接调用
+	"decode.message.from": `
+		{{- if not .Field.Limit }}{{.V.VName}}.UnmarshalObject({{.V.Buffer}})
+		{{- else if .Field.Local }}{{.V.VName}}.UnmarshalObjectState({{.V.Buffer}}, st)
+		{{- else }}gopb.UnmarshalWithState({{.V.VName}}, {{.V.Buffer}}, st){{ end -}}
+	`,
+	// 列表或 map 增加元素后检查 UnmarshalOptions 的限制
+	"decode.limit": `{{ if .Field.Limit }}
+		if !st.Grow(len({{.V.VName}})) {
+			err = gopb.LimitError("{{.Field.Message}}", "{{ or .V.Path .Field.DescName }}", {{.Field.DescNum}}, cap(data)-cap({{.V.Buffer}}))
+			return
+		} {{ end }}
+	`,
+	"decode.pointer": `
+		var pv {{.Field.GoType}}
+		{{GenTemplate .Field.ElemTemplateDecode .Field "Buffer" .V.Buffer "VName" "pv" "Index" .V.Index "Path" .V.Path}}
+		{{.V.VName}} = &pv
+	`,
+
+	"decode.timestamp": `
+		{{GenTemplate "decode.wkt.seconds" .Field "Buffer" .V.Buffer "Index" .V.Index "Path" .V.Path "Check" "CheckTimestamp"}}
+		wt := time.Unix(wsecs, wnanos).UTC()
+		{{.V.VName}} = &wt
+	`,
+	"decode.duration": `
+		{{GenTemplate "decode.wkt.seconds" .Field "Buffer" .V.Buffer "Index" .V.Index "Path" .V.Path "Check" "CheckDuration"}}
+		wd := time.Duration(wsecs)*time.Second + time.Duration(wnanos)
+		{{.V.VName}} = &wd
+	`,
+	// Timestamp/Duration 共用: {int64 seconds = 1; int32 nanos = 2;}
+	"decode.wkt.seconds": `
+		wbuf, cnt := protowire.ConsumeBytes({{.V.Buffer}})
+		if wbuf == nil {
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
+			return
+		}
+		{{.V.Index}} += cnt
+		var wsecs, wnanos int64
+		for windex := 0; windex < len(wbuf); {
+			wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+			if wcnt < 1 {
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" "wbuf[windex:]" "Code" "wcnt"}}
+				return
+			}
+			windex += wcnt
+			if (wnum == 1 || wnum == 2) && wtyp == protowire.VarintType {
+				v, wcnt := protowire.ConsumeVarint(wbuf[windex:])
+				if wcnt < 1 {
+					err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" "wbuf[windex:]" "Code" "wcnt"}}
+					return
+				}
+				windex += wcnt
+				if wnum == 1 {
+					wsecs = int64(v)
+				} else {
+					wnanos = int64(int32(v))
+				}
+				continue
+			}
+			wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+			if wcnt < 0 {
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" "wbuf[windex:]" "Code" "wcnt"}}
+				return
+			}
+			windex += wcnt
+		}
+		// 超出范围的值(例如超过 time.Duration 的约292年)返回错误, 不能静默溢出
+		if err = gopb.{{.V.Check}}(wsecs, wnanos); err != nil {
+			err = gopb.NewDecodeError("{{.Field.Message}}", "{{ or .V.Path .Field.DescName }}", {{.Field.DescNum}}, cap(data)-cap(wbuf), gopb.KindMalformed, err)
+			return
+		}
+	`,
+	"decode.wrapper": `{{ $wv := .Field.WrapperValue }}
+		wbuf, cnt := protowire.ConsumeBytes({{.V.Buffer}})
+		if wbuf == nil {
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
+			return
+		}
+		{{.V.Index}} += cnt
+		var wv {{ $wv.GoType }}
+		for windex := 0; windex < len(wbuf); {
+			wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+			if wcnt < 1 {
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" "wbuf[windex:]" "Code" "wcnt"}}
+				return
+			}
+			windex += wcnt
+			if wnum == 1 && wtyp == {{ $wv.WireType }} {
+				{{GenTemplate $wv.TemplateDecode $wv "Buffer" "wbuf[windex:]" "VName" "wv" "Index" "windex" "Path" (print (or .V.Path .Field.DescName) ".value")}}
+				continue
+			}
+			wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+			if wcnt < 0 {
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" "wbuf[windex:]" "Code" "wcnt"}}
+				return
+			}
+			windex += wcnt
+		} {{ if eq $wv.Kind.String "bytes" }}
+		if wv == nil {
+			wv = []byte{}
+		}
+		{{.V.VName}} = wv {{ else }}
+		{{.V.VName}} = &wv {{ end }}
 	`,
 
 	"decode.slice.bool": `
@@ -383,21 +685,22 @@ var GenProtobufTemplate = map[string]string{
 		if typ == protowire.VarintType {
 			v, cnt := protowire.ConsumeVarint({{.V.Buffer}})
 			if cnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid varint value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 				return
 			}
 			{{.V.VName}} = append({{.V.VName}}, protowire.DecodeBool(v))
+			{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 			{{.V.Index}} += cnt
 			continue
 		}
 		// packed = true
 		if typ != protowire.BytesType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid repeated tag value")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" .Field.WireType}}
 			return
 		}
 		buf, cnt := protowire.ConsumeBytes({{.V.Buffer}})
 		if buf == nil {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid len value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
@@ -408,11 +711,12 @@ var GenProtobufTemplate = map[string]string{
 		for sub < len(buf) {
 			v, cnt := protowire.ConsumeVarint(buf[sub:])
 			if cnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid item value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" "buf[sub:]" "Code" "cnt"}}
 				return
 			}
 			sub += cnt
 			{{.V.VName}} = append({{.V.VName}}, protowire.DecodeBool(v))
+			{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 		}
 	`,
 	"decode.slice.varint": `
@@ -420,21 +724,23 @@ var GenProtobufTemplate = map[string]string{
 		if typ == protowire.VarintType {
 			v, cnt := protowire.ConsumeVarint({{.V.Buffer}})
 			if cnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid varint value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 				return
 			}
+			{{GenTemplate "decode.enum.closed" .Field "Index" .V.Index}}
 			{{.V.VName}} = append({{.V.VName}}, {{.Field.GoType}}(v))
+			{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 			{{.V.Index}} += cnt
 			continue
 		}
 		// packed = true
 		if typ != protowire.BytesType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid repeated tag value")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" .Field.WireType}}
 			return
 		}
 		buf, cnt := protowire.ConsumeBytes({{.V.Buffer}})
 		if buf == nil {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid len value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
@@ -445,11 +751,12 @@ var GenProtobufTemplate = map[string]string{
 		for sub < len(buf) {
 			v, cnt := protowire.ConsumeVarint(buf[sub:])
 			if cnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid item value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" "buf[sub:]" "Code" "cnt"}}
 				return
 			}
-			sub += cnt
+			sub += cnt {{GenTemplate "decode.enum.closed" .Field}}
 			{{.V.VName}} = append({{.V.VName}}, {{.Field.GoType}}(v))
+			{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 		}
 	`,
 	"decode.slice.sint": `
@@ -457,21 +764,22 @@ var GenProtobufTemplate = map[string]string{
 		if typ == protowire.VarintType {
 			v, cnt := protowire.ConsumeVarint({{.V.Buffer}})
 			if cnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid varint value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 				return
 			}
 			{{.V.VName}} = append({{.V.VName}}, {{.Field.GoType}}(protowire.DecodeZigZag(v)))
+			{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 			{{.V.Index}} += cnt
 			continue
 		}
 		// packed = true
 		if typ != protowire.BytesType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid repeated tag value")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" .Field.WireType}}
 			return
 		}
 		buf, cnt := protowire.ConsumeBytes({{.V.Buffer}})
 		if buf == nil {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid len value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
@@ -482,11 +790,12 @@ var GenProtobufTemplate = map[string]string{
 		for sub < len(buf) {
 			v, cnt := protowire.ConsumeVarint(buf[sub:])
 			if cnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid item value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" "buf[sub:]" "Code" "cnt"}}
 				return
 			}
 			sub += cnt
 			{{.V.VName}} = append({{.V.VName}}, {{.Field.GoType}}(protowire.DecodeZigZag(v)))
+			{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 		}
 	`,
 	"decode.slice.fix32": `
@@ -494,21 +803,22 @@ var GenProtobufTemplate = map[string]string{
 		if typ == protowire.Fixed32Type {
 			v, cnt := protowire.ConsumeFixed32({{.V.Buffer}})
 			if cnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid varint value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 				return
 			}
 			{{.V.VName}} = append({{.V.VName}}, {{.Field.GoType}}(v))
+			{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 			{{.V.Index}} += cnt
 			continue
 		}
 		// packed = true
 		if typ != protowire.BytesType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid repeated tag value")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" .Field.WireType}}
 			return
 		}
 		buf, cnt := protowire.ConsumeBytes({{.V.Buffer}})
 		if buf == nil {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid len value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
@@ -519,11 +829,12 @@ var GenProtobufTemplate = map[string]string{
 		for sub < len(buf) {
 			v, cnt := protowire.ConsumeFixed32(buf[sub:])
 			if cnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid item value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" "buf[sub:]" "Code" "cnt"}}
 				return
 			}
 			sub += cnt
 			{{.V.VName}} = append({{.V.VName}}, {{.Field.GoType}}(v))
+			{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 		}
 	`,
 	"decode.slice.float": `
@@ -531,21 +842,22 @@ var GenProtobufTemplate = map[string]string{
 		if typ == protowire.Fixed32Type {
 			v, cnt := protowire.ConsumeFixed32({{.V.Buffer}})
 			if cnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid varint value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 				return
 			}
 			{{.V.VName}} = append({{.V.VName}}, math.Float32frombits(v))
+			{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 			{{.V.Index}} += cnt
 			continue
 		}
 		// packed = true
 		if typ != protowire.BytesType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid repeated tag value")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" .Field.WireType}}
 			return
 		}
 		buf, cnt := protowire.ConsumeBytes({{.V.Buffer}})
 		if buf == nil {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid len value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
@@ -556,11 +868,12 @@ var GenProtobufTemplate = map[string]string{
 		for sub < len(buf) {
 			v, cnt := protowire.ConsumeFixed32(buf[sub:])
 			if cnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid item value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" "buf[sub:]" "Code" "cnt"}}
 				return
 			}
 			sub += cnt
 			{{.V.VName}} = append({{.V.VName}}, math.Float32frombits(v))
+			{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 		}
 	`,
 	"decode.slice.fix64": `
@@ -568,21 +881,22 @@ var GenProtobufTemplate = map[string]string{
 		if typ == protowire.Fixed64Type {
 			v, cnt := protowire.ConsumeFixed64({{.V.Buffer}})
 			if cnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid varint value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 				return
 			}
 			{{.V.VName}} = append({{.V.VName}}, {{.Field.GoType}}(v))
+			{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 			{{.V.Index}} += cnt
 			continue
 		}
 		// packed = true
 		if typ != protowire.BytesType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid repeated tag value")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" .Field.WireType}}
 			return
 		}
 		buf, cnt := protowire.ConsumeBytes({{.V.Buffer}})
 		if buf == nil {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid len value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
@@ -593,11 +907,12 @@ var GenProtobufTemplate = map[string]string{
 		for sub < len(buf) {
 			v, cnt := protowire.ConsumeFixed64(buf[sub:])
 			if cnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid item value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" "buf[sub:]" "Code" "cnt"}}
 				return
 			}
 			sub += cnt
 			{{.V.VName}} = append({{.V.VName}}, {{.Field.GoType}}(v))
+			{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 		}
 	`,
 	"decode.slice.double": `
@@ -605,21 +920,22 @@ var GenProtobufTemplate = map[string]string{
 		if typ == protowire.Fixed64Type {
 			v, cnt := protowire.ConsumeFixed64({{.V.Buffer}})
 			if cnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid varint value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 				return
 			}
 			{{.V.VName}} = append({{.V.VName}}, math.Float64frombits(v))
+			{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 			{{.V.Index}} += cnt
 			continue
 		}
 		// packed = true
 		if typ != protowire.BytesType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid repeated tag value")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" .Field.WireType}}
 			return
 		}
 		buf, cnt := protowire.ConsumeBytes({{.V.Buffer}})
 		if buf == nil {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid len value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
@@ -630,65 +946,110 @@ var GenProtobufTemplate = map[string]string{
 		for sub < len(buf) {
 			v, cnt := protowire.ConsumeFixed64(buf[sub:])
 			if cnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid item value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" "buf[sub:]" "Code" "cnt"}}
 				return
 			}
 			sub += cnt
 			{{.V.VName}} = append({{.V.VName}}, math.Float64frombits(v))
+			{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 		}
 	`,
 	"decode.slice.string": `
 		if typ != protowire.BytesType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid repeated tag value")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.BytesType"}}
 			return
 		}
 		buf, cnt := protowire.ConsumeBytes({{.V.Buffer}})
 		if buf == nil {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid len value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
-		}
+		} {{ if .Field.ValidateUTF8 }}
+		if !utf8.Valid(buf) {
+			err = {{GenTemplate "decode.error.utf8" .Field "Path" .V.Path "Buffer" .V.Buffer}}
+			return
+		} {{ end }}
 		{{.V.Index}} += cnt
 		if {{.V.VName}} == nil {
 			{{.V.VName}} = make([]string, 0, 2)
-		}
-		{{.V.VName}} = append({{.V.VName}}, string(buf))
+		} {{ if .Field.Alias }}
+		{{.V.VName}} = append({{.V.VName}}, unsafe.String(unsafe.SliceData(buf), len(buf))) {{ else }}
+		{{.V.VName}} = append({{.V.VName}}, string(buf)) {{ end }}
+		{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 	`,
 	"decode.slice.bytes": `
 		if typ != protowire.BytesType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid repeated tag value")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.BytesType"}}
 			return
 		}
 		buf, cnt := protowire.ConsumeBytes({{.V.Buffer}})
 		if buf == nil {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid len value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
 		if {{.V.VName}} == nil {
 			{{.V.VName}} = make([][]byte, 0, 2)
-		}
-		{{.V.VName}} = append({{.V.VName}}, buf)
+		} {{ if .Field.Alias }}
+		{{.V.VName}} = append({{.V.VName}}, buf[:len(buf):len(buf)]) {{ else }}
+		item := make([]byte, len(buf))
+		copy(item, buf)
+		{{.V.VName}} = append({{.V.VName}}, item) {{ end }}
+		{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
 	`,
 	"decode.slice.message": `
 		if typ != protowire.BytesType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid repeated tag value")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.BytesType"}}
 			return
 		}
 		buf, cnt := protowire.ConsumeBytes({{.V.Buffer}})
 		if buf == nil {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid len value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
+			return
+		}
+		{{.V.Index}} += cnt
+		if {{.V.VName}} == nil {
+			{{.V.VName}} = make([]*{{.Field.GoType}}, 0, 2)
+		}
+		item := {{ if .Field.Pool }}Acquire{{.Field.GoType}}(){{ else }}&{{.Field.GoType}}{}{{ end }}
+		err = {{GenTemplate "decode.message.from" .Field "Buffer" "buf" "VName" "item"}}
+		if err != nil {
+			err = gopb.WrapDecodeError(err, "{{.Field.Message}}", "{{ or .V.Path .Field.DescName }}", len({{.V.VName}}), cap(data)-cap(buf))
+			return
+		}
+		{{.V.VName}} = append({{.V.VName}}, item)
+		{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
+	`,
+	"decode.slice.group": `
+		if typ != protowire.StartGroupType {
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.StartGroupType"}}
+			return
+		}
+		buf, cnt := protowire.ConsumeGroup({{.Field.DescNum}}, {{.V.Buffer}})
+		if cnt < 0 {
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
 		if {{.V.VName}} == nil {
 			{{.V.VName}} = make([]*{{.Field.GoType}}, 0, 2)
 		}
-		item := &{{.Field.GoType}}{}
-		err = item.UnmarshalObject(buf)
+		item := {{ if .Field.Pool }}Acquire{{.Field.GoType}}(){{ else }}&{{.Field.GoType}}{}{{ end }}
+		err = {{GenTemplate "decode.message.from" .Field "Buffer" "buf" "VName" "item"}}
 		if err != nil {
+			err = gopb.WrapDecodeError(err, "{{.Field.Message}}", "{{ or .V.Path .Field.DescName }}", len({{.V.VName}}), cap(data)-cap(buf))
 			return
 		}
 		{{.V.VName}} = append({{.V.VName}}, item)
+		{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
+	`,
+	"decode.slice.wkt": `
+		if typ != protowire.BytesType {
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.BytesType"}}
+			return
+		}
+		{{.V.VName}} = append({{.V.VName}}, nil)
+		{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
+		{{GenTemplate .Field.ElemTemplateDecode .Field "Buffer" .V.Buffer "VName" (ValueName .V.VName "[len(" .V.VName ")-1]") "Index" .V.Index "Path" .V.Path}}
 	`,
 
 	"size.bool": `
@@ -729,7 +1090,52 @@ var GenProtobufTemplate = map[string]string{
 	`,
 	"size.message": `
 		// {{ TagSize .Field.DescNum }} = protowire.SizeTag({{.Field.DescNum}})
-		{{.V.Size}} += {{ TagSize .Field.DescNum }} +  protowire.SizeBytes({{.V.VName}}.MarshalSize())
+		{{.V.Size}} += {{ TagSize .Field.DescNum }} +  protowire.SizeBytes({{.V.VName}}.{{ if and .Field.SizeCache .V.Cached }}cachedSize{{ else }}MarshalSize{{ end }}())
+	`,
+	"size.group": `
+		// {{ TagSize .Field.DescNum }} = protowire.SizeTag({{.Field.DescNum}})
+		{{.V.Size}} += 2*{{ TagSize .Field.DescNum }} + {{.V.VName}}.MarshalSize()
+	`,
+	"size.pointer": `
+		{{GenTemplate .Field.ElemTemplateSize .Field "Size" .V.Size "VName" (ValueName "*" .V.VName)}}
+	`,
+	"size.oneof": `
+		{{.V.Size}} += {{.V.VName}}.marshalOneofSize()
+	`,
+
+	"size.timestamp": `
+		{
+			wsecs, wnanos := {{.V.VName}}.Unix(), int64({{.V.VName}}.Nanosecond())
+			{{GenTemplate "size.wkt.seconds" .Field "Size" .V.Size}}
+		}
+	`,
+	"size.duration": `
+		{
+			wsecs, wnanos := int64(*{{.V.VName}}/time.Second), int64(*{{.V.VName}}%time.Second)
+			{{GenTemplate "size.wkt.seconds" .Field "Size" .V.Size}}
+		}
+	`,
+	// Timestamp/Duration 共用: {int64 seconds = 1; int32 nanos = 2;}
+	"size.wkt.seconds": `
+		wsize := 0
+		if wsecs != 0 {
+			wsize += 1 + protowire.SizeVarint(uint64(wsecs))
+		}
+		if wnanos != 0 {
+			wsize += 1 + protowire.SizeVarint(uint64(wnanos))
+		}
+		// {{ TagSize .Field.DescNum }} = protowire.SizeTag({{.Field.DescNum}})
+		{{.V.Size}} += {{ TagSize .Field.DescNum }} + protowire.SizeBytes(wsize)
+	`,
+	"size.wrapper": `
+		{ {{ $wv := .Field.WrapperValue }} {{ $vname := ValueName "*" .V.VName }} {{ if eq $wv.Kind.String "bytes" }} {{ $vname = .V.VName }} {{ end }}
+			wsize := 0
+			if {{ call $wv.CheckNotEmpty $vname }} {
+				{{GenTemplate $wv.TemplateSize $wv "Size" "wsize" "VName" $vname}}
+			}
+			// {{ TagSize .Field.DescNum }} = protowire.SizeTag({{.Field.DescNum}})
+			{{.V.Size}} += {{ TagSize .Field.DescNum }} + protowire.SizeBytes(wsize)
+		}
 	`,
 
 	"size.packed.bool": `
@@ -846,51 +1252,116 @@ var GenProtobufTemplate = map[string]string{
 			{{.V.Size}} += protowire.SizeBytes({{.V.VName}}[k].MarshalSize())
 		}
 	`,
+	"size.nopack.group": `
+		// {{ TagSize .Field.DescNum }} = protowire.SizeTag({{.Field.DescNum}})
+		{{.V.Size}} += 2*{{ TagSize .Field.DescNum }} * len({{.V.VName}})
+		for k:=0; k<len({{.V.VName}}); k++ {
+			{{.V.Size}} += {{.V.VName}}[k].MarshalSize()
+		}
+	`,
+	"size.nopack.wkt": `
+		for _, item := range {{.V.VName}} {
+			{{GenTemplate .Field.ElemTemplateSize .Field "Size" .V.Size "VName" "item"}}
+		}
+	`,
 
-	"encode.map": `
+	"encode.map": `{{ if .Field.Deterministic }}
+		if deterministic { {{ if eq .Field.MapKey.Kind.String "bool" }}
+			for _, mk := range [2]bool{false, true} {
+				mv, ok := {{ $.V.VName }}[mk]
+				if !ok {
+					continue
+				} {{ else }}
+			for _, mk := range gopb.SortedMapKeys({{ $.V.VName }}) {
+				mv := {{ $.V.VName }}[mk] {{ end }}
+				{{GenTemplate "encode.map.entry" .Field "Buffer" .V.Buffer}}
+			}
+		} else { {{ end }}
 		for mk, mv := range {{ $.V.VName }} {
-			// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, protowire.BytesType) => {{ TagBinary .Field.DescNum "protowire.BytesType" }}
-			{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum "protowire.BytesType" }})
-			msize := 0 
-			{{GenTemplate .Field.MapKey.TemplateSize .Field.MapKey "Size" "msize" "VName" "mk"}}
-			{{GenTemplate .Field.MapValue.TemplateSize .Field.MapValue "Size" "msize" "VName" "mv"}}
-			{{.V.Buffer}} = protowire.AppendVarint({{.V.Buffer}}, uint64(msize))
-			{{GenTemplate .Field.MapKey.TemplateEncode .Field.MapKey "Buffer" .V.Buffer "VName" "mk"}}
-			{{GenTemplate .Field.MapValue.TemplateEncode .Field.MapValue "Buffer" .V.Buffer "VName" "mv"}}
-		}
+			{{GenTemplate "encode.map.entry" .Field "Buffer" .V.Buffer}}
+		} {{ if .Field.Deterministic }}
+		} {{ end }}
+	`,
+	"encode.map.entry": `
+		// {{.V.Buffer}} = protowire.AppendTag({{.V.Buffer}}, {{ .Field.DescNum }}, protowire.BytesType) => {{ TagBinary .Field.DescNum "protowire.BytesType" }}
+		{{.V.Buffer}} = append({{.V.Buffer}}, {{ TagByes .Field.DescNum "protowire.BytesType" }})
+		msize := 0 
+		{{GenTemplate .Field.MapKey.TemplateSize .Field.MapKey "Size" "msize" "VName" "mk"}}
+		{{GenTemplate .Field.MapValue.TemplateSize .Field.MapValue "Size" "msize" "VName" "mv" "Cached" "true"}}
+		{{.V.Buffer}} = protowire.AppendVarint({{.V.Buffer}}, uint64(msize))
+		{{GenTemplate .Field.MapKey.TemplateEncode .Field.MapKey "Buffer" .V.Buffer "VName" "mk" "Path" (print .Field.DescName ".key")}}
+		{{GenTemplate .Field.MapValue.TemplateEncode .Field.MapValue "Buffer" .V.Buffer "VName" "mv" "Path" (print .Field.DescName ".value")}}
 	`,
 	"decode.map": `
 		if typ != protowire.BytesType {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid repeated tag value")
+			err = {{GenTemplate "decode.error.wiretype" .Field "Path" .V.Path "Buffer" .V.Buffer "Want" "protowire.BytesType"}}
 			return
 		}
 		buf, cnt := protowire.ConsumeBytes({{.V.Buffer}})
 		if buf == nil {
-			err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid len value")
+			err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" .V.Buffer "Code" "cnt"}}
 			return
 		}
 		{{.V.Index}} += cnt
 		if {{.V.VName}}  == nil {
 			{{.V.VName}}  = make({{.Field.TypeName}})
 		}
+		{{ $path := or .V.Path .Field.DescName }}
+		// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
 		var mk {{.Field.MapKey.TypeName}}
 		var mv {{.Field.MapValue.TypeName}}
 		for sindex := 0; sindex < len(buf); {
 			mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
 			if scnt < 1 {
-				err = errors.New("parse {{.Field.Tip}} ID:{{.Field.DescNum}} : invalid varint value")
+				err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" "buf[sindex:]" "Code" "scnt"}}
 				return
 			}
-			_ = typ
 			sindex += scnt
-			switch mi {
-			case 1:
-				{{GenTemplate .Field.MapKey.TemplateDecode .Field.MapKey "Buffer" "buf[sindex:]" "VName" "mk" "Index" "sindex"}}
-			case 2:
-				{{GenTemplate .Field.MapValue.TemplateDecode .Field.MapValue "Buffer" "buf[sindex:]" "VName" "mv" "Index" "sindex"}}
+			// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+			switch { {{ if .Field.MapKey.TemplateCheck }}
+			case mi == 1:
+				{{GenTemplate .Field.MapKey.TemplateCheck .Field.MapKey "Buffer" "buf[sindex:]" "Path" (print $path ".key")}} {{ else }}
+			case mi == 1 && typ == {{.Field.MapKey.WireType}}: {{ end }}
+				{{GenTemplate .Field.MapKey.TemplateDecode .Field.MapKey "Buffer" "buf[sindex:]" "VName" "mk" "Index" "sindex" "Path" (print $path ".key")}} {{ if .Field.MapValue.TemplateCheck }}
+			case mi == 2:
+				{{GenTemplate .Field.MapValue.TemplateCheck .Field.MapValue "Buffer" "buf[sindex:]" "Path" (print $path ".value")}} {{ else }}
+			case mi == 2 && typ == {{.Field.MapValue.WireType}}: {{ end }}
+				{{GenTemplate .Field.MapValue.TemplateDecode .Field.MapValue "Buffer" "buf[sindex:]" "VName" "mv" "Index" "sindex" "Path" (print $path ".value") "InMap" "true"}}
+			default:
+				scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+				if scnt < 0 {
+					err = {{GenTemplate "decode.error" .Field "Path" .V.Path "Buffer" "buf[sindex:]" "Code" "scnt"}}
+					return
+				}
+				sindex += scnt
 			}
-		}
+		} {{ if eq .Field.MapValue.Kind.String "message" }}
+		if mv == nil {
+			{{GenTemplate "decode.map.empty" .Field.MapValue}}
+		} {{ end }} {{ if .Field.MapValue.ClosedEnum }}
+		// 值为封闭枚举的未知值时, 整个条目保存在未知字段中
+		if _, ok := {{.Field.MapValue.ClosedEnum}}[int32(mv)]; !ok { {{ if .Field.Unknown }}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, {{.Field.DescNum}}, protowire.BytesType)
+			x.unknownFields = protowire.AppendBytes(x.unknownFields, buf) {{ end }}
+			continue
+		} {{ end }}
 		{{.V.VName}}[mk] = mv
+		{{GenTemplate "decode.limit" .Field "Path" .V.Path "Buffer" .V.Buffer "VName" .V.VName}}
+	`,
+	// map 条目缺少消息类型的值时使用空消息
+	"decode.map.empty": `
+		{{- if eq .Field.WKT "timestamp" }}
+		wt := time.Unix(0, 0).UTC()
+		mv = &wt
+		{{- else if eq .Field.WKT "duration" }}
+		mv = new(time.Duration)
+		{{- else if and (eq .Field.WKT "wrapper") (eq .Field.WrapperValue.Kind.String "bytes") }}
+		mv = []byte{}
+		{{- else if eq .Field.WKT "wrapper" }}
+		mv = new({{.Field.WrapperValue.GoType}})
+		{{- else }}
+		mv = {{ if .Field.Pool }}Acquire{{.Field.GoType}}(){{ else }}&{{.Field.GoType}}{}{{ end }}
+		{{- end }}
 	`,
 	"size.map": `
 		for mk, mv := range {{.V.VName}} {
@@ -904,4 +1375,186 @@ var GenProtobufTemplate = map[string]string{
 			size += protowire.SizeBytes(msize)
 		}
 	`,
+
+	"copy.value": `
+		{{.V.Dst}} = {{.V.Src}}
+	`,
+	// Checked: 调用者已经检查 Src 不为 nil (Merge 中的 CheckNotEmpty), 不再重复检查
+	"copy.pointer": `{{ if .V.Checked -}}
+		pv := *{{.V.Src}}
+		{{.V.Dst}} = &pv {{ else -}}
+		if {{.V.Src}} != nil {
+			pv := *{{.V.Src}}
+			{{.V.Dst}} = &pv
+		} else {
+			{{.V.Dst}} = nil
+		} {{ end }}
+	`,
+	"copy.bytes": `{{ if .V.Checked -}}
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入
+		{{.V.Dst}} = append([]byte{}, {{.V.Src}}...) {{ else -}}
+		if {{.V.Src}} != nil {
+			// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入.
+			// 空的值为 []byte{}, 保留存在性
+			{{.V.Dst}} = append([]byte{}, {{.V.Src}}...)
+		} else {
+			{{.V.Dst}} = nil
+		} {{ end }}
+	`,
+	"copy.message": `
+		if {{.V.Src}} != nil {
+			if {{.V.Dst}} == nil {
+				{{.V.Dst}} = &{{.Field.GoType}}{}
+			}
+			{{.V.Dst}}.CopyFrom({{.V.Src}})
+		} else {
+			{{.V.Dst}} = nil
+		}
+	`,
+	"copy.oneof": `
+		if {{.V.Src}} != nil {
+			{{.V.Dst}} = {{.V.Src}}.copyOneof()
+		} else {
+			{{.V.Dst}} = nil
+		}
+	`,
+	"copy.list.value": `
+		{{.V.Dst}} = append({{.V.Dst}}[:0], {{.V.Src}}...)
+	`,
+	"copy.list": `
+		// 只复用原来长度内的元素. 超出长度的部分(例如 Release 截断的列表)可能已被其他消息使用, 置为零值后重新分配
+		if n, old := len({{.V.Src}}), len({{.V.Dst}}); n <= old {
+			{{.V.Dst}} = {{.V.Dst}}[:n]
+		} else {
+			{{.V.Dst}} = append({{.V.Dst}}, make({{.Field.TypeName}}, n-old)...)
+		}
+		for k, item := range {{.V.Src}} {
+			{{GenTemplate .Field.ElemTemplateCopy .Field "Dst" (ValueName .V.Dst "[k]") "Src" "item"}}
+		}
+	`,
+	"copy.map": `
+		if {{.V.Dst}} == nil && {{.V.Src}} != nil {
+			{{.V.Dst}} = make({{.Field.TypeName}}, len({{.V.Src}}))
+		}
+		for mk := range {{.V.Dst}} {
+			delete({{.V.Dst}}, mk)
+		}
+		for mk, mv := range {{.V.Src}} {
+			{{GenTemplate .Field.MapValue.TemplateCopy .Field.MapValue "Dst" (ValueName .V.Dst "[mk]") "Src" "mv"}}
+		}
+	`,
+
+	"equal.value": `
+		if {{.V.X}} != {{.V.Y}} {
+			return false
+		}
+	`,
+	"equal.float": `
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if {{.V.X}} != {{.V.Y}} && !(math.IsNaN(float64({{.V.X}})) && math.IsNaN(float64({{.V.Y}}))) {
+			return false
+		}
+	`,
+	"equal.bytes": `
+		if !bytes.Equal({{.V.X}}, {{.V.Y}}) {
+			return false
+		}
+	`,
+	"equal.bytes.presence": `
+		if ({{.V.X}} == nil) != ({{.V.Y}} == nil) || !bytes.Equal({{.V.X}}, {{.V.Y}}) {
+			return false
+		}
+	`,
+	"equal.pointer": `
+		if ({{.V.X}} == nil) != ({{.V.Y}} == nil) {
+			return false
+		}
+		if {{.V.X}} != nil {
+			{{GenTemplate .Field.ElemTemplateEqual .Field "X" (ValueName "*" .V.X) "Y" (ValueName "*" .V.Y)}}
+		}
+	`,
+	"equal.message": `
+		if !{{.V.X}}.Equal({{.V.Y}}) {
+			return false
+		}
+	`,
+	"equal.oneof": `
+		if ({{.V.X}} == nil) != ({{.V.Y}} == nil) || {{.V.X}} != nil && !{{.V.X}}.equalOneof({{.V.Y}}) {
+			return false
+		}
+	`,
+	"equal.timestamp": `
+		if ({{.V.X}} == nil) != ({{.V.Y}} == nil) || {{.V.X}} != nil && !{{.V.X}}.Equal(*{{.V.Y}}) {
+			return false
+		}
+	`,
+	"equal.duration": `
+		if ({{.V.X}} == nil) != ({{.V.Y}} == nil) || {{.V.X}} != nil && *{{.V.X}} != *{{.V.Y}} {
+			return false
+		}
+	`,
+	"equal.wrapper": `{{ $wv := .Field.WrapperValue }} {{ if eq $wv.Kind.String "bytes" }}
+		if ({{.V.X}} == nil) != ({{.V.Y}} == nil) || !bytes.Equal({{.V.X}}, {{.V.Y}}) {
+			return false
+		} {{ else }}
+		if ({{.V.X}} == nil) != ({{.V.Y}} == nil) {
+			return false
+		}
+		if {{.V.X}} != nil {
+			{{GenTemplate $wv.TemplateEqual $wv "X" (ValueName "*" .V.X) "Y" (ValueName "*" .V.Y)}}
+		} {{ end }}
+	`,
+	"equal.list": `
+		if len({{.V.X}}) != len({{.V.Y}}) {
+			return false
+		}
+		for k := range {{.V.X}} {
+			{{GenTemplate .Field.ElemTemplateEqual .Field "X" (ValueName .V.X "[k]") "Y" (ValueName .V.Y "[k]")}}
+		}
+	`,
+	"equal.map": `
+		if len({{.V.X}}) != len({{.V.Y}}) {
+			return false
+		}
+		for mk, mv := range {{.V.X}} {
+			ov, ok := {{.V.Y}}[mk]
+			if !ok {
+				return false
+			}
+			{{GenTemplate .Field.MapValue.TemplateEqual .Field.MapValue "X" "mv" "Y" "ov"}}
+		}
+	`,
+
+	"merge.message": `{{ if .V.Checked -}}
+		if {{.V.Dst}} == nil {
+			{{.V.Dst}} = &{{.Field.GoType}}{}
+		}
+		{{.V.Dst}}.Merge({{.V.Src}}) {{ else -}}
+		if {{.V.Src}} != nil {
+			if {{.V.Dst}} == nil {
+				{{.V.Dst}} = &{{.Field.GoType}}{}
+			}
+			{{.V.Dst}}.Merge({{.V.Src}})
+		} {{ end }}
+	`,
+	"merge.oneof": `
+		{{.V.Dst}} = {{.V.Src}}.mergeOneof({{.V.Dst}})
+	`,
+	"merge.list.value": `
+		{{.V.Dst}} = append({{.V.Dst}}, {{.V.Src}}...)
+	`,
+	"merge.list": `
+		for _, item := range {{.V.Src}} {
+			{{.V.Dst}} = append({{.V.Dst}}, nil)
+			{{GenTemplate .Field.ElemTemplateCopy .Field "Dst" (ValueName .V.Dst "[len(" .V.Dst ")-1]") "Src" "item"}}
+		}
+	`,
+	"merge.map": `
+		if {{.V.Dst}} == nil {
+			{{.V.Dst}} = make({{.Field.TypeName}}, len({{.V.Src}}))
+		}
+		for mk, mv := range {{.V.Src}} {
+			{{GenTemplate .Field.MapValue.TemplateCopy .Field.MapValue "Dst" (ValueName .V.Dst "[mk]") "Src" "mv"}}
+		}
+	`,
 }
@@ -0,0 +1,56 @@
+package genparse
+
+import (
+	"github.com/aggronmagi/protoc-gen-gopb/gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// aliasOptionNumber gopb/gopb.proto 中字段选项 (gopb.alias) 的编号
+const aliasOptionNumber protowire.Number = 60001
+
+// fieldAliasOption returns the value of the field option (gopb.alias).
+// 插件没有链接选项的go类型, 选项保存在 FieldOptions 的未知字段中
+func fieldAliasOption(field protoreflect.FieldDescriptor) (alias, ok bool) {
+	opts, _ := field.Options().(*descriptorpb.FieldOptions)
+	if opts == nil {
+		return
+	}
+	raw := opts.ProtoReflect().GetUnknown()
+	for len(raw) > 0 {
+		num, typ, n := protowire.ConsumeTag(raw)
+		if n < 0 {
+			return
+		}
+		raw = raw[n:]
+		if num == aliasOptionNumber && typ == protowire.VarintType {
+			v, n := protowire.ConsumeVarint(raw)
+			if n < 0 {
+				return
+			}
+			// 重复出现时使用最后一个
+			alias, ok = protowire.DecodeBool(v), true
+		}
+		n = protowire.ConsumeFieldValue(num, typ, raw)
+		if n < 0 {
+			return
+		}
+		raw = raw[n:]
+	}
+	return
+}
+
+// parseFillAliasField string/bytes 解析时引用输入数据. string 使用 unsafe.String
+func parseFillAliasField(g *protogen.GeneratedFile, genField *gengo.GenerateField) {
+	switch genField.Kind {
+	case protoreflect.StringKind:
+		g.Import(protogen.GoImportPath("unsafe"))
+		g.QualifiedGoIdent(protogen.GoIdent{GoName: "String", GoImportPath: "unsafe"})
+	case protoreflect.BytesKind:
+	default:
+		return
+	}
+	genField.Alias = true
+}
@@ -2,6 +2,7 @@ package genparse
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 
@@ -126,7 +127,13 @@ func fieldGoType(g *protogen.GeneratedFile, field *protogen.Field) (goType strin
 		goType = "[]byte"
 		pointer = false // rely on nullability of slices for presence
 	case protoreflect.MessageKind, protoreflect.GroupKind:
-		goType = "*" + g.QualifiedGoIdent(field.Message.GoIdent)
+		if fieldWKT(field) != "" {
+			goType = wktGoType(g, field)
+		} else if fieldIsAny(field) {
+			goType = "*" + g.QualifiedGoIdent(protogen.GoIdent{GoName: "Any", GoImportPath: RuntimePkg})
+		} else {
+			goType = "*" + g.QualifiedGoIdent(field.Message.GoIdent)
+		}
 		pointer = false // pointer captured as part of the type
 	}
 	switch {
@@ -144,13 +151,13 @@ func fieldDefaultValue(g *protogen.GeneratedFile, f *protogen.File, m *protogen.
 	if field.Desc.IsList() {
 		return "nil"
 	}
-	// if field.Desc.HasDefault() {
-	// 	defVarName := "Default_" + m.GoIdent.GoName + "_" + field.GoName
-	// 	if field.Desc.Kind() == protoreflect.BytesKind {
-	// 		return "append([]byte(nil), " + defVarName + "...)"
-	// 	}
-	// 	return defVarName
-	// }
+	if field.Desc.HasDefault() && m != nil {
+		defVarName := "Default_" + m.GoIdent.GoName + "_" + field.GoName
+		if field.Desc.Kind() == protoreflect.BytesKind {
+			return "append([]byte(nil), " + defVarName + "...)"
+		}
+		return defVarName
+	}
 	switch field.Desc.Kind() {
 	case protoreflect.BoolKind:
 		return "false"
@@ -172,3 +179,72 @@ func fieldDefaultValue(g *protogen.GeneratedFile, f *protogen.File, m *protogen.
 		return "0"
 	}
 }
+
+// fieldDefaultDecl returns the declaration of the default value for a field.
+//
+// If it returns isConst=false, the declaration must be placed in a var block.
+func fieldDefaultDecl(g *protogen.GeneratedFile, f *protogen.File, m *protogen.Message, field *protogen.Field) (decl string, isConst bool) {
+	name := "Default_" + m.GoIdent.GoName + "_" + field.GoName
+	goType, _ := fieldGoType(g, field)
+	defVal := field.Desc.Default()
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind:
+		return fmt.Sprintf("%s = %s", name, strconv.Quote(defVal.String())), true
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("%s = []byte(%s)", name, strconv.Quote(string(defVal.Bytes()))), false
+	case protoreflect.EnumKind:
+		idx := field.Desc.DefaultEnumValue().Index()
+		val := field.Enum.Values[idx]
+		if val.GoIdent.GoImportPath == f.GoImportPath {
+			return fmt.Sprintf("%s = %s", name, g.QualifiedGoIdent(val.GoIdent)), true
+		}
+		// If the enum value is declared in a different Go package,
+		// reference it by number since the name may not be correct.
+		// See https://github.com/golang/protobuf/issues/513.
+		return fmt.Sprintf("%s = %s(%d)", name, g.QualifiedGoIdent(field.Enum.GoIdent), val.Desc.Number()), true
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		if v := defVal.Float(); math.IsNaN(v) || math.IsInf(v, 0) {
+			var fn, arg string
+			switch {
+			case math.IsInf(v, -1):
+				fn, arg = "Inf", "-1"
+			case math.IsInf(v, +1):
+				fn, arg = "Inf", "+1"
+			case math.IsNaN(v):
+				fn, arg = "NaN", ""
+			}
+			fn = g.QualifiedGoIdent(protogen.GoIdent{GoName: fn, GoImportPath: "math"})
+			return fmt.Sprintf("%s = %s(%s(%s))", name, goType, fn, arg), false
+		}
+		return fmt.Sprintf("%s = %s(%v)", name, goType, defVal.Float()), true
+	default:
+		return fmt.Sprintf("%s = %s(%v)", name, goType, defVal.Interface()), true
+	}
+}
+
+// messageHasRequired reports whether the message or any message reachable
+// from its fields declares a required field.
+func messageHasRequired(md protoreflect.MessageDescriptor) bool {
+	return hasRequired(md, make(map[protoreflect.FullName]bool))
+}
+
+func hasRequired(md protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool) bool {
+	if visited[md.FullName()] {
+		return false
+	}
+	visited[md.FullName()] = true
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Cardinality() == protoreflect.Required {
+			return true
+		}
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Message() != nil && hasRequired(fd.Message(), visited) {
+			return true
+		}
+	}
+	return false
+}
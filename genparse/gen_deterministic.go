@@ -0,0 +1,25 @@
+package genparse
+
+import (
+	"github.com/aggronmagi/protoc-gen-gopb/gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// parseFillDeterministicField 序列化时传递 deterministic 参数. 扩展字段的 Set 直接序列化值, 没有该参数.
+// 其他包的子消息通过 gopb.MarshalTo 序列化
+func parseFillDeterministicField(g *protogen.GeneratedFile, genField *gengo.GenerateField, f *protogen.File, field *protogen.Field) {
+	if field.Desc.IsExtension() {
+		return
+	}
+	genField.Deterministic = true
+	genField.Local = fieldLocalMessage(genField, f, field)
+	switch {
+	case field.Desc.IsMap():
+		if field.Desc.MapKey().Kind() != protoreflect.BoolKind {
+			g.QualifiedGoIdent(protogen.GoIdent{GoName: "SortedMapKeys", GoImportPath: RuntimePkg})
+		}
+	case field.Message != nil && genField.WKT == "" && !genField.Local:
+		g.QualifiedGoIdent(protogen.GoIdent{GoName: "MarshalTo", GoImportPath: RuntimePkg})
+	}
+}
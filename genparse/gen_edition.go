@@ -0,0 +1,149 @@
+package genparse
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// editions 特性解析.
+//
+// field_presence, repeated_field_encoding, message_encoding 已经由 protoreflect 解析,
+// 分别体现在 HasPresence, IsPacked, Kind(DELIMITED 编码的消息字段为 GroupKind) 中.
+// utf8_validation 没有公开的访问方法, 在这里按照
+// 版本默认值 -> 文件 -> 消息(由外到内) -> oneof -> 字段 的顺序合并.
+// enum_type 由 EnumDescriptor.IsClosed 解析, 封闭枚举的未知值在解析时保存在未知字段中.
+
+// editionDefaults returns the default feature set of the edition.
+func editionDefaults(edition descriptorpb.Edition) *descriptorpb.FeatureSet {
+	switch edition {
+	case descriptorpb.Edition_EDITION_PROTO2:
+		return &descriptorpb.FeatureSet{
+			FieldPresence:         descriptorpb.FeatureSet_EXPLICIT.Enum(),
+			EnumType:              descriptorpb.FeatureSet_CLOSED.Enum(),
+			RepeatedFieldEncoding: descriptorpb.FeatureSet_EXPANDED.Enum(),
+			Utf8Validation:        descriptorpb.FeatureSet_NONE.Enum(),
+			MessageEncoding:       descriptorpb.FeatureSet_LENGTH_PREFIXED.Enum(),
+		}
+	case descriptorpb.Edition_EDITION_PROTO3:
+		return &descriptorpb.FeatureSet{
+			FieldPresence:         descriptorpb.FeatureSet_IMPLICIT.Enum(),
+			EnumType:              descriptorpb.FeatureSet_OPEN.Enum(),
+			RepeatedFieldEncoding: descriptorpb.FeatureSet_PACKED.Enum(),
+			Utf8Validation:        descriptorpb.FeatureSet_VERIFY.Enum(),
+			MessageEncoding:       descriptorpb.FeatureSet_LENGTH_PREFIXED.Enum(),
+		}
+	default: // EDITION_2023
+		return &descriptorpb.FeatureSet{
+			FieldPresence:         descriptorpb.FeatureSet_EXPLICIT.Enum(),
+			EnumType:              descriptorpb.FeatureSet_OPEN.Enum(),
+			RepeatedFieldEncoding: descriptorpb.FeatureSet_PACKED.Enum(),
+			Utf8Validation:        descriptorpb.FeatureSet_VERIFY.Enum(),
+			MessageEncoding:       descriptorpb.FeatureSet_LENGTH_PREFIXED.Enum(),
+		}
+	}
+}
+
+// fileEdition returns the edition of the file. proto2 and proto3 are mapped
+// to the corresponding legacy editions. EDITION_2023 is the only edition supported.
+func fileEdition(fd protoreflect.FileDescriptor) descriptorpb.Edition {
+	switch fd.Syntax() {
+	case protoreflect.Proto2:
+		return descriptorpb.Edition_EDITION_PROTO2
+	case protoreflect.Proto3:
+		return descriptorpb.Edition_EDITION_PROTO3
+	default:
+		return descriptorpb.Edition_EDITION_2023
+	}
+}
+
+// descFeatures returns the features explicitly set in the options of the descriptor.
+func descFeatures(d protoreflect.Descriptor) *descriptorpb.FeatureSet {
+	switch opts := d.Options().(type) {
+	case *descriptorpb.FileOptions:
+		return opts.GetFeatures()
+	case *descriptorpb.MessageOptions:
+		return opts.GetFeatures()
+	case *descriptorpb.FieldOptions:
+		return opts.GetFeatures()
+	case *descriptorpb.OneofOptions:
+		return opts.GetFeatures()
+	case *descriptorpb.EnumOptions:
+		return opts.GetFeatures()
+	}
+	return nil
+}
+
+// mergeFeatures overrides the features in dst with the ones set in src.
+func mergeFeatures(dst, src *descriptorpb.FeatureSet) {
+	if src == nil {
+		return
+	}
+	if src.FieldPresence != nil {
+		dst.FieldPresence = src.FieldPresence
+	}
+	if src.EnumType != nil {
+		dst.EnumType = src.EnumType
+	}
+	if src.RepeatedFieldEncoding != nil {
+		dst.RepeatedFieldEncoding = src.RepeatedFieldEncoding
+	}
+	if src.Utf8Validation != nil {
+		dst.Utf8Validation = src.Utf8Validation
+	}
+	if src.MessageEncoding != nil {
+		dst.MessageEncoding = src.MessageEncoding
+	}
+}
+
+// resolveFeatures returns the resolved features of the descriptor.
+// Descriptors are merged from the file down to d.
+func resolveFeatures(d protoreflect.Descriptor) *descriptorpb.FeatureSet {
+	var chain []protoreflect.Descriptor
+	for p := d; p != nil; p = p.Parent() {
+		chain = append(chain, p)
+		switch fd := p.(type) {
+		case protoreflect.FieldDescriptor:
+			if od := fd.ContainingOneof(); od != nil {
+				chain = append(chain, od)
+			}
+		case protoreflect.MessageDescriptor:
+			// map 的键值继承 map 字段的特性
+			if fd.IsMapEntry() {
+				if parent, ok := fd.Parent().(protoreflect.MessageDescriptor); ok {
+					fields := parent.Fields()
+					for i := 0; i < fields.Len(); i++ {
+						if fields.Get(i).Message() == fd {
+							chain = append(chain, fields.Get(i))
+						}
+					}
+				}
+			}
+		}
+	}
+	features := editionDefaults(fileEdition(d.ParentFile()))
+	for i := len(chain) - 1; i >= 0; i-- {
+		mergeFeatures(features, descFeatures(chain[i]))
+	}
+	return features
+}
+
+// fieldClosedEnum returns the name map (Xxx_name) of the closed enum field, empty for open enums.
+// 扩展字段的值由 Get 直接返回, 不检查
+func fieldClosedEnum(g *protogen.GeneratedFile, field *protogen.Field) string {
+	if field.Enum == nil || !field.Enum.Desc.IsClosed() || field.Desc.IsExtension() {
+		return ""
+	}
+	return g.QualifiedGoIdent(protogen.GoIdent{
+		GoName:       field.Enum.GoIdent.GoName + "_name",
+		GoImportPath: field.Enum.GoIdent.GoImportPath,
+	})
+}
+
+// fieldValidateUTF8 reports whether the string field requires valid UTF-8.
+func fieldValidateUTF8(field protoreflect.FieldDescriptor) bool {
+	if field.Kind() != protoreflect.StringKind {
+		return false
+	}
+	return resolveFeatures(field).GetUtf8Validation() == descriptorpb.FeatureSet_VERIFY
+}
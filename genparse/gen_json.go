@@ -0,0 +1,276 @@
+package genparse
+
+import (
+	"strings"
+
+	"github.com/aggronmagi/protoc-gen-gopb/gengo"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// genjsonTemplate protojson 规范的 JSON 序列化. 不使用反射, 辅助函数在 gopb/json.go
+var genjsonTemplate = `{{ $_ := Import "github.com/aggronmagi/protoc-gen-gopb/gopb" "JSONDecoder" }}
+// MarshalJSON marshal to protobuf canonical JSON
+func (x *{{.TypeName}}) MarshalJSON() ([]byte, error) {
+	return x.AppendJSON(nil)
+}
+
+// AppendJSON appends the protobuf canonical JSON of x to b. nil is appended as empty object
+func (x *{{.TypeName}}) AppendJSON(b []byte) (_ []byte, err error) {
+	if x == nil {
+		return append(b, '{', '}'), nil
+	}
+	b = append(b, '{') {{ range $i,$field := .Fields }} {{ $vname := ValueName "x." $field.GoName }} {{ if JSONStrconv $field }} {{ $_ := Import "strconv" "AppendInt" }} {{ end }} {{ if $field.Oneof }}
+	switch ov := {{ $vname }}.(type) { {{ range $j,$of := $field.Oneof.Fields }}
+	case *{{ $of.OneofWrapper }}:
+		b = append(b, ` + "`" + `"{{ $of.JSONName }}":` + "`" + `...)
+		{{ JSONAppend $of (ValueName "ov." $of.GoName) }}
+		b = append(b, ',') {{ end }}
+	} {{ else }}
+	if {{ call $field.CheckNotEmpty $vname }} {
+		b = append(b, ` + "`" + `"{{ $field.JSONName }}":` + "`" + `...) {{ if $field.IsMap }}
+		b = append(b, '{')
+		for mk, mv := range {{ $vname }} {
+			{{ JSONAppendKey $field.MapKey "mk" }}
+			b = append(b, ':')
+			{{ JSONAppend $field.MapValue "mv" }}
+			b = append(b, ',')
+		}
+		b = gopb.CloseJSON(b, '}') {{ else if $field.IsList }}
+		b = append(b, '[')
+		for _, item := range {{ $vname }} {
+			{{ JSONAppend $field "item" }}
+			b = append(b, ',')
+		}
+		b = gopb.CloseJSON(b, ']') {{ else if $field.Pointer }}
+		{{ JSONAppend $field (ValueName "*" $vname) }} {{ else }}
+		{{ JSONAppend $field $vname }} {{ end }}
+		b = append(b, ',')
+	} {{ end }} {{ end }}
+	return gopb.CloseJSON(b, '}'), nil
+}
+
+// UnmarshalJSON unmarshal from protobuf canonical JSON. both json name and proto name of fields are accepted
+func (x *{{.TypeName}}) UnmarshalJSON(data []byte) error {
+	x.Reset()
+	d := gopb.NewJSONDecoder(data)
+	if err := x.UnmarshalJSONFrom(d); err != nil {
+		return err
+	}
+	return d.End()
+}
+
+// UnmarshalJSONFrom reads a JSON object from d and merges it into x. null values are skipped.
+// duplicate fields, duplicate map keys and more than one member of a oneof are rejected as protojson
+func (x *{{.TypeName}}) UnmarshalJSONFrom(d *gopb.JSONDecoder) error {
+	{{ if .Fields }}// 重复的字段及同一个oneof的多个成员返回错误
+	var seen{{ if .Oneofs }}, oneofs{{ end }} gopb.FieldSet {{ end }}
+	return d.ReadObject(func(name string) (err error) {
+		switch name { {{ range $i,$field := .Fields }} {{ $vname := ValueName "x." $field.GoName }} {{ if $field.Oneof }} {{ range $j,$of := $field.Oneof.Fields }}
+		case {{ JSONNames $of }}:
+			if err = d.CheckField(&seen, {{ $of.DescNum }}, name); err != nil || d.ReadNull() {
+				return
+			}
+			if err = d.CheckOneof(&oneofs, {{ $i }}, name, "{{ $of.Message }}.{{ $field.DescName }}"); err != nil {
+				return
+			}
+			ov := &{{ $of.OneofWrapper }}{}
+			{{ JSONRead $of (ValueName "ov." $of.GoName) }}
+			{{ $vname }} = ov {{ end }} {{ else }}
+		case {{ JSONNames $field }}:
+			if err = d.CheckField(&seen, {{ $field.DescNum }}, name); err != nil || d.ReadNull() {
+				return
+			} {{ if $field.IsMap }}
+			if {{ $vname }} == nil {
+				{{ $vname }} = make({{ $field.TypeName }})
+			}
+			err = d.ReadObject(func(key string) (err error) {
+				{{ JSONReadKey $field.MapKey "key" "mk" }}
+				if _, ok := {{ $vname }}[mk]; ok {
+					return d.DuplicateMapKey(key)
+				}
+				var mv {{ $field.MapValue.TypeName }}
+				{{ JSONRead $field.MapValue "mv" }}
+				{{ $vname }}[mk] = mv
+				return
+			}) {{ else if $field.IsList }}
+			err = d.ReadArray(func() (err error) {
+				var item {{ JSONElemType $field }}
+				{{ JSONRead $field "item" }}
+				{{ $vname }} = append({{ $vname }}, item)
+				return
+			}) {{ else if $field.Pointer }}
+			var pv {{ $field.GoType }}
+			{{ JSONRead $field "pv" }}
+			{{ $vname }} = &pv {{ else }}
+			{{ JSONRead $field $vname }} {{ end }} {{ end }} {{ end }}
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+`
+
+func init() {
+	gengo.RegisterCustomModule(&gengo.CustomModule{
+		Templates: [][2]string{{"genjson", genjsonTemplate}},
+		Funcs: map[string]any{
+			"JSONNames":     getJSONNames,
+			"JSONElemType":  getJSONElemType,
+			"JSONStrconv":   getJSONStrconv,
+			"JSONAppend":    getJSONAppend,
+			"JSONAppendKey": getJSONAppendKey,
+			"JSONRead":      getJSONRead,
+			"JSONReadKey":   getJSONReadKey,
+		},
+	})
+}
+
+// getJSONNames 解析时接受的字段名. json_name 及proto中的名字
+func getJSONNames(field *gengo.GenerateField) string {
+	if field.JSONName == field.DescName {
+		return `"` + field.DescName + `"`
+	}
+	return `"` + field.JSONName + `", "` + field.DescName + `"`
+}
+
+func getJSONElemType(field *gengo.GenerateField) string {
+	return strings.TrimPrefix(field.TypeName, "[]")
+}
+
+// getJSONStrconv 生成的代码是否使用 strconv
+func getJSONStrconv(field *gengo.GenerateField) bool {
+	switch {
+	case field.Oneof != nil:
+		for _, of := range field.Oneof.Fields {
+			if getJSONStrconv(of) {
+				return true
+			}
+		}
+		return false
+	case field.IsMap:
+		return field.MapKey.Kind == protoreflect.BoolKind || getJSONStrconv(field.MapValue)
+	case field.WKT == wktWrapper:
+		return getJSONStrconv(field.WrapperValue)
+	case field.WKT != "":
+		return false
+	}
+	switch field.Kind {
+	case protoreflect.BoolKind, protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return true
+	}
+	return false
+}
+
+// getJSONAppend 把 vname 的值追加到 b 的代码. vname 为元素的值, 显式存在性的标量已经解引用
+func getJSONAppend(field *gengo.GenerateField, vname string) string {
+	switch field.WKT {
+	case wktTimestamp:
+		return "if " + vname + " == nil {\n b = append(b, \"null\"...)\n} else if b, err = gopb.AppendJSONTimestamp(b, *" + vname + "); err != nil {\n return\n}"
+	case wktDuration:
+		return "if " + vname + " == nil {\n b = append(b, \"null\"...)\n} else {\n b = gopb.AppendJSONDuration(b, *" + vname + ")\n}"
+	case wktWrapper:
+		if field.WrapperValue.Kind == protoreflect.BytesKind {
+			return getJSONAppend(field.WrapperValue, vname)
+		}
+		return "if " + vname + " == nil {\n b = append(b, \"null\"...)\n} else {\n " + getJSONAppend(field.WrapperValue, "*"+vname) + "\n}"
+	}
+	switch field.Kind {
+	case protoreflect.BoolKind:
+		return "b = strconv.AppendBool(b, " + vname + ")"
+	case protoreflect.EnumKind:
+		return "b = gopb.AppendJSONEnum(b, int32(" + vname + "), " + field.GoType + "_name)"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "b = strconv.AppendInt(b, int64(" + vname + "), 10)"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "b = strconv.AppendUint(b, uint64(" + vname + "), 10)"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "b = gopb.AppendJSONInt64(b, int64(" + vname + "))"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "b = gopb.AppendJSONUint64(b, uint64(" + vname + "))"
+	case protoreflect.FloatKind:
+		return "b = gopb.AppendJSONFloat(b, float64(" + vname + "), 32)"
+	case protoreflect.DoubleKind:
+		return "b = gopb.AppendJSONFloat(b, " + vname + ", 64)"
+	case protoreflect.StringKind:
+		return "b = gopb.AppendJSONString(b, " + vname + ")"
+	case protoreflect.BytesKind:
+		return "b = gopb.AppendJSONBytes(b, " + vname + ")"
+	default: // message, group, Any
+		return "if b, err = " + vname + ".AppendJSON(b); err != nil {\n return\n}"
+	}
+}
+
+// getJSONAppendKey 把map的键追加到 b 的代码. 键总是字符串
+func getJSONAppendKey(field *gengo.GenerateField, vname string) string {
+	switch field.Kind {
+	case protoreflect.BoolKind:
+		return "b = append(b, '\"')\nb = strconv.AppendBool(b, " + vname + ")\nb = append(b, '\"')"
+	case protoreflect.StringKind:
+		return "b = gopb.AppendJSONString(b, " + vname + ")"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "b = gopb.AppendJSONUint64(b, uint64(" + vname + "))"
+	default:
+		return "b = gopb.AppendJSONInt64(b, int64(" + vname + "))"
+	}
+}
+
+// getJSONRead 从 d 读取值到 vname 的代码. 错误保存在 err 中
+func getJSONRead(field *gengo.GenerateField, vname string) string {
+	switch field.WKT {
+	case wktTimestamp:
+		return "var wt time.Time\nif wt, err = d.ReadTimestamp(); err != nil {\n return\n}\n" + vname + " = &wt"
+	case wktDuration:
+		return "var wd time.Duration\nif wd, err = d.ReadDuration(); err != nil {\n return\n}\n" + vname + " = &wd"
+	case wktWrapper:
+		if field.WrapperValue.Kind == protoreflect.BytesKind {
+			return getJSONRead(field.WrapperValue, vname)
+		}
+		return "var wv " + field.WrapperValue.GoType + "\n" + getJSONRead(field.WrapperValue, "wv") + "\n" + vname + " = &wv"
+	}
+	switch field.Kind {
+	case protoreflect.BoolKind:
+		return vname + ", err = d.ReadBool()"
+	case protoreflect.EnumKind:
+		return "ev, err := d.ReadEnum(" + field.GoType + "_value)\nif err != nil {\n return err\n}\n" + vname + " = " + field.GoType + "(ev)"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return vname + ", err = d.ReadInt32()"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return vname + ", err = d.ReadUint32()"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return vname + ", err = d.ReadInt64()"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return vname + ", err = d.ReadUint64()"
+	case protoreflect.FloatKind:
+		return vname + ", err = d.ReadFloat32()"
+	case protoreflect.DoubleKind:
+		return vname + ", err = d.ReadFloat64()"
+	case protoreflect.StringKind:
+		return vname + ", err = d.ReadString()"
+	case protoreflect.BytesKind:
+		return vname + ", err = d.ReadBytes()"
+	default: // message, group, Any. 多次出现时合并
+		return "if " + vname + " == nil {\n " + vname + " = &" + field.GoType + "{}\n}\nerr = " + vname + ".UnmarshalJSONFrom(d)"
+	}
+}
+
+// getJSONReadKey 把字符串 name 解析为map的键 vname 的代码
+func getJSONReadKey(field *gengo.GenerateField, name, vname string) string {
+	var parse string
+	switch field.Kind {
+	case protoreflect.StringKind:
+		return vname + " := " + name
+	case protoreflect.BoolKind:
+		return vname + ", err := d.ParseMapKeyBool(" + name + ")\nif err != nil {\n return err\n}"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		parse = "d.ParseMapKeyInt(" + name + ", 32)"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		parse = "d.ParseMapKeyUint(" + name + ", 32)"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		parse = "d.ParseMapKeyInt(" + name + ", 64)"
+	default:
+		parse = "d.ParseMapKeyUint(" + name + ", 64)"
+	}
+	return "kv, err := " + parse + "\nif err != nil {\n return err\n}\n" + vname + " := " + field.GoType + "(kv)"
+}
@@ -0,0 +1,16 @@
+package genparse
+
+import (
+	"github.com/aggronmagi/protoc-gen-gopb/gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// parseFillLimitField 解析时传递 gopb.UnmarshalState. 同一个go包中的子消息直接调用 UnmarshalObjectState,
+// 其他包的子消息通过 gopb.UnmarshalWithState 解析, 没有使用 limit 参数生成时不检查限制
+func parseFillLimitField(g *protogen.GeneratedFile, genField *gengo.GenerateField, f *protogen.File, field *protogen.Field) {
+	genField.Limit = true
+	genField.Local = fieldLocalMessage(genField, f, field)
+	if field.Message != nil && genField.WKT == "" && !genField.Local {
+		g.QualifiedGoIdent(protogen.GoIdent{GoName: "UnmarshalWithState", GoImportPath: RuntimePkg})
+	}
+}
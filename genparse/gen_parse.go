@@ -15,11 +15,28 @@ import (
 
 // 外部配置
 var (
-	Getter  bool   = true
-	WirePkg string = "google.golang.org/protobuf/encoding/protowire"
-	Zap     bool   = true
+	Getter        bool   = true
+	WirePkg       string = "google.golang.org/protobuf/encoding/protowire"
+	Zap           bool   = true
+	Unknown       bool   = false
+	WKT           bool   = false
+	Registry      bool   = false
+	JSON          bool   = false
+	Text          bool   = false
+	Reflect       bool   = false
+	Alias         bool   = false
+	Pool          bool   = false
+	SizeCache     bool   = false
+	Deterministic bool   = false
+	Strict        bool   = false
+	UTF8          bool   = true
+	UTF8Marshal   bool   = false
+	Limit         bool   = false
 )
 
+// gopb 运行时支持包
+const RuntimePkg = "github.com/aggronmagi/protoc-gen-gopb/gopb"
+
 // 版本信息
 var (
 	Version = "0.0.5"
@@ -66,20 +83,95 @@ func ParseMessage(t *gengo.GenerateStruct, g *protogen.GeneratedFile, f *protoge
 		m.Desc.Options().(*descriptorpb.MessageOptions).GetDeprecated()).String()
 	msg.TypeName = g.QualifiedGoIdent(m.GoIdent)
 	msg.GoName = m.GoIdent.GoName
+	msg.DescName = string(m.Desc.Name())
+	msg.ProtoName = string(m.Desc.FullName())
 	msg.GenGetter = Getter
+	msg.Unknown = Unknown
+	if Registry {
+		msg.FullName = string(m.Desc.FullName())
+		t.RegisterType = g.QualifiedGoIdent(protogen.GoIdent{GoName: "RegisterType", GoImportPath: RuntimePkg})
+		t.RuntimeMessage = g.QualifiedGoIdent(protogen.GoIdent{GoName: "Message", GoImportPath: RuntimePkg})
+	}
+	for i, ranges := 0, m.Desc.ExtensionRanges(); i < ranges.Len(); i++ {
+		r := ranges.Get(i)
+		msg.ExtensionRanges = append(msg.ExtensionRanges, [2]int{int(r[0]), int(r[1])})
+	}
 
 	for _, field := range m.Fields {
+		if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
+			// oneof 在第一个成员的位置生成接口字段
+			if oneof.Fields[0] != field {
+				continue
+			}
+			gf, ne := parseMessageOneof(msg, g, f, m, oneof)
+			err = multierr.Append(err, ne)
+			if gf != nil {
+				msg.Fields = append(msg.Fields, gf)
+			}
+			continue
+		}
 		gf, ne := parseMessageField(msg, g, f, m, field)
 		err = multierr.Append(err, ne)
 		if gf != nil {
 			msg.Fields = append(msg.Fields, gf)
 		}
 	}
+	// 默认值
+	for _, field := range m.Fields {
+		if !field.Desc.HasDefault() {
+			continue
+		}
+		decl, isConst := fieldDefaultDecl(g, f, m, field)
+		if isConst {
+			msg.DefaultConsts = append(msg.DefaultConsts, decl)
+		} else {
+			msg.DefaultVars = append(msg.DefaultVars, decl)
+		}
+	}
+	if Reflect {
+		parseMessageReflect(t, msg, f, m)
+	}
 	t.Messages = append(t.Messages, msg)
 
+	if msg.Unknown {
+		g.Import(protogen.GoImportPath("bytes"))
+		g.QualifiedGoIdent(protogen.GoIdent{GoName: "Equal", GoImportPath: "bytes"})
+	}
+	if len(msg.ExtensionRanges) > 0 {
+		g.QualifiedGoIdent(protogen.GoIdent{GoName: "EqualExtensions", GoImportPath: RuntimePkg})
+	}
+
+	if Pool {
+		msg.Pool = true
+		g.Import(protogen.GoImportPath("sync"))
+		g.QualifiedGoIdent(protogen.GoIdent{GoName: "Pool", GoImportPath: "sync"})
+	}
+
+	if SizeCache {
+		msg.SizeCache = true
+		g.Import(protogen.GoImportPath("sync/atomic"))
+		g.QualifiedGoIdent(protogen.GoIdent{GoName: "LoadInt32", GoImportPath: "sync/atomic"})
+	}
+
+	msg.Deterministic = Deterministic
+	msg.Limit = Limit
+
+	if messageHasRequired(m.Desc) {
+		msg.CheckRequired = true
+		g.Import(protogen.GoImportPath("strings"))
+		g.QualifiedGoIdent(protogen.GoIdent{GoName: "Join", GoImportPath: "strings"})
+		g.QualifiedGoIdent(protogen.GoIdent{GoName: "New", GoImportPath: "errors"})
+	}
+
 	if Zap {
 		msg.CustomTemplates = append(msg.CustomTemplates, "genzap")
 	}
+	if JSON {
+		msg.CustomTemplates = append(msg.CustomTemplates, "genjson")
+	}
+	if Text {
+		msg.CustomTemplates = append(msg.CustomTemplates, "gentext")
+	}
 
 	// sub enum
 	for _, en := range m.Enums {
@@ -89,34 +181,115 @@ func ParseMessage(t *gengo.GenerateStruct, g *protogen.GeneratedFile, f *protoge
 	for _, msg := range m.Messages {
 		ParseMessage(t, g, f, msg)
 	}
+	// sub extension
+	for _, ext := range m.Extensions {
+		err = multierr.Append(err, ParseExtension(t, g, f, ext))
+	}
 	return
 }
 
-func parseMessageField(msg *gengo.GenerateMessage, g *protogen.GeneratedFile, f *protogen.File, m *protogen.Message, field *protogen.Field) (genField *gengo.GenerateField, err error) {
-	// NOTE: 不支持oneof
-	if oneof := field.Oneof; oneof != nil && !oneof.Desc.IsSynthetic() {
-		err = fmt.Errorf("%s %s %s is oneof type. not support", f.Desc.FullName(), m.GoIdent, field.GoIdent)
-		log.Println(err)
+func ParseExtension(t *gengo.GenerateStruct, g *protogen.GeneratedFile, f *protogen.File, ext *protogen.Extension) (err error) {
+	// 自定义选项扩展的是 descriptor.proto 中的消息, 不是gopb生成的类型
+	if ext.Extendee.Desc.ParentFile().Path() == "google/protobuf/descriptor.proto" {
+		log.Printf("%s extend %s. skip option extension", ext.Desc.FullName(), ext.Extendee.Desc.FullName())
 		return
 	}
-	// weak
-	if field.Desc.IsWeak() {
-		err = fmt.Errorf("%s %s %s is weak type. not support", f.Desc.FullName(), m.GoIdent, field.GoIdent)
-		log.Println(err)
+
+	genExt := &gengo.GenerateExtension{}
+	genExt.LeadingComments = appendDeprecationSuffix(ext.Comments.Leading,
+		ext.Desc.ParentFile(),
+		ext.Desc.Options().(*descriptorpb.FieldOptions).GetDeprecated()).String()
+	genExt.VarName = "E_" + ext.GoIdent.GoName
+	genExt.TypeName = "extensionDesc_" + ext.GoIdent.GoName
+	genExt.Extendee = g.QualifiedGoIdent(ext.Extendee.GoIdent)
+	genExt.FullName = string(ext.Desc.FullName())
+
+	msg := &gengo.GenerateMessage{GoName: ext.Extendee.GoIdent.GoName, ProtoName: string(ext.Extendee.Desc.FullName())}
+	genExt.Field, err = parseMessageField(msg, g, f, nil, ext)
+	if err != nil {
 		return
 	}
-	if field.Desc.HasDefault() {
-		err = fmt.Errorf("%s %s %s has default value. not support", f.Desc.FullName(), m.GoIdent, field.GoIdent)
-		log.Println(err)
+	// 扩展字段的数据由用户设置, Get 总是校验 wire type
+	if genExt.Field.TemplateCheck == "" {
+		parseFillStrictField(g, genExt.Field)
+	}
+	// 标量扩展字段总是序列化
+	switch {
+	case genExt.Field.IsList, genExt.Field.Kind == protoreflect.MessageKind, genExt.Field.Kind == protoreflect.GroupKind:
+	case genExt.Field.Kind == protoreflect.BytesKind:
+		genExt.Field.CheckNotEmpty = func(x string) string {
+			return x + " != nil"
+		}
+	default:
+		genExt.Field.CheckNotEmpty = nil
+	}
+
+	t.Extensions = append(t.Extensions, genExt)
+	return
+}
+
+func parseMessageOneof(msg *gengo.GenerateMessage, g *protogen.GeneratedFile, f *protogen.File, m *protogen.Message, oneof *protogen.Oneof) (genField *gengo.GenerateField, err error) {
+	genOneof := &gengo.GenerateOneof{}
+	genOneof.LeadingComments = oneof.Comments.Leading.String()
+	genOneof.TrailingComment = trailingComment(oneof.Comments.Trailing).String()
+	genOneof.TypeName = "is" + oneof.GoIdent.GoName
+	genOneof.GoName = oneof.GoName
+
+	for _, field := range oneof.Fields {
+		of, ne := parseMessageField(msg, g, f, m, field)
+		err = multierr.Append(err, ne)
+		if of == nil {
+			continue
+		}
+		of.OneofWrapper = g.QualifiedGoIdent(field.GoIdent)
+		genOneof.Fields = append(genOneof.Fields, of)
+	}
+	if err != nil {
 		return
 	}
-	if field.Desc.Kind() == protoreflect.GroupKind {
-		err = fmt.Errorf("%s %s %s is group type. not support", f.Desc.FullName(), m.GoIdent, field.GoIdent)
+
+	// 消息中的接口字段
+	genField = &gengo.GenerateField{}
+	genField.GenerateDoc = genOneof.GenerateDoc
+	genField.TypeName = genOneof.TypeName
+	genField.GoName = genOneof.GoName
+	genField.GoType = genOneof.TypeName
+	genField.Tip = msg.GoName + "." + oneof.GoName
+	genField.GetNilCheck = true
+	genField.DefaultValue = "nil"
+	genField.DescName = string(oneof.Desc.Name())
+	genField.Oneof = genOneof
+	genField.CheckNotEmpty = func(x string) string {
+		return x + " != nil"
+	}
+	genField.TemplateSize = "size.oneof"
+	genField.TemplateEncode = "encode.oneof"
+	genField.TemplateCopy = "copy.oneof"
+	genField.TemplateEqual = "equal.oneof"
+	genField.TemplateMerge = "merge.oneof"
+	genField.Deterministic = Deterministic
+
+	msg.Oneofs = append(msg.Oneofs, genOneof)
+	return
+}
+
+func parseMessageField(msg *gengo.GenerateMessage, g *protogen.GeneratedFile, f *protogen.File, m *protogen.Message, field *protogen.Field) (genField *gengo.GenerateField, err error) {
+	// weak
+	if field.Desc.IsWeak() {
+		err = fmt.Errorf("%s %s %s is weak type. not support", f.Desc.FullName(), m.GoIdent, field.GoIdent)
 		log.Println(err)
 		return
 	}
 
 	goType, pointer := fieldGoType(g, field)
+	presence := field.Desc.HasPresence() && !field.Desc.IsList() && !field.Desc.IsMap()
+	// oneof 成员由包装类型表示是否存在, 扩展字段由 Has 方法表示是否存在,
+	// map 的键值即使在 proto2 中也总是存在
+	if oneof := field.Oneof; (oneof != nil && !oneof.Desc.IsSynthetic()) || field.Desc.IsExtension() ||
+		field.Desc.ContainingMessage().IsMapEntry() {
+		pointer = false
+		presence = false
+	}
 	if pointer {
 		goType = "*" + goType
 	}
@@ -137,6 +310,7 @@ func parseMessageField(msg *gengo.GenerateMessage, g *protogen.GeneratedFile, f
 		genField.GoType = strings.TrimPrefix(strings.TrimPrefix(goType, "[]"), "*")
 	}
 	genField.Tip = msg.GoName + "." + field.GoName
+	genField.Message = msg.ProtoName
 	// getter 相关
 	defaultValue := fieldDefaultValue(g, f, m, field)
 	genField.GetNilCheck = !field.Desc.HasPresence() || defaultValue == "nil"
@@ -144,10 +318,39 @@ func parseMessageField(msg *gengo.GenerateMessage, g *protogen.GeneratedFile, f
 	// tag 相关
 	genField.DescNum = int(field.Desc.Number())
 	genField.DescName = string(field.Desc.Name())
+	genField.JSONName = field.Desc.JSONName()
+	genField.TextName = field.Desc.TextName()
 	genField.DescType, genField.WireType = switchProtoType(field.Desc.Kind())
 	genField.IsList = field.Desc.IsList()
 	genField.IsMap = field.Desc.IsMap()
 	genField.Kind = field.Desc.Kind()
+	genField.HasPresence = presence
+	genField.ValidateUTF8 = UTF8 && fieldValidateUTF8(field.Desc)
+	genField.ValidateUTF8Marshal = genField.ValidateUTF8 && UTF8Marshal
+	if genField.ValidateUTF8 {
+		g.Import(protogen.GoImportPath("unicode/utf8"))
+		g.QualifiedGoIdent(protogen.GoIdent{GoName: "Valid", GoImportPath: "unicode/utf8"})
+	}
+	genField.Any = fieldIsAny(field)
+	genField.Unknown = msg.Unknown
+	genField.ClosedEnum = fieldClosedEnum(g, field)
+	genField.Required = field.Desc.Cardinality() == protoreflect.Required
+	if vd := field.Desc; vd.Kind() == protoreflect.MessageKind || vd.Kind() == protoreflect.GroupKind {
+		if vd.IsMap() {
+			vd = vd.MapValue()
+		}
+		if vd.Message() != nil && messageHasRequired(vd.Message()) {
+			genField.CheckRequired = true
+			switch {
+			case vd != field.Desc:
+				g.Import(protogen.GoImportPath("fmt"))
+				g.QualifiedGoIdent(protogen.GoIdent{GoName: "Sprint", GoImportPath: "fmt"})
+			case field.Desc.IsList():
+				g.Import(protogen.GoImportPath("strconv"))
+				g.QualifiedGoIdent(protogen.GoIdent{GoName: "Itoa", GoImportPath: "strconv"})
+			}
+		}
+	}
 
 	// 序列化
 	switch {
@@ -169,6 +372,12 @@ func parseMessageField(msg *gengo.GenerateMessage, g *protogen.GeneratedFile, f
 		genField.TemplateSize = "size.map"
 		genField.TemplateEncode = "encode.map"
 
+	case fieldWKT(field) != "":
+		err = parseFillWKTFiled(msg, g, f, genField, field)
+		if err != nil {
+			return
+		}
+
 	case field.Desc.IsList():
 		genField.CheckNotEmpty = func(vname string) string {
 			return "len(" + vname + ") > 0"
@@ -182,13 +391,45 @@ func parseMessageField(msg *gengo.GenerateMessage, g *protogen.GeneratedFile, f
 
 	default:
 		parseFillBasicFiled(g, genField, field)
+		if presence {
+			parseFillPresenceFiled(genField, pointer)
+		}
+	}
+
+	parseFillCopyFiled(genField)
+	parseFillEqualFiled(g, genField)
+	parseFillMergeFiled(genField)
+
+	// map 的键值及 wrapper 的值跟随字段的选项
+	if alias, ok := fieldAliasOption(field.Desc); (ok && alias) || (!ok && Alias) {
+		for _, af := range []*gengo.GenerateField{genField, genField.MapKey, genField.MapValue, genField.WrapperValue} {
+			if af != nil {
+				parseFillAliasField(g, af)
+			}
+		}
+	}
+
+	if Pool {
+		parseFillPoolField(genField, f, field)
+	}
+	if SizeCache {
+		parseFillSizeCacheField(genField, f, field)
+	}
+	if Deterministic {
+		parseFillDeterministicField(g, genField, f, field)
+	}
+	if Strict {
+		parseFillStrictField(g, genField)
+	}
+	if Limit {
+		parseFillLimitField(g, genField, f, field)
 	}
 
 	// import
 	g.Import(protogen.GoImportPath(WirePkg))
 	g.QualifiedGoIdent(protogen.GoIdent{GoName: "VarintType", GoImportPath: protogen.GoImportPath(WirePkg)})
-	g.Import(protogen.GoImportPath("errors"))
-	g.QualifiedGoIdent(protogen.GoIdent{GoName: "New", GoImportPath: "errors"})
+	// 解析错误 gopb.DecodeError
+	g.QualifiedGoIdent(protogen.GoIdent{GoName: "ParseError", GoImportPath: RuntimePkg})
 
 	return
 }
@@ -208,6 +449,9 @@ func switchProtoType(kind protoreflect.Kind) (typ int, desc string) {
 	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind:
 		desc = "protowire.BytesType"
 		typ = int(protowire.BytesType)
+	case protoreflect.GroupKind:
+		desc = "protowire.StartGroupType"
+		typ = int(protowire.StartGroupType)
 	}
 	return
 }
@@ -283,9 +527,111 @@ func parseFillBasicFiled(g *protogen.GeneratedFile, genField *gengo.GenerateFiel
 		genField.TemplateEncode = "encode.message"
 		genField.TemplateDecode = "decode.message"
 
+	case protoreflect.GroupKind:
+		genField.CheckNotEmpty = func(x string) string {
+			return x + " != nil"
+		}
+		genField.TemplateSize = "size.group"
+		genField.TemplateEncode = "encode.group"
+		genField.TemplateDecode = "decode.group"
+
 	}
 }
 
+// parseFillCopyFiled 设置 CopyFrom 使用的模板. 列表的元素模板为 ElemTemplateCopy
+func parseFillCopyFiled(genField *gengo.GenerateField) {
+	elem := "copy.value"
+	switch {
+	case genField.WKT == wktWrapper && genField.WrapperValue.Kind == protoreflect.BytesKind:
+		elem = "copy.bytes"
+	case genField.WKT != "":
+		elem = "copy.pointer"
+	case genField.Kind == protoreflect.MessageKind || genField.Kind == protoreflect.GroupKind:
+		elem = "copy.message"
+	case genField.Kind == protoreflect.BytesKind:
+		elem = "copy.bytes"
+	case genField.Pointer:
+		elem = "copy.pointer"
+	}
+	switch {
+	case genField.IsMap:
+		genField.TemplateCopy = "copy.map"
+	case genField.IsList && elem == "copy.value":
+		genField.TemplateCopy = "copy.list.value"
+	case genField.IsList:
+		genField.ElemTemplateCopy, genField.TemplateCopy = elem, "copy.list"
+	default:
+		genField.TemplateCopy = elem
+	}
+}
+
+// parseFillMergeFiled 设置 Merge 使用的模板. 标量及映射为原生类型的 well-known types 直接覆盖
+func parseFillMergeFiled(genField *gengo.GenerateField) {
+	switch {
+	case genField.IsMap:
+		genField.TemplateMerge = "merge.map"
+	case genField.IsList && genField.ElemTemplateCopy == "":
+		genField.TemplateMerge = "merge.list.value"
+	case genField.IsList:
+		genField.TemplateMerge = "merge.list"
+	case genField.WKT == "" && (genField.Kind == protoreflect.MessageKind || genField.Kind == protoreflect.GroupKind):
+		genField.TemplateMerge = "merge.message"
+	default:
+		genField.TemplateMerge = genField.TemplateCopy
+	}
+}
+
+// parseFillEqualFiled 设置 Equal 使用的模板. 列表及指针的元素模板为 ElemTemplateEqual
+func parseFillEqualFiled(g *protogen.GeneratedFile, genField *gengo.GenerateField) {
+	elem := "equal.value"
+	switch {
+	case genField.WKT == wktTimestamp:
+		elem = "equal.timestamp"
+	case genField.WKT == wktDuration:
+		elem = "equal.duration"
+	case genField.WKT == wktWrapper:
+		elem = "equal.wrapper"
+	case genField.Kind == protoreflect.MessageKind || genField.Kind == protoreflect.GroupKind:
+		elem = "equal.message"
+	case genField.Kind == protoreflect.BytesKind && genField.HasPresence:
+		elem = "equal.bytes.presence"
+	case genField.Kind == protoreflect.BytesKind:
+		elem = "equal.bytes"
+	case genField.Kind == protoreflect.FloatKind || genField.Kind == protoreflect.DoubleKind:
+		elem = "equal.float"
+		g.Import(protogen.GoImportPath("math"))
+		g.QualifiedGoIdent(protogen.GoIdent{GoName: "IsNaN", GoImportPath: "math"})
+	}
+	if elem == "equal.bytes" || elem == "equal.bytes.presence" {
+		g.Import(protogen.GoImportPath("bytes"))
+		g.QualifiedGoIdent(protogen.GoIdent{GoName: "Equal", GoImportPath: "bytes"})
+	}
+	switch {
+	case genField.IsMap:
+		genField.TemplateEqual = "equal.map"
+	case genField.IsList:
+		genField.ElemTemplateEqual, genField.TemplateEqual = elem, "equal.list"
+	case genField.Pointer:
+		genField.ElemTemplateEqual, genField.TemplateEqual = elem, "equal.pointer"
+	default:
+		genField.TemplateEqual = elem
+	}
+}
+
+// parseFillPresenceFiled 显式存在性字段. 标量使用指针, 是否序列化只取决于是否为nil
+func parseFillPresenceFiled(genField *gengo.GenerateField, pointer bool) {
+	genField.CheckNotEmpty = func(x string) string {
+		return x + " != nil"
+	}
+	if !pointer {
+		return
+	}
+	genField.Pointer = true
+	genField.ElemTemplateSize, genField.TemplateSize = genField.TemplateSize, "size.pointer"
+	genField.ElemTemplateEncode, genField.TemplateEncode = genField.TemplateEncode, "encode.pointer"
+	genField.ElemTemplateDecode, genField.TemplateDecode = genField.TemplateDecode, "decode.pointer"
+}
+
 func parseFillListPackedFiled(g *protogen.GeneratedFile, genField *gengo.GenerateField, field *protogen.Field) {
 	switch field.Desc.Kind() {
 	case protoreflect.BoolKind:
@@ -419,5 +765,13 @@ func parseFillListNoPackedFiled(g *protogen.GeneratedFile, genField *gengo.Gener
 		genField.TemplateEncode = "encode.nopack.message"
 		genField.TemplateDecode = "decode.slice.message"
 
+	case protoreflect.GroupKind:
+		genField.CheckNotEmpty = func(x string) string {
+			return x + " != nil"
+		}
+		genField.TemplateSize = "size.nopack.group"
+		genField.TemplateEncode = "encode.nopack.group"
+		genField.TemplateDecode = "decode.slice.group"
+
 	}
 }
@@ -0,0 +1,29 @@
+package genparse
+
+import (
+	"github.com/aggronmagi/protoc-gen-gopb/gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// fieldLocalMessage 字段的消息类型由 gopb 生成在同一个go包中, 可以调用其未导出的方法及 Acquire 函数.
+// map 字段本身是 map entry, 由值字段判断; well-known types 及其他包的消息不是
+func fieldLocalMessage(genField *gengo.GenerateField, f *protogen.File, field *protogen.Field) bool {
+	if field.Message == nil || field.Desc.IsMap() || genField.WKT != "" || genField.Any {
+		return false
+	}
+	return field.Message.GoIdent.GoImportPath == f.GoImportPath
+}
+
+// parseFillPoolField 同一个go包中的消息类型才有 Acquire 函数. 其他消息直接分配
+func parseFillPoolField(genField *gengo.GenerateField, f *protogen.File, field *protogen.Field) {
+	genField.Pool = fieldLocalMessage(genField, f, field)
+}
+
+// parseFillSizeCacheField 同一个go包中的消息类型缓存了大小.
+// 扩展字段的 Set 直接序列化值, 之前没有计算大小, 不能使用缓存
+func parseFillSizeCacheField(genField *gengo.GenerateField, f *protogen.File, field *protogen.Field) {
+	if field.Desc.IsExtension() {
+		return
+	}
+	genField.SizeCache = fieldLocalMessage(genField, f, field)
+}
@@ -0,0 +1,61 @@
+package genparse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aggronmagi/protoc-gen-gopb/gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// reflectFileVar 文件描述变量名. file_路径_proto
+func reflectFileVar(f *protogen.File) string {
+	return "f" + f.GoDescriptorIdent.GoName[1:]
+}
+
+// parseMessageReflect 消息在 msgTypes 中的下标为 t.Messages 中的下标
+func parseMessageReflect(t *gengo.GenerateStruct, msg *gengo.GenerateMessage, f *protogen.File, m *protogen.Message) {
+	msg.FullName = string(m.Desc.FullName())
+	msg.ReflectType = fmt.Sprintf("%s_msgTypes[%d]", reflectFileVar(f), len(t.Messages))
+	for _, field := range m.Fields {
+		msg.ReflectFields = append(msg.ReflectFields, field.GoName)
+	}
+	for _, field := range msg.Fields {
+		if field.Oneof == nil {
+			continue
+		}
+		for _, of := range field.Oneof.Fields {
+			msg.ReflectWrappers = append(msg.ReflectWrappers, of.OneofWrapper)
+		}
+	}
+}
+
+// ParseReflect 内嵌去掉源码信息的 FileDescriptorProto, 在 gopb 中注册
+func ParseReflect(t *gengo.GenerateStruct, g *protogen.GeneratedFile, f *protogen.File) (err error) {
+	fdp := proto.Clone(f.Proto).(*descriptorpb.FileDescriptorProto)
+	fdp.SourceCodeInfo = nil
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(fdp)
+	if err != nil {
+		return fmt.Errorf("marshal descriptor of %s failed:%w", f.Desc.Path(), err)
+	}
+	for len(raw) > 0 {
+		n := 16
+		if n > len(raw) {
+			n = len(raw)
+		}
+		line := &strings.Builder{}
+		for _, c := range raw[:n] {
+			fmt.Fprintf(line, "0x%02x, ", c)
+		}
+		t.RawDesc = append(t.RawDesc, strings.TrimSpace(line.String()))
+		raw = raw[n:]
+	}
+	t.FileVar = reflectFileVar(f)
+	t.FilePath = f.Desc.Path()
+	t.RegisterFile = g.QualifiedGoIdent(protogen.GoIdent{GoName: "RegisterFile", GoImportPath: RuntimePkg})
+	t.ReflectMessageType = "*" + g.QualifiedGoIdent(protogen.GoIdent{GoName: "MessageType", GoImportPath: RuntimePkg})
+	t.ReflectMessage = g.QualifiedGoIdent(protogen.GoIdent{GoName: "Message", GoImportPath: "google.golang.org/protobuf/reflect/protoreflect"})
+	return
+}
@@ -0,0 +1,39 @@
+package genparse
+
+import (
+	"github.com/aggronmagi/protoc-gen-gopb/gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// parseFillStrictField 严格模式解析字段前校验 wire type, 不一致时返回 Kind 为 KindWireType 的 *gopb.DecodeError.
+// 不校验时 wire type 不一致的数据会按字段的类型错误解析
+func parseFillStrictField(g *protogen.GeneratedFile, genField *gengo.GenerateField) {
+	switch kind := genField.Kind; {
+	case genField.IsMap, kind == protoreflect.MessageKind:
+		genField.TemplateCheck = "check.message"
+	case kind == protoreflect.GroupKind:
+		genField.TemplateCheck = "check.group"
+	case kind == protoreflect.StringKind:
+		genField.TemplateCheck = "check.string"
+	case kind == protoreflect.BytesKind:
+		genField.TemplateCheck = "check.bytes"
+	case genField.IsList:
+		genField.TemplateCheck = "check.packed"
+	case kind == protoreflect.BoolKind:
+		genField.TemplateCheck = "check.bool"
+	case kind == protoreflect.Sint32Kind, kind == protoreflect.Sint64Kind:
+		genField.TemplateCheck = "check.sint"
+	case kind == protoreflect.Sfixed32Kind, kind == protoreflect.Fixed32Kind:
+		genField.TemplateCheck = "check.fix32"
+	case kind == protoreflect.FloatKind:
+		genField.TemplateCheck = "check.float"
+	case kind == protoreflect.Sfixed64Kind, kind == protoreflect.Fixed64Kind:
+		genField.TemplateCheck = "check.fix64"
+	case kind == protoreflect.DoubleKind:
+		genField.TemplateCheck = "check.double"
+	default:
+		genField.TemplateCheck = "check.varint"
+	}
+	g.QualifiedGoIdent(protogen.GoIdent{GoName: "WireTypeMismatch", GoImportPath: RuntimePkg})
+}
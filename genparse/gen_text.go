@@ -0,0 +1,257 @@
+package genparse
+
+import (
+	"strings"
+
+	"github.com/aggronmagi/protoc-gen-gopb/gengo"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// gentextTemplate prototext 格式的 String 及解析. 辅助函数在 gopb/text.go
+var gentextTemplate = `{{ $_ := Import "github.com/aggronmagi/protoc-gen-gopb/gopb" "TextDecoder" }}
+// String returns the protobuf text format of x
+func (x *{{.TypeName}}) String() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return string(x.AppendText(nil))
+}
+
+// AppendText appends the fields of x in protobuf text format to b
+func (x *{{.TypeName}}) AppendText(b []byte) []byte {
+	if x == nil {
+		return b
+	}
+	{{ if .Fields }}start := len(b){{ end }} {{ range $i,$field := .Fields }} {{ $vname := ValueName "x." $field.GoName }} {{ if TextStrconv $field }} {{ $_ := Import "strconv" "AppendInt" }} {{ end }} {{ if $field.Oneof }}
+	switch ov := {{ $vname }}.(type) { {{ range $j,$of := $field.Oneof.Fields }}
+	case *{{ $of.OneofWrapper }}:
+		b = gopb.AppendTextName(b, start, "{{ $of.TextName }}")
+		{{ TextAppend $of (ValueName "ov." $of.GoName) }} {{ end }}
+	} {{ else }}
+	if {{ call $field.CheckNotEmpty $vname }} { {{ if $field.IsMap }}
+		for mk, mv := range {{ $vname }} {
+			b = gopb.AppendTextName(b, start, "{{ $field.TextName }}")
+			b = append(b, "{key:"...)
+			{{ TextAppend $field.MapKey "mk" }}
+			b = append(b, " value:"...)
+			{{ TextAppend $field.MapValue "mv" }}
+			b = append(b, '}')
+		} {{ else if $field.IsList }}
+		for _, item := range {{ $vname }} {
+			b = gopb.AppendTextName(b, start, "{{ $field.TextName }}")
+			{{ TextAppend $field "item" }}
+		} {{ else }}
+		b = gopb.AppendTextName(b, start, "{{ $field.TextName }}") {{ if $field.Pointer }}
+		{{ TextAppend $field (ValueName "*" $vname) }} {{ else }}
+		{{ TextAppend $field $vname }} {{ end }} {{ end }}
+	} {{ end }} {{ end }}
+	return b
+}
+
+// UnmarshalText unmarshal from protobuf text format
+func (x *{{.TypeName}}) UnmarshalText(data []byte) error {
+	x.Reset()
+	return x.UnmarshalTextFrom(gopb.NewTextDecoder(data))
+}
+
+// UnmarshalTextFrom reads the fields of a message from d and merges them into x.
+// non-repeated fields appeared twice and more than one member of a oneof are rejected as prototext
+func (x *{{.TypeName}}) UnmarshalTextFrom(d *gopb.TextDecoder) error {
+	{{ if TextSingular .Fields }}// 重复的非repeated字段及同一个oneof的多个成员返回错误
+	var seen{{ if .Oneofs }}, oneofs{{ end }} gopb.FieldSet {{ end }}
+	return d.ReadMessage(func(name string) (err error) {
+		switch name { {{ range $i,$field := .Fields }} {{ $vname := ValueName "x." $field.GoName }} {{ if $field.Oneof }} {{ range $j,$of := $field.Oneof.Fields }}
+		case "{{ $of.TextName }}":
+			if err = d.CheckField(&seen, {{ $of.DescNum }}, name); err != nil {
+				return
+			}
+			if err = d.CheckOneof(&oneofs, {{ $i }}, name, "{{ $of.Message }}.{{ $field.DescName }}"); err != nil {
+				return
+			}
+			ov := &{{ $of.OneofWrapper }}{}
+			{{ TextRead $of (ValueName "ov." $of.GoName) }}
+			{{ $vname }} = ov {{ end }} {{ else }}
+		case "{{ $field.TextName }}": {{ if $field.IsMap }}
+			if {{ $vname }} == nil {
+				{{ $vname }} = make({{ $field.TypeName }})
+			}
+			err = d.ReadRepeated(func() error {
+				var mk {{ $field.MapKey.TypeName }}
+				var mv {{ $field.MapValue.TypeName }}
+				var seen gopb.FieldSet
+				err := d.ReadMessage(func(name string) (err error) {
+					switch name {
+					case "key":
+						if err = d.CheckField(&seen, 1, name); err != nil {
+							return
+						}
+						{{ TextRead $field.MapKey "mk" }}
+					case "value":
+						if err = d.CheckField(&seen, 2, name); err != nil {
+							return
+						}
+						{{ TextRead $field.MapValue "mv" }}
+					default:
+						err = d.UnknownField(name)
+					}
+					return
+				})
+				{{ $vname }}[mk] = mv
+				return err
+			}) {{ else if $field.IsList }}
+			err = d.ReadRepeated(func() (err error) {
+				var item {{ TextElemType $field }}
+				{{ TextRead $field "item" }}
+				{{ $vname }} = append({{ $vname }}, item)
+				return
+			}) {{ else if $field.Pointer }}
+			if err = d.CheckField(&seen, {{ $field.DescNum }}, name); err != nil {
+				return
+			}
+			var pv {{ $field.GoType }}
+			{{ TextRead $field "pv" }}
+			{{ $vname }} = &pv {{ else }}
+			if err = d.CheckField(&seen, {{ $field.DescNum }}, name); err != nil {
+				return
+			}
+			{{ TextRead $field $vname }} {{ end }} {{ end }} {{ end }}
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+`
+
+func init() {
+	gengo.RegisterCustomModule(&gengo.CustomModule{
+		Templates: [][2]string{{"gentext", gentextTemplate}},
+		Funcs: map[string]any{
+			"TextElemType": getTextElemType,
+			"TextStrconv":  getTextStrconv,
+			"TextAppend":   getTextAppend,
+			"TextRead":     getTextRead,
+			"TextSingular": getTextSingular,
+		},
+	})
+}
+
+func getTextElemType(field *gengo.GenerateField) string {
+	return strings.TrimPrefix(field.TypeName, "[]")
+}
+
+// getTextSingular 消息中是否有非repeated的字段. 解析时需要检查重复
+func getTextSingular(fields []*gengo.GenerateField) bool {
+	for _, field := range fields {
+		if !field.IsList && !field.IsMap {
+			return true
+		}
+	}
+	return false
+}
+
+// getTextStrconv 生成的代码是否使用 strconv
+func getTextStrconv(field *gengo.GenerateField) bool {
+	switch {
+	case field.Oneof != nil:
+		for _, of := range field.Oneof.Fields {
+			if getTextStrconv(of) {
+				return true
+			}
+		}
+		return false
+	case field.IsMap:
+		return getTextStrconv(field.MapKey) || getTextStrconv(field.MapValue)
+	case field.WKT == wktWrapper:
+		return getTextStrconv(field.WrapperValue)
+	case field.WKT != "":
+		return false
+	}
+	switch field.Kind {
+	case protoreflect.BoolKind, protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Int64Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed64Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return true
+	}
+	return false
+}
+
+// getTextAppend 把 vname 的值追加到 b 的代码. 消息使用 {} 包围
+func getTextAppend(field *gengo.GenerateField, vname string) string {
+	switch field.WKT {
+	case wktTimestamp:
+		return "if " + vname + " != nil {\n b = gopb.AppendTextTimestamp(b, *" + vname + ")\n} else {\n b = append(b, '{', '}')\n}"
+	case wktDuration:
+		return "if " + vname + " != nil {\n b = gopb.AppendTextDuration(b, *" + vname + ")\n} else {\n b = append(b, '{', '}')\n}"
+	case wktWrapper:
+		value := vname
+		if field.WrapperValue.Kind != protoreflect.BytesKind {
+			value = "*" + vname
+		}
+		return "b = append(b, '{')\nif " + field.WrapperValue.CheckNotEmpty(value) + " {\n b = append(b, \"value:\"...)\n " +
+			getTextAppend(field.WrapperValue, value) + "\n}\nb = append(b, '}')"
+	}
+	switch field.Kind {
+	case protoreflect.BoolKind:
+		return "b = strconv.AppendBool(b, " + vname + ")"
+	case protoreflect.EnumKind:
+		return "b = gopb.AppendTextEnum(b, int32(" + vname + "), " + field.GoType + "_name)"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "b = strconv.AppendInt(b, int64(" + vname + "), 10)"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "b = strconv.AppendUint(b, uint64(" + vname + "), 10)"
+	case protoreflect.FloatKind:
+		return "b = gopb.AppendTextFloat(b, float64(" + vname + "), 32)"
+	case protoreflect.DoubleKind:
+		return "b = gopb.AppendTextFloat(b, " + vname + ", 64)"
+	case protoreflect.StringKind:
+		return "b = gopb.AppendTextString(b, " + vname + ")"
+	case protoreflect.BytesKind:
+		return "b = gopb.AppendTextBytes(b, " + vname + ")"
+	default: // message, group, Any
+		return "b = append(b, '{')\nb = " + vname + ".AppendText(b)\nb = append(b, '}')"
+	}
+}
+
+// getTextRead 从 d 读取值到 vname 的代码. 错误保存在 err 中
+func getTextRead(field *gengo.GenerateField, vname string) string {
+	switch field.WKT {
+	case wktTimestamp:
+		return "var wt time.Time\nif wt, err = d.ReadTimestamp(); err != nil {\n return\n}\n" + vname + " = &wt"
+	case wktDuration:
+		return "var wd time.Duration\nif wd, err = d.ReadDuration(); err != nil {\n return\n}\n" + vname + " = &wd"
+	case wktWrapper:
+		// value 只能出现一次
+		read := "var ws gopb.FieldSet\nerr = d.ReadMessage(func(name string) (err error) {\n if name != \"value\" {\n return d.UnknownField(name)\n}\n" +
+			"if err = d.CheckField(&ws, 1, name); err != nil {\n return\n}\n"
+		if field.WrapperValue.Kind == protoreflect.BytesKind {
+			return vname + " = []byte{}\n" + read + getTextRead(field.WrapperValue, vname) + "\nreturn\n})"
+		}
+		return "var wv " + field.WrapperValue.GoType + "\n" + read + getTextRead(field.WrapperValue, "wv") + "\nreturn\n})\n" + vname + " = &wv"
+	}
+	switch field.Kind {
+	case protoreflect.BoolKind:
+		return vname + ", err = d.ReadBool()"
+	case protoreflect.EnumKind:
+		return "ev, err := d.ReadEnum(" + field.GoType + "_value)\nif err != nil {\n return err\n}\n" + vname + " = " + field.GoType + "(ev)"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return vname + ", err = d.ReadInt32()"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return vname + ", err = d.ReadUint32()"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return vname + ", err = d.ReadInt64()"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return vname + ", err = d.ReadUint64()"
+	case protoreflect.FloatKind:
+		return vname + ", err = d.ReadFloat32()"
+	case protoreflect.DoubleKind:
+		return vname + ", err = d.ReadFloat64()"
+	case protoreflect.StringKind:
+		return vname + ", err = d.ReadString()"
+	case protoreflect.BytesKind:
+		return vname + ", err = d.ReadBytes()"
+	default: // message, group, Any. 多次出现时合并
+		return "if " + vname + " == nil {\n " + vname + " = &" + field.GoType + "{}\n}\nerr = " + vname + ".UnmarshalTextFrom(d)"
+	}
+}
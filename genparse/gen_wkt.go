@@ -0,0 +1,87 @@
+package genparse
+
+import (
+	"github.com/aggronmagi/protoc-gen-gopb/gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// 映射为go原生类型的 well-known types
+const (
+	wktTimestamp = "timestamp" // google.protobuf.Timestamp => *time.Time
+	wktDuration  = "duration"  // google.protobuf.Duration => *time.Duration
+	wktWrapper   = "wrapper"   // google.protobuf.XXXValue => *T, BytesValue => []byte
+)
+
+// fieldWKT returns the well-known type of the field mapped to native go type.
+// empty if the mapping is disabled or the field is not a well-known type.
+func fieldWKT(field *protogen.Field) string {
+	if !WKT || field.Message == nil || field.Desc.IsMap() {
+		return ""
+	}
+	switch field.Message.Desc.FullName() {
+	case "google.protobuf.Timestamp":
+		return wktTimestamp
+	case "google.protobuf.Duration":
+		return wktDuration
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue",
+		"google.protobuf.Int64Value", "google.protobuf.UInt64Value",
+		"google.protobuf.Int32Value", "google.protobuf.UInt32Value",
+		"google.protobuf.BoolValue", "google.protobuf.StringValue",
+		"google.protobuf.BytesValue":
+		return wktWrapper
+	}
+	return ""
+}
+
+// fieldIsAny reports whether the field is google.protobuf.Any mapped to gopb.Any.
+// gopb.Any 实现了消息的序列化方法, 使用消息的模板.
+func fieldIsAny(field *protogen.Field) bool {
+	return WKT && field.Message != nil && !field.Desc.IsMap() &&
+		field.Message.Desc.FullName() == "google.protobuf.Any"
+}
+
+// wktGoType returns the native go type of the well-known type field element.
+func wktGoType(g *protogen.GeneratedFile, field *protogen.Field) string {
+	switch fieldWKT(field) {
+	case wktTimestamp:
+		return "*" + g.QualifiedGoIdent(protogen.GoIdent{GoName: "Time", GoImportPath: "time"})
+	case wktDuration:
+		return "*" + g.QualifiedGoIdent(protogen.GoIdent{GoName: "Duration", GoImportPath: "time"})
+	case wktWrapper:
+		goType, _ := fieldGoType(g, field.Message.Fields[0])
+		if goType == "[]byte" {
+			return goType // rely on nullability of slices for presence
+		}
+		return "*" + goType
+	}
+	return ""
+}
+
+// parseFillWKTFiled 使用原生类型的 well-known types 字段. 序列化格式与对应的消息一致
+func parseFillWKTFiled(msg *gengo.GenerateMessage, g *protogen.GeneratedFile, f *protogen.File, genField *gengo.GenerateField, field *protogen.Field) (err error) {
+	genField.WKT = fieldWKT(field)
+	genField.CheckNotEmpty = func(x string) string {
+		return x + " != nil"
+	}
+	genField.TemplateSize = "size." + genField.WKT
+	genField.TemplateEncode = "encode." + genField.WKT
+	genField.TemplateDecode = "decode." + genField.WKT
+
+	if genField.WKT == wktWrapper {
+		genField.WrapperValue, err = parseMessageField(msg, g, f, field.Message, field.Message.Fields[0])
+		if err != nil {
+			return
+		}
+		genField.WrapperValue.Tip = genField.Tip + ".value"
+	}
+
+	if field.Desc.IsList() {
+		genField.CheckNotEmpty = func(x string) string {
+			return "len(" + x + ") > 0"
+		}
+		genField.ElemTemplateSize, genField.TemplateSize = genField.TemplateSize, "size.nopack.wkt"
+		genField.ElemTemplateEncode, genField.TemplateEncode = genField.TemplateEncode, "encode.nopack.wkt"
+		genField.ElemTemplateDecode, genField.TemplateDecode = genField.TemplateDecode, "decode.slice.wkt"
+	}
+	return
+}
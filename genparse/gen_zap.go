@@ -14,18 +14,37 @@ func (x *{{.GoName}}) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	{{- $import := ZapImport $field.MapKey -}} 
 	{{- if $import}} {{$i := Import "strconv" "FormatInt"}} {{end }}
 	enc.AddObject("{{$field.GoName}}", zapcore.ObjectMarshalerFunc(func(oe zapcore.ObjectEncoder) error {
-		for k,v := range x.{{$field.GoName}} {
-			oe.Add{{ZapFieldFunc $field.MapValue}}({{ZapMapKey $field.MapKey}}, v{{ZapFieldMethod $field.MapValue}})
+		for k,v := range x.{{$field.GoName}} { {{if ZapDeref $field.MapValue}}
+			if v != nil {
+				oe.Add{{ZapFieldFunc $field.MapValue}}({{ZapMapKey $field.MapKey}}, *v)
+			} {{else}}
+			oe.Add{{ZapFieldFunc $field.MapValue}}({{ZapMapKey $field.MapKey}}, v{{ZapFieldMethod $field.MapValue}}) {{end}}
 		}
 		return nil
 	})){{else if $field.IsList }}
 	enc.AddArray("{{$field.GoName}}", zapcore.ArrayMarshalerFunc(func(ae zapcore.ArrayEncoder) error { 
-		for _,v := range x.{{$field.GoName}} { {{$fname := ZapFieldFunc $field}} {{if eq $fname "Binary"}} {{$_ := Import "encoding/base64" "encode"}}
+		for _,v := range x.{{$field.GoName}} { {{$fname := ZapFieldFunc $field}} {{if ZapDeref $field}}
+			if v != nil {
+				ae.Append{{$fname}}(*v)
+			} {{else if eq $fname "Binary"}} {{$_ := Import "encoding/base64" "encode"}}
 			ae.AppendString(base64.StdEncoding.EncodeToString(v{{ZapFieldMethod $field}})) {{else}}
 			ae.Append{{$fname}}(v{{ZapFieldMethod $field}}) {{end}}
 		}
 		return nil 
-	})){{else}}
+	})){{else if $field.Oneof }}
+	switch ov := x.{{$field.GoName}}.(type) { {{ range $j,$of := $field.Oneof.Fields }}
+	case *{{$of.OneofWrapper}}: {{$fname := ZapFieldFunc $of}} {{if ZapDeref $of}}
+		if ov.{{$of.GoName}} != nil {
+			enc.Add{{$fname}}("{{$of.GoName}}", *ov.{{$of.GoName}})
+		} {{else}}
+		enc.Add{{$fname}}("{{$of.GoName}}", ov.{{$of.GoName}}{{ZapFieldMethod $of}}) {{end}} {{ end }}
+	}{{else if ZapDeref $field }}
+	if x.{{$field.GoName}} != nil {
+		enc.Add{{ZapFieldFunc $field}}("{{$field.GoName}}", *x.{{$field.GoName}})
+	}{{else if $field.Pointer }}
+	if x.{{$field.GoName}} != nil {
+		enc.Add{{ZapFieldFunc $field}}("{{$field.GoName}}", {{ $method := ZapFieldMethod $field }}{{ if $method }}(*x.{{$field.GoName}}){{ $method }}{{ else }}*x.{{$field.GoName}}{{ end }})
+	}{{else}}
 	enc.Add{{ZapFieldFunc $field}}("{{$field.GoName}}", x.{{$field.GoName}}{{ZapFieldMethod $field}}){{end}}{{end}}
 	return nil 
 }
@@ -51,6 +70,7 @@ func init() {
 			"ZapFieldMethod": getZapMethod,
 			"ZapMapKey":      getZaoFieldMapKey,
 			"ZapImport":      getZaoImprtConv,
+			"ZapDeref":       getZapDeref,
 		},
 	})
 }
@@ -96,7 +116,23 @@ func getZaoFieldMapKey(field *gengo.GenerateField) (funcName string) {
 	return
 }
 
+// getZapDeref well-known types 使用指针表示, 需要判空后解引用. BytesValue 除外
+func getZapDeref(field *gengo.GenerateField) bool {
+	return field.WKT != "" && !(field.WrapperValue != nil && field.WrapperValue.Kind == protoreflect.BytesKind)
+}
+
 func getZapFieldFunc(field *gengo.GenerateField) (funcName string) {
+	switch field.WKT {
+	case wktTimestamp:
+		return "Time"
+	case wktDuration:
+		return "Duration"
+	case wktWrapper:
+		return getZapFieldFunc(field.WrapperValue)
+	}
+	if field.Any {
+		return "String"
+	}
 	switch field.Kind {
 	case protoreflect.BoolKind:
 		funcName = "Bool"
@@ -125,8 +161,11 @@ func getZapFieldFunc(field *gengo.GenerateField) (funcName string) {
 }
 
 func getZapMethod(field *gengo.GenerateField) (fieldMethod string) {
-	switch field.Kind {
-	case protoreflect.EnumKind:
+	if field.WKT != "" {
+		return
+	}
+	switch {
+	case field.Any, field.Kind == protoreflect.EnumKind:
 		fieldMethod = ".String()"
 	}
 	return
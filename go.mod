@@ -1,8 +1,8 @@
 module github.com/aggronmagi/protoc-gen-gopb
 
-go 1.19
+go 1.20
 
 require (
 	go.uber.org/multierr v1.11.0
-	google.golang.org/protobuf v1.30.0
+	google.golang.org/protobuf v1.34.2
 )
@@ -0,0 +1,195 @@
+package gopb
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Any google.protobuf.Any 的gopb实现. 序列化格式与 google.protobuf.Any 一致.
+type Any struct {
+	// 消息类型, 格式为 "type.googleapis.com/包名.消息名"
+	TypeUrl string `json:"type_url,omitempty"`
+	// 消息序列化后的数据
+	Value []byte `json:"value,omitempty"`
+}
+
+func (x *Any) Reset() {
+	*x = Any{}
+}
+
+func (x *Any) GetTypeUrl() string {
+	if x != nil {
+		return x.TypeUrl
+	}
+	return ""
+}
+
+func (x *Any) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// Clone returns a deep copy of x
+func (x *Any) Clone() *Any {
+	if x == nil {
+		return nil
+	}
+	y := &Any{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the value slice of x
+func (x *Any) CopyFrom(src *Any) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	x.TypeUrl = src.TypeUrl
+	x.Value = append(x.Value[:0], src.Value...)
+}
+
+// Merge merges src into x. 非空字段覆盖
+func (x *Any) Merge(src *Any) {
+	if src == nil {
+		return
+	}
+	if len(src.TypeUrl) > 0 {
+		x.TypeUrl = src.TypeUrl
+	}
+	if len(src.Value) > 0 {
+		x.Value = append(x.Value[:0:0], src.Value...)
+	}
+}
+
+// Equal reports whether x and other are equal
+func (x *Any) Equal(other *Any) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	return x.TypeUrl == other.TypeUrl && bytes.Equal(x.Value, other.Value)
+}
+
+// MessageName returns the full name of the message packed in the Any.
+// The name is the part of the type url after the last '/'.
+func (x *Any) MessageName() string {
+	url := x.GetTypeUrl()
+	if i := strings.LastIndexByte(url, '/'); i >= 0 {
+		return url[i+1:]
+	}
+	return url
+}
+
+// MessageIs reports whether the Any contains a message of the same type as msg.
+func (x *Any) MessageIs(msg Message) bool {
+	return x.MessageName() == msg.XXX_MessageName()
+}
+
+// XXX_MessageName returns the full name of google.protobuf.Any.
+func (x *Any) XXX_MessageName() string {
+	return "google.protobuf.Any"
+}
+
+// String returns the type url and the length of the value. safe for nil.
+func (x *Any) String() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return "type_url:" + strconv.Quote(x.TypeUrl) + " value:<" + strconv.Itoa(len(x.Value)) + " bytes>"
+}
+
+// MarshalObject marshal data to []byte
+func (x *Any) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.MarshalObjectTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *Any) MarshalSize() (size int) {
+	if len(x.TypeUrl) > 0 {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeBytes(len(x.TypeUrl))
+	}
+	if len(x.Value) > 0 {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeBytes(len(x.Value))
+	}
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *Any) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if len(x.TypeUrl) > 0 {
+		// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+		data = append(data, 0xa)
+		data = protowire.AppendString(data, x.TypeUrl)
+	}
+	if len(x.Value) > 0 {
+		// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+		data = append(data, 0x12)
+		data = protowire.AppendBytes(data, x.Value)
+	}
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *Any) UnmarshalObject(data []byte) (err error) {
+	const name = "google.protobuf.Any"
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			return ParseError(name, "", 0, index, cnt)
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			if typ != protowire.BytesType {
+				return WireTypeMismatch(name, "type_url", 1, index, typ, protowire.BytesType)
+			}
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 0 {
+				return ParseError(name, "type_url", 1, index, cnt)
+			}
+			// type_url 为 proto3 string, 与 protobuf-go 一致总是校验
+			if !utf8.ValidString(v) {
+				return UTF8Error(name, "type_url", 1, index)
+			}
+			index += cnt
+			x.TypeUrl = v
+		case 2:
+			if typ != protowire.BytesType {
+				return WireTypeMismatch(name, "value", 2, index, typ, protowire.BytesType)
+			}
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if cnt < 0 {
+				return ParseError(name, "value", 2, index, cnt)
+			}
+			index += cnt
+			x.Value = make([]byte, len(v))
+			copy(x.Value, v)
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return ParseError(name, "", num, index, cnt)
+			}
+			index += cnt
+		}
+	}
+
+	return
+}
@@ -0,0 +1,73 @@
+package gopb
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestAnyUnmarshalError(t *testing.T) {
+	tag := func(num protowire.Number, typ protowire.Type) []byte {
+		return protowire.AppendTag(nil, num, typ)
+	}
+	cat := func(bs ...[]byte) (b []byte) {
+		for _, v := range bs {
+			b = append(b, v...)
+		}
+		return
+	}
+	tests := []struct {
+		name   string
+		data   []byte
+		field  string
+		num    protowire.Number
+		kind   ErrorKind
+		offset int
+	}{
+		{"invalid tag", []byte{0}, "", 0, KindMalformed, 0},
+		{"truncated tag", []byte{0x80}, "", 0, KindTruncated, 0},
+		{"type_url wire type", cat(tag(1, protowire.VarintType), []byte{1}), "type_url", 1, KindWireType, 1},
+		{"value wire type", cat(tag(1, protowire.BytesType), []byte{1, 'a'}, tag(2, protowire.Fixed32Type), make([]byte, 4)), "value", 2, KindWireType, 4},
+		{"invalid utf8 type_url", cat(tag(1, protowire.BytesType), []byte{2, 0xff, 'a'}), "type_url", 1, KindInvalidUTF8, 1},
+		{"truncated type_url", cat(tag(1, protowire.BytesType), []byte{5, 'a'}), "type_url", 1, KindTruncated, 1},
+		{"truncated value", cat(tag(2, protowire.BytesType), []byte{2}), "value", 2, KindTruncated, 1},
+		{"truncated unknown", cat(tag(3, protowire.Fixed64Type), []byte{1}), "", 3, KindTruncated, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Any{}).UnmarshalObject(tt.data)
+			var de *DecodeError
+			if !errors.As(err, &de) {
+				t.Fatalf("UnmarshalObject = %v, want *DecodeError", err)
+			}
+			if de.Message != "google.protobuf.Any" || de.Field != tt.field || de.Number != tt.num ||
+				de.Kind != tt.kind || de.Offset != tt.offset {
+				t.Errorf("UnmarshalObject = %+v, want field %q number %d kind %v offset %d", de, tt.field, tt.num, tt.kind, tt.offset)
+			}
+			if tt.kind == KindWireType && !errors.Is(err, ErrWireType) {
+				t.Errorf("errors.Is(%v, ErrWireType) = false", err)
+			}
+			if tt.kind == KindInvalidUTF8 && !errors.Is(err, ErrInvalidUTF8) {
+				t.Errorf("errors.Is(%v, ErrInvalidUTF8) = false", err)
+			}
+		})
+	}
+}
+
+func TestAnyUnmarshal(t *testing.T) {
+	var data []byte
+	data = protowire.AppendTag(data, 3, protowire.VarintType)
+	data = protowire.AppendVarint(data, 1)
+	data = protowire.AppendTag(data, 1, protowire.BytesType)
+	data = protowire.AppendString(data, "type.googleapis.com/a.B")
+	data = protowire.AppendTag(data, 2, protowire.BytesType)
+	data = protowire.AppendBytes(data, []byte{1, 2})
+	x := &Any{}
+	if err := x.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	if x.TypeUrl != "type.googleapis.com/a.B" || string(x.Value) != "\x01\x02" {
+		t.Errorf("UnmarshalObject = %v", x)
+	}
+}
@@ -0,0 +1,46 @@
+package gopb
+
+import "sort"
+
+// DeterministicMarshaler 使用 deterministic 参数生成的消息实现的接口.
+// map 按键排序序列化, 相同的消息总是得到相同的数据
+type DeterministicMarshaler interface {
+	MarshalObjectDeterministic() (data []byte, err error)
+	MarshalObjectDeterministicTo(buf []byte) (data []byte, err error)
+}
+
+// MarshalTo marshal m to buf. If deterministic is true and m implements DeterministicMarshaler,
+// map entries are sorted by key. 生成的代码用于序列化其他go包中的子消息
+func MarshalTo(m interface {
+	MarshalObjectTo(buf []byte) (data []byte, err error)
+}, buf []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		if dm, ok := m.(DeterministicMarshaler); ok {
+			return dm.MarshalObjectDeterministicTo(buf)
+		}
+	}
+	return m.MarshalObjectTo(buf)
+}
+
+// MapKey map 键对应的go类型. bool 键由生成的代码按 false, true 的顺序处理
+type MapKey interface {
+	~int32 | ~int64 | ~uint32 | ~uint64 | ~string
+}
+
+// SortedMapKeys returns the keys of m in ascending order.
+// 数值按大小排序, 字符串按字节序排序, 与 proto.MarshalOptions{Deterministic: true} 一致
+func SortedMapKeys[K MapKey, V any](m map[K]V) []K {
+	keys := make(sortedKeys[K], 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Sort(keys)
+	return keys
+}
+
+// sortedKeys sort.Slice 使用反射交换元素, 这里实现 sort.Interface
+type sortedKeys[K MapKey] []K
+
+func (s sortedKeys[K]) Len() int           { return len(s) }
+func (s sortedKeys[K]) Less(i, j int) bool { return s[i] < s[j] }
+func (s sortedKeys[K]) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,187 @@
+package gopb
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ErrWireType 字段的 wire type 与定义不一致. 使用 errors.Is 判断
+var ErrWireType = errors.New("wire type mismatch")
+
+// ErrInvalidTime Timestamp/Duration 超出范围或 seconds/nanos 无效. 使用 errors.Is 判断
+var ErrInvalidTime = errors.New("invalid Timestamp or Duration")
+
+// ErrInvalidUTF8 string 字段不是有效的 utf8. 使用 errors.Is 判断
+var ErrInvalidUTF8 = errors.New("invalid utf8")
+
+// WireTypeError 字段的 wire type 与 proto 定义不一致. 作为 DecodeError.Err 返回, 字段路径见 DecodeError
+type WireTypeError struct {
+	Number protowire.Number // 字段编号
+	Type   protowire.Type   // 数据中的 wire type
+	Want   protowire.Type   // 定义的 wire type. packed 列表也接受 BytesType
+}
+
+func (e *WireTypeError) Error() string {
+	return fmt.Sprintf("wire type %d, want %d", e.Type, e.Want)
+}
+
+// Is reports whether target is ErrWireType
+func (e *WireTypeError) Is(target error) bool {
+	return target == ErrWireType
+}
+
+// ErrorKind 解析错误的类型
+type ErrorKind uint8
+
+const (
+	// KindMalformed 数据格式错误. 无效的tag, varint溢出, group不匹配等
+	KindMalformed ErrorKind = iota
+	// KindTruncated 数据不完整
+	KindTruncated
+	// KindWireType 字段的 wire type 与定义不一致
+	KindWireType
+	// KindInvalidUTF8 string 字段不是有效的 utf8
+	KindInvalidUTF8
+	// KindLimit 超过 UnmarshalOptions 的限制
+	KindLimit
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case KindMalformed:
+		return "malformed"
+	case KindTruncated:
+		return "truncated"
+	case KindWireType:
+		return "wire type mismatch"
+	case KindInvalidUTF8:
+		return "invalid utf8"
+	case KindLimit:
+		return "limit exceeded"
+	}
+	return "unknown"
+}
+
+// DecodeError 生成的 UnmarshalObject 返回的解析错误. 使用 errors.As 获取.
+// 嵌套消息的错误由外层消息加上字段路径, Message 为最外层的消息
+type DecodeError struct {
+	Message string           // 消息的 proto 全名
+	Field   string           // 字段路径. 例如 r_msg[2].name, m_str_int.key. 为空时表示tag错误
+	Number  protowire.Number // 出错字段的编号
+	Offset  int              // 出错位置在输入数据中的偏移
+	Kind    ErrorKind
+	Err     error // 原始错误
+}
+
+func (e *DecodeError) Error() string {
+	name := e.Message
+	if e.Field != "" {
+		name += "." + e.Field
+	}
+	// Err 与 Kind 相同时(例如 ErrInvalidUTF8)只输出一次
+	if e.Err == nil || e.Err.Error() == e.Kind.String() {
+		return fmt.Sprintf("parse %s ID:%d offset:%d : %s", name, e.Number, e.Offset, e.Kind)
+	}
+	return fmt.Sprintf("parse %s ID:%d offset:%d : %s: %v", name, e.Number, e.Offset, e.Kind, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// NewDecodeError returns a *DecodeError.
+func NewDecodeError(message, field string, num protowire.Number, offset int, kind ErrorKind, err error) error {
+	return &DecodeError{Message: message, Field: field, Number: num, Offset: offset, Kind: kind, Err: err}
+}
+
+// ParseError returns a *DecodeError for the negative length n returned by the protowire Consume functions.
+func ParseError(message, field string, num protowire.Number, offset, n int) error {
+	err := protowire.ParseError(n)
+	kind := KindMalformed
+	if err == io.ErrUnexpectedEOF {
+		kind = KindTruncated
+	}
+	return &DecodeError{Message: message, Field: field, Number: num, Offset: offset, Kind: kind, Err: err}
+}
+
+// WireTypeMismatch returns a *DecodeError wrapping a *WireTypeError.
+func WireTypeMismatch(message, field string, num protowire.Number, offset int, typ, want protowire.Type) error {
+	return &DecodeError{Message: message, Field: field, Number: num, Offset: offset, Kind: KindWireType,
+		Err: &WireTypeError{Number: num, Type: typ, Want: want}}
+}
+
+// UTF8Error returns a *DecodeError of KindInvalidUTF8.
+func UTF8Error(message, field string, num protowire.Number, offset int) error {
+	return &DecodeError{Message: message, Field: field, Number: num, Offset: offset, Kind: KindInvalidUTF8, Err: ErrInvalidUTF8}
+}
+
+// 与 timestamppb.CheckValid 一致: 0001-01-01T00:00:00Z 至 9999-12-31T23:59:59Z
+const (
+	minTimestampSeconds = -62135596800
+	maxTimestampSeconds = 253402300799
+)
+
+// CheckTimestamp returns an error wrapping ErrInvalidTime if seconds/nanos is not a valid
+// google.protobuf.Timestamp, the same as timestamppb.CheckValid.
+func CheckTimestamp(secs, nanos int64) error {
+	switch {
+	case secs < minTimestampSeconds || secs > maxTimestampSeconds:
+		return fmt.Errorf("timestamp seconds %d out of range: %w", secs, ErrInvalidTime)
+	case nanos < 0 || nanos >= 1e9:
+		return fmt.Errorf("timestamp nanos %d out of range: %w", nanos, ErrInvalidTime)
+	}
+	return nil
+}
+
+// CheckDuration returns an error wrapping ErrInvalidTime if seconds/nanos is not a valid
+// google.protobuf.Duration (see durationpb.CheckValid) or does not fit in time.Duration (about ±292 years).
+func CheckDuration(secs, nanos int64) error {
+	const maxSecs = math.MaxInt64 / int64(time.Second)
+	switch {
+	case nanos <= -1e9 || nanos >= 1e9:
+		return fmt.Errorf("duration nanos %d out of range: %w", nanos, ErrInvalidTime)
+	case (secs > 0 && nanos < 0) || (secs < 0 && nanos > 0):
+		return fmt.Errorf("duration seconds %d and nanos %d have different signs: %w", secs, nanos, ErrInvalidTime)
+	case secs > maxSecs || secs < -maxSecs ||
+		(secs == maxSecs && nanos > math.MaxInt64%int64(time.Second)) ||
+		(secs == -maxSecs && nanos < math.MinInt64%int64(time.Second)):
+		return fmt.Errorf("duration seconds %d overflows time.Duration: %w", secs, ErrInvalidTime)
+	}
+	return nil
+}
+
+// MarshalUTF8Error 序列化时 string 字段不是有效的 utf8
+func MarshalUTF8Error(message, field string) error {
+	return fmt.Errorf("marshal %s.%s : %w", message, field, ErrInvalidUTF8)
+}
+
+// maxFieldPath 字段路径的最大长度. 嵌套很深时每层都要复制路径, 超过后只保留外层的部分
+const maxFieldPath = 256
+
+// WrapDecodeError 嵌套消息解析失败时, 在错误的字段路径前加上父消息中的字段.
+// index 不小于0时为列表下标, offset 为子消息数据在父消息数据中的偏移
+func WrapDecodeError(err error, message, field string, index, offset int) error {
+	de, ok := err.(*DecodeError)
+	if !ok {
+		return err
+	}
+	if index >= 0 {
+		field += "[" + strconv.Itoa(index) + "]"
+	}
+	if de.Field != "" {
+		field += "." + de.Field
+	}
+	if len(field) > maxFieldPath {
+		field = field[:maxFieldPath] + "..."
+	}
+	de.Message = message
+	de.Field = field
+	de.Offset += offset
+	return de
+}
@@ -0,0 +1,47 @@
+package gopb
+
+import (
+	"bytes"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// EqualExtensions reports whether the encoded extension fields a and b are equal.
+// 按字段编号比较, 不同扩展字段出现的顺序不影响结果; 同一个字段的记录按出现的顺序比较
+func EqualExtensions(a, b []byte) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	fa, ok := splitFields(a)
+	if !ok {
+		return false
+	}
+	fb, ok := splitFields(b)
+	if !ok || len(fa) != len(fb) {
+		return false
+	}
+	for num, v := range fa {
+		if !bytes.Equal(v, fb[num]) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitFields 按字段编号拼接编码的记录(tag 及值). 数据无效时返回 false
+func splitFields(b []byte) (map[protowire.Number][]byte, bool) {
+	fields := make(map[protowire.Number][]byte)
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, false
+		}
+		m := protowire.ConsumeFieldValue(num, typ, b[n:])
+		if m < 0 {
+			return nil, false
+		}
+		fields[num] = append(fields[num], b[:n+m]...)
+		b = b[n+m:]
+	}
+	return fields, true
+}
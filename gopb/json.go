@@ -0,0 +1,1103 @@
+package gopb
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// 生成的 MarshalJSON/UnmarshalJSON (json 参数) 使用的辅助函数.
+// 输出及接受的格式与 protojson 的规范映射一致:
+//   - 字段名使用 lowerCamel 的 json_name, 解析时同时接受proto中的名字
+//   - 64位整数输出为字符串, 解析时接受数字及字符串
+//   - 枚举输出名字, 未定义的值输出数字
+//   - bytes 输出为标准base64, 解析时接受标准及url编码, 可以省略填充
+//   - float/double 的 NaN, Infinity, -Infinity 输出为字符串
+//   - Timestamp 为 RFC 3339 格式, Duration 为 "1.5s" 格式
+//   - 重复的字段, 重复的map键, 同一个oneof的多个成员返回错误
+//   - Any 中的 well-known types 为 {"@type": ..., "value": ...}, 不支持 Struct/Value/ListValue/FieldMask
+
+// CloseJSON closes the JSON object or array in b with c.
+// The trailing comma after the last member is replaced.
+func CloseJSON(b []byte, c byte) []byte {
+	if n := len(b); n > 0 && b[n-1] == ',' {
+		b[n-1] = c
+		return b
+	}
+	return append(b, c)
+}
+
+// AppendJSONString appends the quoted JSON string of s to b.
+// invalid UTF-8 is replaced with U+FFFD.
+func AppendJSONString(b []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c >= utf8.RuneSelf {
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if r == utf8.RuneError && size == 1 {
+				b = append(b, s[start:i]...)
+				b = append(b, "�"...)
+				i += size
+				start = i
+				continue
+			}
+			i += size
+			continue
+		}
+		if c >= 0x20 && c != '"' && c != '\\' {
+			i++
+			continue
+		}
+		b = append(b, s[start:i]...)
+		switch c {
+		case '"', '\\':
+			b = append(b, '\\', c)
+		case '\b':
+			b = append(b, '\\', 'b')
+		case '\f':
+			b = append(b, '\\', 'f')
+		case '\n':
+			b = append(b, '\\', 'n')
+		case '\r':
+			b = append(b, '\\', 'r')
+		case '\t':
+			b = append(b, '\\', 't')
+		default:
+			b = append(b, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+		}
+		i++
+		start = i
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
+
+// AppendJSONBytes appends v as standard base64 JSON string to b.
+func AppendJSONBytes(b []byte, v []byte) []byte {
+	b = append(b, '"')
+	n := len(b)
+	size := base64.StdEncoding.EncodedLen(len(v))
+	b = append(b, make([]byte, size)...)
+	base64.StdEncoding.Encode(b[n:], v)
+	return append(b, '"')
+}
+
+// AppendJSONInt64 appends v as quoted JSON string to b.
+func AppendJSONInt64(b []byte, v int64) []byte {
+	b = append(b, '"')
+	b = strconv.AppendInt(b, v, 10)
+	return append(b, '"')
+}
+
+// AppendJSONUint64 appends v as quoted JSON string to b.
+func AppendJSONUint64(b []byte, v uint64) []byte {
+	b = append(b, '"')
+	b = strconv.AppendUint(b, v, 10)
+	return append(b, '"')
+}
+
+// AppendJSONFloat appends v to b. bitSize is 32 for float, 64 for double.
+func AppendJSONFloat(b []byte, v float64, bitSize int) []byte {
+	switch {
+	case math.IsNaN(v):
+		return append(b, `"NaN"`...)
+	case math.IsInf(v, 1):
+		return append(b, `"Infinity"`...)
+	case math.IsInf(v, -1):
+		return append(b, `"-Infinity"`...)
+	}
+	// 与 encoding/json 一致
+	f := byte('f')
+	if abs := math.Abs(v); abs != 0 {
+		if bitSize == 64 && (abs < 1e-6 || abs >= 1e21) ||
+			bitSize == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			f = 'e'
+		}
+	}
+	b = strconv.AppendFloat(b, v, f, -1, bitSize)
+	if f == 'e' {
+		// clean up e-09 to e-9
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b
+}
+
+// AppendJSONEnum appends the name of the enum value to b. undefined value is appended as number.
+func AppendJSONEnum(b []byte, v int32, names map[int32]string) []byte {
+	if name, ok := names[v]; ok {
+		return AppendJSONString(b, name)
+	}
+	return strconv.AppendInt(b, int64(v), 10)
+}
+
+// AppendJSONTimestamp appends t in RFC 3339 format with 0, 3, 6 or 9 fractional digits.
+func AppendJSONTimestamp(b []byte, t time.Time) ([]byte, error) {
+	t = t.UTC()
+	if year := t.Year(); year < 1 || year > 9999 {
+		return b, fmt.Errorf("gopb: timestamp %v out of range", t)
+	}
+	b = append(b, '"')
+	b = t.AppendFormat(b, "2006-01-02T15:04:05")
+	b = appendNanos(b, uint32(t.Nanosecond()))
+	return append(b, 'Z', '"'), nil
+}
+
+// AppendJSONDuration appends d in "1.5s" format with 0, 3, 6 or 9 fractional digits.
+func AppendJSONDuration(b []byte, d time.Duration) []byte {
+	b = append(b, '"')
+	u := uint64(d)
+	if d < 0 {
+		b = append(b, '-')
+		u = -u
+	}
+	b = strconv.AppendUint(b, u/uint64(time.Second), 10)
+	b = appendNanos(b, uint32(u%uint64(time.Second)))
+	return append(b, 's', '"')
+}
+
+// appendNanos appends the fractional seconds with 0, 3, 6 or 9 digits
+func appendNanos(b []byte, nanos uint32) []byte {
+	if nanos == 0 {
+		return b
+	}
+	digits := 9
+	for digits > 3 && nanos%1000 == 0 {
+		nanos /= 1000
+		digits -= 3
+	}
+	b = append(b, '.')
+	n := len(b)
+	b = strconv.AppendUint(b, uint64(nanos), 10)
+	for len(b)-n < digits {
+		b = append(b[:n+1], b[n:]...)
+		b[n] = '0'
+	}
+	return b
+}
+
+// FieldSet 记录对象中已经读取的字段号(或oneof的序号), 检查重复的字段. 零值可以直接使用
+type FieldSet struct {
+	low  uint64
+	high map[int32]struct{}
+}
+
+// Add adds num to the set. returns false if num is already in the set
+func (s *FieldSet) Add(num int32) bool {
+	if num >= 0 && num < 64 {
+		bit := uint64(1) << num
+		if s.low&bit != 0 {
+			return false
+		}
+		s.low |= bit
+		return true
+	}
+	if _, ok := s.high[num]; ok {
+		return false
+	}
+	if s.high == nil {
+		s.high = make(map[int32]struct{})
+	}
+	s.high[num] = struct{}{}
+	return true
+}
+
+// JSONDecoder 读取 protojson 格式的数据. 由生成的 UnmarshalJSONFrom 使用
+type JSONDecoder struct {
+	data []byte
+	pos  int
+	// 读取下一个对象时跳过的字段. Any 中的 "@type"
+	skipKey string
+}
+
+// NewJSONDecoder returns a decoder reading from data
+func NewJSONDecoder(data []byte) *JSONDecoder {
+	return &JSONDecoder{data: data}
+}
+
+// JSONError 语法或类型错误
+type JSONError struct {
+	Offset int
+	Msg    string
+}
+
+func (e *JSONError) Error() string {
+	return "gopb: json: " + e.Msg + " at offset " + strconv.Itoa(e.Offset)
+}
+
+func (d *JSONDecoder) errorf(format string, args ...any) error {
+	return &JSONError{Offset: d.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (d *JSONDecoder) skipSpace() {
+	for d.pos < len(d.data) {
+		switch d.data[d.pos] {
+		case ' ', '\t', '\n', '\r':
+			d.pos++
+		default:
+			return
+		}
+	}
+}
+
+// peek returns the next non-space byte. 0 at the end of data
+func (d *JSONDecoder) peek() byte {
+	d.skipSpace()
+	if d.pos < len(d.data) {
+		return d.data[d.pos]
+	}
+	return 0
+}
+
+func (d *JSONDecoder) consume(c byte) error {
+	if d.peek() != c {
+		return d.errorf("expect %q", c)
+	}
+	d.pos++
+	return nil
+}
+
+func (d *JSONDecoder) literal(s string) bool {
+	d.skipSpace()
+	if !strings.HasPrefix(string(d.data[d.pos:]), s) {
+		return false
+	}
+	d.pos += len(s)
+	return true
+}
+
+// End checks that only spaces remain
+func (d *JSONDecoder) End() error {
+	if d.peek() != 0 {
+		return d.errorf("unexpected data after top-level value")
+	}
+	return nil
+}
+
+// UnknownField returns the error of unknown field name
+func (d *JSONDecoder) UnknownField(name string) error {
+	return d.errorf("unknown field %q", name)
+}
+
+// CheckField adds the field num to seen. returns error if the field already appeared in the object
+func (d *JSONDecoder) CheckField(seen *FieldSet, num int32, name string) error {
+	if !seen.Add(num) {
+		return d.errorf("duplicate field %q", name)
+	}
+	return nil
+}
+
+// CheckOneof adds the oneof index to seen. returns error if another member of the oneof is already set
+func (d *JSONDecoder) CheckOneof(seen *FieldSet, index int32, name, oneof string) error {
+	if !seen.Add(index) {
+		return d.errorf("field %q: oneof %s is already set", name, oneof)
+	}
+	return nil
+}
+
+// DuplicateMapKey returns the error of map key appeared twice
+func (d *JSONDecoder) DuplicateMapKey(key string) error {
+	return d.errorf("duplicate map key %q", key)
+}
+
+// ReadNull consumes null if it is the next value
+func (d *JSONDecoder) ReadNull() bool {
+	return d.literal("null")
+}
+
+// ReadObject reads a JSON object. fn is called for each member and must read the value.
+func (d *JSONDecoder) ReadObject(fn func(name string) error) (err error) {
+	skipKey := d.skipKey
+	d.skipKey = ""
+	if err = d.consume('{'); err != nil {
+		return
+	}
+	if d.peek() == '}' {
+		d.pos++
+		return
+	}
+	for {
+		if d.peek() != '"' {
+			return d.errorf("expect object key")
+		}
+		var name string
+		if name, err = d.ReadString(); err != nil {
+			return
+		}
+		if err = d.consume(':'); err != nil {
+			return
+		}
+		if skipKey != "" && name == skipKey {
+			err = d.Skip()
+		} else {
+			err = fn(name)
+		}
+		if err != nil {
+			return
+		}
+		switch d.peek() {
+		case ',':
+			d.pos++
+		case '}':
+			d.pos++
+			return
+		default:
+			return d.errorf("expect ',' or '}'")
+		}
+	}
+}
+
+// ReadArray reads a JSON array. fn is called for each element and must read the value.
+func (d *JSONDecoder) ReadArray(fn func() error) (err error) {
+	if err = d.consume('['); err != nil {
+		return
+	}
+	if d.peek() == ']' {
+		d.pos++
+		return
+	}
+	for {
+		if err = fn(); err != nil {
+			return
+		}
+		switch d.peek() {
+		case ',':
+			d.pos++
+		case ']':
+			d.pos++
+			return
+		default:
+			return d.errorf("expect ',' or ']'")
+		}
+	}
+}
+
+// Skip skips the next value
+func (d *JSONDecoder) Skip() (err error) {
+	switch c := d.peek(); {
+	case c == '{':
+		return d.ReadObject(func(string) error { return d.Skip() })
+	case c == '[':
+		return d.ReadArray(d.Skip)
+	case c == '"':
+		_, err = d.ReadString()
+	case d.literal("true"), d.literal("false"), d.literal("null"):
+	default:
+		_, err = d.readNumber()
+	}
+	return
+}
+
+// ReadRaw reads the next value and returns its raw data
+func (d *JSONDecoder) ReadRaw() ([]byte, error) {
+	d.skipSpace()
+	start := d.pos
+	if err := d.Skip(); err != nil {
+		return nil, err
+	}
+	return d.data[start:d.pos], nil
+}
+
+// ReadBool reads true or false
+func (d *JSONDecoder) ReadBool() (bool, error) {
+	switch {
+	case d.literal("true"):
+		return true, nil
+	case d.literal("false"):
+		return false, nil
+	}
+	return false, d.errorf("invalid bool value")
+}
+
+// ReadString reads a JSON string
+func (d *JSONDecoder) ReadString() (string, error) {
+	if err := d.consume('"'); err != nil {
+		return "", err
+	}
+	start := d.pos
+	// 快速路径: 没有转义
+	for d.pos < len(d.data) {
+		c := d.data[d.pos]
+		if c == '"' {
+			s := d.data[start:d.pos]
+			d.pos++
+			if !utf8.Valid(s) {
+				return "", d.errorf("invalid UTF-8 in string")
+			}
+			return string(s), nil
+		}
+		if c == '\\' || c < 0x20 {
+			break
+		}
+		d.pos++
+	}
+	buf := append([]byte(nil), d.data[start:d.pos]...)
+	for d.pos < len(d.data) {
+		c := d.data[d.pos]
+		switch {
+		case c == '"':
+			d.pos++
+			if !utf8.Valid(buf) {
+				return "", d.errorf("invalid UTF-8 in string")
+			}
+			return string(buf), nil
+		case c < 0x20:
+			return "", d.errorf("invalid control character in string")
+		case c != '\\':
+			buf = append(buf, c)
+			d.pos++
+			continue
+		}
+		d.pos++
+		if d.pos >= len(d.data) {
+			break
+		}
+		c = d.data[d.pos]
+		d.pos++
+		switch c {
+		case '"', '\\', '/':
+			buf = append(buf, c)
+		case 'b':
+			buf = append(buf, '\b')
+		case 'f':
+			buf = append(buf, '\f')
+		case 'n':
+			buf = append(buf, '\n')
+		case 'r':
+			buf = append(buf, '\r')
+		case 't':
+			buf = append(buf, '\t')
+		case 'u':
+			r, ok := d.readHex4()
+			if !ok {
+				return "", d.errorf("invalid escape in string")
+			}
+			// 代理对必须成对出现, 单独的代理项与 protojson 一样返回错误
+			if utf16.IsSurrogate(r) {
+				r2 := utf8.RuneError
+				if d.pos+1 < len(d.data) && d.data[d.pos] == '\\' && d.data[d.pos+1] == 'u' {
+					d.pos += 2
+					if r2, ok = d.readHex4(); !ok {
+						return "", d.errorf("invalid escape in string")
+					}
+				}
+				if r = utf16.DecodeRune(r, r2); r == utf8.RuneError {
+					return "", d.errorf("invalid surrogate in string")
+				}
+			}
+			buf = utf8.AppendRune(buf, r)
+		default:
+			return "", d.errorf("invalid escape in string")
+		}
+	}
+	return "", d.errorf("unexpected end of string")
+}
+
+func (d *JSONDecoder) readHex4() (r rune, ok bool) {
+	if d.pos+4 > len(d.data) {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(string(d.data[d.pos:d.pos+4]), 16, 16)
+	if err != nil {
+		return 0, false
+	}
+	d.pos += 4
+	return rune(v), true
+}
+
+// readNumber reads the literal of a JSON number
+func (d *JSONDecoder) readNumber() (string, error) {
+	d.skipSpace()
+	start := d.pos
+	if d.pos < len(d.data) && d.data[d.pos] == '-' {
+		d.pos++
+	}
+	digits := d.pos
+	for d.pos < len(d.data) {
+		c := d.data[d.pos]
+		if (c >= '0' && c <= '9') || c == '.' || c == 'e' || c == 'E' || c == '+' || c == '-' {
+			d.pos++
+			continue
+		}
+		break
+	}
+	if d.pos == digits {
+		return "", d.errorf("invalid number")
+	}
+	return string(d.data[start:d.pos]), nil
+}
+
+// readNumberOrString reads a JSON number or a quoted number
+func (d *JSONDecoder) readNumberOrString() (s string, quoted bool, err error) {
+	if d.peek() == '"' {
+		s, err = d.ReadString()
+		return s, true, err
+	}
+	s, err = d.readNumber()
+	return
+}
+
+func (d *JSONDecoder) readInt(bitSize int) (int64, error) {
+	s, _, err := d.readNumberOrString()
+	if err != nil {
+		return 0, err
+	}
+	v, err := strconv.ParseInt(s, 10, bitSize)
+	if err == nil {
+		return v, nil
+	}
+	// 接受 1.0, 1e2 等整数值
+	f, ferr := strconv.ParseFloat(s, 64)
+	if ferr != nil || f != math.Trunc(f) || f < -math.Ldexp(1, bitSize-1) || f >= math.Ldexp(1, bitSize-1) {
+		return 0, d.errorf("invalid int%d value %q", bitSize, s)
+	}
+	return int64(f), nil
+}
+
+func (d *JSONDecoder) readUint(bitSize int) (uint64, error) {
+	s, _, err := d.readNumberOrString()
+	if err != nil {
+		return 0, err
+	}
+	v, err := strconv.ParseUint(s, 10, bitSize)
+	if err == nil {
+		return v, nil
+	}
+	f, ferr := strconv.ParseFloat(s, 64)
+	if ferr != nil || f != math.Trunc(f) || f < 0 || f >= math.Ldexp(1, bitSize) {
+		return 0, d.errorf("invalid uint%d value %q", bitSize, s)
+	}
+	return uint64(f), nil
+}
+
+// ReadInt32 reads a number or quoted number
+func (d *JSONDecoder) ReadInt32() (int32, error) {
+	v, err := d.readInt(32)
+	return int32(v), err
+}
+
+// ReadInt64 reads a number or quoted number
+func (d *JSONDecoder) ReadInt64() (int64, error) {
+	return d.readInt(64)
+}
+
+// ReadUint32 reads a number or quoted number
+func (d *JSONDecoder) ReadUint32() (uint32, error) {
+	v, err := d.readUint(32)
+	return uint32(v), err
+}
+
+// ReadUint64 reads a number or quoted number
+func (d *JSONDecoder) ReadUint64() (uint64, error) {
+	return d.readUint(64)
+}
+
+func (d *JSONDecoder) readFloat(bitSize int) (float64, error) {
+	s, quoted, err := d.readNumberOrString()
+	if err != nil {
+		return 0, err
+	}
+	if quoted {
+		switch s {
+		case "NaN":
+			return math.NaN(), nil
+		case "Infinity":
+			return math.Inf(1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		}
+	}
+	v, err := strconv.ParseFloat(s, bitSize)
+	if err != nil {
+		return 0, d.errorf("invalid float%d value %q", bitSize, s)
+	}
+	return v, nil
+}
+
+// ReadFloat32 reads a number, quoted number, "NaN", "Infinity" or "-Infinity"
+func (d *JSONDecoder) ReadFloat32() (float32, error) {
+	v, err := d.readFloat(32)
+	return float32(v), err
+}
+
+// ReadFloat64 reads a number, quoted number, "NaN", "Infinity" or "-Infinity"
+func (d *JSONDecoder) ReadFloat64() (float64, error) {
+	return d.readFloat(64)
+}
+
+// ReadBytes reads base64 string. standard and url encoding, with or without padding are accepted.
+// the result is not nil.
+func (d *JSONDecoder) ReadBytes() ([]byte, error) {
+	s, err := d.ReadString()
+	if err != nil {
+		return nil, err
+	}
+	enc := base64.StdEncoding
+	if strings.ContainsAny(s, "-_") {
+		enc = base64.URLEncoding
+	}
+	if len(s)%4 != 0 {
+		enc = enc.WithPadding(base64.NoPadding)
+	}
+	v, err := enc.DecodeString(s)
+	if err != nil {
+		return nil, d.errorf("invalid base64 value: %v", err)
+	}
+	if v == nil {
+		v = []byte{}
+	}
+	return v, nil
+}
+
+// ReadEnum reads the enum name or number
+func (d *JSONDecoder) ReadEnum(values map[string]int32) (int32, error) {
+	if d.peek() == '"' {
+		s, err := d.ReadString()
+		if err != nil {
+			return 0, err
+		}
+		v, ok := values[s]
+		if !ok {
+			return 0, d.errorf("invalid enum value %q", s)
+		}
+		return v, nil
+	}
+	s, err := d.readNumber()
+	if err != nil {
+		return 0, err
+	}
+	v, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, d.errorf("invalid enum value %q", s)
+	}
+	return int32(v), nil
+}
+
+// ParseMapKeyBool parses the map key name as bool
+func (d *JSONDecoder) ParseMapKeyBool(name string) (bool, error) {
+	switch name {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return false, d.errorf("invalid bool map key %q", name)
+}
+
+// ParseMapKeyInt parses the map key name as integer. bitSize is 32 or 64.
+func (d *JSONDecoder) ParseMapKeyInt(name string, bitSize int) (int64, error) {
+	v, err := strconv.ParseInt(name, 10, bitSize)
+	if err != nil {
+		return 0, d.errorf("invalid int%d map key %q", bitSize, name)
+	}
+	return v, nil
+}
+
+// ParseMapKeyUint parses the map key name as unsigned integer. bitSize is 32 or 64.
+func (d *JSONDecoder) ParseMapKeyUint(name string, bitSize int) (uint64, error) {
+	v, err := strconv.ParseUint(name, 10, bitSize)
+	if err != nil {
+		return 0, d.errorf("invalid uint%d map key %q", bitSize, name)
+	}
+	return v, nil
+}
+
+// ReadTimestamp reads RFC 3339 timestamp. the result is in UTC.
+func (d *JSONDecoder) ReadTimestamp() (time.Time, error) {
+	s, err := d.ReadString()
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, d.errorf("invalid timestamp value %q", s)
+	}
+	t = t.UTC()
+	if year := t.Year(); year < 1 || year > 9999 {
+		return time.Time{}, d.errorf("timestamp %q out of range", s)
+	}
+	return t, nil
+}
+
+// ReadDuration reads duration in "1.5s" format
+func (d *JSONDecoder) ReadDuration() (time.Duration, error) {
+	s, err := d.ReadString()
+	if err != nil {
+		return 0, err
+	}
+	v, ok := parseDuration(s)
+	if !ok {
+		return 0, d.errorf("invalid duration value %q", s)
+	}
+	return v, nil
+}
+
+func parseDuration(s string) (time.Duration, bool) {
+	if !strings.HasSuffix(s, "s") {
+		return 0, false
+	}
+	s = s[:len(s)-1]
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+	secs, frac, _ := strings.Cut(s, ".")
+	if secs == "" || len(frac) > 9 || strings.HasPrefix(secs, "+") {
+		return 0, false
+	}
+	sv, err := strconv.ParseUint(secs, 10, 64)
+	if err != nil || sv > math.MaxInt64/uint64(time.Second) {
+		return 0, false
+	}
+	var nanos uint64
+	if frac != "" {
+		nv, err := strconv.ParseUint(frac, 10, 32)
+		if err != nil || strings.HasPrefix(frac, "+") {
+			return 0, false
+		}
+		nanos = nv
+		for i := len(frac); i < 9; i++ {
+			nanos *= 10
+		}
+	}
+	u := sv*uint64(time.Second) + nanos
+	if u > math.MaxInt64 {
+		return 0, false
+	}
+	if neg {
+		return -time.Duration(u), true
+	}
+	return time.Duration(u), true
+}
+
+// jsonMessage 使用 json 参数生成的消息实现的接口
+type jsonMessage interface {
+	AppendJSON(b []byte) ([]byte, error)
+	UnmarshalJSONFrom(d *JSONDecoder) error
+}
+
+// MarshalJSON marshal to protobuf canonical JSON. the message type must be registered
+func (x *Any) MarshalJSON() ([]byte, error) {
+	return x.AppendJSON(nil)
+}
+
+// AppendJSON appends {"@type": type_url, fields of the message...} to b.
+// well-known types with special JSON format are appended as {"@type": type_url, "value": ...}
+func (x *Any) AppendJSON(b []byte) (_ []byte, err error) {
+	if x.GetTypeUrl() == "" && len(x.GetValue()) == 0 {
+		return append(b, '{', '}'), nil
+	}
+	if isAnyWKT(x.MessageName()) {
+		b = append(b, `{"@type":`...)
+		b = AppendJSONString(b, x.TypeUrl)
+		b = append(b, `,"value":`...)
+		if b, err = appendAnyWKT(b, x.MessageName(), x.Value); err != nil {
+			return b, err
+		}
+		return append(b, '}'), nil
+	}
+	msg, err := UnmarshalAny(x)
+	if err != nil {
+		return b, err
+	}
+	jm, ok := msg.(jsonMessage)
+	if !ok {
+		return b, fmt.Errorf("gopb: message %s does not support json", x.MessageName())
+	}
+	b = append(b, `{"@type":`...)
+	b = AppendJSONString(b, x.TypeUrl)
+	start := len(b)
+	if b, err = jm.AppendJSON(b); err != nil {
+		return b, err
+	}
+	// 消息的字段合并到 "@type" 之后
+	if b[start+1] == '}' {
+		return append(b[:start], '}'), nil
+	}
+	b[start] = ','
+	return b, nil
+}
+
+// UnmarshalJSON unmarshal from protobuf canonical JSON. the message type must be registered
+func (x *Any) UnmarshalJSON(data []byte) error {
+	d := NewJSONDecoder(data)
+	if err := x.UnmarshalJSONFrom(d); err != nil {
+		return err
+	}
+	return d.End()
+}
+
+// UnmarshalJSONFrom reads {"@type": type_url, fields of the message...} from d.
+// well-known types are read from {"@type": type_url, "value": ...} without registration
+func (x *Any) UnmarshalJSONFrom(d *JSONDecoder) (err error) {
+	raw, err := d.ReadRaw()
+	if err != nil {
+		return
+	}
+	var typeUrl string
+	var fields, hasType bool
+	sub := NewJSONDecoder(raw)
+	err = sub.ReadObject(func(name string) (err error) {
+		if name == "@type" {
+			if hasType {
+				return sub.errorf(`duplicate field "@type"`)
+			}
+			hasType = true
+			typeUrl, err = sub.ReadString()
+			return
+		}
+		fields = true
+		return sub.Skip()
+	})
+	if err != nil {
+		return
+	}
+	if typeUrl == "" {
+		if fields {
+			return errors.New("gopb: json: missing @type in Any")
+		}
+		x.Reset()
+		return
+	}
+	x.TypeUrl = typeUrl
+	if isAnyWKT(x.MessageName()) {
+		var hasValue bool
+		sub = NewJSONDecoder(raw)
+		err = sub.ReadObject(func(name string) (err error) {
+			switch name {
+			case "@type":
+				return sub.Skip()
+			case "value":
+				if hasValue {
+					return sub.errorf(`duplicate field "value"`)
+				}
+				hasValue = true
+				x.Value, err = readAnyWKT(sub, x.MessageName())
+				return
+			}
+			return sub.UnknownField(name)
+		})
+		if err == nil && !hasValue {
+			err = errors.New(`gopb: json: missing "value" in Any`)
+		}
+		return
+	}
+	msg, ok := NewMessage(x.MessageName())
+	if !ok {
+		return fmt.Errorf("gopb: message %s is not registered", x.MessageName())
+	}
+	jm, ok := msg.(jsonMessage)
+	if !ok {
+		return fmt.Errorf("gopb: message %s does not support json", x.MessageName())
+	}
+	sub = NewJSONDecoder(raw)
+	sub.skipKey = "@type"
+	if err = jm.UnmarshalJSONFrom(sub); err != nil {
+		return
+	}
+	x.Value, err = msg.MarshalObject()
+	return
+}
+
+// isAnyWKT 在 Any 中使用 {"@type": type_url, "value": ...} 形式的 well-known types.
+// Struct, Value, ListValue, FieldMask 不支持
+func isAnyWKT(name string) bool {
+	switch name {
+	case "google.protobuf.Timestamp", "google.protobuf.Duration", "google.protobuf.Empty", "google.protobuf.Any",
+		"google.protobuf.DoubleValue", "google.protobuf.FloatValue", "google.protobuf.Int64Value",
+		"google.protobuf.UInt64Value", "google.protobuf.Int32Value", "google.protobuf.UInt32Value",
+		"google.protobuf.BoolValue", "google.protobuf.StringValue", "google.protobuf.BytesValue":
+		return true
+	}
+	return false
+}
+
+// wktFields 读取 well-known type 的字段1,2. 数值保存在 u, string/bytes 保存在 s, 重复的字段后出现的覆盖
+func wktFields(name string, data []byte) (u [3]uint64, s [3][]byte, err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if cnt < 0 {
+			return u, s, ParseError(name, "", 0, index, cnt)
+		}
+		index += cnt
+		if num > 2 {
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+		} else {
+			switch typ {
+			case protowire.VarintType:
+				u[num], cnt = protowire.ConsumeVarint(data[index:])
+			case protowire.Fixed32Type:
+				var v uint32
+				v, cnt = protowire.ConsumeFixed32(data[index:])
+				u[num] = uint64(v)
+			case protowire.Fixed64Type:
+				u[num], cnt = protowire.ConsumeFixed64(data[index:])
+			case protowire.BytesType:
+				s[num], cnt = protowire.ConsumeBytes(data[index:])
+			default:
+				cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			}
+		}
+		if cnt < 0 {
+			return u, s, ParseError(name, "", num, index, cnt)
+		}
+		index += cnt
+	}
+	return
+}
+
+// appendAnyWKT 把 well-known type 序列化的数据 value 以 JSON 格式追加到 b
+func appendAnyWKT(b []byte, name string, value []byte) (_ []byte, err error) {
+	if name == "google.protobuf.Any" {
+		v := &Any{}
+		if err = v.UnmarshalObject(value); err != nil {
+			return b, err
+		}
+		return v.AppendJSON(b)
+	}
+	u, s, err := wktFields(name, value)
+	if err != nil {
+		return b, err
+	}
+	switch name {
+	case "google.protobuf.Timestamp":
+		return AppendJSONTimestamp(b, time.Unix(int64(u[1]), int64(int32(u[2]))).UTC())
+	case "google.protobuf.Duration":
+		return AppendJSONDuration(b, time.Duration(int64(u[1]))*time.Second+time.Duration(int32(u[2]))), nil
+	case "google.protobuf.Empty":
+		return append(b, '{', '}'), nil
+	case "google.protobuf.DoubleValue":
+		return AppendJSONFloat(b, math.Float64frombits(u[1]), 64), nil
+	case "google.protobuf.FloatValue":
+		return AppendJSONFloat(b, float64(math.Float32frombits(uint32(u[1]))), 32), nil
+	case "google.protobuf.Int64Value":
+		return AppendJSONInt64(b, int64(u[1])), nil
+	case "google.protobuf.UInt64Value":
+		return AppendJSONUint64(b, u[1]), nil
+	case "google.protobuf.Int32Value":
+		return strconv.AppendInt(b, int64(int32(u[1])), 10), nil
+	case "google.protobuf.UInt32Value":
+		return strconv.AppendUint(b, uint64(uint32(u[1])), 10), nil
+	case "google.protobuf.BoolValue":
+		return strconv.AppendBool(b, u[1] != 0), nil
+	case "google.protobuf.StringValue":
+		return AppendJSONString(b, string(s[1])), nil
+	default: // BytesValue
+		return AppendJSONBytes(b, s[1]), nil
+	}
+}
+
+// readAnyWKT 从 d 读取 well-known type 的 JSON 值, 返回序列化的数据
+func readAnyWKT(d *JSONDecoder, name string) (data []byte, err error) {
+	switch name {
+	case "google.protobuf.Any":
+		v := &Any{}
+		if err = v.UnmarshalJSONFrom(d); err != nil {
+			return
+		}
+		return v.MarshalObject()
+	case "google.protobuf.Timestamp":
+		t, err := d.ReadTimestamp()
+		if err != nil {
+			return nil, err
+		}
+		return appendSecondsNanos(nil, t.Unix(), int32(t.Nanosecond())), nil
+	case "google.protobuf.Duration":
+		v, err := d.ReadDuration()
+		if err != nil {
+			return nil, err
+		}
+		return appendSecondsNanos(nil, int64(v/time.Second), int32(v%time.Second)), nil
+	case "google.protobuf.Empty":
+		return nil, d.ReadObject(d.UnknownField)
+	}
+	// wrapper 只有字段1, 零值不序列化
+	data = []byte{}
+	switch name {
+	case "google.protobuf.DoubleValue":
+		var v float64
+		if v, err = d.ReadFloat64(); err == nil && math.Float64bits(v) != 0 {
+			data = protowire.AppendTag(data, 1, protowire.Fixed64Type)
+			data = protowire.AppendFixed64(data, math.Float64bits(v))
+		}
+	case "google.protobuf.FloatValue":
+		var v float32
+		if v, err = d.ReadFloat32(); err == nil && math.Float32bits(v) != 0 {
+			data = protowire.AppendTag(data, 1, protowire.Fixed32Type)
+			data = protowire.AppendFixed32(data, math.Float32bits(v))
+		}
+	case "google.protobuf.Int64Value", "google.protobuf.Int32Value":
+		var v int64
+		if name == "google.protobuf.Int64Value" {
+			v, err = d.ReadInt64()
+		} else {
+			var v32 int32
+			v32, err = d.ReadInt32()
+			v = int64(v32)
+		}
+		if err == nil && v != 0 {
+			data = protowire.AppendTag(data, 1, protowire.VarintType)
+			data = protowire.AppendVarint(data, uint64(v))
+		}
+	case "google.protobuf.UInt64Value", "google.protobuf.UInt32Value":
+		var v uint64
+		if name == "google.protobuf.UInt64Value" {
+			v, err = d.ReadUint64()
+		} else {
+			var v32 uint32
+			v32, err = d.ReadUint32()
+			v = uint64(v32)
+		}
+		if err == nil && v != 0 {
+			data = protowire.AppendTag(data, 1, protowire.VarintType)
+			data = protowire.AppendVarint(data, v)
+		}
+	case "google.protobuf.BoolValue":
+		var v bool
+		if v, err = d.ReadBool(); err == nil && v {
+			data = protowire.AppendTag(data, 1, protowire.VarintType)
+			data = protowire.AppendVarint(data, 1)
+		}
+	case "google.protobuf.StringValue":
+		var v string
+		if v, err = d.ReadString(); err == nil && v != "" {
+			data = protowire.AppendTag(data, 1, protowire.BytesType)
+			data = protowire.AppendString(data, v)
+		}
+	default: // BytesValue
+		var v []byte
+		if v, err = d.ReadBytes(); err == nil && len(v) > 0 {
+			data = protowire.AppendTag(data, 1, protowire.BytesType)
+			data = protowire.AppendBytes(data, v)
+		}
+	}
+	return
+}
+
+// appendSecondsNanos 序列化 Timestamp/Duration 的 seconds 及 nanos 字段
+func appendSecondsNanos(b []byte, seconds int64, nanos int32) []byte {
+	if seconds != 0 {
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(seconds))
+	}
+	if nanos != 0 {
+		b = protowire.AppendTag(b, 2, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(int64(nanos)))
+	}
+	return b
+}
@@ -0,0 +1,114 @@
+package gopb
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// well-known types 在 Any 中使用 {"@type": ..., "value": ...} 形式, 与 protojson 一致
+func TestAnyJSONWellKnownTypes(t *testing.T) {
+	inner, err := anypb.New(wrapperspb.String("x"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msgs := []proto.Message{
+		timestamppb.New(time.Unix(1700000000, 123000000)),
+		timestamppb.New(time.Unix(0, 0)),
+		durationpb.New(-1500 * time.Millisecond),
+		&emptypb.Empty{},
+		wrapperspb.Double(1.5),
+		wrapperspb.Float(-2.5),
+		wrapperspb.Int64(-1 << 40),
+		wrapperspb.UInt64(1 << 63),
+		wrapperspb.Int32(-7),
+		wrapperspb.UInt32(7),
+		wrapperspb.Bool(true),
+		wrapperspb.Bool(false),
+		wrapperspb.String("héllo"),
+		wrapperspb.Bytes([]byte{0, 1, 0xff}),
+		inner,
+	}
+	for _, m := range msgs {
+		name := string(m.ProtoReflect().Descriptor().FullName())
+		t.Run(name, func(t *testing.T) {
+			pa, err := anypb.New(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := protojson.Marshal(pa)
+			if err != nil {
+				t.Fatal(err)
+			}
+			x := &Any{}
+			if err := x.UnmarshalJSON(want); err != nil {
+				t.Fatalf("UnmarshalJSON(%s): %v", want, err)
+			}
+			if x.TypeUrl != pa.TypeUrl || !proto.Equal(mustUnmarshal(t, m, x.Value), m) {
+				t.Fatalf("UnmarshalJSON(%s) = %v", want, x)
+			}
+
+			got, err := x.MarshalJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			back := &anypb.Any{}
+			if err := protojson.Unmarshal(got, back); err != nil {
+				t.Fatalf("protojson.Unmarshal(%s): %v", got, err)
+			}
+			if !proto.Equal(back, pa) {
+				t.Errorf("MarshalJSON = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func mustUnmarshal(t *testing.T, m proto.Message, data []byte) proto.Message {
+	v := m.ProtoReflect().New().Interface()
+	if err := proto.Unmarshal(data, v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestAnyJSONReject(t *testing.T) {
+	tests := []struct {
+		data string
+		err  string
+	}{
+		{`{"@type":"type.googleapis.com/google.protobuf.Int32Value"}`, `missing "value"`},
+		{`{"@type":"type.googleapis.com/google.protobuf.Int32Value","value":1,"value":2}`, `duplicate field "value"`},
+		{`{"@type":"type.googleapis.com/google.protobuf.Int32Value","@type":"type.googleapis.com/google.protobuf.Int32Value","value":1}`, `duplicate field "@type"`},
+		{`{"@type":"type.googleapis.com/google.protobuf.Int32Value","value":1,"x":2}`, `unknown field "x"`},
+		{`{"@type":"type.googleapis.com/google.protobuf.Empty","value":{"x":1}}`, `unknown field "x"`},
+		{`{"@type":"type.googleapis.com/google.protobuf.Int32Value","value":"a"}`, `int32`},
+		{`{"@type":"type.googleapis.com/google.protobuf.StringValue","value":"\ud800"}`, `invalid surrogate`},
+		{"{\"@type\":\"type.googleapis.com/google.protobuf.StringValue\",\"value\":\"\xff\"}", `invalid UTF-8`},
+	}
+	for _, tt := range tests {
+		err := (&Any{}).UnmarshalJSON([]byte(tt.data))
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("UnmarshalJSON(%s) = %v, want %q", tt.data, err, tt.err)
+		}
+	}
+}
+
+func TestFieldSet(t *testing.T) {
+	var s FieldSet
+	for _, num := range []int32{0, 1, 63, 64, 1000, 536870911} {
+		if !s.Add(num) {
+			t.Errorf("first Add(%d) = false", num)
+		}
+		if s.Add(num) {
+			t.Errorf("second Add(%d) = true", num)
+		}
+	}
+}
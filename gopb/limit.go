@@ -0,0 +1,75 @@
+package gopb
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// DefaultMaxDepth UnmarshalOptions.MaxDepth 为0时消息嵌套的最大深度, 与 protobuf-go 一致
+const DefaultMaxDepth = 10000
+
+// ErrLimit 超过 UnmarshalOptions 的限制. 使用 errors.Is 判断
+var ErrLimit = errors.New("exceeds UnmarshalOptions")
+
+// UnmarshalOptions 解析不可信的数据时的限制. 零值只限制嵌套深度(DefaultMaxDepth).
+// 只有使用 limit 参数生成的消息支持, 超过限制时返回 Kind 为 KindLimit 的 *DecodeError
+type UnmarshalOptions struct {
+	// MaxDepth 消息嵌套的最大深度, 最外层消息为1. 0 使用 DefaultMaxDepth
+	MaxDepth int
+	// MaxElements 解析的消息, 列表元素及 map 条目的总数上限. 0 不限制
+	MaxElements int
+	// MaxLength 单个列表或 map 的最大长度. 0 不限制
+	MaxLength int
+}
+
+// UnmarshalState 一次解析的状态. 生成的代码在嵌套消息间传递, 记录当前深度及元素数量
+type UnmarshalState struct {
+	UnmarshalOptions
+	depth    int
+	elements int
+}
+
+// Enter 开始解析一个消息. 超过深度或元素数量限制时返回 false
+func (s *UnmarshalState) Enter() bool {
+	s.depth++
+	s.elements++
+	max := s.MaxDepth
+	if max <= 0 {
+		max = DefaultMaxDepth
+	}
+	return s.depth <= max && (s.MaxElements <= 0 || s.elements <= s.MaxElements)
+}
+
+// Leave 消息解析完成
+func (s *UnmarshalState) Leave() {
+	s.depth--
+}
+
+// Grow 列表或 map 增加了一个元素, n 为增加后的长度. 超过限制时返回 false
+func (s *UnmarshalState) Grow(n int) bool {
+	s.elements++
+	return (s.MaxElements <= 0 || s.elements <= s.MaxElements) && (s.MaxLength <= 0 || n <= s.MaxLength)
+}
+
+// LimitedUnmarshaler 使用 limit 参数生成的消息实现的接口
+type LimitedUnmarshaler interface {
+	UnmarshalObjectWith(data []byte, opts UnmarshalOptions) error
+	UnmarshalObjectState(data []byte, st *UnmarshalState) error
+}
+
+// UnmarshalWithState unmarshal data to m with the state st. If m does not implement LimitedUnmarshaler,
+// UnmarshalObject is used and the limits are not applied to m. 生成的代码用于解析其他go包中的子消息
+func UnmarshalWithState(m interface {
+	UnmarshalObject(data []byte) error
+}, data []byte, st *UnmarshalState) error {
+	if lm, ok := m.(LimitedUnmarshaler); ok {
+		return lm.UnmarshalObjectState(data, st)
+	}
+	return m.UnmarshalObject(data)
+}
+
+// LimitError returns a *DecodeError of KindLimit.
+func LimitError(message, field string, num protowire.Number, offset int) error {
+	return &DecodeError{Message: message, Field: field, Number: num, Offset: offset, Kind: KindLimit, Err: ErrLimit}
+}
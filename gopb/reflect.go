@@ -0,0 +1,1169 @@
+package gopb
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+	"unsafe"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// 生成的 ProtoReflect (reflect 参数) 使用的反射支持.
+//   - 生成的文件内嵌序列化后的 FileDescriptorProto, 第一次使用时构建描述符.
+//     描述符注册在 gopb 自己的注册表中, 不会与 protoc-gen-go 生成的同名类型冲突
+//   - protoreflect.Message 通过 reflect 访问生成的结构体字段, 修改直接作用于原消息
+//   - proto.Marshal/proto.Unmarshal/proto.Size 通过 ProtoMethods 使用生成的
+//     MarshalObjectTo/UnmarshalObject/MarshalSize, 不经过反射
+//   - 映射为 go 原生类型的 Timestamp/Duration/XXXValue 使用对应的适配器
+// 扩展字段不能通过反射访问, GetUnknown 返回扩展字段及未知字段的原始数据.
+
+var (
+	fileMu       sync.RWMutex
+	fileInfos    = map[string]*File{}
+	pendingFiles []*File
+	files        = &protoregistry.Files{}
+
+	typesMu sync.Mutex
+	types   = &protoregistry.Types{}
+)
+
+// Types resolves the message types of the files generated with the reflect option,
+// falling back to protoregistry.GlobalTypes.
+// Use it as the Resolver of proto/protojson/prototext options to expand Any.
+var Types interface {
+	protoregistry.MessageTypeResolver
+	protoregistry.ExtensionTypeResolver
+} = typeResolver{}
+
+// gopb/gopb.proto 只定义了字段选项, 导入它的文件构建描述符时需要
+func init() {
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("gopb/gopb.proto"),
+		Package:    proto.String("gopb"),
+		Dependency: []string{"google/protobuf/descriptor.proto"},
+		Extension: []*descriptorpb.FieldDescriptorProto{{
+			Name:     proto.String("alias"),
+			Number:   proto.Int32(60001),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum(),
+			Extendee: proto.String(".google.protobuf.FieldOptions"),
+			JsonName: proto.String("alias"),
+		}},
+		Options: &descriptorpb.FileOptions{GoPackage: proto.String("github.com/aggronmagi/protoc-gen-gopb/gopb")},
+	}
+	rawDesc, err := proto.Marshal(fdp)
+	if err != nil {
+		panic(err)
+	}
+	RegisterFile(fdp.GetName(), rawDesc)
+}
+
+// File is a proto file embedded in the generated code.
+// The descriptor is built on first use, after the files it imports.
+type File struct {
+	path     string
+	rawDesc  []byte
+	once     sync.Once
+	desc     protoreflect.FileDescriptor
+	messages []*MessageType
+}
+
+// RegisterFile registers the serialized FileDescriptorProto of a generated file.
+// It panics if the path is already registered.
+func RegisterFile(path string, rawDesc []byte) *File {
+	fileMu.Lock()
+	defer fileMu.Unlock()
+	if _, ok := fileInfos[path]; ok {
+		panic(fmt.Sprintf("gopb: file %s is already registered", path))
+	}
+	f := &File{path: path, rawDesc: rawDesc}
+	fileInfos[path] = f
+	pendingFiles = append(pendingFiles, f)
+	return f
+}
+
+// Path returns the path of the proto file.
+func (f *File) Path() string {
+	return f.path
+}
+
+// Descriptor returns the file descriptor. It panics if the embedded descriptor is invalid.
+func (f *File) Descriptor() protoreflect.FileDescriptor {
+	f.once.Do(f.build)
+	return f.desc
+}
+
+func (f *File) build() {
+	fdp := &descriptorpb.FileDescriptorProto{}
+	if err := proto.Unmarshal(f.rawDesc, fdp); err != nil {
+		panic(fmt.Sprintf("gopb: invalid descriptor of %s: %v", f.path, err))
+	}
+	fd, err := protodesc.NewFile(fdp, fileResolver{})
+	if err != nil {
+		panic(fmt.Sprintf("gopb: invalid descriptor of %s: %v", f.path, err))
+	}
+	fileMu.Lock()
+	defer fileMu.Unlock()
+	if err = files.RegisterFile(fd); err != nil {
+		panic(fmt.Sprintf("gopb: register file %s: %v", f.path, err))
+	}
+	for _, mt := range f.messages {
+		d, err := files.FindDescriptorByName(mt.name)
+		if err != nil {
+			panic(fmt.Sprintf("gopb: message %s not found in %s", mt.name, f.path))
+		}
+		mt.desc = d.(protoreflect.MessageDescriptor)
+	}
+	f.desc = fd
+}
+
+// NewMessageType returns the message type of the generated struct pointer x.
+// fields are the go names of the fields in the order of the descriptor,
+// the fields of a oneof are found in the wrappers.
+func (f *File) NewMessageType(name string, x any, fields []string, wrappers ...any) *MessageType {
+	mt := &MessageType{
+		file:     f,
+		name:     protoreflect.FullName(name),
+		goType:   reflect.TypeOf(x),
+		goFields: fields,
+		build:    (*MessageType).buildStruct,
+	}
+	for _, w := range wrappers {
+		mt.wrappers = append(mt.wrappers, reflect.TypeOf(w))
+	}
+	f.messages = append(f.messages, mt)
+	return mt
+}
+
+// fileResolver 构建描述符时查找依赖. 优先使用 gopb 生成的文件
+type fileResolver struct{}
+
+func (fileResolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	fileMu.RLock()
+	f := fileInfos[path]
+	fileMu.RUnlock()
+	if f != nil {
+		return f.Descriptor(), nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+func (fileResolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	fileMu.RLock()
+	d, err := files.FindDescriptorByName(name)
+	fileMu.RUnlock()
+	if err == nil {
+		return d, nil
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
+
+type typeResolver struct{}
+
+// syncTypes 构建所有已注册的文件, 并注册其中的消息类型. 调用者持有 typesMu
+func syncTypes() {
+	fileMu.Lock()
+	pending := pendingFiles
+	pendingFiles = nil
+	fileMu.Unlock()
+	for _, f := range pending {
+		f.Descriptor()
+		for _, mt := range f.messages {
+			if err := types.RegisterMessage(mt); err != nil {
+				panic(fmt.Sprintf("gopb: register message %s: %v", mt.name, err))
+			}
+		}
+	}
+}
+
+func (typeResolver) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	typesMu.Lock()
+	defer typesMu.Unlock()
+	syncTypes()
+	if mt, err := types.FindMessageByName(name); err == nil {
+		return mt, nil
+	}
+	return protoregistry.GlobalTypes.FindMessageByName(name)
+}
+
+func (typeResolver) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	typesMu.Lock()
+	defer typesMu.Unlock()
+	syncTypes()
+	if mt, err := types.FindMessageByURL(url); err == nil {
+		return mt, nil
+	}
+	return protoregistry.GlobalTypes.FindMessageByURL(url)
+}
+
+func (typeResolver) FindExtensionByName(field protoreflect.FullName) (protoreflect.ExtensionType, error) {
+	return protoregistry.GlobalTypes.FindExtensionByName(field)
+}
+
+func (typeResolver) FindExtensionByNumber(message protoreflect.FullName, field protoreflect.FieldNumber) (protoreflect.ExtensionType, error) {
+	return protoregistry.GlobalTypes.FindExtensionByNumber(message, field)
+}
+
+// MessageType implements protoreflect.MessageType for a generated message.
+type MessageType struct {
+	file     *File
+	name     protoreflect.FullName
+	desc     protoreflect.MessageDescriptor
+	goType   reflect.Type // 指向存储类型的指针
+	goFields []string
+	wrappers []reflect.Type
+	newPtr   func() reflect.Value
+
+	once      sync.Once
+	build     func(mt *MessageType)
+	fields    []*fieldInfo // 按 FieldDescriptor.Index 排列
+	unknown   int          // unknownFields 的下标, -1 表示不存在
+	extension int          // extensionFields 的下标, -1 表示不存在
+	methods   *protoiface.Methods
+}
+
+// MessageOf returns the protoreflect.Message view of the generated message x.
+func (mt *MessageType) MessageOf(x any) protoreflect.Message {
+	mt.lazyInit()
+	return &messageReflect{mt: mt, p: reflect.ValueOf(x)}
+}
+
+// New returns a new empty message.
+func (mt *MessageType) New() protoreflect.Message {
+	mt.lazyInit()
+	return &messageReflect{mt: mt, p: mt.newPtr()}
+}
+
+// Zero returns an invalid nil message.
+func (mt *MessageType) Zero() protoreflect.Message {
+	mt.lazyInit()
+	return &messageReflect{mt: mt, p: reflect.Zero(mt.goType)}
+}
+
+// Descriptor returns the message descriptor.
+func (mt *MessageType) Descriptor() protoreflect.MessageDescriptor {
+	if mt.file != nil {
+		mt.file.Descriptor()
+	}
+	return mt.desc
+}
+
+func (mt *MessageType) lazyInit() {
+	mt.once.Do(func() {
+		mt.Descriptor()
+		mt.unknown, mt.extension = -1, -1
+		mt.build(mt)
+	})
+}
+
+func (mt *MessageType) field(fd protoreflect.FieldDescriptor) *fieldInfo {
+	if fd.IsExtension() {
+		panic(fmt.Sprintf("gopb: extension field %s is not supported by reflection", fd.FullName()))
+	}
+	if fd.ContainingMessage().FullName() != mt.desc.FullName() {
+		panic(fmt.Sprintf("gopb: field %s does not belong to message %s", fd.FullName(), mt.desc.FullName()))
+	}
+	return mt.fields[fd.Index()]
+}
+
+// buildStruct 生成的结构体. 字段按名字查找, oneof 成员在包装类型中
+func (mt *MessageType) buildStruct() {
+	st := mt.goType.Elem()
+	fds := mt.desc.Fields()
+	if len(mt.goFields) != fds.Len() {
+		panic(fmt.Sprintf("gopb: %v has %d fields, descriptor %s has %d", st, len(mt.goFields), mt.desc.FullName(), fds.Len()))
+	}
+	mt.newPtr = func() reflect.Value {
+		return reflect.New(st)
+	}
+	mt.fields = make([]*fieldInfo, fds.Len())
+	for i := 0; i < fds.Len(); i++ {
+		fd, name := fds.Get(i), mt.goFields[i]
+		if od := fd.ContainingOneof(); od != nil && !od.IsSynthetic() {
+			mt.fields[i] = mt.oneofField(fd, name)
+			continue
+		}
+		sf, ok := st.FieldByName(name)
+		if !ok {
+			panic(fmt.Sprintf("gopb: field %s not found in %v", name, st))
+		}
+		mt.fields[i] = structField(fd, sf.Index[0], sf.Type)
+	}
+	if sf, ok := st.FieldByName("unknownFields"); ok {
+		mt.unknown = sf.Index[0]
+	}
+	if sf, ok := st.FieldByName("extensionFields"); ok {
+		mt.extension = sf.Index[0]
+	}
+	mt.methods = messageMethods
+	if mt.goType.Implements(reflect.TypeOf((*DeterministicMarshaler)(nil)).Elem()) {
+		mt.methods = deterministicMethods
+	}
+}
+
+func (mt *MessageType) oneofField(fd protoreflect.FieldDescriptor, name string) *fieldInfo {
+	st := mt.goType.Elem()
+	for _, wt := range mt.wrappers {
+		if wt.Elem().Field(0).Name != name {
+			continue
+		}
+		for i := 0; i < st.NumField(); i++ {
+			if sf := st.Field(i); sf.Type.Kind() == reflect.Interface && wt.Implements(sf.Type) {
+				return oneofField(fd, i, wt)
+			}
+		}
+	}
+	panic(fmt.Sprintf("gopb: oneof field %s not found in %v", name, st))
+}
+
+// rawFields 访问未导出的 unknownFields/extensionFields
+func rawFields(p reflect.Value, index int) *[]byte {
+	return (*[]byte)(unsafe.Pointer(p.Elem().Field(index).UnsafeAddr()))
+}
+
+// fastMessage 生成的序列化方法
+type fastMessage interface {
+	MarshalObjectTo(buf []byte) ([]byte, error)
+	MarshalSize() int
+	UnmarshalObject(data []byte) error
+}
+
+func fastOf(m protoreflect.Message) (fastMessage, bool) {
+	if !m.IsValid() {
+		return nil, false
+	}
+	x, ok := m.Interface().(fastMessage)
+	return x, ok
+}
+
+// messageMethods proto.Marshal/proto.Unmarshal 使用生成的方法.
+// 不支持丢弃未知字段, 由 proto 通过反射处理. 没有 deterministic 参数生成的消息确定性序列化也通过反射处理
+var messageMethods = &protoiface.Methods{
+	Size: func(in protoiface.SizeInput) protoiface.SizeOutput {
+		x, ok := fastOf(in.Message)
+		if !ok {
+			return protoiface.SizeOutput{}
+		}
+		return protoiface.SizeOutput{Size: x.MarshalSize()}
+	},
+	Marshal: func(in protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x, ok := fastOf(in.Message)
+		if !ok {
+			return protoiface.MarshalOutput{Buf: in.Buf}, nil
+		}
+		buf, err := x.MarshalObjectTo(in.Buf)
+		return protoiface.MarshalOutput{Buf: buf}, err
+	},
+	Unmarshal: func(in protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x, ok := fastOf(in.Message)
+		if !ok {
+			return protoiface.UnmarshalOutput{}, fmt.Errorf("gopb: unmarshal into invalid message %s", in.Message.Descriptor().FullName())
+		}
+		return protoiface.UnmarshalOutput{}, x.UnmarshalObject(in.Buf)
+	},
+	CheckInitialized: func(in protoiface.CheckInitializedInput) (protoiface.CheckInitializedOutput, error) {
+		// 没有 IsInitialized 方法时, 消息及嵌套的消息都没有 required 字段
+		if x, ok := in.Message.Interface().(interface{ IsInitialized() error }); ok && in.Message.IsValid() {
+			return protoiface.CheckInitializedOutput{}, x.IsInitialized()
+		}
+		return protoiface.CheckInitializedOutput{}, nil
+	},
+}
+
+// deterministicMethods 使用 deterministic 参数生成的消息, 确定性序列化使用 MarshalObjectDeterministicTo
+var deterministicMethods = &protoiface.Methods{
+	Flags: protoiface.SupportMarshalDeterministic,
+	Size:  messageMethods.Size,
+	Marshal: func(in protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		if in.Flags&protoiface.MarshalDeterministic == 0 {
+			return messageMethods.Marshal(in)
+		}
+		x, ok := in.Message.Interface().(DeterministicMarshaler)
+		if !ok || !in.Message.IsValid() {
+			return protoiface.MarshalOutput{Buf: in.Buf}, nil
+		}
+		buf, err := x.MarshalObjectDeterministicTo(in.Buf)
+		return protoiface.MarshalOutput{Buf: buf}, err
+	},
+	Unmarshal:        messageMethods.Unmarshal,
+	CheckInitialized: messageMethods.CheckInitialized,
+}
+
+// messageReflect implements protoreflect.Message over a pointer to the go value.
+type messageReflect struct {
+	mt *MessageType
+	p  reflect.Value
+}
+
+func (m *messageReflect) Descriptor() protoreflect.MessageDescriptor { return m.mt.desc }
+func (m *messageReflect) Type() protoreflect.MessageType             { return m.mt }
+func (m *messageReflect) New() protoreflect.Message                  { return m.mt.New() }
+func (m *messageReflect) IsValid() bool                              { return !m.p.IsNil() }
+func (m *messageReflect) ProtoMethods() *protoiface.Methods          { return m.mt.methods }
+
+// ProtoReflect makes the adapters of well-known types implement proto.Message.
+func (m *messageReflect) ProtoReflect() protoreflect.Message { return m }
+
+func (m *messageReflect) Interface() protoreflect.ProtoMessage {
+	if x, ok := m.p.Interface().(protoreflect.ProtoMessage); ok {
+		return x
+	}
+	return m
+}
+
+// value 读取使用的值. 无效的消息读取空消息的字段
+func (m *messageReflect) value() reflect.Value {
+	if m.p.IsNil() {
+		return m.mt.newPtr()
+	}
+	return m.p
+}
+
+func (m *messageReflect) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if m.p.IsNil() {
+		return
+	}
+	for _, fi := range m.mt.fields {
+		if fi.has(m.p) && !f(fi.fd, fi.get(m.p)) {
+			return
+		}
+	}
+}
+
+func (m *messageReflect) Has(fd protoreflect.FieldDescriptor) bool {
+	fi := m.mt.field(fd)
+	return !m.p.IsNil() && fi.has(m.p)
+}
+
+func (m *messageReflect) Clear(fd protoreflect.FieldDescriptor) {
+	m.mt.field(fd).clear(m.p)
+}
+
+func (m *messageReflect) Get(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	return m.mt.field(fd).get(m.value())
+}
+
+func (m *messageReflect) Set(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	m.mt.field(fd).set(m.p, v)
+}
+
+func (m *messageReflect) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	return m.mt.field(fd).mutable(m.p)
+}
+
+func (m *messageReflect) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	return m.mt.field(fd).newField()
+}
+
+func (m *messageReflect) WhichOneof(od protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	for i := 0; i < od.Fields().Len(); i++ {
+		if fd := od.Fields().Get(i); m.Has(fd) {
+			return fd
+		}
+	}
+	return nil
+}
+
+func (m *messageReflect) GetUnknown() protoreflect.RawFields {
+	if m.p.IsNil() {
+		return nil
+	}
+	var ext, unknown []byte
+	if m.mt.extension >= 0 {
+		ext = *rawFields(m.p, m.mt.extension)
+	}
+	if m.mt.unknown >= 0 {
+		unknown = *rawFields(m.p, m.mt.unknown)
+	}
+	if len(ext) == 0 {
+		return unknown
+	}
+	return append(ext[:len(ext):len(ext)], unknown...)
+}
+
+// SetUnknown 扩展范围内的字段保存到 extensionFields, 其余的保存到 unknownFields.
+// 没有对应的存储时丢弃
+func (m *messageReflect) SetUnknown(raw protoreflect.RawFields) {
+	var ext, unknown []byte
+	ranges := m.mt.desc.ExtensionRanges()
+	for len(raw) > 0 {
+		num, typ, n := protowire.ConsumeTag(raw)
+		if n > 0 {
+			if vn := protowire.ConsumeFieldValue(num, typ, raw[n:]); vn >= 0 {
+				n += vn
+			} else {
+				n = vn
+			}
+		}
+		if n < 0 {
+			unknown = append(unknown, raw...)
+			break
+		}
+		if ranges.Has(num) && m.mt.extension >= 0 {
+			ext = append(ext, raw[:n]...)
+		} else {
+			unknown = append(unknown, raw[:n]...)
+		}
+		raw = raw[n:]
+	}
+	if m.mt.extension >= 0 {
+		*rawFields(m.p, m.mt.extension) = ext
+	}
+	if m.mt.unknown >= 0 {
+		*rawFields(m.p, m.mt.unknown) = unknown
+	}
+}
+
+// fieldInfo 字段的访问方法. p 为指向消息存储的指针
+type fieldInfo struct {
+	fd       protoreflect.FieldDescriptor
+	has      func(p reflect.Value) bool
+	clear    func(p reflect.Value)
+	get      func(p reflect.Value) protoreflect.Value
+	set      func(p reflect.Value, v protoreflect.Value)
+	mutable  func(p reflect.Value) protoreflect.Value
+	newField func() protoreflect.Value
+}
+
+func notMutable(fd protoreflect.FieldDescriptor) func(p reflect.Value) protoreflect.Value {
+	return func(reflect.Value) protoreflect.Value {
+		panic(fmt.Sprintf("gopb: invalid Mutable on field %s", fd.FullName()))
+	}
+}
+
+// structField 结构体中下标为 index, 类型为 ft 的字段
+func structField(fd protoreflect.FieldDescriptor, index int, ft reflect.Type) *fieldInfo {
+	field := func(p reflect.Value) reflect.Value {
+		return p.Elem().Field(index)
+	}
+	fi := &fieldInfo{
+		fd: fd,
+		clear: func(p reflect.Value) {
+			field(p).Set(reflect.Zero(ft))
+		},
+	}
+	switch {
+	case fd.IsMap():
+		key, val := newConverter(fd.MapKey(), ft.Key()), newConverter(fd.MapValue(), ft.Elem())
+		fi.has = func(p reflect.Value) bool {
+			return field(p).Len() > 0
+		}
+		fi.get = func(p reflect.Value) protoreflect.Value {
+			return protoreflect.ValueOfMap(&mapReflect{p: field(p).Addr(), key: key, val: val})
+		}
+		fi.set = func(p reflect.Value, v protoreflect.Value) {
+			field(p).Set(mapValue(v.Map(), ft, key, val))
+		}
+		fi.mutable = func(p reflect.Value) protoreflect.Value {
+			if f := field(p); f.IsNil() {
+				f.Set(reflect.MakeMap(ft))
+			}
+			return fi.get(p)
+		}
+		fi.newField = func() protoreflect.Value {
+			mp := reflect.New(ft)
+			mp.Elem().Set(reflect.MakeMap(ft))
+			return protoreflect.ValueOfMap(&mapReflect{p: mp, key: key, val: val})
+		}
+	case fd.IsList():
+		conv := newConverter(fd, ft.Elem())
+		fi.has = func(p reflect.Value) bool {
+			return field(p).Len() > 0
+		}
+		fi.get = func(p reflect.Value) protoreflect.Value {
+			return protoreflect.ValueOfList(&listReflect{p: field(p).Addr(), conv: conv})
+		}
+		fi.set = func(p reflect.Value, v protoreflect.Value) {
+			field(p).Set(listValue(v.List(), ft, conv))
+		}
+		fi.mutable = fi.get
+		fi.newField = func() protoreflect.Value {
+			return protoreflect.ValueOfList(&listReflect{p: reflect.New(ft), conv: conv})
+		}
+	case fd.Message() != nil:
+		conv := newConverter(fd, ft)
+		fi.has = func(p reflect.Value) bool {
+			return !field(p).IsNil()
+		}
+		fi.get = func(p reflect.Value) protoreflect.Value {
+			return conv.toPB(field(p))
+		}
+		fi.set = func(p reflect.Value, v protoreflect.Value) {
+			field(p).Set(conv.toGo(v))
+		}
+		fi.mutable = func(p reflect.Value) protoreflect.Value {
+			f := field(p)
+			if f.IsNil() {
+				f.Set(conv.toGo(protoreflect.ValueOfMessage(conv.newMessage())))
+			}
+			return conv.toPB(f)
+		}
+		fi.newField = func() protoreflect.Value {
+			return protoreflect.ValueOfMessage(conv.newMessage())
+		}
+	case ft.Kind() == reflect.Ptr || (ft.Kind() == reflect.Slice && fd.HasPresence()):
+		// 显式存在性. 标量为指针, bytes 为 nil 表示不存在
+		conv := newConverter(fd, ft)
+		if ft.Kind() == reflect.Ptr {
+			conv = newConverter(fd, ft.Elem())
+		}
+		fi.has = func(p reflect.Value) bool {
+			return !field(p).IsNil()
+		}
+		fi.get = func(p reflect.Value) protoreflect.Value {
+			f := field(p)
+			switch {
+			case f.IsNil():
+				return fd.Default()
+			case ft.Kind() == reflect.Ptr:
+				return conv.toPB(f.Elem())
+			}
+			return conv.toPB(f)
+		}
+		fi.set = func(p reflect.Value, v protoreflect.Value) {
+			if ft.Kind() != reflect.Ptr {
+				field(p).Set(conv.toGo(v))
+				return
+			}
+			np := reflect.New(ft.Elem())
+			np.Elem().Set(conv.toGo(v))
+			field(p).Set(np)
+		}
+		fi.mutable = notMutable(fd)
+		fi.newField = fd.Default
+	default:
+		conv := newConverter(fd, ft)
+		fi.has = func(p reflect.Value) bool {
+			return !isEmptyValue(field(p))
+		}
+		fi.get = func(p reflect.Value) protoreflect.Value {
+			return conv.toPB(field(p))
+		}
+		fi.set = func(p reflect.Value, v protoreflect.Value) {
+			field(p).Set(conv.toGo(v))
+		}
+		fi.mutable = notMutable(fd)
+		fi.newField = fd.Default
+	}
+	return fi
+}
+
+// isEmptyValue 隐式存在性的字段是否为空. 与 proto 一致, -0 不为空
+func isEmptyValue(v reflect.Value) bool {
+	if v.Kind() == reflect.Slice {
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
+
+// oneofField 消息中下标为 index 的接口字段, 值为包装类型 wt 时表示字段存在
+func oneofField(fd protoreflect.FieldDescriptor, index int, wt reflect.Type) *fieldInfo {
+	ft := wt.Elem().Field(0).Type
+	conv := newConverter(fd, ft)
+	iface := func(p reflect.Value) reflect.Value {
+		return p.Elem().Field(index)
+	}
+	fi := &fieldInfo{fd: fd}
+	fi.has = func(p reflect.Value) bool {
+		v := iface(p)
+		return !v.IsNil() && v.Elem().Type() == wt
+	}
+	fi.clear = func(p reflect.Value) {
+		if fi.has(p) {
+			v := iface(p)
+			v.Set(reflect.Zero(v.Type()))
+		}
+	}
+	fi.get = func(p reflect.Value) protoreflect.Value {
+		switch {
+		case fi.has(p):
+			return conv.toPB(iface(p).Elem().Elem().Field(0))
+		case conv.newMessage != nil:
+			return conv.toPB(reflect.Zero(ft))
+		}
+		return fd.Default()
+	}
+	fi.set = func(p reflect.Value, v protoreflect.Value) {
+		w := reflect.New(wt.Elem())
+		w.Elem().Field(0).Set(conv.toGo(v))
+		iface(p).Set(w)
+	}
+	if conv.newMessage == nil {
+		fi.mutable = notMutable(fd)
+		fi.newField = fd.Default
+		return fi
+	}
+	fi.mutable = func(p reflect.Value) protoreflect.Value {
+		if !fi.has(p) || iface(p).Elem().Elem().Field(0).IsNil() {
+			fi.set(p, protoreflect.ValueOfMessage(conv.newMessage()))
+		}
+		return fi.get(p)
+	}
+	fi.newField = func() protoreflect.Value {
+		return protoreflect.ValueOfMessage(conv.newMessage())
+	}
+	return fi
+}
+
+// converter go 值与 protoreflect.Value 之间的转换
+type converter struct {
+	goType reflect.Type
+	toPB   func(v reflect.Value) protoreflect.Value
+	toGo   func(v protoreflect.Value) reflect.Value
+	// 消息类型创建新的消息. 标量为nil
+	newMessage func() protoreflect.Message
+}
+
+func newConverter(fd protoreflect.FieldDescriptor, t reflect.Type) *converter {
+	if fd.Message() != nil {
+		return messageConverter(fd.Message(), t)
+	}
+	c := &converter{goType: t}
+	var set func(rv reflect.Value, v protoreflect.Value)
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		c.toPB = func(v reflect.Value) protoreflect.Value { return protoreflect.ValueOfBool(v.Bool()) }
+		set = func(rv reflect.Value, v protoreflect.Value) { rv.SetBool(v.Bool()) }
+	case protoreflect.EnumKind:
+		c.toPB = func(v reflect.Value) protoreflect.Value {
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v.Int()))
+		}
+		set = func(rv reflect.Value, v protoreflect.Value) { rv.SetInt(int64(v.Enum())) }
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		c.toPB = func(v reflect.Value) protoreflect.Value { return protoreflect.ValueOfInt32(int32(v.Int())) }
+		set = func(rv reflect.Value, v protoreflect.Value) { rv.SetInt(v.Int()) }
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		c.toPB = func(v reflect.Value) protoreflect.Value { return protoreflect.ValueOfInt64(v.Int()) }
+		set = func(rv reflect.Value, v protoreflect.Value) { rv.SetInt(v.Int()) }
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		c.toPB = func(v reflect.Value) protoreflect.Value { return protoreflect.ValueOfUint32(uint32(v.Uint())) }
+		set = func(rv reflect.Value, v protoreflect.Value) { rv.SetUint(v.Uint()) }
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		c.toPB = func(v reflect.Value) protoreflect.Value { return protoreflect.ValueOfUint64(v.Uint()) }
+		set = func(rv reflect.Value, v protoreflect.Value) { rv.SetUint(v.Uint()) }
+	case protoreflect.FloatKind:
+		c.toPB = func(v reflect.Value) protoreflect.Value { return protoreflect.ValueOfFloat32(float32(v.Float())) }
+		set = func(rv reflect.Value, v protoreflect.Value) { rv.SetFloat(v.Float()) }
+	case protoreflect.DoubleKind:
+		c.toPB = func(v reflect.Value) protoreflect.Value { return protoreflect.ValueOfFloat64(v.Float()) }
+		set = func(rv reflect.Value, v protoreflect.Value) { rv.SetFloat(v.Float()) }
+	case protoreflect.StringKind:
+		c.toPB = func(v reflect.Value) protoreflect.Value { return protoreflect.ValueOfString(v.String()) }
+		set = func(rv reflect.Value, v protoreflect.Value) { rv.SetString(v.String()) }
+	case protoreflect.BytesKind:
+		c.toPB = func(v reflect.Value) protoreflect.Value { return protoreflect.ValueOfBytes(v.Bytes()) }
+		set = func(rv reflect.Value, v protoreflect.Value) {
+			b := v.Bytes()
+			if b == nil {
+				b = []byte{}
+			}
+			rv.SetBytes(b)
+		}
+	default:
+		panic(fmt.Sprintf("gopb: invalid kind %v of field %s", fd.Kind(), fd.FullName()))
+	}
+	c.toGo = func(v protoreflect.Value) reflect.Value {
+		rv := reflect.New(t).Elem()
+		set(rv, v)
+		return rv
+	}
+	return c
+}
+
+var protoMessageType = reflect.TypeOf((*protoreflect.ProtoMessage)(nil)).Elem()
+
+// messageConverter 生成的消息使用自身的 ProtoReflect, 映射为 go 原生类型的 well-known types 使用适配器.
+// 设置其他实现的消息时复制一份
+func messageConverter(md protoreflect.MessageDescriptor, t reflect.Type) *converter {
+	c := &converter{goType: t}
+	if t.Implements(protoMessageType) {
+		c.newMessage = func() protoreflect.Message {
+			return reflect.New(t.Elem()).Interface().(protoreflect.ProtoMessage).ProtoReflect()
+		}
+		c.toPB = func(v reflect.Value) protoreflect.Value {
+			return protoreflect.ValueOfMessage(v.Interface().(protoreflect.ProtoMessage).ProtoReflect())
+		}
+		c.toGo = func(v protoreflect.Value) reflect.Value {
+			rv := reflect.ValueOf(v.Message().Interface())
+			if rv.Type() != t {
+				rv = reflect.ValueOf(convertMessage(v.Message(), c.newMessage).Interface())
+			}
+			return rv
+		}
+		return c
+	}
+	mt := wktType(md.FullName(), t)
+	if mt == nil {
+		panic(fmt.Sprintf("gopb: %v of %s does not implement proto.Message. generate it with the reflect option", t, md.FullName()))
+	}
+	c.newMessage = mt.New
+	pointerOf := func(v protoreflect.Value) reflect.Value {
+		m, ok := v.Message().(*messageReflect)
+		if !ok || m.mt != mt {
+			m = convertMessage(v.Message(), mt.New).(*messageReflect)
+		}
+		return m.p
+	}
+	if t.Kind() == reflect.Ptr {
+		c.toPB = func(v reflect.Value) protoreflect.Value {
+			return protoreflect.ValueOfMessage(&messageReflect{mt: mt, p: v})
+		}
+		c.toGo = pointerOf
+		return c
+	}
+	// BytesValue 映射为 []byte, nil 表示不存在
+	c.toPB = func(v reflect.Value) protoreflect.Value {
+		var p reflect.Value
+		switch {
+		case v.IsNil():
+			p = reflect.Zero(mt.goType)
+		case v.CanAddr():
+			p = v.Addr()
+		default:
+			p = reflect.New(t)
+			p.Elem().Set(v)
+		}
+		return protoreflect.ValueOfMessage(&messageReflect{mt: mt, p: p})
+	}
+	c.toGo = func(v protoreflect.Value) reflect.Value {
+		if p := pointerOf(v); !p.IsNil() && !p.Elem().IsNil() {
+			return p.Elem()
+		}
+		return reflect.ValueOf([]byte{})
+	}
+	return c
+}
+
+// convertMessage 复制其他实现的同类型消息
+func convertMessage(m protoreflect.Message, newMessage func() protoreflect.Message) protoreflect.Message {
+	dst := newMessage()
+	if dst.Descriptor().FullName() != m.Descriptor().FullName() {
+		panic(fmt.Sprintf("gopb: invalid message %s, want %s", m.Descriptor().FullName(), dst.Descriptor().FullName()))
+	}
+	proto.Merge(dst.Interface(), m.Interface())
+	return dst
+}
+
+// listReflect implements protoreflect.List over a pointer to the slice.
+type listReflect struct {
+	p    reflect.Value
+	conv *converter
+}
+
+func (l *listReflect) Len() int {
+	if l.p.IsNil() {
+		return 0
+	}
+	return l.p.Elem().Len()
+}
+
+func (l *listReflect) Get(i int) protoreflect.Value {
+	return l.conv.toPB(l.p.Elem().Index(i))
+}
+
+func (l *listReflect) Set(i int, v protoreflect.Value) {
+	l.p.Elem().Index(i).Set(l.conv.toGo(v))
+}
+
+func (l *listReflect) Append(v protoreflect.Value) {
+	s := l.p.Elem()
+	s.Set(reflect.Append(s, l.conv.toGo(v)))
+}
+
+func (l *listReflect) AppendMutable() protoreflect.Value {
+	if l.conv.newMessage == nil {
+		panic("gopb: invalid AppendMutable on list of scalar")
+	}
+	l.Append(protoreflect.ValueOfMessage(l.conv.newMessage()))
+	return l.Get(l.Len() - 1)
+}
+
+func (l *listReflect) Truncate(n int) {
+	s := l.p.Elem()
+	s.Set(s.Slice(0, n))
+}
+
+func (l *listReflect) NewElement() protoreflect.Value {
+	if l.conv.newMessage != nil {
+		return protoreflect.ValueOfMessage(l.conv.newMessage())
+	}
+	return l.conv.toPB(reflect.Zero(l.conv.goType))
+}
+
+func (l *listReflect) IsValid() bool {
+	return !l.p.IsNil()
+}
+
+// listValue 转换为 go 的切片. 其他实现的列表逐个复制
+func listValue(list protoreflect.List, t reflect.Type, conv *converter) reflect.Value {
+	if l, ok := list.(*listReflect); ok && l.p.Type().Elem() == t {
+		return l.p.Elem()
+	}
+	s := reflect.MakeSlice(t, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		s = reflect.Append(s, conv.toGo(list.Get(i)))
+	}
+	return s
+}
+
+// mapReflect implements protoreflect.Map over a pointer to the map.
+type mapReflect struct {
+	p        reflect.Value
+	key, val *converter
+}
+
+func (m *mapReflect) Len() int {
+	if m.p.IsNil() {
+		return 0
+	}
+	return m.p.Elem().Len()
+}
+
+func (m *mapReflect) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if m.Len() == 0 {
+		return
+	}
+	iter := m.p.Elem().MapRange()
+	for iter.Next() {
+		if !f(m.key.toPB(iter.Key()).MapKey(), m.val.toPB(iter.Value())) {
+			return
+		}
+	}
+}
+
+func (m *mapReflect) Has(k protoreflect.MapKey) bool {
+	return m.Len() > 0 && m.p.Elem().MapIndex(m.key.toGo(k.Value())).IsValid()
+}
+
+func (m *mapReflect) Clear(k protoreflect.MapKey) {
+	if m.Len() > 0 {
+		m.p.Elem().SetMapIndex(m.key.toGo(k.Value()), reflect.Value{})
+	}
+}
+
+func (m *mapReflect) Get(k protoreflect.MapKey) protoreflect.Value {
+	if m.Len() == 0 {
+		return protoreflect.Value{}
+	}
+	v := m.p.Elem().MapIndex(m.key.toGo(k.Value()))
+	if !v.IsValid() {
+		return protoreflect.Value{}
+	}
+	return m.val.toPB(v)
+}
+
+func (m *mapReflect) Set(k protoreflect.MapKey, v protoreflect.Value) {
+	mv := m.p.Elem()
+	if mv.IsNil() {
+		mv.Set(reflect.MakeMap(mv.Type()))
+	}
+	mv.SetMapIndex(m.key.toGo(k.Value()), m.val.toGo(v))
+}
+
+// Mutable 值为 BytesValue 时返回的是副本
+func (m *mapReflect) Mutable(k protoreflect.MapKey) protoreflect.Value {
+	if m.val.newMessage == nil {
+		panic("gopb: invalid Mutable on map of scalar")
+	}
+	if v := m.Get(k); v.IsValid() && v.Message().IsValid() {
+		return v
+	}
+	m.Set(k, protoreflect.ValueOfMessage(m.val.newMessage()))
+	return m.Get(k)
+}
+
+func (m *mapReflect) NewValue() protoreflect.Value {
+	if m.val.newMessage != nil {
+		return protoreflect.ValueOfMessage(m.val.newMessage())
+	}
+	return m.val.toPB(reflect.Zero(m.val.goType))
+}
+
+func (m *mapReflect) IsValid() bool {
+	return !m.p.IsNil() && !m.p.Elem().IsNil()
+}
+
+// mapValue 转换为 go 的map. 其他实现的map逐个复制
+func mapValue(pm protoreflect.Map, t reflect.Type, key, val *converter) reflect.Value {
+	if m, ok := pm.(*mapReflect); ok && m.p.Type().Elem() == t {
+		return m.p.Elem()
+	}
+	mv := reflect.MakeMapWithSize(t, pm.Len())
+	pm.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		mv.SetMapIndex(key.toGo(k.Value()), val.toGo(v))
+		return true
+	})
+	return mv
+}
+
+// ProtoReflect returns a protoreflect.Message view of x, which implements proto.Message.
+func (x *Any) ProtoReflect() protoreflect.Message {
+	return anyType.MessageOf(x)
+}
+
+var anyType = &MessageType{
+	desc:     anypb.File_google_protobuf_any_proto.Messages().ByName("Any"),
+	goType:   reflect.TypeOf((*Any)(nil)),
+	goFields: []string{"TypeUrl", "Value"},
+	build:    (*MessageType).buildStruct,
+}
+
+var (
+	timeType     = reflect.TypeOf((*time.Time)(nil))
+	durationType = reflect.TypeOf((*time.Duration)(nil))
+)
+
+var (
+	timestampType = &MessageType{
+		desc:   timestamppb.File_google_protobuf_timestamp_proto.Messages().ByName("Timestamp"),
+		goType: timeType,
+		build:  buildTimestamp,
+	}
+	durationMessageType = &MessageType{
+		desc:   durationpb.File_google_protobuf_duration_proto.Messages().ByName("Duration"),
+		goType: durationType,
+		build:  buildDuration,
+	}
+	wrapperTypes = map[protoreflect.FullName]*MessageType{}
+)
+
+func init() {
+	wrappers := wrapperspb.File_google_protobuf_wrappers_proto.Messages()
+	goTypes := map[protoreflect.Name]reflect.Type{
+		"DoubleValue": reflect.TypeOf((*float64)(nil)),
+		"FloatValue":  reflect.TypeOf((*float32)(nil)),
+		"Int64Value":  reflect.TypeOf((*int64)(nil)),
+		"UInt64Value": reflect.TypeOf((*uint64)(nil)),
+		"Int32Value":  reflect.TypeOf((*int32)(nil)),
+		"UInt32Value": reflect.TypeOf((*uint32)(nil)),
+		"BoolValue":   reflect.TypeOf((*bool)(nil)),
+		"StringValue": reflect.TypeOf((*string)(nil)),
+		"BytesValue":  reflect.TypeOf((*[]byte)(nil)),
+	}
+	for i := 0; i < wrappers.Len(); i++ {
+		md := wrappers.Get(i)
+		wrapperTypes[md.FullName()] = &MessageType{desc: md, goType: goTypes[md.Name()], build: buildWrapper}
+	}
+}
+
+// wktType 映射为 go 原生类型 t 的 well-known type. 不支持时返回nil
+func wktType(name protoreflect.FullName, t reflect.Type) *MessageType {
+	var mt *MessageType
+	switch name {
+	case timestampType.desc.FullName():
+		mt = timestampType
+	case durationMessageType.desc.FullName():
+		mt = durationMessageType
+	default:
+		mt = wrapperTypes[name]
+	}
+	if mt == nil || (t != mt.goType && reflect.PtrTo(t) != mt.goType) {
+		return nil
+	}
+	mt.lazyInit()
+	return mt
+}
+
+// scalarField well-known type 的标量字段
+func scalarField(fd protoreflect.FieldDescriptor, has func(p reflect.Value) bool,
+	get func(p reflect.Value) protoreflect.Value, set func(p reflect.Value, v protoreflect.Value)) *fieldInfo {
+	return &fieldInfo{
+		fd:  fd,
+		has: has,
+		clear: func(p reflect.Value) {
+			set(p, fd.Default())
+		},
+		get:      get,
+		set:      set,
+		mutable:  notMutable(fd),
+		newField: fd.Default,
+	}
+}
+
+// buildTimestamp time.Time. 新消息为 unix 0
+func buildTimestamp(mt *MessageType) {
+	mt.newPtr = func() reflect.Value {
+		t := time.Unix(0, 0).UTC()
+		return reflect.ValueOf(&t)
+	}
+	fds := mt.desc.Fields()
+	mt.fields = []*fieldInfo{
+		scalarField(fds.ByName("seconds"), func(p reflect.Value) bool {
+			return p.Interface().(*time.Time).Unix() != 0
+		}, func(p reflect.Value) protoreflect.Value {
+			return protoreflect.ValueOfInt64(p.Interface().(*time.Time).Unix())
+		}, func(p reflect.Value, v protoreflect.Value) {
+			t := p.Interface().(*time.Time)
+			*t = time.Unix(v.Int(), int64(t.Nanosecond())).UTC()
+		}),
+		scalarField(fds.ByName("nanos"), func(p reflect.Value) bool {
+			return p.Interface().(*time.Time).Nanosecond() != 0
+		}, func(p reflect.Value) protoreflect.Value {
+			return protoreflect.ValueOfInt32(int32(p.Interface().(*time.Time).Nanosecond()))
+		}, func(p reflect.Value, v protoreflect.Value) {
+			t := p.Interface().(*time.Time)
+			*t = time.Unix(t.Unix(), v.Int()).UTC()
+		}),
+	}
+}
+
+// buildDuration time.Duration
+func buildDuration(mt *MessageType) {
+	mt.newPtr = func() reflect.Value {
+		return reflect.New(mt.goType.Elem())
+	}
+	fds := mt.desc.Fields()
+	mt.fields = []*fieldInfo{
+		scalarField(fds.ByName("seconds"), func(p reflect.Value) bool {
+			return *p.Interface().(*time.Duration)/time.Second != 0
+		}, func(p reflect.Value) protoreflect.Value {
+			return protoreflect.ValueOfInt64(int64(*p.Interface().(*time.Duration) / time.Second))
+		}, func(p reflect.Value, v protoreflect.Value) {
+			d := p.Interface().(*time.Duration)
+			*d = time.Duration(v.Int())*time.Second + *d%time.Second
+		}),
+		scalarField(fds.ByName("nanos"), func(p reflect.Value) bool {
+			return *p.Interface().(*time.Duration)%time.Second != 0
+		}, func(p reflect.Value) protoreflect.Value {
+			return protoreflect.ValueOfInt32(int32(*p.Interface().(*time.Duration) % time.Second))
+		}, func(p reflect.Value, v protoreflect.Value) {
+			d := p.Interface().(*time.Duration)
+			*d = *d/time.Second*time.Second + time.Duration(v.Int())
+		}),
+	}
+}
+
+// buildWrapper XXXValue 映射为指针, BytesValue 为 []byte
+func buildWrapper(mt *MessageType) {
+	et := mt.goType.Elem()
+	mt.newPtr = func() reflect.Value {
+		p := reflect.New(et)
+		if et.Kind() == reflect.Slice {
+			p.Elem().Set(reflect.MakeSlice(et, 0, 0))
+		}
+		return p
+	}
+	fd := mt.desc.Fields().ByName("value")
+	conv := newConverter(fd, et)
+	mt.fields = []*fieldInfo{
+		scalarField(fd, func(p reflect.Value) bool {
+			return !isEmptyValue(p.Elem())
+		}, func(p reflect.Value) protoreflect.Value {
+			return conv.toPB(p.Elem())
+		}, func(p reflect.Value, v protoreflect.Value) {
+			p.Elem().Set(conv.toGo(v))
+		}),
+	}
+}
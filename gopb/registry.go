@@ -0,0 +1,83 @@
+// Package gopb 是 protoc-gen-gopb 生成代码使用的运行时支持.
+//
+// 包含 google.protobuf.Any 的实现, 以及消息全名到构造函数的注册表.
+// 使用 registry 参数生成的代码会在 init() 中把消息注册到这里.
+package gopb
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Message 生成的消息都会实现的接口. XXX_MessageName 需要使用 registry 参数生成.
+type Message interface {
+	MarshalObjectTo(buf []byte) (data []byte, err error)
+	MarshalObject() (data []byte, err error)
+	UnmarshalObject(data []byte) (err error)
+	MarshalSize() (size int)
+	XXX_MessageName() string
+}
+
+// TypeUrlPrefix MarshalAny 使用的类型前缀
+const TypeUrlPrefix = "type.googleapis.com/"
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]func() Message{}
+)
+
+func init() {
+	RegisterType("google.protobuf.Any", func() Message { return &Any{} })
+}
+
+// RegisterType registers the constructor of the message by its full name.
+// It panics if the name is already registered.
+func RegisterType(name string, newFunc func() Message) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[name]; ok {
+		panic(fmt.Sprintf("gopb: message %s is already registered", name))
+	}
+	registry[name] = newFunc
+}
+
+// NewMessage returns a new empty message of the registered full name.
+func NewMessage(name string) (msg Message, ok bool) {
+	registryMu.RLock()
+	newFunc, ok := registry[name]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return newFunc(), true
+}
+
+// MarshalAny marshals the message into a new Any.
+func MarshalAny(msg Message) (*Any, error) {
+	value, err := msg.MarshalObject()
+	if err != nil {
+		return nil, err
+	}
+	return &Any{TypeUrl: TypeUrlPrefix + msg.XXX_MessageName(), Value: value}, nil
+}
+
+// UnmarshalAny unmarshals the Any into a new message of the registered type.
+func UnmarshalAny(any *Any) (Message, error) {
+	name := any.MessageName()
+	msg, ok := NewMessage(name)
+	if !ok {
+		return nil, fmt.Errorf("gopb: message %s is not registered", name)
+	}
+	if err := msg.UnmarshalObject(any.GetValue()); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// UnmarshalAnyTo unmarshals the Any into msg. The type must match.
+func UnmarshalAnyTo(any *Any, msg Message) error {
+	if !any.MessageIs(msg) {
+		return fmt.Errorf("gopb: mismatched message type: got %s, want %s", any.MessageName(), msg.XXX_MessageName())
+	}
+	return msg.UnmarshalObject(any.GetValue())
+}
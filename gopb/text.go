@@ -0,0 +1,747 @@
+package gopb
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// 生成的 String/UnmarshalText (text 参数) 使用的辅助函数.
+// 输出及接受的格式与 prototext 一致:
+//   - 字段名使用proto中的名字, group 使用消息名
+//   - 重复字段每个元素输出一次, 解析时也接受 [a, b] 列表
+//   - map 的每个键值输出为 {key:k value:v}
+//   - 枚举输出名字, 未定义的值输出数字
+//   - string/bytes 使用C风格转义, 解析时接受单引号及相邻字符串拼接
+//   - 解析时忽略 # 开始的注释, 字段之间可以使用 , 或 ; 分隔
+//   - 非repeated字段(包括map条目的key/value)出现多次, 同一个oneof的多个成员返回错误
+//   - Any 输出 type_url/value 字段, 解析时也接受展开的形式 [type_url] {...}, 消息类型需要注册
+// 不支持扩展字段.
+
+// AppendTextName appends the field name and ':' to b.
+// A space is appended first if b has grown since start.
+func AppendTextName(b []byte, start int, name string) []byte {
+	if len(b) > start {
+		b = append(b, ' ')
+	}
+	b = append(b, name...)
+	return append(b, ':')
+}
+
+// AppendTextString appends the quoted string of s to b.
+// invalid UTF-8 is escaped as octal bytes.
+func AppendTextString(b []byte, s string) []byte {
+	return appendTextQuoted(b, s, false)
+}
+
+// AppendTextBytes appends the quoted string of v to b. non-ASCII bytes are escaped as octal.
+func AppendTextBytes(b []byte, v []byte) []byte {
+	return appendTextQuoted(b, string(v), true)
+}
+
+func appendTextQuoted(b []byte, s string, ascii bool) []byte {
+	b = append(b, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c >= utf8.RuneSelf && !ascii {
+			if r, size := utf8.DecodeRuneInString(s[i:]); r != utf8.RuneError || size != 1 {
+				b = append(b, s[i:i+size]...)
+				i += size
+				continue
+			}
+		}
+		switch {
+		case c == '"' || c == '\\':
+			b = append(b, '\\', c)
+		case c == '\n':
+			b = append(b, '\\', 'n')
+		case c == '\r':
+			b = append(b, '\\', 'r')
+		case c == '\t':
+			b = append(b, '\\', 't')
+		case c < 0x20 || c >= 0x7f:
+			b = append(b, '\\', '0'+c>>6, '0'+(c>>3)&7, '0'+c&7)
+		default:
+			b = append(b, c)
+		}
+		i++
+	}
+	return append(b, '"')
+}
+
+// AppendTextFloat appends v to b. bitSize is 32 for float, 64 for double.
+func AppendTextFloat(b []byte, v float64, bitSize int) []byte {
+	switch {
+	case math.IsNaN(v):
+		return append(b, "nan"...)
+	case math.IsInf(v, 1):
+		return append(b, "inf"...)
+	case math.IsInf(v, -1):
+		return append(b, "-inf"...)
+	}
+	return strconv.AppendFloat(b, v, 'g', -1, bitSize)
+}
+
+// AppendTextEnum appends the name of the enum value to b. undefined value is appended as number.
+func AppendTextEnum(b []byte, v int32, names map[int32]string) []byte {
+	if name, ok := names[v]; ok {
+		return append(b, name...)
+	}
+	return strconv.AppendInt(b, int64(v), 10)
+}
+
+// AppendTextTimestamp appends t as google.protobuf.Timestamp message {seconds:N nanos:N}
+func AppendTextTimestamp(b []byte, t time.Time) []byte {
+	return appendTextSeconds(b, t.Unix(), int64(t.Nanosecond()))
+}
+
+// AppendTextDuration appends d as google.protobuf.Duration message {seconds:N nanos:N}
+func AppendTextDuration(b []byte, d time.Duration) []byte {
+	return appendTextSeconds(b, int64(d/time.Second), int64(d%time.Second))
+}
+
+func appendTextSeconds(b []byte, secs, nanos int64) []byte {
+	b = append(b, '{')
+	start := len(b)
+	if secs != 0 {
+		b = AppendTextName(b, start, "seconds")
+		b = strconv.AppendInt(b, secs, 10)
+	}
+	if nanos != 0 {
+		b = AppendTextName(b, start, "nanos")
+		b = strconv.AppendInt(b, nanos, 10)
+	}
+	return append(b, '}')
+}
+
+// TextDecoder 读取 prototext 格式的数据. 由生成的 UnmarshalTextFrom 使用
+type TextDecoder struct {
+	data []byte
+	pos  int
+	// 顶层消息没有 {} 包围, 读取到数据结束
+	top bool
+}
+
+// NewTextDecoder returns a decoder reading the top-level message from data
+func NewTextDecoder(data []byte) *TextDecoder {
+	return &TextDecoder{data: data, top: true}
+}
+
+// TextError 语法或类型错误
+type TextError struct {
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e *TextError) Error() string {
+	return "gopb: text: " + e.Msg + " at line " + strconv.Itoa(e.Line) + " column " + strconv.Itoa(e.Column)
+}
+
+func (d *TextDecoder) errorf(format string, args ...any) error {
+	line, col := 1, 1
+	for _, c := range d.data[:d.pos] {
+		if c == '\n' {
+			line++
+			col = 1
+		} else {
+			col++
+		}
+	}
+	return &TextError{Line: line, Column: col, Msg: fmt.Sprintf(format, args...)}
+}
+
+// skipSpace skips spaces and # comments
+func (d *TextDecoder) skipSpace() {
+	for d.pos < len(d.data) {
+		switch d.data[d.pos] {
+		case ' ', '\t', '\n', '\r', '\v', '\f':
+			d.pos++
+		case '#':
+			for d.pos < len(d.data) && d.data[d.pos] != '\n' {
+				d.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// peek returns the next non-space byte. 0 at the end of data
+func (d *TextDecoder) peek() byte {
+	d.skipSpace()
+	if d.pos < len(d.data) {
+		return d.data[d.pos]
+	}
+	return 0
+}
+
+// UnknownField returns the error of unknown field name
+func (d *TextDecoder) UnknownField(name string) error {
+	if strings.HasPrefix(name, "[") {
+		return d.errorf("extension %s is not supported", name)
+	}
+	return d.errorf("unknown field %q", name)
+}
+
+// CheckField adds the field num to seen. returns error if the non-repeated field already appeared in the message
+func (d *TextDecoder) CheckField(seen *FieldSet, num int32, name string) error {
+	if !seen.Add(num) {
+		return d.errorf("non-repeated field %q is repeated", name)
+	}
+	return nil
+}
+
+// CheckOneof adds the oneof index to seen. returns error if another member of the oneof is already set
+func (d *TextDecoder) CheckOneof(seen *FieldSet, index int32, name, oneof string) error {
+	if !seen.Add(index) {
+		return d.errorf("field %q: oneof %s is already set", name, oneof)
+	}
+	return nil
+}
+
+// ReadMessage reads the fields of a message. fn is called for each field and must read the value.
+// The top-level message is read to the end of data, others are enclosed in {} or <>.
+func (d *TextDecoder) ReadMessage(fn func(name string) error) (err error) {
+	var end byte
+	if d.top {
+		d.top = false
+	} else {
+		switch d.peek() {
+		case '{':
+			end = '}'
+		case '<':
+			end = '>'
+		default:
+			return d.errorf("expect '{' or '<'")
+		}
+		d.pos++
+	}
+	for {
+		switch c := d.peek(); {
+		case c == end:
+			if end != 0 {
+				d.pos++
+			}
+			return
+		case c == 0:
+			return d.errorf("unexpected end of data")
+		}
+		var name string
+		if d.peek() == '[' {
+			// 扩展字段或展开的 Any, 名字包括 []
+			if name, err = d.readBracketName(); err != nil {
+				return
+			}
+		} else if name = d.readToken(); name == "" {
+			return d.errorf("expect field name")
+		}
+		// 消息字段可以省略 ':'
+		if d.peek() == ':' {
+			d.pos++
+		}
+		if err = fn(name); err != nil {
+			return
+		}
+		if c := d.peek(); c == ',' || c == ';' {
+			d.pos++
+		}
+	}
+}
+
+// readBracketName reads [name] of an extension or an expanded Any
+func (d *TextDecoder) readBracketName() (string, error) {
+	d.pos++
+	n := strings.IndexByte(string(d.data[d.pos:]), ']')
+	if n < 0 {
+		return "", d.errorf("expect ']'")
+	}
+	name := strings.TrimSpace(string(d.data[d.pos : d.pos+n]))
+	if name == "" {
+		return "", d.errorf("expect field name")
+	}
+	d.pos += n + 1
+	return "[" + name + "]", nil
+}
+
+// ReadRepeated reads a list [a, b] or a single element. fn is called for each element.
+func (d *TextDecoder) ReadRepeated(fn func() error) (err error) {
+	if d.peek() != '[' {
+		return fn()
+	}
+	d.pos++
+	if d.peek() == ']' {
+		d.pos++
+		return
+	}
+	for {
+		if err = fn(); err != nil {
+			return
+		}
+		switch d.peek() {
+		case ',':
+			d.pos++
+		case ']':
+			d.pos++
+			return
+		default:
+			return d.errorf("expect ',' or ']'")
+		}
+	}
+}
+
+// readToken reads an identifier or a number literal
+func (d *TextDecoder) readToken() string {
+	d.skipSpace()
+	start := d.pos
+	for d.pos < len(d.data) {
+		c := d.data[d.pos]
+		if c == '_' || c == '.' || c == '-' || c == '+' ||
+			('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') {
+			d.pos++
+			continue
+		}
+		break
+	}
+	return string(d.data[start:d.pos])
+}
+
+// ReadBool reads true, True, t, false, False, f, 1 or 0
+func (d *TextDecoder) ReadBool() (bool, error) {
+	switch s := d.readToken(); s {
+	case "true", "True", "t", "1":
+		return true, nil
+	case "false", "False", "f", "0":
+		return false, nil
+	default:
+		return false, d.errorf("invalid bool value %q", s)
+	}
+}
+
+func (d *TextDecoder) readInt(bitSize int) (int64, error) {
+	s := d.readToken()
+	v, err := strconv.ParseInt(s, 0, bitSize)
+	if err != nil || strings.Contains(s, "_") {
+		return 0, d.errorf("invalid int%d value %q", bitSize, s)
+	}
+	return v, nil
+}
+
+func (d *TextDecoder) readUint(bitSize int) (uint64, error) {
+	s := d.readToken()
+	v, err := strconv.ParseUint(s, 0, bitSize)
+	if err != nil || strings.Contains(s, "_") {
+		return 0, d.errorf("invalid uint%d value %q", bitSize, s)
+	}
+	return v, nil
+}
+
+// ReadInt32 reads a decimal, hex or octal integer
+func (d *TextDecoder) ReadInt32() (int32, error) {
+	v, err := d.readInt(32)
+	return int32(v), err
+}
+
+// ReadInt64 reads a decimal, hex or octal integer
+func (d *TextDecoder) ReadInt64() (int64, error) {
+	return d.readInt(64)
+}
+
+// ReadUint32 reads a decimal, hex or octal integer
+func (d *TextDecoder) ReadUint32() (uint32, error) {
+	v, err := d.readUint(32)
+	return uint32(v), err
+}
+
+// ReadUint64 reads a decimal, hex or octal integer
+func (d *TextDecoder) ReadUint64() (uint64, error) {
+	return d.readUint(64)
+}
+
+func (d *TextDecoder) readFloat(bitSize int) (float64, error) {
+	s := d.readToken()
+	switch strings.ToLower(s) {
+	case "inf", "infinity", "+inf", "+infinity":
+		return math.Inf(1), nil
+	case "-inf", "-infinity":
+		return math.Inf(-1), nil
+	case "nan", "-nan", "+nan":
+		return math.NaN(), nil
+	}
+	// 1.5f
+	v, err := strconv.ParseFloat(strings.TrimRight(s, "fF"), bitSize)
+	if err != nil || strings.Contains(s, "_") {
+		return 0, d.errorf("invalid float%d value %q", bitSize, s)
+	}
+	return v, nil
+}
+
+// ReadFloat32 reads a number, inf, -inf or nan
+func (d *TextDecoder) ReadFloat32() (float32, error) {
+	v, err := d.readFloat(32)
+	return float32(v), err
+}
+
+// ReadFloat64 reads a number, inf, -inf or nan
+func (d *TextDecoder) ReadFloat64() (float64, error) {
+	return d.readFloat(64)
+}
+
+// ReadString reads one or more adjacent quoted strings. the result must be valid UTF-8.
+func (d *TextDecoder) ReadString() (string, error) {
+	v, err := d.readQuoted()
+	if err != nil {
+		return "", err
+	}
+	if !utf8.Valid(v) {
+		return "", d.errorf("invalid UTF-8 in string")
+	}
+	return string(v), nil
+}
+
+// ReadBytes reads one or more adjacent quoted strings. the result is not nil.
+func (d *TextDecoder) ReadBytes() ([]byte, error) {
+	return d.readQuoted()
+}
+
+func (d *TextDecoder) readQuoted() ([]byte, error) {
+	buf := []byte{}
+	if c := d.peek(); c != '"' && c != '\'' {
+		return nil, d.errorf("expect string")
+	}
+	for {
+		q := d.peek()
+		if q != '"' && q != '\'' {
+			return buf, nil
+		}
+		d.pos++
+		for {
+			if d.pos >= len(d.data) || d.data[d.pos] == '\n' {
+				return nil, d.errorf("unexpected end of string")
+			}
+			c := d.data[d.pos]
+			d.pos++
+			if c == q {
+				break
+			}
+			if c != '\\' {
+				buf = append(buf, c)
+				continue
+			}
+			var err error
+			if buf, err = d.readEscape(buf); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// readEscape reads the escape sequence after '\' and appends the value to buf
+func (d *TextDecoder) readEscape(buf []byte) ([]byte, error) {
+	if d.pos >= len(d.data) {
+		return nil, d.errorf("invalid escape in string")
+	}
+	c := d.data[d.pos]
+	d.pos++
+	switch c {
+	case 'a':
+		return append(buf, '\a'), nil
+	case 'b':
+		return append(buf, '\b'), nil
+	case 'f':
+		return append(buf, '\f'), nil
+	case 'n':
+		return append(buf, '\n'), nil
+	case 'r':
+		return append(buf, '\r'), nil
+	case 't':
+		return append(buf, '\t'), nil
+	case 'v':
+		return append(buf, '\v'), nil
+	case '\\', '\'', '"', '?':
+		return append(buf, c), nil
+	case '0', '1', '2', '3', '4', '5', '6', '7':
+		v := uint(c - '0')
+		for i := 0; i < 2 && d.pos < len(d.data) && '0' <= d.data[d.pos] && d.data[d.pos] <= '7'; i++ {
+			v = v*8 + uint(d.data[d.pos]-'0')
+			d.pos++
+		}
+		if v > 0xff {
+			return nil, d.errorf("invalid octal escape in string")
+		}
+		return append(buf, byte(v)), nil
+	case 'x', 'u', 'U':
+		n := 2
+		switch c {
+		case 'u':
+			n = 4
+		case 'U':
+			n = 8
+		}
+		start := d.pos
+		for d.pos < len(d.data) && d.pos-start < n && isHex(d.data[d.pos]) {
+			d.pos++
+		}
+		if d.pos == start || (c != 'x' && d.pos-start != n) {
+			return nil, d.errorf("invalid hex escape in string")
+		}
+		v, _ := strconv.ParseUint(string(d.data[start:d.pos]), 16, 32)
+		if c == 'x' {
+			return append(buf, byte(v)), nil
+		}
+		if v > utf8.MaxRune {
+			return nil, d.errorf("invalid unicode escape in string")
+		}
+		return utf8.AppendRune(buf, rune(v)), nil
+	}
+	return nil, d.errorf("invalid escape in string")
+}
+
+func isHex(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+// ReadEnum reads the enum name or number
+func (d *TextDecoder) ReadEnum(values map[string]int32) (int32, error) {
+	s := d.readToken()
+	if v, ok := values[s]; ok {
+		return v, nil
+	}
+	v, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return 0, d.errorf("invalid enum value %q", s)
+	}
+	return int32(v), nil
+}
+
+// ReadTimestamp reads google.protobuf.Timestamp message {seconds:N nanos:N}. the result is in UTC.
+func (d *TextDecoder) ReadTimestamp() (time.Time, error) {
+	secs, nanos, err := d.readSeconds()
+	return time.Unix(secs, nanos).UTC(), err
+}
+
+// ReadDuration reads google.protobuf.Duration message {seconds:N nanos:N}
+func (d *TextDecoder) ReadDuration() (time.Duration, error) {
+	secs, nanos, err := d.readSeconds()
+	return time.Duration(secs)*time.Second + time.Duration(nanos), err
+}
+
+func (d *TextDecoder) readSeconds() (secs, nanos int64, err error) {
+	var seen FieldSet
+	err = d.ReadMessage(func(name string) (err error) {
+		switch name {
+		case "seconds":
+			if err = d.CheckField(&seen, 1, name); err != nil {
+				return
+			}
+			secs, err = d.ReadInt64()
+		case "nanos":
+			if err = d.CheckField(&seen, 2, name); err != nil {
+				return
+			}
+			var v int32
+			v, err = d.ReadInt32()
+			nanos = int64(v)
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+	return
+}
+
+// AppendText appends {type_url:"..." value:"..."} fields to b. The value is not expanded.
+func (x *Any) AppendText(b []byte) []byte {
+	if x == nil {
+		return b
+	}
+	start := len(b)
+	if len(x.TypeUrl) > 0 {
+		b = AppendTextName(b, start, "type_url")
+		b = AppendTextString(b, x.TypeUrl)
+	}
+	if len(x.Value) > 0 {
+		b = AppendTextName(b, start, "value")
+		b = AppendTextBytes(b, x.Value)
+	}
+	return b
+}
+
+// UnmarshalText unmarshal from protobuf text format
+func (x *Any) UnmarshalText(data []byte) error {
+	x.Reset()
+	return x.UnmarshalTextFrom(NewTextDecoder(data))
+}
+
+// textMessage 使用 text 参数生成的消息实现的接口
+type textMessage interface {
+	UnmarshalTextFrom(d *TextDecoder) error
+}
+
+// UnmarshalTextFrom reads the fields of Any from d.
+// The expanded form [type_url] {...} is also accepted, the message type must be registered.
+func (x *Any) UnmarshalTextFrom(d *TextDecoder) error {
+	var seen FieldSet
+	// 展开的形式只能出现一次, 不能与 type_url/value 同时使用
+	fields, expanded := false, false
+	return d.ReadMessage(func(name string) (err error) {
+		if strings.HasPrefix(name, "[") {
+			if fields || expanded {
+				return d.errorf("field %s: Any is already set", name)
+			}
+			expanded = true
+			return x.readExpanded(d, name[1:len(name)-1])
+		}
+		if expanded {
+			return d.errorf("field %q: Any is already set by the expanded form", name)
+		}
+		fields = true
+		switch name {
+		case "type_url":
+			if err = d.CheckField(&seen, 1, name); err != nil {
+				return
+			}
+			x.TypeUrl, err = d.ReadString()
+		case "value":
+			if err = d.CheckField(&seen, 2, name); err != nil {
+				return
+			}
+			x.Value, err = d.ReadBytes()
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+// readExpanded 读取展开的 Any 的消息, 序列化后保存到 Value
+func (x *Any) readExpanded(d *TextDecoder, typeUrl string) (err error) {
+	x.TypeUrl = typeUrl
+	if isAnyWKT(x.MessageName()) {
+		x.Value, err = readTextAnyWKT(d, x.MessageName())
+		return
+	}
+	msg, ok := NewMessage(x.MessageName())
+	if !ok {
+		return fmt.Errorf("gopb: message %s is not registered", x.MessageName())
+	}
+	tm, ok := msg.(textMessage)
+	if !ok {
+		return fmt.Errorf("gopb: message %s does not support text", x.MessageName())
+	}
+	if err = tm.UnmarshalTextFrom(d); err != nil {
+		return
+	}
+	x.Value, err = msg.MarshalObject()
+	return
+}
+
+// readTextAnyWKT 读取展开的 Any 中的 well-known type, 返回序列化的数据
+func readTextAnyWKT(d *TextDecoder, name string) (data []byte, err error) {
+	switch name {
+	case "google.protobuf.Any":
+		v := &Any{}
+		if err = v.UnmarshalTextFrom(d); err != nil {
+			return
+		}
+		return v.MarshalObject()
+	case "google.protobuf.Timestamp", "google.protobuf.Duration":
+		secs, nanos, err := d.readSeconds()
+		if err != nil {
+			return nil, err
+		}
+		return appendSecondsNanos([]byte{}, secs, int32(nanos)), nil
+	case "google.protobuf.Empty":
+		return []byte{}, d.ReadMessage(d.UnknownField)
+	}
+	// wrapper 只有字段 value, 零值不序列化
+	var seen FieldSet
+	data = []byte{}
+	err = d.ReadMessage(func(field string) (err error) {
+		if field != "value" {
+			return d.UnknownField(field)
+		}
+		if err = d.CheckField(&seen, 1, field); err != nil {
+			return
+		}
+		data, err = readTextWrapperValue(d, data[:0], name)
+		return
+	})
+	return
+}
+
+// readTextWrapperValue 读取 wrapper 的 value 字段并序列化到 b
+func readTextWrapperValue(d *TextDecoder, b []byte, name string) ([]byte, error) {
+	switch name {
+	case "google.protobuf.DoubleValue":
+		v, err := d.ReadFloat64()
+		if err != nil || math.Float64bits(v) == 0 {
+			return b, err
+		}
+		b = protowire.AppendTag(b, 1, protowire.Fixed64Type)
+		return protowire.AppendFixed64(b, math.Float64bits(v)), nil
+	case "google.protobuf.FloatValue":
+		v, err := d.ReadFloat32()
+		if err != nil || math.Float32bits(v) == 0 {
+			return b, err
+		}
+		b = protowire.AppendTag(b, 1, protowire.Fixed32Type)
+		return protowire.AppendFixed32(b, math.Float32bits(v)), nil
+	case "google.protobuf.Int64Value", "google.protobuf.Int32Value":
+		var v int64
+		var err error
+		if name == "google.protobuf.Int64Value" {
+			v, err = d.ReadInt64()
+		} else {
+			var v32 int32
+			v32, err = d.ReadInt32()
+			v = int64(v32)
+		}
+		if err != nil || v == 0 {
+			return b, err
+		}
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		return protowire.AppendVarint(b, uint64(v)), nil
+	case "google.protobuf.UInt64Value", "google.protobuf.UInt32Value":
+		var v uint64
+		var err error
+		if name == "google.protobuf.UInt64Value" {
+			v, err = d.ReadUint64()
+		} else {
+			var v32 uint32
+			v32, err = d.ReadUint32()
+			v = uint64(v32)
+		}
+		if err != nil || v == 0 {
+			return b, err
+		}
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		return protowire.AppendVarint(b, v), nil
+	case "google.protobuf.BoolValue":
+		v, err := d.ReadBool()
+		if err != nil || !v {
+			return b, err
+		}
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		return protowire.AppendVarint(b, 1), nil
+	case "google.protobuf.StringValue":
+		v, err := d.ReadString()
+		if err != nil || v == "" {
+			return b, err
+		}
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		return protowire.AppendString(b, v), nil
+	}
+	// BytesValue
+	v, err := d.ReadBytes()
+	if err != nil || len(v) == 0 {
+		return b, err
+	}
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	return protowire.AppendBytes(b, v), nil
+}
@@ -0,0 +1,118 @@
+package alias
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestGoldenAlias(t *testing.T) {
+	want := &golden.All{
+		FString: "s", FBytes: []byte{1, 2}, RString: []string{"a", ""}, RBytes: [][]byte{{3}, {}},
+		MStrInt: map[string]int32{"k": 1}, MIntStr: map[int32]string{1: "v"}, MBytes: map[string]*wrapperspb.BytesValue{"b": wrapperspb.Bytes([]byte{4})},
+		O: &golden.All_OStr{OStr: "o"}, FSv: wrapperspb.String("sv"), FBv: wrapperspb.Bytes([]byte{5}), PString: proto.String(""), PBytes: []byte{},
+		FMsg: &golden.Inner{Name: "n"},
+	}
+	data, err := proto.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := &All{}
+	if err := x.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	out, err := x.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := &golden.All{}
+	if err := proto.Unmarshal(out, got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("UnmarshalObject then MarshalObject = %v, want %v", got, want)
+	}
+	// 空的 bytes 仍然是非nil, 表示字段已设置
+	if x.PBytes == nil || x.PString == nil || x.RBytes[1] == nil {
+		t.Errorf("empty values = %v %v %v, want set", x.PBytes, x.PString, x.RBytes[1])
+	}
+}
+
+// 开启 alias 时解析结果引用输入数据, 未开启时复制
+func TestAliasInput(t *testing.T) {
+	data, err := proto.Marshal(&golden.All{FString: "abc", FBytes: []byte("xyz"), RBytes: [][]byte{[]byte("r")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := &All{}
+	if err := x.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	y := &testpb.All{}
+	if err := y.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{"abc", "xyz", "r"} {
+		i := bytes.Index(data, []byte(s))
+		data[i] = 'Z'
+	}
+	if x.FString != "Zbc" || string(x.FBytes) != "Zyz" || string(x.RBytes[0]) != "Z" {
+		t.Errorf("alias = %q %q %q, want to share the input", x.FString, x.FBytes, x.RBytes[0])
+	}
+	if y.FString != "abc" || string(y.FBytes) != "xyz" || string(y.RBytes[0]) != "r" {
+		t.Errorf("copy = %q %q %q, want unchanged", y.FString, y.FBytes, y.RBytes[0])
+	}
+
+	// 引用输入的 bytes 容量被截断, append 不会覆盖后面的输入数据
+	if cap(x.FBytes) != len(x.FBytes) {
+		t.Errorf("cap(FBytes) = %d, want %d", cap(x.FBytes), len(x.FBytes))
+	}
+}
+
+// 解析结果引用输入数据时, CopyFrom 及 Merge 写入这些字段不能修改输入数据
+func TestAliasCopyDoesNotWriteInput(t *testing.T) {
+	data, err := proto.Marshal(&golden.All{
+		FBytes: []byte("xyz"), RBytes: [][]byte{[]byte("r0"), []byte("r1")}, PBytes: []byte("p"),
+		MBytes: map[string]*wrapperspb.BytesValue{"k": wrapperspb.Bytes([]byte("m"))}, FBv: wrapperspb.Bytes([]byte("bv")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := append([]byte(nil), data...)
+	src := &All{FBytes: []byte("XYZ"), RBytes: [][]byte{[]byte("R0"), []byte("R1")}, PBytes: []byte("P"), FBv: []byte("BV")}
+
+	x := &All{}
+	if err := x.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	x.CopyFrom(src)
+	if !bytes.Equal(data, input) {
+		t.Errorf("CopyFrom changed the input: %q, want %q", data, input)
+	}
+	if !x.Equal(src) {
+		t.Errorf("CopyFrom = %v, want %v", x, src)
+	}
+
+	x = &All{}
+	if err := x.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	x.Merge(src)
+	if !bytes.Equal(data, input) {
+		t.Errorf("Merge changed the input: %q, want %q", data, input)
+	}
+	if string(x.FBytes) != "XYZ" || len(x.RBytes) != 4 || string(x.PBytes) != "P" {
+		t.Errorf("Merge = %v", x)
+	}
+
+	// 空的值复制后仍然是非nil
+	x.CopyFrom(&All{PBytes: []byte{}, RBytes: [][]byte{{}}})
+	if x.PBytes == nil || x.RBytes[0] == nil {
+		t.Errorf("CopyFrom empty = %v %v, want set", x.PBytes, x.RBytes[0])
+	}
+}
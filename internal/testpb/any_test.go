@@ -0,0 +1,44 @@
+package testpb
+
+import (
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/gopb"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestGoldenAny(t *testing.T) {
+	any, err := gopb.MarshalAny(&Inner{Id: 1, Name: "n", Child: &Inner{Nums: []int32{1}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := anypb.New(&golden.Inner{Id: 1, Name: "n", Child: &golden.Inner{Nums: []int32{1}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if any.TypeUrl != want.TypeUrl {
+		t.Errorf("TypeUrl = %q, want %q", any.TypeUrl, want.TypeUrl)
+	}
+	checkGolden(t, &All{FAny: any}, &All{}, &golden.All{FAny: want})
+	checkGolden(t, &All{FAny: &gopb.Any{}}, &All{}, &golden.All{FAny: &anypb.Any{}})
+
+	x := &All{}
+	data, _ := (&All{FAny: any}).MarshalObject()
+	if err := x.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	msg, err := gopb.UnmarshalAny(x.FAny)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inner, ok := msg.(*Inner); !ok || inner.Id != 1 || inner.Child.GetNums()[0] != 1 {
+		t.Errorf("UnmarshalAny = %#v, want *Inner", msg)
+	}
+	if !x.FAny.MessageIs(&Inner{}) || x.FAny.MessageIs(&All{}) {
+		t.Errorf("MessageIs(%q) mismatch", x.FAny.TypeUrl)
+	}
+	if _, err := gopb.UnmarshalAny(&gopb.Any{TypeUrl: "type.googleapis.com/gopb.testpb.Unknown"}); err == nil {
+		t.Error("UnmarshalAny of unregistered type = nil, want error")
+	}
+}
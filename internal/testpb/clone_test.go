@@ -0,0 +1,116 @@
+package testpb
+
+import (
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestClone(t *testing.T) {
+	x, want := sampleAll(t)
+	y := x.Clone()
+	checkGolden(t, y, &All{}, want)
+
+	// 修改原消息不影响克隆的消息
+	x.FBytes[0] = 'x'
+	x.FMsg.Child.Name = "x"
+	x.FMsg.Nums[0] = 100
+	x.RMsg[0].Id = 100
+	x.RBytes[0][0] = 100
+	x.MStrMsg["m"].Nums[0] = 100
+	x.MStrInt["b"] = 2
+	*x.MTs["t"] = x.MTs["t"].Add(1)
+	x.O.(*All_OMsg).OMsg.Name = "x"
+	x.FAny.Value[0] = 100
+	*x.FTs = x.FTs.Add(1)
+	*x.PInt32 = 100
+	*x.FSv = "x"
+	x.FBv[0] = 100
+	*x.RTs[0] = x.RTs[0].Add(1)
+	x.PBytes[0] = 100
+	checkGolden(t, y, &All{}, want)
+
+	if (*All)(nil).Clone() != nil {
+		t.Error("Clone of nil != nil")
+	}
+}
+
+func TestCopyFrom(t *testing.T) {
+	src, want := sampleAll(t)
+	dst := &All{
+		FInt64: 5, RInt32: []int32{1, 2, 3}, RMsg: []*Inner{{Name: "old"}, {}, {}},
+		MStrInt: map[string]int32{"old": 1}, O: &All_OStr{OStr: "old"}, FI64: ptr[int64](1),
+	}
+	dst.CopyFrom(src)
+	checkGolden(t, dst, &All{}, want)
+	src.RMsg[0].Id = 100
+	src.MStrMsg["m"].Nums[0] = 100
+	checkGolden(t, dst, &All{}, want)
+
+	// nil 源消息清空目标消息
+	dst.CopyFrom(nil)
+	if size := dst.MarshalSize(); size != 0 {
+		t.Errorf("CopyFrom(nil) left %d bytes", size)
+	}
+}
+
+// proto2 的存在性, 扩展, group 及未知字段同样复制
+func TestCloneProto2(t *testing.T) {
+	ext := goldenExt()
+	data, err := proto.Marshal(ext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := &P2Ext{}
+	if err := x.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, x.Clone(), &P2Ext{}, ext)
+
+	group := &golden.P2Group{G: &golden.P2Group_G{A: proto.Int32(0)}, Rg: []*golden.P2Group_RG{{Nested: &golden.P2Group{}}}}
+	data, _ = proto.Marshal(group)
+	g := &P2Group{}
+	if err := g.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, g.Clone(), &P2Group{}, group)
+
+	// 未知字段
+	y := &Empty{}
+	if err := y.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	z := &Empty{}
+	z.CopyFrom(y)
+	checkGolden(t, z, &Empty{}, group)
+}
+
+// CopyFrom 只复用目标列表长度内的元素, 长度之外(容量之内)的旧元素可能已被其他消息使用
+func TestCopyFromListBeyondLen(t *testing.T) {
+	shared := &Inner{Name: "shared"}
+	backing := []*Inner{{Name: "a"}, shared}
+	dst := &All{RMsg: backing[:1]}
+	reused := dst.RMsg[0]
+
+	dst.CopyFrom(&All{RMsg: []*Inner{{Id: 1}, {Id: 2}, {Id: 3}}})
+	if shared.Name != "shared" || shared.Id != 0 {
+		t.Errorf("CopyFrom wrote into the element beyond len: %v", shared)
+	}
+	if dst.RMsg[0] != reused || dst.RMsg[1] == shared {
+		t.Errorf("CopyFrom = %p %p, want to reuse only the element within len", dst.RMsg[0], dst.RMsg[1])
+	}
+	want := &All{RMsg: []*Inner{{Id: 1}, {Id: 2}, {Id: 3}}}
+	if !dst.Equal(want) {
+		t.Errorf("CopyFrom = %v, want %v", dst, want)
+	}
+
+	// 同样适用于 bytes 列表
+	buf := []byte("xy")
+	bs := [][]byte{buf[:1], buf[1:]}
+	y := &All{RBytes: bs[:1]}
+	y.CopyFrom(&All{RBytes: [][]byte{[]byte("1"), []byte("2")}})
+	if string(buf) != "xy" || string(y.RBytes[1]) != "2" {
+		t.Errorf("CopyFrom = %q, input %q", y.RBytes, buf)
+	}
+}
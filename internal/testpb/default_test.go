@@ -0,0 +1,44 @@
+package testpb
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/proto"
+)
+
+// 未设置的字段 getter 返回与 protobuf-go 相同的默认值
+func TestDefaultGetters(t *testing.T) {
+	for _, x := range []*P2Default{nil, {}} {
+		want := &golden.P2Default{}
+		if x.GetI32() != want.GetI32() || x.GetI64() != want.GetI64() || x.GetU32() != want.GetU32() ||
+			x.GetS() != want.GetS() || !bytes.Equal(x.GetB(), want.GetB()) || x.GetBl() != want.GetBl() ||
+			x.GetD() != want.GetD() || !math.IsNaN(float64(x.GetF())) || x.GetDn() != want.GetDn() ||
+			int32(x.GetLv()) != int32(want.GetLv()) || x.GetF2() != want.GetF2() || x.GetSf64() != want.GetSf64() ||
+			x.GetU64() != want.GetU64() || x.GetEmpty() != want.GetEmpty() {
+			t.Errorf("getters of %v differ from %v", x, want)
+		}
+	}
+
+	// 修改返回的 bytes 不影响默认值
+	b := (&P2Default{}).GetB()
+	b[0] = 'x'
+	if !bytes.Equal(Default_P2Default_B, []byte("a\x00b")) {
+		t.Errorf("Default_P2Default_B = %q, modified through GetB", Default_P2Default_B)
+	}
+}
+
+func TestGoldenDefault(t *testing.T) {
+	// 默认值不写入 wire 数据
+	if size := (&P2Default{}).MarshalSize(); size != 0 {
+		t.Errorf("MarshalSize = %d, want 0", size)
+	}
+	x := &P2Default{I32: ptr[int32](0), S: ptr(""), B: []byte{}, Bl: ptr(false), D: ptr(1.0), Lv: ptr(Level_LEVEL_ZERO), U64: ptr[uint64](0)}
+	want := &golden.P2Default{I32: proto.Int32(0), S: proto.String(""), B: []byte{}, Bl: proto.Bool(false), D: proto.Float64(1), Lv: golden.Level_LEVEL_ZERO.Enum(), U64: proto.Uint64(0)}
+	checkGolden(t, x, &P2Default{}, want)
+	if x.GetI32() != 0 || x.GetS() != "" || x.GetBl() || x.GetLv() != Level_LEVEL_ZERO || x.GetU64() != 0 {
+		t.Errorf("getters of %+v return defaults for set fields", x)
+	}
+}
@@ -0,0 +1,102 @@
+package testpb
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/proto"
+)
+
+type deterministicMessage interface {
+	message
+	MarshalObjectDeterministic() ([]byte, error)
+}
+
+// checkGoldenDeterministic 检查 x 的确定性序列化结果与 protobuf-go 的确定性序列化完全一致
+func checkGoldenDeterministic(t *testing.T, x deterministicMessage, want proto.Message) {
+	t.Helper()
+	data, err := x.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.Unmarshal(data, want); err != nil {
+		t.Fatal(err)
+	}
+	wantData, err := proto.MarshalOptions{Deterministic: true}.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		got, err := x.MarshalObjectDeterministic()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, wantData) {
+			t.Fatalf("MarshalObjectDeterministic = %x, want %x", got, wantData)
+		}
+	}
+	// 开启 reflect 时 protobuf-go 的确定性序列化也使用生成的方法
+	got, err := proto.MarshalOptions{Deterministic: true}.Marshal(x.(proto.Message))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, wantData) {
+		t.Fatalf("proto.MarshalOptions.Marshal = %x, want %x", got, wantData)
+	}
+}
+
+func TestGoldenDeterministic(t *testing.T) {
+	ts := time.Unix(3, 4).UTC()
+	dur := time.Second
+	x := &All{
+		FInt32:  1,
+		MStrInt: map[string]int32{}, MIntStr: map[int32]string{}, MStrMsg: map[string]*Inner{},
+		MTs: map[string]*time.Time{}, MDur: map[string]*time.Duration{}, MI32: map[string]*int32{},
+		MBytes: map[string][]byte{},
+	}
+	for i := -5; i < 5; i++ {
+		k := strconv.Itoa(i)
+		x.MStrInt[k] = int32(i)
+		x.MIntStr[int32(i)] = k
+		x.MStrMsg[k] = &Inner{Id: int32(i), Child: &Inner{Nums: []int32{int32(i)}}}
+		x.MTs[k] = &ts
+		x.MDur[k] = &dur
+		x.MI32[k] = ptr(int32(i))
+		x.MBytes[k] = []byte(k)
+	}
+	checkGoldenDeterministic(t, x, &golden.All{})
+
+	// map 的值中的 map 递归排序
+	node := &Node{M: map[string]*Node{}}
+	for i := 0; i < 10; i++ {
+		child := &Node{V: int64(i), M: map[string]*Node{}}
+		for j := 0; j < 10; j++ {
+			child.M[strconv.Itoa(j)] = &Node{V: int64(j)}
+		}
+		node.M[strconv.Itoa(i)] = child
+		node.Kids = append(node.Kids, child)
+	}
+	node.Child = node.M["1"]
+	checkGoldenDeterministic(t, node, &golden.Node{})
+}
+
+func TestGoldenDeterministicProto2(t *testing.T) {
+	x := &P2Opt{M: map[string]Level{}}
+	req := &P2Req{Id: ptr[int32](1), M: map[string]*P2Req{}}
+	for i := 0; i < 10; i++ {
+		k := strconv.Itoa(i)
+		x.M[k] = Level(i % 3)
+		req.M[k] = &P2Req{Id: ptr(int32(i)), M: map[string]*P2Req{k: {Id: ptr[int32](0)}}}
+	}
+	checkGoldenDeterministic(t, x, &golden.P2Opt{})
+	checkGoldenDeterministic(t, req, &golden.P2Req{})
+
+	y := &Edition{Req: ptr[int32](0), ClosedMap: map[int32]EdClosed{}}
+	for i := int32(-5); i < 5; i++ {
+		y.ClosedMap[i] = EdClosed_ED_CLOSED_B
+	}
+	checkGoldenDeterministic(t, y, &golden.Edition{})
+}
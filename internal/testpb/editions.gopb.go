@@ -0,0 +1,2307 @@
+// Code generated by protoc-gen-gopb. DO NOT EDIT.
+// Code generated by protoc-gen-gopb. DO NOT EDIT.
+// versions:
+// 	protoc-gen-gopb 0.0.5
+// 	protoc          (unknown)
+// source:  editions.proto
+
+package testpb
+
+import (
+	bytes "bytes"
+	errors "errors"
+	gopb "github.com/aggronmagi/protoc-gen-gopb/gopb"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+	utf8 "unicode/utf8"
+)
+
+type EdOpen int32
+
+const (
+	EdOpen_ED_OPEN_ZERO EdOpen = 0
+	EdOpen_ED_OPEN_A    EdOpen = 1
+)
+
+// Enum value maps for EdOpen.
+var (
+	EdOpen_name = map[int32]string{
+		0: "ED_OPEN_ZERO",
+		1: "ED_OPEN_A",
+	}
+	EdOpen_value = map[string]int32{
+		"ED_OPEN_ZERO": 0,
+		"ED_OPEN_A":    1,
+	}
+)
+
+func (x EdOpen) Enum() *EdOpen {
+	p := new(EdOpen)
+	*p = x
+	return p
+}
+
+func (x EdOpen) String() string {
+	if name, ok := EdOpen_name[int32(x)]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(x), 10)
+}
+
+type EdClosed int32
+
+const (
+	EdClosed_ED_CLOSED_ZERO EdClosed = 0
+	EdClosed_ED_CLOSED_B    EdClosed = 1
+	EdClosed_ED_CLOSED_C    EdClosed = 2
+)
+
+// Enum value maps for EdClosed.
+var (
+	EdClosed_name = map[int32]string{
+		0: "ED_CLOSED_ZERO",
+		1: "ED_CLOSED_B",
+		2: "ED_CLOSED_C",
+	}
+	EdClosed_value = map[string]int32{
+		"ED_CLOSED_ZERO": 0,
+		"ED_CLOSED_B":    1,
+		"ED_CLOSED_C":    2,
+	}
+)
+
+func (x EdClosed) Enum() *EdClosed {
+	p := new(EdClosed)
+	*p = x
+	return p
+}
+
+func (x EdClosed) String() string {
+	if name, ok := EdClosed_name[int32(x)]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(x), 10)
+}
+
+type Edition struct {
+	// 默认 EXPLICIT, 标量使用指针
+	Explicit *int32 `json:"explicit,omitempty"`
+	Implicit int32  `json:"implicit,omitempty"`
+	Req      *int32 `json:"req,omitempty"`
+	// 默认 PACKED
+	Packed        []int32            `json:"packed,omitempty"`
+	Expanded      []int32            `json:"expanded,omitempty"`
+	Closed        *EdClosed          `json:"closed,omitempty"`
+	ClosedList    []EdClosed         `json:"closed_list,omitempty"`
+	Checked       *string            `json:"checked,omitempty"`
+	Unchecked     *string            `json:"unchecked,omitempty"`
+	Delimited     *Edition_Child     `json:"delimited,omitempty"`
+	DelimitedList []*Edition_Child   `json:"delimited_list,omitempty"`
+	Open          *EdOpen            `json:"open,omitempty"`
+	ClosedMap     map[int32]EdClosed `json:"closed_map,omitempty"`
+	ImplicitStr   string             `json:"implicit_str,omitempty"`
+	unknownFields []byte
+}
+
+func (x *Edition) Reset() {
+	*x = Edition{}
+}
+
+var poolEdition = sync.Pool{New: func() any { return &Edition{} }}
+
+// AcquireEdition returns an empty Edition from the pool. call Release to put it back
+func AcquireEdition() *Edition {
+	return poolEdition.Get().(*Edition)
+}
+
+// Release resets x, releases its sub-messages and puts x back to the pool. x must not be used after Release.
+// 列表截断保留容量, map 清空后保留
+func (x *Edition) Release() {
+	if x == nil {
+		return
+	}
+	x.Delimited.Release()
+	for i, v := range x.DelimitedList {
+		v.Release()
+		x.DelimitedList[i] = nil
+	}
+	for mk := range x.ClosedMap {
+		delete(x.ClosedMap, mk)
+	}
+	*x = Edition{
+		Packed:        x.Packed[:0],
+		Expanded:      x.Expanded[:0],
+		ClosedList:    x.ClosedList[:0],
+		DelimitedList: x.DelimitedList[:0],
+		ClosedMap:     x.ClosedMap,
+		unknownFields: x.unknownFields[:0],
+	}
+	poolEdition.Put(x)
+}
+
+// 默认 EXPLICIT, 标量使用指针
+func (x *Edition) GetExplicit() int32 {
+	if x != nil && x.Explicit != nil {
+		return *x.Explicit
+	}
+	return 0
+}
+
+// HasExplicit report whether the field is set
+func (x *Edition) HasExplicit() bool {
+	return x != nil && x.Explicit != nil
+}
+
+// ClearExplicit clear the field
+func (x *Edition) ClearExplicit() {
+	x.Explicit = nil
+}
+
+func (x *Edition) GetImplicit() int32 {
+	if x != nil {
+		return x.Implicit
+	}
+	return 0
+}
+
+func (x *Edition) GetReq() int32 {
+	if x != nil && x.Req != nil {
+		return *x.Req
+	}
+	return 0
+}
+
+// HasReq report whether the field is set
+func (x *Edition) HasReq() bool {
+	return x != nil && x.Req != nil
+}
+
+// ClearReq clear the field
+func (x *Edition) ClearReq() {
+	x.Req = nil
+}
+
+// 默认 PACKED
+func (x *Edition) GetPacked() []int32 {
+	if x != nil {
+		return x.Packed
+	}
+	return nil
+}
+
+func (x *Edition) GetExpanded() []int32 {
+	if x != nil {
+		return x.Expanded
+	}
+	return nil
+}
+
+func (x *Edition) GetClosed() EdClosed {
+	if x != nil && x.Closed != nil {
+		return *x.Closed
+	}
+	return EdClosed_ED_CLOSED_ZERO
+}
+
+// HasClosed report whether the field is set
+func (x *Edition) HasClosed() bool {
+	return x != nil && x.Closed != nil
+}
+
+// ClearClosed clear the field
+func (x *Edition) ClearClosed() {
+	x.Closed = nil
+}
+
+func (x *Edition) GetClosedList() []EdClosed {
+	if x != nil {
+		return x.ClosedList
+	}
+	return nil
+}
+
+func (x *Edition) GetChecked() string {
+	if x != nil && x.Checked != nil {
+		return *x.Checked
+	}
+	return ""
+}
+
+// HasChecked report whether the field is set
+func (x *Edition) HasChecked() bool {
+	return x != nil && x.Checked != nil
+}
+
+// ClearChecked clear the field
+func (x *Edition) ClearChecked() {
+	x.Checked = nil
+}
+
+func (x *Edition) GetUnchecked() string {
+	if x != nil && x.Unchecked != nil {
+		return *x.Unchecked
+	}
+	return ""
+}
+
+// HasUnchecked report whether the field is set
+func (x *Edition) HasUnchecked() bool {
+	return x != nil && x.Unchecked != nil
+}
+
+// ClearUnchecked clear the field
+func (x *Edition) ClearUnchecked() {
+	x.Unchecked = nil
+}
+
+func (x *Edition) GetDelimited() *Edition_Child {
+	if x != nil {
+		return x.Delimited
+	}
+	return nil
+}
+
+// HasDelimited report whether the field is set
+func (x *Edition) HasDelimited() bool {
+	return x != nil && x.Delimited != nil
+}
+
+// ClearDelimited clear the field
+func (x *Edition) ClearDelimited() {
+	x.Delimited = nil
+}
+
+func (x *Edition) GetDelimitedList() []*Edition_Child {
+	if x != nil {
+		return x.DelimitedList
+	}
+	return nil
+}
+
+func (x *Edition) GetOpen() EdOpen {
+	if x != nil && x.Open != nil {
+		return *x.Open
+	}
+	return EdOpen_ED_OPEN_ZERO
+}
+
+// HasOpen report whether the field is set
+func (x *Edition) HasOpen() bool {
+	return x != nil && x.Open != nil
+}
+
+// ClearOpen clear the field
+func (x *Edition) ClearOpen() {
+	x.Open = nil
+}
+
+func (x *Edition) GetClosedMap() map[int32]EdClosed {
+	if x != nil {
+		return x.ClosedMap
+	}
+	return nil
+}
+
+func (x *Edition) GetImplicitStr() string {
+	if x != nil {
+		return x.ImplicitStr
+	}
+	return ""
+}
+
+// Clone returns a deep copy of x
+func (x *Edition) Clone() *Edition {
+	if x == nil {
+		return nil
+	}
+	y := &Edition{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *Edition) CopyFrom(src *Edition) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.Explicit != nil {
+		pv := *src.Explicit
+		x.Explicit = &pv
+	} else {
+		x.Explicit = nil
+	}
+	x.Implicit = src.Implicit
+	if src.Req != nil {
+		pv := *src.Req
+		x.Req = &pv
+	} else {
+		x.Req = nil
+	}
+	x.Packed = append(x.Packed[:0], src.Packed...)
+	x.Expanded = append(x.Expanded[:0], src.Expanded...)
+	if src.Closed != nil {
+		pv := *src.Closed
+		x.Closed = &pv
+	} else {
+		x.Closed = nil
+	}
+	x.ClosedList = append(x.ClosedList[:0], src.ClosedList...)
+	if src.Checked != nil {
+		pv := *src.Checked
+		x.Checked = &pv
+	} else {
+		x.Checked = nil
+	}
+	if src.Unchecked != nil {
+		pv := *src.Unchecked
+		x.Unchecked = &pv
+	} else {
+		x.Unchecked = nil
+	}
+	if src.Delimited != nil {
+		if x.Delimited == nil {
+			x.Delimited = &Edition_Child{}
+		}
+		x.Delimited.CopyFrom(src.Delimited)
+	} else {
+		x.Delimited = nil
+	}
+	// 只复用原来长度内的元素. 超出长度的部分(例如 Release 截断的列表)可能已被其他消息使用, 置为零值后重新分配
+	if n, old := len(src.DelimitedList), len(x.DelimitedList); n <= old {
+		x.DelimitedList = x.DelimitedList[:n]
+	} else {
+		x.DelimitedList = append(x.DelimitedList, make([]*Edition_Child, n-old)...)
+	}
+	for k, item := range src.DelimitedList {
+		if item != nil {
+			if x.DelimitedList[k] == nil {
+				x.DelimitedList[k] = &Edition_Child{}
+			}
+			x.DelimitedList[k].CopyFrom(item)
+		} else {
+			x.DelimitedList[k] = nil
+		}
+	}
+	if src.Open != nil {
+		pv := *src.Open
+		x.Open = &pv
+	} else {
+		x.Open = nil
+	}
+	if x.ClosedMap == nil && src.ClosedMap != nil {
+		x.ClosedMap = make(map[int32]EdClosed, len(src.ClosedMap))
+	}
+	for mk := range x.ClosedMap {
+		delete(x.ClosedMap, mk)
+	}
+	for mk, mv := range src.ClosedMap {
+		x.ClosedMap[mk] = mv
+	}
+	x.ImplicitStr = src.ImplicitStr
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *Edition) Equal(other *Edition) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.Explicit == nil) != (other.Explicit == nil) {
+		return false
+	}
+	if x.Explicit != nil {
+		if *x.Explicit != *other.Explicit {
+			return false
+		}
+	}
+	if x.Implicit != other.Implicit {
+		return false
+	}
+	if (x.Req == nil) != (other.Req == nil) {
+		return false
+	}
+	if x.Req != nil {
+		if *x.Req != *other.Req {
+			return false
+		}
+	}
+	if len(x.Packed) != len(other.Packed) {
+		return false
+	}
+	for k := range x.Packed {
+		if x.Packed[k] != other.Packed[k] {
+			return false
+		}
+	}
+	if len(x.Expanded) != len(other.Expanded) {
+		return false
+	}
+	for k := range x.Expanded {
+		if x.Expanded[k] != other.Expanded[k] {
+			return false
+		}
+	}
+	if (x.Closed == nil) != (other.Closed == nil) {
+		return false
+	}
+	if x.Closed != nil {
+		if *x.Closed != *other.Closed {
+			return false
+		}
+	}
+	if len(x.ClosedList) != len(other.ClosedList) {
+		return false
+	}
+	for k := range x.ClosedList {
+		if x.ClosedList[k] != other.ClosedList[k] {
+			return false
+		}
+	}
+	if (x.Checked == nil) != (other.Checked == nil) {
+		return false
+	}
+	if x.Checked != nil {
+		if *x.Checked != *other.Checked {
+			return false
+		}
+	}
+	if (x.Unchecked == nil) != (other.Unchecked == nil) {
+		return false
+	}
+	if x.Unchecked != nil {
+		if *x.Unchecked != *other.Unchecked {
+			return false
+		}
+	}
+	if !x.Delimited.Equal(other.Delimited) {
+		return false
+	}
+	if len(x.DelimitedList) != len(other.DelimitedList) {
+		return false
+	}
+	for k := range x.DelimitedList {
+		if !x.DelimitedList[k].Equal(other.DelimitedList[k]) {
+			return false
+		}
+	}
+	if (x.Open == nil) != (other.Open == nil) {
+		return false
+	}
+	if x.Open != nil {
+		if *x.Open != *other.Open {
+			return false
+		}
+	}
+	if len(x.ClosedMap) != len(other.ClosedMap) {
+		return false
+	}
+	for mk, mv := range x.ClosedMap {
+		ov, ok := other.ClosedMap[mk]
+		if !ok {
+			return false
+		}
+		if mv != ov {
+			return false
+		}
+	}
+	if x.ImplicitStr != other.ImplicitStr {
+		return false
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *Edition) Merge(src *Edition) {
+	if src == nil {
+		return
+	}
+	if src.Explicit != nil {
+		pv := *src.Explicit
+		x.Explicit = &pv
+	}
+	if src.Implicit != 0 {
+		x.Implicit = src.Implicit
+	}
+	if src.Req != nil {
+		pv := *src.Req
+		x.Req = &pv
+	}
+	if len(src.Packed) > 0 {
+		x.Packed = append(x.Packed, src.Packed...)
+	}
+	if len(src.Expanded) > 0 {
+		x.Expanded = append(x.Expanded, src.Expanded...)
+	}
+	if src.Closed != nil {
+		pv := *src.Closed
+		x.Closed = &pv
+	}
+	if len(src.ClosedList) > 0 {
+		x.ClosedList = append(x.ClosedList, src.ClosedList...)
+	}
+	if src.Checked != nil {
+		pv := *src.Checked
+		x.Checked = &pv
+	}
+	if src.Unchecked != nil {
+		pv := *src.Unchecked
+		x.Unchecked = &pv
+	}
+	if src.Delimited != nil {
+		if x.Delimited == nil {
+			x.Delimited = &Edition_Child{}
+		}
+		x.Delimited.Merge(src.Delimited)
+	}
+	if src.DelimitedList != nil {
+		for _, item := range src.DelimitedList {
+			x.DelimitedList = append(x.DelimitedList, nil)
+			if item != nil {
+				if x.DelimitedList[len(x.DelimitedList)-1] == nil {
+					x.DelimitedList[len(x.DelimitedList)-1] = &Edition_Child{}
+				}
+				x.DelimitedList[len(x.DelimitedList)-1].CopyFrom(item)
+			} else {
+				x.DelimitedList[len(x.DelimitedList)-1] = nil
+			}
+		}
+	}
+	if src.Open != nil {
+		pv := *src.Open
+		x.Open = &pv
+	}
+	if len(src.ClosedMap) > 0 {
+		if x.ClosedMap == nil {
+			x.ClosedMap = make(map[int32]EdClosed, len(src.ClosedMap))
+		}
+		for mk, mv := range src.ClosedMap {
+			x.ClosedMap[mk] = mv
+		}
+	}
+	if len(src.ImplicitStr) > 0 {
+		x.ImplicitStr = src.ImplicitStr
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// XXX_MessageName returns the full name of Edition
+func (x *Edition) XXX_MessageName() string {
+	return "gopb.testpb.Edition"
+}
+
+// ProtoReflect returns a protoreflect.Message view of x, which implements proto.Message.
+// proto.Marshal/proto.Unmarshal use MarshalObjectTo/UnmarshalObject
+func (x *Edition) ProtoReflect() protoreflect.Message {
+	return file_editions_proto_msgTypes[0].MessageOf(x)
+}
+
+// MarshalObject marshal data to []byte
+func (x *Edition) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, false)
+}
+
+// MarshalSize calc marshal data need space
+func (x *Edition) MarshalSize() (size int) {
+	if x.Explicit != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(*x.Explicit))
+	}
+	if x.Implicit != 0 {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeVarint(uint64(x.Implicit))
+	}
+	if x.Req != nil {
+		// 1 = protowire.SizeTag(3)
+		size += 1 + protowire.SizeVarint(uint64(*x.Req))
+	}
+	if len(x.Packed) > 0 {
+		size += 1 // size += protowire.SizeTag(4)
+		if len(x.Packed) > 0 {
+			fsize := 0
+			for _, item := range x.Packed {
+				fsize += protowire.SizeVarint(uint64(item))
+			}
+			size += protowire.SizeBytes(fsize)
+		}
+	}
+	if len(x.Expanded) > 0 {
+		// 1 = protowire.SizeTag(5)
+		size += 1 * len(x.Expanded)
+		for k := 0; k < len(x.Expanded); k++ {
+			size += protowire.SizeVarint(uint64(x.Expanded[k]))
+		}
+	}
+	if x.Closed != nil {
+		// 1 = protowire.SizeTag(6)
+		size += 1 + protowire.SizeVarint(uint64(*x.Closed))
+	}
+	if len(x.ClosedList) > 0 {
+		size += 1 // size += protowire.SizeTag(7)
+		if len(x.ClosedList) > 0 {
+			fsize := 0
+			for _, item := range x.ClosedList {
+				fsize += protowire.SizeVarint(uint64(item))
+			}
+			size += protowire.SizeBytes(fsize)
+		}
+	}
+	if x.Checked != nil {
+		// 1 = protowire.SizeTag(8)
+		size += 1 + protowire.SizeBytes(len(*x.Checked))
+	}
+	if x.Unchecked != nil {
+		// 1 = protowire.SizeTag(9)
+		size += 1 + protowire.SizeBytes(len(*x.Unchecked))
+	}
+	if x.Delimited != nil {
+		// 1 = protowire.SizeTag(10)
+		size += 2*1 + x.Delimited.MarshalSize()
+	}
+	if x.DelimitedList != nil {
+		// 1 = protowire.SizeTag(11)
+		size += 2 * 1 * len(x.DelimitedList)
+		for k := 0; k < len(x.DelimitedList); k++ {
+			size += x.DelimitedList[k].MarshalSize()
+		}
+	}
+	if x.Open != nil {
+		// 1 = protowire.SizeTag(12)
+		size += 1 + protowire.SizeVarint(uint64(*x.Open))
+	}
+	if len(x.ClosedMap) > 0 {
+		for mk, mv := range x.ClosedMap {
+			_ = mk
+			_ = mv
+			// 1 = protowire.SizeTag(13)
+			size += 1
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeVarint(uint64(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeVarint(uint64(mv))
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	if len(x.ImplicitStr) > 0 {
+		// 1 = protowire.SizeTag(14)
+		size += 1 + protowire.SizeBytes(len(x.ImplicitStr))
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *Edition) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, false)
+}
+
+// MarshalObjectDeterministic marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *Edition) MarshalObjectDeterministic() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, true)
+}
+
+// MarshalObjectDeterministicTo marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *Edition) MarshalObjectDeterministicTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, true)
+}
+
+// marshalTo marshal data to []byte. deterministic 时 map 按键排序
+func (x *Edition) marshalTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+	if x.Explicit != nil {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(*x.Explicit))
+	}
+	if x.Implicit != 0 {
+		// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+		data = append(data, 0x10)
+		data = protowire.AppendVarint(data, uint64(x.Implicit))
+	}
+	if x.Req != nil {
+		// data = protowire.AppendTag(data, 3, protowire.VarintType) => 00011000
+		data = append(data, 0x18)
+		data = protowire.AppendVarint(data, uint64(*x.Req))
+	}
+	if len(x.Packed) > 0 {
+		// data = protowire.AppendTag(data, 4, protowire.BytesType) => 00100010
+		data = append(data, 0x22)
+		size := 0
+		for _, v := range x.Packed {
+			size += protowire.SizeVarint(uint64(v))
+		}
+		data = protowire.AppendVarint(data, uint64(size))
+		for _, v := range x.Packed {
+			data = protowire.AppendVarint(data, uint64(v))
+		}
+	}
+	if len(x.Expanded) > 0 {
+		for _, item := range x.Expanded {
+			// data = protowire.AppendTag(data, 5, protowire.VarintType) => 00101000
+			data = append(data, 0x28)
+			data = protowire.AppendVarint(data, uint64(item))
+		}
+	}
+	if x.Closed != nil {
+		// data = protowire.AppendTag(data, 6, protowire.VarintType) => 00110000
+		data = append(data, 0x30)
+		data = protowire.AppendVarint(data, uint64(*x.Closed))
+	}
+	if len(x.ClosedList) > 0 {
+		// data = protowire.AppendTag(data, 7, protowire.BytesType) => 00111010
+		data = append(data, 0x3a)
+		size := 0
+		for _, v := range x.ClosedList {
+			size += protowire.SizeVarint(uint64(v))
+		}
+		data = protowire.AppendVarint(data, uint64(size))
+		for _, v := range x.ClosedList {
+			data = protowire.AppendVarint(data, uint64(v))
+		}
+	}
+	if x.Checked != nil {
+
+		if !utf8.ValidString(*x.Checked) {
+			err = gopb.MarshalUTF8Error("gopb.testpb.Edition", "checked")
+			return
+		}
+		// data = protowire.AppendTag(data, 8, protowire.BytesType) => 01000010
+		data = append(data, 0x42)
+		data = protowire.AppendString(data, *x.Checked)
+	}
+	if x.Unchecked != nil {
+
+		// data = protowire.AppendTag(data, 9, protowire.BytesType) => 01001010
+		data = append(data, 0x4a)
+		data = protowire.AppendString(data, *x.Unchecked)
+	}
+	if x.Delimited != nil {
+		// data = protowire.AppendTag(data, 10, protowire.StartGroupType) => 01010011
+		data = append(data, 0x53)
+		data, err = x.Delimited.marshalTo(data, deterministic)
+		if err != nil {
+			return
+		}
+		// data = protowire.AppendTag(data, 10, protowire.EndGroupType) => 01010100
+		data = append(data, 0x54)
+	}
+	if x.DelimitedList != nil {
+		for _, item := range x.DelimitedList {
+			// data = protowire.AppendTag(data, 11, protowire.StartGroupType) => 01011011
+			data = append(data, 0x5b)
+			data, err = item.marshalTo(data, deterministic)
+			if err != nil {
+				return
+			}
+			// data = protowire.AppendTag(data, 11, protowire.EndGroupType) => 01011100
+			data = append(data, 0x5c)
+		}
+	}
+	if x.Open != nil {
+		// data = protowire.AppendTag(data, 12, protowire.VarintType) => 01100000
+		data = append(data, 0x60)
+		data = protowire.AppendVarint(data, uint64(*x.Open))
+	}
+	if len(x.ClosedMap) > 0 {
+
+		if deterministic {
+			for _, mk := range gopb.SortedMapKeys(x.ClosedMap) {
+				mv := x.ClosedMap[mk]
+				// data = protowire.AppendTag(data, 13, protowire.BytesType) => 01101010
+				data = append(data, 0x6a)
+				msize := 0
+				// 1 = protowire.SizeTag(1)
+				msize += 1 + protowire.SizeVarint(uint64(mk))
+				// 1 = protowire.SizeTag(2)
+				msize += 1 + protowire.SizeVarint(uint64(mv))
+				data = protowire.AppendVarint(data, uint64(msize))
+				// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+				data = append(data, 0x8)
+				data = protowire.AppendVarint(data, uint64(mk))
+				// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+				data = append(data, 0x10)
+				data = protowire.AppendVarint(data, uint64(mv))
+			}
+		} else {
+			for mk, mv := range x.ClosedMap {
+				// data = protowire.AppendTag(data, 13, protowire.BytesType) => 01101010
+				data = append(data, 0x6a)
+				msize := 0
+				// 1 = protowire.SizeTag(1)
+				msize += 1 + protowire.SizeVarint(uint64(mk))
+				// 1 = protowire.SizeTag(2)
+				msize += 1 + protowire.SizeVarint(uint64(mv))
+				data = protowire.AppendVarint(data, uint64(msize))
+				// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+				data = append(data, 0x8)
+				data = protowire.AppendVarint(data, uint64(mk))
+				// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+				data = append(data, 0x10)
+				data = protowire.AppendVarint(data, uint64(mv))
+			}
+		}
+	}
+	if len(x.ImplicitStr) > 0 {
+
+		if !utf8.ValidString(x.ImplicitStr) {
+			err = gopb.MarshalUTF8Error("gopb.testpb.Edition", "implicit_str")
+			return
+		}
+		// data = protowire.AppendTag(data, 14, protowire.BytesType) => 01110010
+		data = append(data, 0x72)
+		data = protowire.AppendString(data, x.ImplicitStr)
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte. 只限制嵌套深度(gopb.DefaultMaxDepth)
+func (x *Edition) UnmarshalObject(data []byte) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{})
+}
+
+// UnmarshalObjectWith unmarshal data from []byte with the limits of opts
+func (x *Edition) UnmarshalObjectWith(data []byte, opts gopb.UnmarshalOptions) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{UnmarshalOptions: opts})
+}
+
+// UnmarshalObjectState unmarshal data from []byte. st 在嵌套消息间传递, 由生成的代码使用
+func (x *Edition) UnmarshalObjectState(data []byte, st *gopb.UnmarshalState) (err error) {
+	if !st.Enter() {
+		return gopb.LimitError("gopb.testpb.Edition", "", 0, 0)
+	}
+
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.Edition", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "explicit", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.Explicit = &pv
+		case 2:
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "implicit", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.Implicit = int32(v)
+		case 3:
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "req", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.Req = &pv
+		case 4:
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Edition", "packed", 4, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				x.Packed = append(x.Packed, int32(v))
+
+				if !st.Grow(len(x.Packed)) {
+					err = gopb.LimitError("gopb.testpb.Edition", "packed", 4, cap(data)-cap(data[index:]))
+					return
+				}
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "packed", 4, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.Edition", "packed", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.Packed == nil {
+				x.Packed = make([]int32, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Edition", "packed", 4, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.Packed = append(x.Packed, int32(v))
+
+				if !st.Grow(len(x.Packed)) {
+					err = gopb.LimitError("gopb.testpb.Edition", "packed", 4, cap(data)-cap(data[index:]))
+					return
+				}
+			}
+		case 5:
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Edition", "expanded", 5, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				x.Expanded = append(x.Expanded, int32(v))
+
+				if !st.Grow(len(x.Expanded)) {
+					err = gopb.LimitError("gopb.testpb.Edition", "expanded", 5, cap(data)-cap(data[index:]))
+					return
+				}
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "expanded", 5, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.Edition", "expanded", 5, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.Expanded == nil {
+				x.Expanded = make([]int32, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Edition", "expanded", 5, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.Expanded = append(x.Expanded, int32(v))
+
+				if !st.Grow(len(x.Expanded)) {
+					err = gopb.LimitError("gopb.testpb.Edition", "expanded", 5, cap(data)-cap(data[index:]))
+					return
+				}
+			}
+		case 6:
+			var pv EdClosed
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "closed", 6, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if _, ok := EdClosed_name[int32(v)]; !ok {
+				x.unknownFields = protowire.AppendTag(x.unknownFields, 6, protowire.VarintType)
+				x.unknownFields = protowire.AppendVarint(x.unknownFields, v)
+				continue
+			}
+			pv = EdClosed(v)
+			x.Closed = &pv
+		case 7:
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Edition", "closed_list", 7, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				if _, ok := EdClosed_name[int32(v)]; !ok {
+					x.unknownFields = protowire.AppendTag(x.unknownFields, 7, protowire.VarintType)
+					x.unknownFields = protowire.AppendVarint(x.unknownFields, v)
+					index += cnt
+					continue
+				}
+				x.ClosedList = append(x.ClosedList, EdClosed(v))
+
+				if !st.Grow(len(x.ClosedList)) {
+					err = gopb.LimitError("gopb.testpb.Edition", "closed_list", 7, cap(data)-cap(data[index:]))
+					return
+				}
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "closed_list", 7, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.Edition", "closed_list", 7, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.ClosedList == nil {
+				x.ClosedList = make([]EdClosed, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Edition", "closed_list", 7, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				if _, ok := EdClosed_name[int32(v)]; !ok {
+					x.unknownFields = protowire.AppendTag(x.unknownFields, 7, protowire.VarintType)
+					x.unknownFields = protowire.AppendVarint(x.unknownFields, v)
+					continue
+				}
+				x.ClosedList = append(x.ClosedList, EdClosed(v))
+
+				if !st.Grow(len(x.ClosedList)) {
+					err = gopb.LimitError("gopb.testpb.Edition", "closed_list", 7, cap(data)-cap(data[index:]))
+					return
+				}
+			}
+		case 8:
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "checked", 8, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			if !utf8.ValidString(v) {
+				err = gopb.UTF8Error("gopb.testpb.Edition", "checked", 8, cap(data)-cap(data[index:]))
+				return
+			}
+			index += cnt
+			pv = v
+			x.Checked = &pv
+		case 9:
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "unchecked", 9, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = v
+			x.Unchecked = &pv
+		case 10:
+			v, cnt := protowire.ConsumeGroup(10, data[index:])
+			if cnt < 0 {
+				err = gopb.ParseError("gopb.testpb.Edition", "delimited", 10, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.Delimited == nil {
+				x.Delimited = AcquireEdition_Child()
+			}
+			err = x.Delimited.UnmarshalObjectState(v, st)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.Edition", "delimited", -1, cap(data)-cap(v))
+				return
+			}
+		case 11:
+			if typ != protowire.StartGroupType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "delimited_list", 11, cap(data)-cap(data[index:]), typ, protowire.StartGroupType)
+				return
+			}
+			buf, cnt := protowire.ConsumeGroup(11, data[index:])
+			if cnt < 0 {
+				err = gopb.ParseError("gopb.testpb.Edition", "delimited_list", 11, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.DelimitedList == nil {
+				x.DelimitedList = make([]*Edition_Child, 0, 2)
+			}
+			item := AcquireEdition_Child()
+			err = item.UnmarshalObjectState(buf, st)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.Edition", "delimited_list", len(x.DelimitedList), cap(data)-cap(buf))
+				return
+			}
+			x.DelimitedList = append(x.DelimitedList, item)
+
+			if !st.Grow(len(x.DelimitedList)) {
+				err = gopb.LimitError("gopb.testpb.Edition", "delimited_list", 11, cap(data)-cap(data[index:]))
+				return
+			}
+		case 12:
+			var pv EdOpen
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "open", 12, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = EdOpen(v)
+			x.Open = &pv
+		case 13:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "closed_map", 13, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.Edition", "closed_map", 13, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.ClosedMap == nil {
+				x.ClosedMap = make(map[int32]EdClosed)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk int32
+			var mv EdClosed
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Edition", "closed_map", 13, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1 && typ == protowire.VarintType:
+					v, cnt := protowire.ConsumeVarint(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.Edition", "closed_map.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					mk = int32(v)
+				case mi == 2 && typ == protowire.VarintType:
+					v, cnt := protowire.ConsumeVarint(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.Edition", "closed_map.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					mv = EdClosed(v)
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.Edition", "closed_map", 13, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			// 值为封闭枚举的未知值时, 整个条目保存在未知字段中
+			if _, ok := EdClosed_name[int32(mv)]; !ok {
+				x.unknownFields = protowire.AppendTag(x.unknownFields, 13, protowire.BytesType)
+				x.unknownFields = protowire.AppendBytes(x.unknownFields, buf)
+				continue
+			}
+			x.ClosedMap[mk] = mv
+
+			if !st.Grow(len(x.ClosedMap)) {
+				err = gopb.LimitError("gopb.testpb.Edition", "closed_map", 13, cap(data)-cap(data[index:]))
+				return
+			}
+		case 14:
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "implicit_str", 14, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			if !utf8.ValidString(v) {
+				err = gopb.UTF8Error("gopb.testpb.Edition", "implicit_str", 14, cap(data)-cap(data[index:]))
+				return
+			}
+			index += cnt
+			x.ImplicitStr = v
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.Edition", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+	st.Leave()
+
+	return
+}
+
+// IsInitialized check all required fields are set, including nested messages
+func (x *Edition) IsInitialized() error {
+	if missing := x.AppendMissingRequired(nil, "Edition"); len(missing) > 0 {
+		return errors.New("required fields not set: " + strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// AppendMissingRequired append the path of required fields not set to missing. path is the path of x
+func (x *Edition) AppendMissingRequired(missing []string, path string) []string {
+	if x.Req == nil {
+		missing = append(missing, path+".req")
+	}
+	return missing
+}
+
+// MarshalObjectChecked marshal data to []byte. return error if any required field is not set
+func (x *Edition) MarshalObjectChecked() (data []byte, err error) {
+	if err = x.IsInitialized(); err != nil {
+		return
+	}
+	return x.MarshalObject()
+}
+
+// UnmarshalObjectChecked unmarshal data from []byte. return error if any required field is not set
+func (x *Edition) UnmarshalObjectChecked(data []byte) (err error) {
+	if err = x.UnmarshalObject(data); err != nil {
+		return
+	}
+	return x.IsInitialized()
+}
+
+// MarshalJSON marshal to protobuf canonical JSON
+func (x *Edition) MarshalJSON() ([]byte, error) {
+	return x.AppendJSON(nil)
+}
+
+// AppendJSON appends the protobuf canonical JSON of x to b. nil is appended as empty object
+func (x *Edition) AppendJSON(b []byte) (_ []byte, err error) {
+	if x == nil {
+		return append(b, '{', '}'), nil
+	}
+	b = append(b, '{')
+	if x.Explicit != nil {
+		b = append(b, `"explicit":`...)
+		b = strconv.AppendInt(b, int64(*x.Explicit), 10)
+		b = append(b, ',')
+	}
+	if x.Implicit != 0 {
+		b = append(b, `"implicit":`...)
+		b = strconv.AppendInt(b, int64(x.Implicit), 10)
+		b = append(b, ',')
+	}
+	if x.Req != nil {
+		b = append(b, `"req":`...)
+		b = strconv.AppendInt(b, int64(*x.Req), 10)
+		b = append(b, ',')
+	}
+	if len(x.Packed) > 0 {
+		b = append(b, `"packed":`...)
+		b = append(b, '[')
+		for _, item := range x.Packed {
+			b = strconv.AppendInt(b, int64(item), 10)
+			b = append(b, ',')
+		}
+		b = gopb.CloseJSON(b, ']')
+		b = append(b, ',')
+	}
+	if len(x.Expanded) > 0 {
+		b = append(b, `"expanded":`...)
+		b = append(b, '[')
+		for _, item := range x.Expanded {
+			b = strconv.AppendInt(b, int64(item), 10)
+			b = append(b, ',')
+		}
+		b = gopb.CloseJSON(b, ']')
+		b = append(b, ',')
+	}
+	if x.Closed != nil {
+		b = append(b, `"closed":`...)
+		b = gopb.AppendJSONEnum(b, int32(*x.Closed), EdClosed_name)
+		b = append(b, ',')
+	}
+	if len(x.ClosedList) > 0 {
+		b = append(b, `"closedList":`...)
+		b = append(b, '[')
+		for _, item := range x.ClosedList {
+			b = gopb.AppendJSONEnum(b, int32(item), EdClosed_name)
+			b = append(b, ',')
+		}
+		b = gopb.CloseJSON(b, ']')
+		b = append(b, ',')
+	}
+	if x.Checked != nil {
+		b = append(b, `"checked":`...)
+		b = gopb.AppendJSONString(b, *x.Checked)
+		b = append(b, ',')
+	}
+	if x.Unchecked != nil {
+		b = append(b, `"unchecked":`...)
+		b = gopb.AppendJSONString(b, *x.Unchecked)
+		b = append(b, ',')
+	}
+	if x.Delimited != nil {
+		b = append(b, `"delimited":`...)
+		if b, err = x.Delimited.AppendJSON(b); err != nil {
+			return
+		}
+		b = append(b, ',')
+	}
+	if x.DelimitedList != nil {
+		b = append(b, `"delimitedList":`...)
+		b = append(b, '[')
+		for _, item := range x.DelimitedList {
+			if b, err = item.AppendJSON(b); err != nil {
+				return
+			}
+			b = append(b, ',')
+		}
+		b = gopb.CloseJSON(b, ']')
+		b = append(b, ',')
+	}
+	if x.Open != nil {
+		b = append(b, `"open":`...)
+		b = gopb.AppendJSONEnum(b, int32(*x.Open), EdOpen_name)
+		b = append(b, ',')
+	}
+	if len(x.ClosedMap) > 0 {
+		b = append(b, `"closedMap":`...)
+		b = append(b, '{')
+		for mk, mv := range x.ClosedMap {
+			b = gopb.AppendJSONInt64(b, int64(mk))
+			b = append(b, ':')
+			b = gopb.AppendJSONEnum(b, int32(mv), EdClosed_name)
+			b = append(b, ',')
+		}
+		b = gopb.CloseJSON(b, '}')
+		b = append(b, ',')
+	}
+	if len(x.ImplicitStr) > 0 {
+		b = append(b, `"implicitStr":`...)
+		b = gopb.AppendJSONString(b, x.ImplicitStr)
+		b = append(b, ',')
+	}
+	return gopb.CloseJSON(b, '}'), nil
+}
+
+// UnmarshalJSON unmarshal from protobuf canonical JSON. both json name and proto name of fields are accepted
+func (x *Edition) UnmarshalJSON(data []byte) error {
+	x.Reset()
+	d := gopb.NewJSONDecoder(data)
+	if err := x.UnmarshalJSONFrom(d); err != nil {
+		return err
+	}
+	return d.End()
+}
+
+// UnmarshalJSONFrom reads a JSON object from d and merges it into x. null values are skipped.
+// duplicate fields, duplicate map keys and more than one member of a oneof are rejected as protojson
+func (x *Edition) UnmarshalJSONFrom(d *gopb.JSONDecoder) error {
+	// 重复的字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadObject(func(name string) (err error) {
+		switch name {
+		case "explicit":
+			if err = d.CheckField(&seen, 1, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.Explicit = &pv
+		case "implicit":
+			if err = d.CheckField(&seen, 2, name); err != nil || d.ReadNull() {
+				return
+			}
+			x.Implicit, err = d.ReadInt32()
+		case "req":
+			if err = d.CheckField(&seen, 3, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.Req = &pv
+		case "packed":
+			if err = d.CheckField(&seen, 4, name); err != nil || d.ReadNull() {
+				return
+			}
+			err = d.ReadArray(func() (err error) {
+				var item int32
+				item, err = d.ReadInt32()
+				x.Packed = append(x.Packed, item)
+				return
+			})
+		case "expanded":
+			if err = d.CheckField(&seen, 5, name); err != nil || d.ReadNull() {
+				return
+			}
+			err = d.ReadArray(func() (err error) {
+				var item int32
+				item, err = d.ReadInt32()
+				x.Expanded = append(x.Expanded, item)
+				return
+			})
+		case "closed":
+			if err = d.CheckField(&seen, 6, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv EdClosed
+			ev, err := d.ReadEnum(EdClosed_value)
+			if err != nil {
+				return err
+			}
+			pv = EdClosed(ev)
+			x.Closed = &pv
+		case "closedList", "closed_list":
+			if err = d.CheckField(&seen, 7, name); err != nil || d.ReadNull() {
+				return
+			}
+			err = d.ReadArray(func() (err error) {
+				var item EdClosed
+				ev, err := d.ReadEnum(EdClosed_value)
+				if err != nil {
+					return err
+				}
+				item = EdClosed(ev)
+				x.ClosedList = append(x.ClosedList, item)
+				return
+			})
+		case "checked":
+			if err = d.CheckField(&seen, 8, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv string
+			pv, err = d.ReadString()
+			x.Checked = &pv
+		case "unchecked":
+			if err = d.CheckField(&seen, 9, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv string
+			pv, err = d.ReadString()
+			x.Unchecked = &pv
+		case "delimited":
+			if err = d.CheckField(&seen, 10, name); err != nil || d.ReadNull() {
+				return
+			}
+			if x.Delimited == nil {
+				x.Delimited = &Edition_Child{}
+			}
+			err = x.Delimited.UnmarshalJSONFrom(d)
+		case "delimitedList", "delimited_list":
+			if err = d.CheckField(&seen, 11, name); err != nil || d.ReadNull() {
+				return
+			}
+			err = d.ReadArray(func() (err error) {
+				var item *Edition_Child
+				if item == nil {
+					item = &Edition_Child{}
+				}
+				err = item.UnmarshalJSONFrom(d)
+				x.DelimitedList = append(x.DelimitedList, item)
+				return
+			})
+		case "open":
+			if err = d.CheckField(&seen, 12, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv EdOpen
+			ev, err := d.ReadEnum(EdOpen_value)
+			if err != nil {
+				return err
+			}
+			pv = EdOpen(ev)
+			x.Open = &pv
+		case "closedMap", "closed_map":
+			if err = d.CheckField(&seen, 13, name); err != nil || d.ReadNull() {
+				return
+			}
+			if x.ClosedMap == nil {
+				x.ClosedMap = make(map[int32]EdClosed)
+			}
+			err = d.ReadObject(func(key string) (err error) {
+				kv, err := d.ParseMapKeyInt(key, 32)
+				if err != nil {
+					return err
+				}
+				mk := int32(kv)
+				if _, ok := x.ClosedMap[mk]; ok {
+					return d.DuplicateMapKey(key)
+				}
+				var mv EdClosed
+				ev, err := d.ReadEnum(EdClosed_value)
+				if err != nil {
+					return err
+				}
+				mv = EdClosed(ev)
+				x.ClosedMap[mk] = mv
+				return
+			})
+		case "implicitStr", "implicit_str":
+			if err = d.CheckField(&seen, 14, name); err != nil || d.ReadNull() {
+				return
+			}
+			x.ImplicitStr, err = d.ReadString()
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+// String returns the protobuf text format of x
+func (x *Edition) String() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return string(x.AppendText(nil))
+}
+
+// AppendText appends the fields of x in protobuf text format to b
+func (x *Edition) AppendText(b []byte) []byte {
+	if x == nil {
+		return b
+	}
+	start := len(b)
+	if x.Explicit != nil {
+		b = gopb.AppendTextName(b, start, "explicit")
+		b = strconv.AppendInt(b, int64(*x.Explicit), 10)
+	}
+	if x.Implicit != 0 {
+		b = gopb.AppendTextName(b, start, "implicit")
+		b = strconv.AppendInt(b, int64(x.Implicit), 10)
+	}
+	if x.Req != nil {
+		b = gopb.AppendTextName(b, start, "req")
+		b = strconv.AppendInt(b, int64(*x.Req), 10)
+	}
+	if len(x.Packed) > 0 {
+		for _, item := range x.Packed {
+			b = gopb.AppendTextName(b, start, "packed")
+			b = strconv.AppendInt(b, int64(item), 10)
+		}
+	}
+	if len(x.Expanded) > 0 {
+		for _, item := range x.Expanded {
+			b = gopb.AppendTextName(b, start, "expanded")
+			b = strconv.AppendInt(b, int64(item), 10)
+		}
+	}
+	if x.Closed != nil {
+		b = gopb.AppendTextName(b, start, "closed")
+		b = gopb.AppendTextEnum(b, int32(*x.Closed), EdClosed_name)
+	}
+	if len(x.ClosedList) > 0 {
+		for _, item := range x.ClosedList {
+			b = gopb.AppendTextName(b, start, "closed_list")
+			b = gopb.AppendTextEnum(b, int32(item), EdClosed_name)
+		}
+	}
+	if x.Checked != nil {
+		b = gopb.AppendTextName(b, start, "checked")
+		b = gopb.AppendTextString(b, *x.Checked)
+	}
+	if x.Unchecked != nil {
+		b = gopb.AppendTextName(b, start, "unchecked")
+		b = gopb.AppendTextString(b, *x.Unchecked)
+	}
+	if x.Delimited != nil {
+		b = gopb.AppendTextName(b, start, "delimited")
+		b = append(b, '{')
+		b = x.Delimited.AppendText(b)
+		b = append(b, '}')
+	}
+	if x.DelimitedList != nil {
+		for _, item := range x.DelimitedList {
+			b = gopb.AppendTextName(b, start, "delimited_list")
+			b = append(b, '{')
+			b = item.AppendText(b)
+			b = append(b, '}')
+		}
+	}
+	if x.Open != nil {
+		b = gopb.AppendTextName(b, start, "open")
+		b = gopb.AppendTextEnum(b, int32(*x.Open), EdOpen_name)
+	}
+	if len(x.ClosedMap) > 0 {
+		for mk, mv := range x.ClosedMap {
+			b = gopb.AppendTextName(b, start, "closed_map")
+			b = append(b, "{key:"...)
+			b = strconv.AppendInt(b, int64(mk), 10)
+			b = append(b, " value:"...)
+			b = gopb.AppendTextEnum(b, int32(mv), EdClosed_name)
+			b = append(b, '}')
+		}
+	}
+	if len(x.ImplicitStr) > 0 {
+		b = gopb.AppendTextName(b, start, "implicit_str")
+		b = gopb.AppendTextString(b, x.ImplicitStr)
+	}
+	return b
+}
+
+// UnmarshalText unmarshal from protobuf text format
+func (x *Edition) UnmarshalText(data []byte) error {
+	x.Reset()
+	return x.UnmarshalTextFrom(gopb.NewTextDecoder(data))
+}
+
+// UnmarshalTextFrom reads the fields of a message from d and merges them into x.
+// non-repeated fields appeared twice and more than one member of a oneof are rejected as prototext
+func (x *Edition) UnmarshalTextFrom(d *gopb.TextDecoder) error {
+	// 重复的非repeated字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadMessage(func(name string) (err error) {
+		switch name {
+		case "explicit":
+			if err = d.CheckField(&seen, 1, name); err != nil {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.Explicit = &pv
+		case "implicit":
+			if err = d.CheckField(&seen, 2, name); err != nil {
+				return
+			}
+			x.Implicit, err = d.ReadInt32()
+		case "req":
+			if err = d.CheckField(&seen, 3, name); err != nil {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.Req = &pv
+		case "packed":
+			err = d.ReadRepeated(func() (err error) {
+				var item int32
+				item, err = d.ReadInt32()
+				x.Packed = append(x.Packed, item)
+				return
+			})
+		case "expanded":
+			err = d.ReadRepeated(func() (err error) {
+				var item int32
+				item, err = d.ReadInt32()
+				x.Expanded = append(x.Expanded, item)
+				return
+			})
+		case "closed":
+			if err = d.CheckField(&seen, 6, name); err != nil {
+				return
+			}
+			var pv EdClosed
+			ev, err := d.ReadEnum(EdClosed_value)
+			if err != nil {
+				return err
+			}
+			pv = EdClosed(ev)
+			x.Closed = &pv
+		case "closed_list":
+			err = d.ReadRepeated(func() (err error) {
+				var item EdClosed
+				ev, err := d.ReadEnum(EdClosed_value)
+				if err != nil {
+					return err
+				}
+				item = EdClosed(ev)
+				x.ClosedList = append(x.ClosedList, item)
+				return
+			})
+		case "checked":
+			if err = d.CheckField(&seen, 8, name); err != nil {
+				return
+			}
+			var pv string
+			pv, err = d.ReadString()
+			x.Checked = &pv
+		case "unchecked":
+			if err = d.CheckField(&seen, 9, name); err != nil {
+				return
+			}
+			var pv string
+			pv, err = d.ReadString()
+			x.Unchecked = &pv
+		case "delimited":
+			if err = d.CheckField(&seen, 10, name); err != nil {
+				return
+			}
+			if x.Delimited == nil {
+				x.Delimited = &Edition_Child{}
+			}
+			err = x.Delimited.UnmarshalTextFrom(d)
+		case "delimited_list":
+			err = d.ReadRepeated(func() (err error) {
+				var item *Edition_Child
+				if item == nil {
+					item = &Edition_Child{}
+				}
+				err = item.UnmarshalTextFrom(d)
+				x.DelimitedList = append(x.DelimitedList, item)
+				return
+			})
+		case "open":
+			if err = d.CheckField(&seen, 12, name); err != nil {
+				return
+			}
+			var pv EdOpen
+			ev, err := d.ReadEnum(EdOpen_value)
+			if err != nil {
+				return err
+			}
+			pv = EdOpen(ev)
+			x.Open = &pv
+		case "closed_map":
+			if x.ClosedMap == nil {
+				x.ClosedMap = make(map[int32]EdClosed)
+			}
+			err = d.ReadRepeated(func() error {
+				var mk int32
+				var mv EdClosed
+				var seen gopb.FieldSet
+				err := d.ReadMessage(func(name string) (err error) {
+					switch name {
+					case "key":
+						if err = d.CheckField(&seen, 1, name); err != nil {
+							return
+						}
+						mk, err = d.ReadInt32()
+					case "value":
+						if err = d.CheckField(&seen, 2, name); err != nil {
+							return
+						}
+						ev, err := d.ReadEnum(EdClosed_value)
+						if err != nil {
+							return err
+						}
+						mv = EdClosed(ev)
+					default:
+						err = d.UnknownField(name)
+					}
+					return
+				})
+				x.ClosedMap[mk] = mv
+				return err
+			})
+		case "implicit_str":
+			if err = d.CheckField(&seen, 14, name); err != nil {
+				return
+			}
+			x.ImplicitStr, err = d.ReadString()
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+type Edition_Child struct {
+	A             *int32         `json:"a,omitempty"`
+	Child         *Edition_Child `json:"child,omitempty"`
+	unknownFields []byte
+}
+
+func (x *Edition_Child) Reset() {
+	*x = Edition_Child{}
+}
+
+var poolEdition_Child = sync.Pool{New: func() any { return &Edition_Child{} }}
+
+// AcquireEdition_Child returns an empty Edition_Child from the pool. call Release to put it back
+func AcquireEdition_Child() *Edition_Child {
+	return poolEdition_Child.Get().(*Edition_Child)
+}
+
+// Release resets x, releases its sub-messages and puts x back to the pool. x must not be used after Release.
+// 列表截断保留容量, map 清空后保留
+func (x *Edition_Child) Release() {
+	if x == nil {
+		return
+	}
+	x.Child.Release()
+	*x = Edition_Child{
+		unknownFields: x.unknownFields[:0],
+	}
+	poolEdition_Child.Put(x)
+}
+
+func (x *Edition_Child) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+// HasA report whether the field is set
+func (x *Edition_Child) HasA() bool {
+	return x != nil && x.A != nil
+}
+
+// ClearA clear the field
+func (x *Edition_Child) ClearA() {
+	x.A = nil
+}
+
+func (x *Edition_Child) GetChild() *Edition_Child {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+// HasChild report whether the field is set
+func (x *Edition_Child) HasChild() bool {
+	return x != nil && x.Child != nil
+}
+
+// ClearChild clear the field
+func (x *Edition_Child) ClearChild() {
+	x.Child = nil
+}
+
+// Clone returns a deep copy of x
+func (x *Edition_Child) Clone() *Edition_Child {
+	if x == nil {
+		return nil
+	}
+	y := &Edition_Child{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *Edition_Child) CopyFrom(src *Edition_Child) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.A != nil {
+		pv := *src.A
+		x.A = &pv
+	} else {
+		x.A = nil
+	}
+	if src.Child != nil {
+		if x.Child == nil {
+			x.Child = &Edition_Child{}
+		}
+		x.Child.CopyFrom(src.Child)
+	} else {
+		x.Child = nil
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *Edition_Child) Equal(other *Edition_Child) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.A == nil) != (other.A == nil) {
+		return false
+	}
+	if x.A != nil {
+		if *x.A != *other.A {
+			return false
+		}
+	}
+	if !x.Child.Equal(other.Child) {
+		return false
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *Edition_Child) Merge(src *Edition_Child) {
+	if src == nil {
+		return
+	}
+	if src.A != nil {
+		pv := *src.A
+		x.A = &pv
+	}
+	if src.Child != nil {
+		if x.Child == nil {
+			x.Child = &Edition_Child{}
+		}
+		x.Child.Merge(src.Child)
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// XXX_MessageName returns the full name of Edition_Child
+func (x *Edition_Child) XXX_MessageName() string {
+	return "gopb.testpb.Edition.Child"
+}
+
+// ProtoReflect returns a protoreflect.Message view of x, which implements proto.Message.
+// proto.Marshal/proto.Unmarshal use MarshalObjectTo/UnmarshalObject
+func (x *Edition_Child) ProtoReflect() protoreflect.Message {
+	return file_editions_proto_msgTypes[1].MessageOf(x)
+}
+
+// MarshalObject marshal data to []byte
+func (x *Edition_Child) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, false)
+}
+
+// MarshalSize calc marshal data need space
+func (x *Edition_Child) MarshalSize() (size int) {
+	if x.A != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(*x.A))
+	}
+	if x.Child != nil {
+		// 1 = protowire.SizeTag(2)
+		size += 2*1 + x.Child.MarshalSize()
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *Edition_Child) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, false)
+}
+
+// MarshalObjectDeterministic marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *Edition_Child) MarshalObjectDeterministic() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, true)
+}
+
+// MarshalObjectDeterministicTo marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *Edition_Child) MarshalObjectDeterministicTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, true)
+}
+
+// marshalTo marshal data to []byte. deterministic 时 map 按键排序
+func (x *Edition_Child) marshalTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+	if x.A != nil {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(*x.A))
+	}
+	if x.Child != nil {
+		// data = protowire.AppendTag(data, 2, protowire.StartGroupType) => 00010011
+		data = append(data, 0x13)
+		data, err = x.Child.marshalTo(data, deterministic)
+		if err != nil {
+			return
+		}
+		// data = protowire.AppendTag(data, 2, protowire.EndGroupType) => 00010100
+		data = append(data, 0x14)
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte. 只限制嵌套深度(gopb.DefaultMaxDepth)
+func (x *Edition_Child) UnmarshalObject(data []byte) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{})
+}
+
+// UnmarshalObjectWith unmarshal data from []byte with the limits of opts
+func (x *Edition_Child) UnmarshalObjectWith(data []byte, opts gopb.UnmarshalOptions) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{UnmarshalOptions: opts})
+}
+
+// UnmarshalObjectState unmarshal data from []byte. st 在嵌套消息间传递, 由生成的代码使用
+func (x *Edition_Child) UnmarshalObjectState(data []byte, st *gopb.UnmarshalState) (err error) {
+	if !st.Enter() {
+		return gopb.LimitError("gopb.testpb.Edition.Child", "", 0, 0)
+	}
+
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.Edition.Child", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition.Child", "a", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.A = &pv
+		case 2:
+			v, cnt := protowire.ConsumeGroup(2, data[index:])
+			if cnt < 0 {
+				err = gopb.ParseError("gopb.testpb.Edition.Child", "child", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.Child == nil {
+				x.Child = AcquireEdition_Child()
+			}
+			err = x.Child.UnmarshalObjectState(v, st)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.Edition.Child", "child", -1, cap(data)-cap(v))
+				return
+			}
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.Edition.Child", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+	st.Leave()
+
+	return
+}
+
+// MarshalJSON marshal to protobuf canonical JSON
+func (x *Edition_Child) MarshalJSON() ([]byte, error) {
+	return x.AppendJSON(nil)
+}
+
+// AppendJSON appends the protobuf canonical JSON of x to b. nil is appended as empty object
+func (x *Edition_Child) AppendJSON(b []byte) (_ []byte, err error) {
+	if x == nil {
+		return append(b, '{', '}'), nil
+	}
+	b = append(b, '{')
+	if x.A != nil {
+		b = append(b, `"a":`...)
+		b = strconv.AppendInt(b, int64(*x.A), 10)
+		b = append(b, ',')
+	}
+	if x.Child != nil {
+		b = append(b, `"child":`...)
+		if b, err = x.Child.AppendJSON(b); err != nil {
+			return
+		}
+		b = append(b, ',')
+	}
+	return gopb.CloseJSON(b, '}'), nil
+}
+
+// UnmarshalJSON unmarshal from protobuf canonical JSON. both json name and proto name of fields are accepted
+func (x *Edition_Child) UnmarshalJSON(data []byte) error {
+	x.Reset()
+	d := gopb.NewJSONDecoder(data)
+	if err := x.UnmarshalJSONFrom(d); err != nil {
+		return err
+	}
+	return d.End()
+}
+
+// UnmarshalJSONFrom reads a JSON object from d and merges it into x. null values are skipped.
+// duplicate fields, duplicate map keys and more than one member of a oneof are rejected as protojson
+func (x *Edition_Child) UnmarshalJSONFrom(d *gopb.JSONDecoder) error {
+	// 重复的字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadObject(func(name string) (err error) {
+		switch name {
+		case "a":
+			if err = d.CheckField(&seen, 1, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.A = &pv
+		case "child":
+			if err = d.CheckField(&seen, 2, name); err != nil || d.ReadNull() {
+				return
+			}
+			if x.Child == nil {
+				x.Child = &Edition_Child{}
+			}
+			err = x.Child.UnmarshalJSONFrom(d)
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+// String returns the protobuf text format of x
+func (x *Edition_Child) String() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return string(x.AppendText(nil))
+}
+
+// AppendText appends the fields of x in protobuf text format to b
+func (x *Edition_Child) AppendText(b []byte) []byte {
+	if x == nil {
+		return b
+	}
+	start := len(b)
+	if x.A != nil {
+		b = gopb.AppendTextName(b, start, "a")
+		b = strconv.AppendInt(b, int64(*x.A), 10)
+	}
+	if x.Child != nil {
+		b = gopb.AppendTextName(b, start, "child")
+		b = append(b, '{')
+		b = x.Child.AppendText(b)
+		b = append(b, '}')
+	}
+	return b
+}
+
+// UnmarshalText unmarshal from protobuf text format
+func (x *Edition_Child) UnmarshalText(data []byte) error {
+	x.Reset()
+	return x.UnmarshalTextFrom(gopb.NewTextDecoder(data))
+}
+
+// UnmarshalTextFrom reads the fields of a message from d and merges them into x.
+// non-repeated fields appeared twice and more than one member of a oneof are rejected as prototext
+func (x *Edition_Child) UnmarshalTextFrom(d *gopb.TextDecoder) error {
+	// 重复的非repeated字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadMessage(func(name string) (err error) {
+		switch name {
+		case "a":
+			if err = d.CheckField(&seen, 1, name); err != nil {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.A = &pv
+		case "child":
+			if err = d.CheckField(&seen, 2, name); err != nil {
+				return
+			}
+			if x.Child == nil {
+				x.Child = &Edition_Child{}
+			}
+			err = x.Child.UnmarshalTextFrom(d)
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+var file_editions_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0b, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x22, 0x89, 0x06,
+	0x0a, 0x07, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x08,
+	0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x03, 0x52, 0x03, 0x72, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x08, 0x65, 0x78, 0x70,
+	0x61, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x42, 0x05, 0xaa, 0x01, 0x02,
+	0x18, 0x02, 0x52, 0x08, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67,
+	0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0b, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x45,
+	0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x23, 0x0a,
+	0x09, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x05, 0xaa, 0x01, 0x02, 0x20, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x28, 0x02, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x28, 0x02, 0x52, 0x0d,
+	0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x6f,
+	0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x4f, 0x70, 0x65, 0x6e,
+	0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x5f, 0x6d, 0x61, 0x70, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x70,
+	0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x12, 0x28, 0x0a, 0x0c, 0x69, 0x6d,
+	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69,
+	0x74, 0x53, 0x74, 0x72, 0x1a, 0x53, 0x0a, 0x0e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4e, 0x0a, 0x05, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x61,
+	0x12, 0x37, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x42, 0x05, 0xaa, 0x01, 0x02,
+	0x28, 0x02, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2a, 0x29, 0x0a, 0x06, 0x45, 0x64, 0x4f,
+	0x70, 0x65, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x5a,
+	0x45, 0x52, 0x4f, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x4e,
+	0x5f, 0x41, 0x10, 0x01, 0x2a, 0x46, 0x0a, 0x08, 0x45, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x0e, 0x45, 0x44, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x5a, 0x45,
+	0x52, 0x4f, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x44, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x44, 0x5f, 0x42, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x44, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
+	0x45, 0x44, 0x5f, 0x43, 0x10, 0x02, 0x1a, 0x04, 0x3a, 0x02, 0x10, 0x02, 0x42, 0x3e, 0x5a, 0x3c,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x67, 0x67, 0x72, 0x6f,
+	0x6e, 0x6d, 0x61, 0x67, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e,
+	0x2d, 0x67, 0x6f, 0x70, 0x62, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x62, 0x3b, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x62, 0x08, 0x65, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x70, 0xe8, 0x07,
+}
+
+var file_editions_proto = gopb.RegisterFile("editions.proto", file_editions_proto_rawDesc)
+
+var file_editions_proto_msgTypes = [...]*gopb.MessageType{
+	file_editions_proto.NewMessageType("gopb.testpb.Edition", (*Edition)(nil), []string{"Explicit", "Implicit", "Req", "Packed", "Expanded", "Closed", "ClosedList", "Checked", "Unchecked", "Delimited", "DelimitedList", "Open", "ClosedMap", "ImplicitStr"}),
+	file_editions_proto.NewMessageType("gopb.testpb.Edition.Child", (*Edition_Child)(nil), []string{"A", "Child"}),
+}
+
+func init() {
+	gopb.RegisterType("gopb.testpb.Edition", func() gopb.Message { return &Edition{} })
+	gopb.RegisterType("gopb.testpb.Edition.Child", func() gopb.Message { return &Edition_Child{} })
+}
@@ -0,0 +1,129 @@
+package testpb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGoldenEdition(t *testing.T) {
+	x := &Edition{
+		Explicit: ptr[int32](0), Implicit: 1, Req: ptr[int32](2),
+		Packed: []int32{1, 2}, Expanded: []int32{3, 4},
+		Closed: ptr(EdClosed_ED_CLOSED_C), ClosedList: []EdClosed{EdClosed_ED_CLOSED_B, EdClosed_ED_CLOSED_ZERO},
+		Checked: ptr("c"), Unchecked: ptr(""),
+		Delimited:     &Edition_Child{A: ptr[int32](5), Child: &Edition_Child{}},
+		DelimitedList: []*Edition_Child{{}, {A: ptr[int32](6)}},
+		Open:          ptr(EdOpen(7)),
+		ImplicitStr:   "s",
+	}
+	want := &golden.Edition{
+		Explicit: proto.Int32(0), Implicit: 1, Req: proto.Int32(2),
+		Packed: []int32{1, 2}, Expanded: []int32{3, 4},
+		Closed: golden.EdClosed_ED_CLOSED_C.Enum(), ClosedList: []golden.EdClosed{golden.EdClosed_ED_CLOSED_B, golden.EdClosed_ED_CLOSED_ZERO},
+		Checked: proto.String("c"), Unchecked: proto.String(""),
+		Delimited:     &golden.Edition_Child{A: proto.Int32(5), Child: &golden.Edition_Child{}},
+		DelimitedList: []*golden.Edition_Child{{}, {A: proto.Int32(6)}},
+		Open:          golden.EdOpen(7).Enum(),
+		ImplicitStr:   "s",
+	}
+	checkGolden(t, x, &Edition{}, want)
+
+	// 没有 map 时字段顺序确定, 编码(packed/expanded, delimited)与 protobuf-go 完全一致
+	got, err := x.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := proto.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("MarshalObject = %x, want %x", got, data)
+	}
+
+	// IMPLICIT 的零值不序列化
+	if size := (&Edition{Implicit: 0, ImplicitStr: ""}).MarshalSize(); size != 0 {
+		t.Errorf("MarshalSize = %d, want 0", size)
+	}
+
+	// LEGACY_REQUIRED 与 proto2 required 相同
+	if err := (&Edition{}).IsInitialized(); err == nil {
+		t.Error("IsInitialized = nil, want req missing")
+	}
+}
+
+// 封闭枚举的未定义值与 protobuf-go 一样保存在未知字段中, 不修改字段
+func TestClosedEnumUnknown(t *testing.T) {
+	varint := func(b []byte, num protowire.Number, v uint64) []byte {
+		return protowire.AppendVarint(protowire.AppendTag(b, num, protowire.VarintType), v)
+	}
+	entry := func(b []byte, num protowire.Number, key []byte, v uint64) []byte {
+		return protowire.AppendBytes(protowire.AppendTag(b, num, protowire.BytesType), varint(key, 2, v))
+	}
+	packed := func(b []byte, num protowire.Number, vs ...uint64) []byte {
+		var buf []byte
+		for _, v := range vs {
+			buf = protowire.AppendVarint(buf, v)
+		}
+		return protowire.AppendBytes(protowire.AppendTag(b, num, protowire.BytesType), buf)
+	}
+
+	// protobuf-go 对 editions 的封闭枚举不检查, 这里按 protobuf 规范检查未知字段
+	t.Run("editions", func(t *testing.T) {
+		var data, unknown []byte
+		data = varint(data, 3, 0)
+		data = varint(data, 6, 1)
+		data = varint(data, 6, 9)
+		data = varint(data, 7, 2)
+		data = varint(data, 7, 8)
+		data = packed(data, 7, 1, 9)
+		data = entry(data, 13, varint(nil, 1, 1), 2)
+		data = entry(data, 13, varint(nil, 1, 2), 9)
+		data = varint(data, 12, 9)
+		unknown = varint(unknown, 6, 9)
+		unknown = varint(unknown, 7, 8)
+		unknown = varint(unknown, 7, 9)
+		unknown = entry(unknown, 13, varint(nil, 1, 2), 9)
+
+		x := &Edition{}
+		if err := x.UnmarshalObject(data); err != nil {
+			t.Fatal(err)
+		}
+		if x.GetClosed() != EdClosed_ED_CLOSED_B || len(x.ClosedList) != 2 || len(x.ClosedMap) != 1 || x.GetOpen() != 9 {
+			t.Errorf("UnmarshalObject = %+v", x)
+		}
+		if !bytes.Equal(x.unknownFields, unknown) {
+			t.Errorf("unknownFields = %x, want %x", x.unknownFields, unknown)
+		}
+		got, err := x.MarshalObject()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.HasSuffix(got, unknown) {
+			t.Errorf("MarshalObject = %x, want suffix %x", got, unknown)
+		}
+	})
+
+	t.Run("proto2", func(t *testing.T) {
+		var data []byte
+		data = varint(data, 8, 7)
+		data = varint(data, 10, 1)
+		data = entry(data, 12, protowire.AppendString(protowire.AppendTag(nil, 1, protowire.BytesType), "a"), 3)
+		x := &P2Opt{}
+		if err := x.UnmarshalObject(data); err != nil {
+			t.Fatal(err)
+		}
+		if x.HasLv() || len(x.M) != 0 || len(x.R) != 1 {
+			t.Errorf("UnmarshalObject = %+v", x)
+		}
+		want := &golden.P2Opt{}
+		if err := proto.Unmarshal(data, want); err != nil {
+			t.Fatal(err)
+		}
+		checkGolden(t, x, &P2Opt{}, want)
+	})
+}
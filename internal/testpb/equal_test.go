@@ -0,0 +1,118 @@
+package testpb
+
+import (
+	"math"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// toGolden 将 x 序列化后解析为与 typ 同类型的 protobuf-go 消息
+func toGolden(t *testing.T, x message, typ proto.Message) proto.Message {
+	t.Helper()
+	data, err := x.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := typ.ProtoReflect().New().Interface()
+	if err := proto.Unmarshal(data, m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// Equal 与 proto.Equal 的结果一致
+func TestGoldenEqual(t *testing.T) {
+	sample, _ := sampleAll(t)
+	nan := math.NaN()
+	unknown := &All{}
+	unknown.unknownFields = protowire.AppendVarint(protowire.AppendTag(nil, 1000, protowire.VarintType), 1)
+	tests := []struct {
+		name string
+		a, b *All
+	}{
+		{"empty", &All{}, &All{}},
+		{"sample", sample, sample.Clone()},
+		{"scalar", &All{FInt32: 1}, &All{FInt32: 2}},
+		{"implicit zero", &All{FInt32: 0, FString: ""}, &All{}},
+		{"nil and empty bytes", &All{FBytes: []byte{}}, &All{}},
+		{"nil and empty list", &All{RInt32: []int32{}, RMsg: []*Inner{}}, &All{}},
+		{"nil and empty map", &All{MStrInt: map[string]int32{}}, &All{}},
+		{"list order", &All{RInt32: []int32{1, 2}}, &All{RInt32: []int32{2, 1}}},
+		{"map value", &All{MStrMsg: map[string]*Inner{"a": {}}}, &All{MStrMsg: map[string]*Inner{"a": {Id: 1}}}},
+		{"map key", &All{MStrInt: map[string]int32{"a": 1}}, &All{MStrInt: map[string]int32{"b": 1}}},
+		{"presence zero", &All{PInt32: ptr[int32](0)}, &All{}},
+		{"presence empty bytes", &All{PBytes: []byte{}}, &All{}},
+		{"presence same", &All{PInt32: ptr[int32](1)}, &All{PInt32: ptr[int32](1)}},
+		{"nan", &All{FDouble: nan}, &All{FDouble: nan}},
+		{"nan list", &All{RDouble: []float64{nan}}, &All{RDouble: []float64{nan}}},
+		{"negative zero", &All{FDouble: math.Copysign(0, -1)}, &All{}},
+		{"empty message", &All{FMsg: &Inner{}}, &All{}},
+		{"nested message", &All{FMsg: &Inner{Child: &Inner{Id: 1}}}, &All{FMsg: &Inner{Child: &Inner{Id: 2}}}},
+		{"oneof member", &All{O: &All_OStr{}}, &All{O: &All_OInt{}}},
+		{"oneof zero", &All{O: &All_OInt{}}, &All{}},
+		{"oneof value", &All{O: &All_OMsg{OMsg: &Inner{}}}, &All{O: &All_OMsg{OMsg: &Inner{}}}},
+		{"wkt", &All{FSv: ptr("")}, &All{}},
+		{"unknown", unknown, &All{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := proto.Equal(toGolden(t, tt.a, &golden.All{}), toGolden(t, tt.b, &golden.All{}))
+			if got := tt.a.Equal(tt.b); got != want {
+				t.Errorf("a.Equal(b) = %v, want %v", got, want)
+			}
+			if got := tt.b.Equal(tt.a); got != want {
+				t.Errorf("b.Equal(a) = %v, want %v", got, want)
+			}
+		})
+	}
+
+	if (&All{}).Equal(nil) || !(*All)(nil).Equal(nil) {
+		t.Error("Equal with nil message mismatch")
+	}
+}
+
+func TestGoldenEqualProto2(t *testing.T) {
+	ext := &P2Ext{}
+	if err := E_EInt.Set(ext, 1); err != nil {
+		t.Fatal(err)
+	}
+	ext2 := ext.Clone()
+	if err := E_EInt.Set(ext2, 2); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		a, b message
+		typ  proto.Message
+	}{
+		{"extension same", ext, ext.Clone(), &golden.P2Ext{}},
+		{"extension value", ext, ext2, &golden.P2Ext{}},
+		{"extension unset", ext, &P2Ext{}, &golden.P2Ext{}},
+		{"default", &P2Default{I32: ptr(Default_P2Default_I32)}, &P2Default{}, &golden.P2Default{}},
+		{"group", &P2Group{G: &P2Group_G{}}, &P2Group{}, &golden.P2Group{}},
+		{"group value", &P2Group{Rg: []*P2Group_RG{{C: ptr[int32](1)}}}, &P2Group{Rg: []*P2Group_RG{{C: ptr[int32](1)}}}, &golden.P2Group{}},
+		{"oneof", &P2Oneof{O: &P2Oneof_Lv{}}, &P2Oneof{O: &P2Oneof_A{}}, &golden.P2Oneof{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := proto.Equal(toGolden(t, tt.a, tt.typ), toGolden(t, tt.b, tt.typ))
+			var got bool
+			switch a := tt.a.(type) {
+			case *P2Ext:
+				got = a.Equal(tt.b.(*P2Ext))
+			case *P2Default:
+				got = a.Equal(tt.b.(*P2Default))
+			case *P2Group:
+				got = a.Equal(tt.b.(*P2Group))
+			case *P2Oneof:
+				got = a.Equal(tt.b.(*P2Oneof))
+			}
+			if got != want {
+				t.Errorf("Equal = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package testpb
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/gopb"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/wiretest"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// 解析错误返回 *gopb.DecodeError, 嵌套消息的错误由外层消息加上字段路径, 偏移为在输入数据中的位置
+func TestDecodeError(t *testing.T) {
+	bytesField := func(num protowire.Number, v []byte) []byte {
+		return protowire.AppendBytes(protowire.AppendTag(nil, num, protowire.BytesType), v)
+	}
+	// name 的长度为5, 只有2个字节
+	name := append(protowire.AppendTag(nil, 2, protowire.BytesType), 5, 'a', 'b')
+	tests := []struct {
+		name   string
+		data   []byte
+		field  string
+		num    protowire.Number
+		offset int
+		kind   gopb.ErrorKind
+	}{
+		{"list", append(bytesField(22, nil), bytesField(22, name)...), "r_msg[1].name", 2, 7, gopb.KindTruncated},
+		{"map key", bytesField(30, append(protowire.AppendTag(nil, 1, protowire.BytesType), 3, 'a')), "m_str_int.key", 1, 4, gopb.KindTruncated},
+		{"map value", bytesField(32, append(bytesField(1, []byte("k")), bytesField(2, name)...)), "m_str_msg.value.name", 2, 9, gopb.KindTruncated},
+		{"tag", []byte{0}, "", 0, 0, gopb.KindMalformed},
+		{"tag after message", append(bytesField(9, bytesField(4, nil)), 0), "", 0, 4, gopb.KindMalformed},
+		{"nested tag", bytesField(9, bytesField(4, []byte{0})), "f_msg.child", 0, 4, gopb.KindMalformed},
+		{"varint", []byte{8, 0xff}, "f_int32", 1, 1, gopb.KindTruncated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&All{}).UnmarshalObject(tt.data)
+			var de *gopb.DecodeError
+			if !errors.As(err, &de) {
+				t.Fatalf("UnmarshalObject = %v, want *gopb.DecodeError", err)
+			}
+			if de.Message != "gopb.testpb.All" || de.Field != tt.field || de.Number != tt.num || de.Offset != tt.offset || de.Kind != tt.kind {
+				t.Errorf("UnmarshalObject = %+v, want field %q number %d offset %d kind %v", de, tt.field, tt.num, tt.offset, tt.kind)
+			}
+			if tt.kind == gopb.KindTruncated && !errors.Is(err, io.ErrUnexpectedEOF) {
+				t.Errorf("UnmarshalObject = %v, want io.ErrUnexpectedEOF", err)
+			}
+			if !strings.Contains(err.Error(), "gopb.testpb.All") {
+				t.Errorf("Error() = %q", err.Error())
+			}
+		})
+	}
+}
+
+// Error 输出完整的字段路径, Err 与 Kind 相同时只输出一次
+func TestDecodeErrorString(t *testing.T) {
+	tests := []struct {
+		data []byte
+		want string
+	}{
+		{wiretest.Bytes(9, wiretest.Bytes(2, []byte{0xff})), "parse gopb.testpb.All.f_msg.name ID:2 offset:3 : invalid utf8"},
+		{[]byte{8, 0xff}, "parse gopb.testpb.All.f_int32 ID:1 offset:1 : truncated: unexpected EOF"},
+	}
+	for _, tt := range tests {
+		if err := (&All{}).UnmarshalObject(tt.data); err == nil || err.Error() != tt.want {
+			t.Errorf("UnmarshalObject(%x) = %v, want %q", tt.data, err, tt.want)
+		}
+	}
+	err := gopb.NewDecodeError("a.B", "c", 1, 0, gopb.KindMalformed, nil)
+	if want := "parse a.B.c ID:1 offset:0 : malformed"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+// Any 的解析错误由外层消息加上字段路径
+func TestAnyDecodeErrorPath(t *testing.T) {
+	// type_url 的 wire type 错误
+	err := (&All{}).UnmarshalObject(wiretest.Bytes(50, wiretest.Varint(1, 1)))
+	var de *gopb.DecodeError
+	if !errors.As(err, &de) {
+		t.Fatalf("UnmarshalObject = %v, want *gopb.DecodeError", err)
+	}
+	if de.Message != "gopb.testpb.All" || de.Field != "f_any.type_url" || de.Kind != gopb.KindWireType || de.Offset != 4 {
+		t.Errorf("UnmarshalObject = %+v", de)
+	}
+}
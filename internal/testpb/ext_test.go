@@ -0,0 +1,189 @@
+package testpb
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/gopb"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/wiretest"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func goldenExt() *golden.P2Ext {
+	x := &golden.P2Ext{A: proto.Int32(1)}
+	proto.SetExtension(x, golden.E_EInt, int32(-1))
+	proto.SetExtension(x, golden.E_EStr, "s")
+	proto.SetExtension(x, golden.E_ERep, []int32{1, 2})
+	proto.SetExtension(x, golden.E_EMsg, &golden.P2Opt{S: proto.String("m")})
+	proto.SetExtension(x, golden.E_EPacked, []int32{3, -4})
+	proto.SetExtension(x, golden.E_ELv, golden.Level_HIGH)
+	proto.SetExtension(x, golden.E_ESint, int64(-5))
+	proto.SetExtension(x, golden.E_EFixed, uint32(6))
+	return x
+}
+
+func TestGoldenExtension(t *testing.T) {
+	x := &P2Ext{A: ptr[int32](1)}
+	for _, err := range []error{
+		E_EInt.Set(x, -1),
+		E_EStr.Set(x, "s"),
+		E_ERep.Set(x, []int32{1, 2}),
+		E_EMsg.Set(x, &P2Opt{S: ptr("m")}),
+		E_EPacked.Set(x, []int32{3, -4}),
+		E_ELv.Set(x, Level_HIGH),
+		E_ESint.Set(x, -5),
+		E_EFixed.Set(x, 6),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkGolden(t, x, &P2Ext{}, goldenExt())
+}
+
+func TestExtensionGet(t *testing.T) {
+	data, err := proto.Marshal(goldenExt())
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := &P2Ext{}
+	if err := x.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	check := func(name string, got, want interface{}, err error) {
+		t.Helper()
+		if err != nil {
+			t.Errorf("%s.Get: %v", name, err)
+		} else if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s.Get = %v, want %v", name, got, want)
+		}
+	}
+	i32, err := E_EInt.Get(x)
+	check("E_EInt", i32, int32(-1), err)
+	s, err := E_EStr.Get(x)
+	check("E_EStr", s, "s", err)
+	rep, err := E_ERep.Get(x)
+	check("E_ERep", rep, []int32{1, 2}, err)
+	msg, err := E_EMsg.Get(x)
+	check("E_EMsg", msg.GetS(), "m", err)
+	packed, err := E_EPacked.Get(x)
+	check("E_EPacked", packed, []int32{3, -4}, err)
+	lv, err := E_ELv.Get(x)
+	check("E_ELv", lv, Level_HIGH, err)
+	sint, err := E_ESint.Get(x)
+	check("E_ESint", sint, int64(-5), err)
+	fixed, err := E_EFixed.Get(x)
+	check("E_EFixed", fixed, uint32(6), err)
+
+	// 重复字段也接受 packed 编码
+	raw := protowire.AppendTag(nil, E_ERep.Number(), protowire.BytesType)
+	raw = protowire.AppendBytes(raw, []byte{1, 2})
+	y := &P2Ext{}
+	y.SetExtension(E_ERep.Number(), raw)
+	rep, err = E_ERep.Get(y)
+	check("E_ERep packed", rep, []int32{1, 2}, err)
+	rep, err = E_ERep.Get(&P2Ext{})
+	check("E_ERep unset", rep, []int32(nil), err)
+
+	if !E_EInt.Has(x) || !x.HasExtension(E_EInt.Number()) {
+		t.Error("E_EInt.Has = false, want true")
+	}
+	E_EInt.Clear(x)
+	if E_EInt.Has(x) {
+		t.Error("E_EInt.Has after Clear = true, want false")
+	}
+	if i32, err = E_EInt.Get(x); err != nil || i32 != 0 {
+		t.Errorf("E_EInt.Get after Clear = %v, %v, want 0", i32, err)
+	}
+	if E_EInt.Name() != string(golden.E_EInt.TypeDescriptor().FullName()) {
+		t.Errorf("E_EInt.Name = %q", E_EInt.Name())
+	}
+}
+
+// Get 校验 wire type, 不一致时返回 Kind 为 gopb.KindWireType 的错误
+func TestExtensionGetWireType(t *testing.T) {
+	x := &P2Ext{}
+	x.SetExtension(E_EInt.Number(), wiretest.Fixed32(E_EInt.Number(), 1))
+	x.SetExtension(E_EStr.Number(), wiretest.Varint(E_EStr.Number(), 1))
+	x.SetExtension(E_EMsg.Number(), wiretest.Fixed64(E_EMsg.Number(), 1))
+	x.SetExtension(E_ERep.Number(), wiretest.Fixed32(E_ERep.Number(), 1))
+	for _, get := range []func() error{
+		func() error { _, err := E_EInt.Get(x); return err },
+		func() error { _, err := E_EStr.Get(x); return err },
+		func() error { _, err := E_EMsg.Get(x); return err },
+		func() error { _, err := E_ERep.Get(x); return err },
+	} {
+		err := get()
+		var de *gopb.DecodeError
+		if !errors.As(err, &de) || !errors.Is(err, gopb.ErrWireType) || de.Kind != gopb.KindWireType {
+			t.Errorf("Get = %v, want *gopb.DecodeError of KindWireType", err)
+		}
+	}
+	// protobuf-go 同样拒绝
+	data, err := x.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	y := &golden.P2Ext{}
+	if err := proto.Unmarshal(data, y); err != nil {
+		t.Fatal(err)
+	}
+	if proto.HasExtension(y, golden.E_EInt) {
+		t.Error("proto.HasExtension(E_EInt) = true, want false")
+	}
+}
+
+// Equal 按字段编号比较扩展字段, 与设置的顺序无关
+func TestExtensionEqualOrder(t *testing.T) {
+	x, y := &P2Ext{}, &P2Ext{}
+	if err := E_EInt.Set(x, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := E_EStr.Set(x, "s"); err != nil {
+		t.Fatal(err)
+	}
+	if err := E_EStr.Set(y, "s"); err != nil {
+		t.Fatal(err)
+	}
+	if err := E_EInt.Set(y, 1); err != nil {
+		t.Fatal(err)
+	}
+	gx, gy := &golden.P2Ext{}, &golden.P2Ext{}
+	for _, v := range []struct {
+		x *P2Ext
+		g *golden.P2Ext
+	}{{x, gx}, {y, gy}} {
+		data, err := v.x.MarshalObject()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := proto.Unmarshal(data, v.g); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !x.Equal(y) || !proto.Equal(gx, gy) {
+		t.Errorf("Equal = %v, proto.Equal = %v, want true", x.Equal(y), proto.Equal(gx, gy))
+	}
+
+	// 同一个字段的值不同时不相等
+	if err := E_EInt.Set(y, 2); err != nil {
+		t.Fatal(err)
+	}
+	if x.Equal(y) {
+		t.Error("Equal = true, want false")
+	}
+	// 重复字段按元素的顺序比较
+	x, y = &P2Ext{}, &P2Ext{}
+	if err := E_ERep.Set(x, []int32{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := E_ERep.Set(y, []int32{2, 1}); err != nil {
+		t.Fatal(err)
+	}
+	if x.Equal(y) {
+		t.Error("Equal of reordered repeated extension = true, want false")
+	}
+}
@@ -0,0 +1,13 @@
+// Package testpb 是测试使用的生成代码.
+//
+// testpb.proto, proto2.proto 与 editions.proto 由 protoc-gen-gopb 生成到本包,
+// 同样的文件由 protoc-gen-go 生成到 golden 包, 测试中用来对照 protobuf-go 的编解码结果.
+// testpb.proto 还使用不同的参数生成到子包: alias 开启 alias, sizecache 开启 sizecache, strict 开启 strict.
+// 需要 protoc-gen-gopb 与 protoc-gen-go 在 PATH 中.
+package testpb
+
+//go:generate protoc --gopb_out=../.. --gopb_opt=module=github.com/aggronmagi/protoc-gen-gopb,zap=false,unknown=true,wkt=true,registry=true,json=true,text=true,reflect=true,pool=true,deterministic=true,utf8_marshal=true,limit=true testpb.proto proto2.proto editions.proto
+//go:generate protoc --go_out=../.. --go_opt=module=github.com/aggronmagi/protoc-gen-gopb,Mtestpb.proto=github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden;golden,Mproto2.proto=github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden;golden,Meditions.proto=github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden;golden testpb.proto proto2.proto editions.proto
+//go:generate protoc --gopb_out=../.. --gopb_opt=module=github.com/aggronmagi/protoc-gen-gopb,zap=false,wkt=true,alias=true,Mtestpb.proto=github.com/aggronmagi/protoc-gen-gopb/internal/testpb/alias;alias testpb.proto
+//go:generate protoc --gopb_out=../.. --gopb_opt=module=github.com/aggronmagi/protoc-gen-gopb,zap=false,wkt=true,sizecache=true,Mtestpb.proto=github.com/aggronmagi/protoc-gen-gopb/internal/testpb/sizecache;sizecache testpb.proto
+//go:generate protoc --gopb_out=../.. --gopb_opt=module=github.com/aggronmagi/protoc-gen-gopb,zap=false,unknown=true,wkt=true,strict=true,Mtestpb.proto=github.com/aggronmagi/protoc-gen-gopb/internal/testpb/strict;strict,Mproto2.proto=github.com/aggronmagi/protoc-gen-gopb/internal/testpb/strict;strict,Meditions.proto=github.com/aggronmagi/protoc-gen-gopb/internal/testpb/strict;strict testpb.proto proto2.proto editions.proto
@@ -0,0 +1,481 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: editions.proto
+
+package golden
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EdOpen int32
+
+const (
+	EdOpen_ED_OPEN_ZERO EdOpen = 0
+	EdOpen_ED_OPEN_A    EdOpen = 1
+)
+
+// Enum value maps for EdOpen.
+var (
+	EdOpen_name = map[int32]string{
+		0: "ED_OPEN_ZERO",
+		1: "ED_OPEN_A",
+	}
+	EdOpen_value = map[string]int32{
+		"ED_OPEN_ZERO": 0,
+		"ED_OPEN_A":    1,
+	}
+)
+
+func (x EdOpen) Enum() *EdOpen {
+	p := new(EdOpen)
+	*p = x
+	return p
+}
+
+func (x EdOpen) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EdOpen) Descriptor() protoreflect.EnumDescriptor {
+	return file_editions_proto_enumTypes[0].Descriptor()
+}
+
+func (EdOpen) Type() protoreflect.EnumType {
+	return &file_editions_proto_enumTypes[0]
+}
+
+func (x EdOpen) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EdOpen.Descriptor instead.
+func (EdOpen) EnumDescriptor() ([]byte, []int) {
+	return file_editions_proto_rawDescGZIP(), []int{0}
+}
+
+type EdClosed int32
+
+const (
+	EdClosed_ED_CLOSED_ZERO EdClosed = 0
+	EdClosed_ED_CLOSED_B    EdClosed = 1
+	EdClosed_ED_CLOSED_C    EdClosed = 2
+)
+
+// Enum value maps for EdClosed.
+var (
+	EdClosed_name = map[int32]string{
+		0: "ED_CLOSED_ZERO",
+		1: "ED_CLOSED_B",
+		2: "ED_CLOSED_C",
+	}
+	EdClosed_value = map[string]int32{
+		"ED_CLOSED_ZERO": 0,
+		"ED_CLOSED_B":    1,
+		"ED_CLOSED_C":    2,
+	}
+)
+
+func (x EdClosed) Enum() *EdClosed {
+	p := new(EdClosed)
+	*p = x
+	return p
+}
+
+func (x EdClosed) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EdClosed) Descriptor() protoreflect.EnumDescriptor {
+	return file_editions_proto_enumTypes[1].Descriptor()
+}
+
+func (EdClosed) Type() protoreflect.EnumType {
+	return &file_editions_proto_enumTypes[1]
+}
+
+func (x EdClosed) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EdClosed.Descriptor instead.
+func (EdClosed) EnumDescriptor() ([]byte, []int) {
+	return file_editions_proto_rawDescGZIP(), []int{1}
+}
+
+type Edition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 默认 EXPLICIT, 标量使用指针
+	Explicit *int32 `protobuf:"varint,1,opt,name=explicit" json:"explicit,omitempty"`
+	Implicit int32  `protobuf:"varint,2,opt,name=implicit" json:"implicit,omitempty"`
+	Req      *int32 `protobuf:"varint,3,req,name=req" json:"req,omitempty"`
+	// 默认 PACKED
+	Packed        []int32            `protobuf:"varint,4,rep,packed,name=packed" json:"packed,omitempty"`
+	Expanded      []int32            `protobuf:"varint,5,rep,name=expanded" json:"expanded,omitempty"`
+	Closed        *EdClosed          `protobuf:"varint,6,opt,name=closed,enum=gopb.testpb.EdClosed" json:"closed,omitempty"`
+	ClosedList    []EdClosed         `protobuf:"varint,7,rep,packed,name=closed_list,json=closedList,enum=gopb.testpb.EdClosed" json:"closed_list,omitempty"`
+	Checked       *string            `protobuf:"bytes,8,opt,name=checked" json:"checked,omitempty"`
+	Unchecked     *string            `protobuf:"bytes,9,opt,name=unchecked" json:"unchecked,omitempty"`
+	Delimited     *Edition_Child     `protobuf:"group,10,opt,name=Child,json=delimited" json:"delimited,omitempty"`
+	DelimitedList []*Edition_Child   `protobuf:"group,11,rep,name=Child,json=delimitedList" json:"delimited_list,omitempty"`
+	Open          *EdOpen            `protobuf:"varint,12,opt,name=open,enum=gopb.testpb.EdOpen" json:"open,omitempty"`
+	ClosedMap     map[int32]EdClosed `protobuf:"bytes,13,rep,name=closed_map,json=closedMap" json:"closed_map,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=gopb.testpb.EdClosed"`
+	ImplicitStr   string             `protobuf:"bytes,14,opt,name=implicit_str,json=implicitStr" json:"implicit_str,omitempty"`
+}
+
+func (x *Edition) Reset() {
+	*x = Edition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editions_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Edition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Edition) ProtoMessage() {}
+
+func (x *Edition) ProtoReflect() protoreflect.Message {
+	mi := &file_editions_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Edition.ProtoReflect.Descriptor instead.
+func (*Edition) Descriptor() ([]byte, []int) {
+	return file_editions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Edition) GetExplicit() int32 {
+	if x != nil && x.Explicit != nil {
+		return *x.Explicit
+	}
+	return 0
+}
+
+func (x *Edition) GetImplicit() int32 {
+	if x != nil {
+		return x.Implicit
+	}
+	return 0
+}
+
+func (x *Edition) GetReq() int32 {
+	if x != nil && x.Req != nil {
+		return *x.Req
+	}
+	return 0
+}
+
+func (x *Edition) GetPacked() []int32 {
+	if x != nil {
+		return x.Packed
+	}
+	return nil
+}
+
+func (x *Edition) GetExpanded() []int32 {
+	if x != nil {
+		return x.Expanded
+	}
+	return nil
+}
+
+func (x *Edition) GetClosed() EdClosed {
+	if x != nil && x.Closed != nil {
+		return *x.Closed
+	}
+	return EdClosed_ED_CLOSED_ZERO
+}
+
+func (x *Edition) GetClosedList() []EdClosed {
+	if x != nil {
+		return x.ClosedList
+	}
+	return nil
+}
+
+func (x *Edition) GetChecked() string {
+	if x != nil && x.Checked != nil {
+		return *x.Checked
+	}
+	return ""
+}
+
+func (x *Edition) GetUnchecked() string {
+	if x != nil && x.Unchecked != nil {
+		return *x.Unchecked
+	}
+	return ""
+}
+
+func (x *Edition) GetDelimited() *Edition_Child {
+	if x != nil {
+		return x.Delimited
+	}
+	return nil
+}
+
+func (x *Edition) GetDelimitedList() []*Edition_Child {
+	if x != nil {
+		return x.DelimitedList
+	}
+	return nil
+}
+
+func (x *Edition) GetOpen() EdOpen {
+	if x != nil && x.Open != nil {
+		return *x.Open
+	}
+	return EdOpen_ED_OPEN_ZERO
+}
+
+func (x *Edition) GetClosedMap() map[int32]EdClosed {
+	if x != nil {
+		return x.ClosedMap
+	}
+	return nil
+}
+
+func (x *Edition) GetImplicitStr() string {
+	if x != nil {
+		return x.ImplicitStr
+	}
+	return ""
+}
+
+type Edition_Child struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A     *int32         `protobuf:"varint,1,opt,name=a" json:"a,omitempty"`
+	Child *Edition_Child `protobuf:"group,2,opt,name=Child,json=child" json:"child,omitempty"`
+}
+
+func (x *Edition_Child) Reset() {
+	*x = Edition_Child{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editions_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Edition_Child) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Edition_Child) ProtoMessage() {}
+
+func (x *Edition_Child) ProtoReflect() protoreflect.Message {
+	mi := &file_editions_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Edition_Child.ProtoReflect.Descriptor instead.
+func (*Edition_Child) Descriptor() ([]byte, []int) {
+	return file_editions_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Edition_Child) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+func (x *Edition_Child) GetChild() *Edition_Child {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+var File_editions_proto protoreflect.FileDescriptor
+
+var file_editions_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0b, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x22, 0x89, 0x06,
+	0x0a, 0x07, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x08,
+	0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x03, 0x52, 0x03, 0x72, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x08, 0x65, 0x78, 0x70,
+	0x61, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x42, 0x05, 0xaa, 0x01, 0x02,
+	0x18, 0x02, 0x52, 0x08, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67,
+	0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0b, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x45,
+	0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x23, 0x0a,
+	0x09, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x05, 0xaa, 0x01, 0x02, 0x20, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x28, 0x02, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x28, 0x02, 0x52, 0x0d,
+	0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x6f,
+	0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x4f, 0x70, 0x65, 0x6e,
+	0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x5f, 0x6d, 0x61, 0x70, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x70,
+	0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x12, 0x28, 0x0a, 0x0c, 0x69, 0x6d,
+	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69,
+	0x74, 0x53, 0x74, 0x72, 0x1a, 0x53, 0x0a, 0x0e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4e, 0x0a, 0x05, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x61,
+	0x12, 0x37, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x42, 0x05, 0xaa, 0x01, 0x02,
+	0x28, 0x02, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2a, 0x29, 0x0a, 0x06, 0x45, 0x64, 0x4f,
+	0x70, 0x65, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x5a,
+	0x45, 0x52, 0x4f, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x4e,
+	0x5f, 0x41, 0x10, 0x01, 0x2a, 0x46, 0x0a, 0x08, 0x45, 0x64, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x0e, 0x45, 0x44, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x5a, 0x45,
+	0x52, 0x4f, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x44, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x44, 0x5f, 0x42, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x44, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
+	0x45, 0x44, 0x5f, 0x43, 0x10, 0x02, 0x1a, 0x04, 0x3a, 0x02, 0x10, 0x02, 0x42, 0x3e, 0x5a, 0x3c,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x67, 0x67, 0x72, 0x6f,
+	0x6e, 0x6d, 0x61, 0x67, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e,
+	0x2d, 0x67, 0x6f, 0x70, 0x62, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x62, 0x3b, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x62, 0x08, 0x65, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x70, 0xe8, 0x07,
+}
+
+var (
+	file_editions_proto_rawDescOnce sync.Once
+	file_editions_proto_rawDescData = file_editions_proto_rawDesc
+)
+
+func file_editions_proto_rawDescGZIP() []byte {
+	file_editions_proto_rawDescOnce.Do(func() {
+		file_editions_proto_rawDescData = protoimpl.X.CompressGZIP(file_editions_proto_rawDescData)
+	})
+	return file_editions_proto_rawDescData
+}
+
+var file_editions_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_editions_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_editions_proto_goTypes = []any{
+	(EdOpen)(0),           // 0: gopb.testpb.EdOpen
+	(EdClosed)(0),         // 1: gopb.testpb.EdClosed
+	(*Edition)(nil),       // 2: gopb.testpb.Edition
+	nil,                   // 3: gopb.testpb.Edition.ClosedMapEntry
+	(*Edition_Child)(nil), // 4: gopb.testpb.Edition.Child
+}
+var file_editions_proto_depIdxs = []int32{
+	1, // 0: gopb.testpb.Edition.closed:type_name -> gopb.testpb.EdClosed
+	1, // 1: gopb.testpb.Edition.closed_list:type_name -> gopb.testpb.EdClosed
+	4, // 2: gopb.testpb.Edition.delimited:type_name -> gopb.testpb.Edition.Child
+	4, // 3: gopb.testpb.Edition.delimited_list:type_name -> gopb.testpb.Edition.Child
+	0, // 4: gopb.testpb.Edition.open:type_name -> gopb.testpb.EdOpen
+	3, // 5: gopb.testpb.Edition.closed_map:type_name -> gopb.testpb.Edition.ClosedMapEntry
+	1, // 6: gopb.testpb.Edition.ClosedMapEntry.value:type_name -> gopb.testpb.EdClosed
+	4, // 7: gopb.testpb.Edition.Child.child:type_name -> gopb.testpb.Edition.Child
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_editions_proto_init() }
+func file_editions_proto_init() {
+	if File_editions_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_editions_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Edition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editions_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Edition_Child); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_editions_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_editions_proto_goTypes,
+		DependencyIndexes: file_editions_proto_depIdxs,
+		EnumInfos:         file_editions_proto_enumTypes,
+		MessageInfos:      file_editions_proto_msgTypes,
+	}.Build()
+	File_editions_proto = out.File
+	file_editions_proto_rawDesc = nil
+	file_editions_proto_goTypes = nil
+	file_editions_proto_depIdxs = nil
+}
@@ -0,0 +1,1301 @@
+// proto2 语法的测试消息. 修改后需要重新生成, 见 gen.go
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: proto2.proto
+
+package golden
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	math "math"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Level int32
+
+const (
+	Level_LEVEL_ZERO Level = 0
+	Level_LOW        Level = 1
+	Level_HIGH       Level = 2
+)
+
+// Enum value maps for Level.
+var (
+	Level_name = map[int32]string{
+		0: "LEVEL_ZERO",
+		1: "LOW",
+		2: "HIGH",
+	}
+	Level_value = map[string]int32{
+		"LEVEL_ZERO": 0,
+		"LOW":        1,
+		"HIGH":       2,
+	}
+)
+
+func (x Level) Enum() *Level {
+	p := new(Level)
+	*p = x
+	return p
+}
+
+func (x Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto2_proto_enumTypes[0].Descriptor()
+}
+
+func (Level) Type() protoreflect.EnumType {
+	return &file_proto2_proto_enumTypes[0]
+}
+
+func (x Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Level) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Level(num)
+	return nil
+}
+
+// Deprecated: Use Level.Descriptor instead.
+func (Level) EnumDescriptor() ([]byte, []int) {
+	return file_proto2_proto_rawDescGZIP(), []int{0}
+}
+
+type P2Oneof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to O:
+	//	*P2Oneof_A
+	//	*P2Oneof_B
+	//	*P2Oneof_Msg
+	//	*P2Oneof_Lv
+	O isP2Oneof_O `protobuf_oneof:"o"`
+	R []int32     `protobuf:"varint,5,rep,name=r" json:"r,omitempty"`
+}
+
+func (x *P2Oneof) Reset() {
+	*x = P2Oneof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto2_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *P2Oneof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*P2Oneof) ProtoMessage() {}
+
+func (x *P2Oneof) ProtoReflect() protoreflect.Message {
+	mi := &file_proto2_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use P2Oneof.ProtoReflect.Descriptor instead.
+func (*P2Oneof) Descriptor() ([]byte, []int) {
+	return file_proto2_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *P2Oneof) GetO() isP2Oneof_O {
+	if m != nil {
+		return m.O
+	}
+	return nil
+}
+
+func (x *P2Oneof) GetA() int32 {
+	if x, ok := x.GetO().(*P2Oneof_A); ok {
+		return x.A
+	}
+	return 0
+}
+
+func (x *P2Oneof) GetB() string {
+	if x, ok := x.GetO().(*P2Oneof_B); ok {
+		return x.B
+	}
+	return ""
+}
+
+func (x *P2Oneof) GetMsg() *P2Oneof {
+	if x, ok := x.GetO().(*P2Oneof_Msg); ok {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *P2Oneof) GetLv() Level {
+	if x, ok := x.GetO().(*P2Oneof_Lv); ok {
+		return x.Lv
+	}
+	return Level_LEVEL_ZERO
+}
+
+func (x *P2Oneof) GetR() []int32 {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+type isP2Oneof_O interface {
+	isP2Oneof_O()
+}
+
+type P2Oneof_A struct {
+	A int32 `protobuf:"varint,1,opt,name=a,oneof"`
+}
+
+type P2Oneof_B struct {
+	B string `protobuf:"bytes,2,opt,name=b,oneof"`
+}
+
+type P2Oneof_Msg struct {
+	Msg *P2Oneof `protobuf:"bytes,3,opt,name=msg,oneof"`
+}
+
+type P2Oneof_Lv struct {
+	Lv Level `protobuf:"varint,4,opt,name=lv,enum=gopb.testpb.Level,oneof"`
+}
+
+func (*P2Oneof_A) isP2Oneof_O() {}
+
+func (*P2Oneof_B) isP2Oneof_O() {}
+
+func (*P2Oneof_Msg) isP2Oneof_O() {}
+
+func (*P2Oneof_Lv) isP2Oneof_O() {}
+
+type P2Opt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	I32 *int32           `protobuf:"varint,1,opt,name=i32" json:"i32,omitempty"`
+	I64 *int64           `protobuf:"varint,2,opt,name=i64" json:"i64,omitempty"`
+	S   *string          `protobuf:"bytes,3,opt,name=s" json:"s,omitempty"`
+	B   []byte           `protobuf:"bytes,4,opt,name=b" json:"b,omitempty"`
+	Bl  *bool            `protobuf:"varint,5,opt,name=bl" json:"bl,omitempty"`
+	D   *float64         `protobuf:"fixed64,6,opt,name=d" json:"d,omitempty"`
+	F   *float32         `protobuf:"fixed32,7,opt,name=f" json:"f,omitempty"`
+	Lv  *Level           `protobuf:"varint,8,opt,name=lv,enum=gopb.testpb.Level" json:"lv,omitempty"`
+	Msg *P2Opt           `protobuf:"bytes,9,opt,name=msg" json:"msg,omitempty"`
+	R   []int32          `protobuf:"varint,10,rep,name=r" json:"r,omitempty"`
+	Rp  []int32          `protobuf:"varint,11,rep,packed,name=rp" json:"rp,omitempty"`
+	M   map[string]Level `protobuf:"bytes,12,rep,name=m" json:"m,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=gopb.testpb.Level"`
+	S32 *int32           `protobuf:"zigzag32,13,opt,name=s32" json:"s32,omitempty"`
+	F64 *uint64          `protobuf:"fixed64,14,opt,name=f64" json:"f64,omitempty"`
+}
+
+func (x *P2Opt) Reset() {
+	*x = P2Opt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto2_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *P2Opt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*P2Opt) ProtoMessage() {}
+
+func (x *P2Opt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto2_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use P2Opt.ProtoReflect.Descriptor instead.
+func (*P2Opt) Descriptor() ([]byte, []int) {
+	return file_proto2_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *P2Opt) GetI32() int32 {
+	if x != nil && x.I32 != nil {
+		return *x.I32
+	}
+	return 0
+}
+
+func (x *P2Opt) GetI64() int64 {
+	if x != nil && x.I64 != nil {
+		return *x.I64
+	}
+	return 0
+}
+
+func (x *P2Opt) GetS() string {
+	if x != nil && x.S != nil {
+		return *x.S
+	}
+	return ""
+}
+
+func (x *P2Opt) GetB() []byte {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+func (x *P2Opt) GetBl() bool {
+	if x != nil && x.Bl != nil {
+		return *x.Bl
+	}
+	return false
+}
+
+func (x *P2Opt) GetD() float64 {
+	if x != nil && x.D != nil {
+		return *x.D
+	}
+	return 0
+}
+
+func (x *P2Opt) GetF() float32 {
+	if x != nil && x.F != nil {
+		return *x.F
+	}
+	return 0
+}
+
+func (x *P2Opt) GetLv() Level {
+	if x != nil && x.Lv != nil {
+		return *x.Lv
+	}
+	return Level_LEVEL_ZERO
+}
+
+func (x *P2Opt) GetMsg() *P2Opt {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *P2Opt) GetR() []int32 {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *P2Opt) GetRp() []int32 {
+	if x != nil {
+		return x.Rp
+	}
+	return nil
+}
+
+func (x *P2Opt) GetM() map[string]Level {
+	if x != nil {
+		return x.M
+	}
+	return nil
+}
+
+func (x *P2Opt) GetS32() int32 {
+	if x != nil && x.S32 != nil {
+		return *x.S32
+	}
+	return 0
+}
+
+func (x *P2Opt) GetF64() uint64 {
+	if x != nil && x.F64 != nil {
+		return *x.F64
+	}
+	return 0
+}
+
+type P2Default struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	I32   *int32   `protobuf:"varint,1,opt,name=i32,def=-7" json:"i32,omitempty"`
+	I64   *int64   `protobuf:"varint,2,opt,name=i64,def=1099511627776" json:"i64,omitempty"`
+	U32   *uint32  `protobuf:"varint,3,opt,name=u32,def=42" json:"u32,omitempty"`
+	S     *string  `protobuf:"bytes,4,opt,name=s,def=hi \"there\"\n" json:"s,omitempty"`
+	B     []byte   `protobuf:"bytes,5,opt,name=b,def=a\\000b" json:"b,omitempty"`
+	Bl    *bool    `protobuf:"varint,6,opt,name=bl,def=1" json:"bl,omitempty"`
+	D     *float64 `protobuf:"fixed64,7,opt,name=d,def=inf" json:"d,omitempty"`
+	F     *float32 `protobuf:"fixed32,8,opt,name=f,def=nan" json:"f,omitempty"`
+	Dn    *float64 `protobuf:"fixed64,9,opt,name=dn,def=-inf" json:"dn,omitempty"`
+	Lv    *Level   `protobuf:"varint,10,opt,name=lv,enum=gopb.testpb.Level,def=2" json:"lv,omitempty"`
+	F2    *float32 `protobuf:"fixed32,11,opt,name=f2,def=1.5" json:"f2,omitempty"`
+	Sf64  *int64   `protobuf:"fixed64,12,opt,name=sf64,def=-9223372036854775808" json:"sf64,omitempty"`
+	U64   *uint64  `protobuf:"varint,13,opt,name=u64,def=18446744073709551615" json:"u64,omitempty"`
+	Empty *string  `protobuf:"bytes,14,opt,name=empty,def=" json:"empty,omitempty"`
+}
+
+// Default values for P2Default fields.
+const (
+	Default_P2Default_I32   = int32(-7)
+	Default_P2Default_I64   = int64(1099511627776)
+	Default_P2Default_U32   = uint32(42)
+	Default_P2Default_S     = string("hi \"there\"\n")
+	Default_P2Default_Bl    = bool(true)
+	Default_P2Default_Lv    = Level_HIGH
+	Default_P2Default_F2    = float32(1.5)
+	Default_P2Default_Sf64  = int64(-9223372036854775808)
+	Default_P2Default_U64   = uint64(18446744073709551615)
+	Default_P2Default_Empty = string("")
+)
+
+// Default values for P2Default fields.
+var (
+	Default_P2Default_B  = []byte("a\x00b")
+	Default_P2Default_D  = float64(math.Inf(+1))
+	Default_P2Default_F  = float32(math.NaN())
+	Default_P2Default_Dn = float64(math.Inf(-1))
+)
+
+func (x *P2Default) Reset() {
+	*x = P2Default{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto2_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *P2Default) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*P2Default) ProtoMessage() {}
+
+func (x *P2Default) ProtoReflect() protoreflect.Message {
+	mi := &file_proto2_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use P2Default.ProtoReflect.Descriptor instead.
+func (*P2Default) Descriptor() ([]byte, []int) {
+	return file_proto2_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *P2Default) GetI32() int32 {
+	if x != nil && x.I32 != nil {
+		return *x.I32
+	}
+	return Default_P2Default_I32
+}
+
+func (x *P2Default) GetI64() int64 {
+	if x != nil && x.I64 != nil {
+		return *x.I64
+	}
+	return Default_P2Default_I64
+}
+
+func (x *P2Default) GetU32() uint32 {
+	if x != nil && x.U32 != nil {
+		return *x.U32
+	}
+	return Default_P2Default_U32
+}
+
+func (x *P2Default) GetS() string {
+	if x != nil && x.S != nil {
+		return *x.S
+	}
+	return Default_P2Default_S
+}
+
+func (x *P2Default) GetB() []byte {
+	if x != nil && x.B != nil {
+		return x.B
+	}
+	return append([]byte(nil), Default_P2Default_B...)
+}
+
+func (x *P2Default) GetBl() bool {
+	if x != nil && x.Bl != nil {
+		return *x.Bl
+	}
+	return Default_P2Default_Bl
+}
+
+func (x *P2Default) GetD() float64 {
+	if x != nil && x.D != nil {
+		return *x.D
+	}
+	return Default_P2Default_D
+}
+
+func (x *P2Default) GetF() float32 {
+	if x != nil && x.F != nil {
+		return *x.F
+	}
+	return Default_P2Default_F
+}
+
+func (x *P2Default) GetDn() float64 {
+	if x != nil && x.Dn != nil {
+		return *x.Dn
+	}
+	return Default_P2Default_Dn
+}
+
+func (x *P2Default) GetLv() Level {
+	if x != nil && x.Lv != nil {
+		return *x.Lv
+	}
+	return Default_P2Default_Lv
+}
+
+func (x *P2Default) GetF2() float32 {
+	if x != nil && x.F2 != nil {
+		return *x.F2
+	}
+	return Default_P2Default_F2
+}
+
+func (x *P2Default) GetSf64() int64 {
+	if x != nil && x.Sf64 != nil {
+		return *x.Sf64
+	}
+	return Default_P2Default_Sf64
+}
+
+func (x *P2Default) GetU64() uint64 {
+	if x != nil && x.U64 != nil {
+		return *x.U64
+	}
+	return Default_P2Default_U64
+}
+
+func (x *P2Default) GetEmpty() string {
+	if x != nil && x.Empty != nil {
+		return *x.Empty
+	}
+	return Default_P2Default_Empty
+}
+
+type P2Ext struct {
+	state           protoimpl.MessageState
+	sizeCache       protoimpl.SizeCache
+	unknownFields   protoimpl.UnknownFields
+	extensionFields protoimpl.ExtensionFields
+
+	A *int32 `protobuf:"varint,1,opt,name=a" json:"a,omitempty"`
+}
+
+func (x *P2Ext) Reset() {
+	*x = P2Ext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto2_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *P2Ext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*P2Ext) ProtoMessage() {}
+
+func (x *P2Ext) ProtoReflect() protoreflect.Message {
+	mi := &file_proto2_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use P2Ext.ProtoReflect.Descriptor instead.
+func (*P2Ext) Descriptor() ([]byte, []int) {
+	return file_proto2_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *P2Ext) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+type P2Group struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	G     *P2Group_G    `protobuf:"group,1,opt,name=G,json=g" json:"g,omitempty"`
+	Rg    []*P2Group_RG `protobuf:"group,4,rep,name=RG,json=rg" json:"rg,omitempty"`
+	After *int32        `protobuf:"varint,7,opt,name=after" json:"after,omitempty"`
+}
+
+func (x *P2Group) Reset() {
+	*x = P2Group{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto2_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *P2Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*P2Group) ProtoMessage() {}
+
+func (x *P2Group) ProtoReflect() protoreflect.Message {
+	mi := &file_proto2_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use P2Group.ProtoReflect.Descriptor instead.
+func (*P2Group) Descriptor() ([]byte, []int) {
+	return file_proto2_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *P2Group) GetG() *P2Group_G {
+	if x != nil {
+		return x.G
+	}
+	return nil
+}
+
+func (x *P2Group) GetRg() []*P2Group_RG {
+	if x != nil {
+		return x.Rg
+	}
+	return nil
+}
+
+func (x *P2Group) GetAfter() int32 {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return 0
+}
+
+type P2Req struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    *int32            `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	Name  *string           `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Child *P2Req            `protobuf:"bytes,3,opt,name=child" json:"child,omitempty"`
+	Items []*P2Req          `protobuf:"bytes,4,rep,name=items" json:"items,omitempty"`
+	M     map[string]*P2Req `protobuf:"bytes,5,rep,name=m" json:"m,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (x *P2Req) Reset() {
+	*x = P2Req{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto2_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *P2Req) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*P2Req) ProtoMessage() {}
+
+func (x *P2Req) ProtoReflect() protoreflect.Message {
+	mi := &file_proto2_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use P2Req.ProtoReflect.Descriptor instead.
+func (*P2Req) Descriptor() ([]byte, []int) {
+	return file_proto2_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *P2Req) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *P2Req) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *P2Req) GetChild() *P2Req {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *P2Req) GetItems() []*P2Req {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *P2Req) GetM() map[string]*P2Req {
+	if x != nil {
+		return x.M
+	}
+	return nil
+}
+
+// 不直接包含 required 字段, 通过子消息间接包含.
+type P2ReqHolder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Req *P2Req `protobuf:"bytes,1,opt,name=req" json:"req,omitempty"`
+	N   *int32 `protobuf:"varint,2,opt,name=n" json:"n,omitempty"`
+}
+
+func (x *P2ReqHolder) Reset() {
+	*x = P2ReqHolder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto2_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *P2ReqHolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*P2ReqHolder) ProtoMessage() {}
+
+func (x *P2ReqHolder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto2_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use P2ReqHolder.ProtoReflect.Descriptor instead.
+func (*P2ReqHolder) Descriptor() ([]byte, []int) {
+	return file_proto2_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *P2ReqHolder) GetReq() *P2Req {
+	if x != nil {
+		return x.Req
+	}
+	return nil
+}
+
+func (x *P2ReqHolder) GetN() int32 {
+	if x != nil && x.N != nil {
+		return *x.N
+	}
+	return 0
+}
+
+type P2Group_G struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A *int32  `protobuf:"varint,2,opt,name=a" json:"a,omitempty"`
+	B *string `protobuf:"bytes,3,opt,name=b" json:"b,omitempty"`
+}
+
+func (x *P2Group_G) Reset() {
+	*x = P2Group_G{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto2_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *P2Group_G) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*P2Group_G) ProtoMessage() {}
+
+func (x *P2Group_G) ProtoReflect() protoreflect.Message {
+	mi := &file_proto2_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use P2Group_G.ProtoReflect.Descriptor instead.
+func (*P2Group_G) Descriptor() ([]byte, []int) {
+	return file_proto2_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *P2Group_G) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+func (x *P2Group_G) GetB() string {
+	if x != nil && x.B != nil {
+		return *x.B
+	}
+	return ""
+}
+
+type P2Group_RG struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	C      *int32   `protobuf:"varint,5,opt,name=c" json:"c,omitempty"`
+	Nested *P2Group `protobuf:"bytes,6,opt,name=nested" json:"nested,omitempty"`
+}
+
+func (x *P2Group_RG) Reset() {
+	*x = P2Group_RG{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto2_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *P2Group_RG) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*P2Group_RG) ProtoMessage() {}
+
+func (x *P2Group_RG) ProtoReflect() protoreflect.Message {
+	mi := &file_proto2_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use P2Group_RG.ProtoReflect.Descriptor instead.
+func (*P2Group_RG) Descriptor() ([]byte, []int) {
+	return file_proto2_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *P2Group_RG) GetC() int32 {
+	if x != nil && x.C != nil {
+		return *x.C
+	}
+	return 0
+}
+
+func (x *P2Group_RG) GetNested() *P2Group {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+var file_proto2_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*P2Ext)(nil),
+		ExtensionType: (*int32)(nil),
+		Field:         100,
+		Name:          "gopb.testpb.e_int",
+		Tag:           "varint,100,opt,name=e_int",
+		Filename:      "proto2.proto",
+	},
+	{
+		ExtendedType:  (*P2Ext)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         101,
+		Name:          "gopb.testpb.e_str",
+		Tag:           "bytes,101,opt,name=e_str",
+		Filename:      "proto2.proto",
+	},
+	{
+		ExtendedType:  (*P2Ext)(nil),
+		ExtensionType: ([]int32)(nil),
+		Field:         102,
+		Name:          "gopb.testpb.e_rep",
+		Tag:           "varint,102,rep,name=e_rep",
+		Filename:      "proto2.proto",
+	},
+	{
+		ExtendedType:  (*P2Ext)(nil),
+		ExtensionType: (*P2Opt)(nil),
+		Field:         103,
+		Name:          "gopb.testpb.e_msg",
+		Tag:           "bytes,103,opt,name=e_msg",
+		Filename:      "proto2.proto",
+	},
+	{
+		ExtendedType:  (*P2Ext)(nil),
+		ExtensionType: ([]int32)(nil),
+		Field:         104,
+		Name:          "gopb.testpb.e_packed",
+		Tag:           "varint,104,rep,packed,name=e_packed",
+		Filename:      "proto2.proto",
+	},
+	{
+		ExtendedType:  (*P2Ext)(nil),
+		ExtensionType: (*Level)(nil),
+		Field:         105,
+		Name:          "gopb.testpb.e_lv",
+		Tag:           "varint,105,opt,name=e_lv,enum=gopb.testpb.Level",
+		Filename:      "proto2.proto",
+	},
+	{
+		ExtendedType:  (*P2Ext)(nil),
+		ExtensionType: (*int64)(nil),
+		Field:         106,
+		Name:          "gopb.testpb.e_sint",
+		Tag:           "zigzag64,106,opt,name=e_sint",
+		Filename:      "proto2.proto",
+	},
+	{
+		ExtendedType:  (*P2Ext)(nil),
+		ExtensionType: (*uint32)(nil),
+		Field:         107,
+		Name:          "gopb.testpb.e_fixed",
+		Tag:           "fixed32,107,opt,name=e_fixed",
+		Filename:      "proto2.proto",
+	},
+}
+
+// Extension fields to P2Ext.
+var (
+	// optional int32 e_int = 100;
+	E_EInt = &file_proto2_proto_extTypes[0]
+	// optional string e_str = 101;
+	E_EStr = &file_proto2_proto_extTypes[1]
+	// repeated int32 e_rep = 102;
+	E_ERep = &file_proto2_proto_extTypes[2]
+	// optional gopb.testpb.P2Opt e_msg = 103;
+	E_EMsg = &file_proto2_proto_extTypes[3]
+	// repeated int32 e_packed = 104;
+	E_EPacked = &file_proto2_proto_extTypes[4]
+	// optional gopb.testpb.Level e_lv = 105;
+	E_ELv = &file_proto2_proto_extTypes[5]
+	// optional sint64 e_sint = 106;
+	E_ESint = &file_proto2_proto_extTypes[6]
+	// optional fixed32 e_fixed = 107;
+	E_EFixed = &file_proto2_proto_extTypes[7]
+)
+
+var File_proto2_proto protoreflect.FileDescriptor
+
+var file_proto2_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b,
+	0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x22, 0x8c, 0x01, 0x0a, 0x07,
+	0x50, 0x32, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x0e, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x00, 0x52, 0x01, 0x61, 0x12, 0x0e, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x01, 0x62, 0x12, 0x28, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x62, 0x2e, 0x50, 0x32, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x12, 0x24, 0x0a, 0x02, 0x6c, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x48, 0x00, 0x52, 0x02, 0x6c, 0x76, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x01, 0x72, 0x42, 0x03, 0x0a, 0x01, 0x6f, 0x22, 0xf6, 0x02, 0x0a, 0x05, 0x50,
+	0x32, 0x4f, 0x70, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x69, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x36, 0x34, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x69, 0x36, 0x34, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x01, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x01, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x62, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x02, 0x62, 0x6c, 0x12, 0x0c, 0x0a, 0x01, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x01, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x66,
+	0x12, 0x22, 0x0a, 0x02, 0x6c, 0x76, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67,
+	0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x02, 0x6c, 0x76, 0x12, 0x24, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e,
+	0x50, 0x32, 0x4f, 0x70, 0x74, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x05, 0x52, 0x01, 0x72, 0x12, 0x12, 0x0a, 0x02, 0x72, 0x70, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x05, 0x42, 0x02, 0x10, 0x01, 0x52, 0x02, 0x72, 0x70, 0x12, 0x27, 0x0a, 0x01,
+	0x6d, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x4f, 0x70, 0x74, 0x2e, 0x4d, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x01, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x33, 0x32, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x11, 0x52, 0x03, 0x73, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x36, 0x34, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x66, 0x36, 0x34, 0x1a, 0x48, 0x0a, 0x06, 0x4d, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x62, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x84, 0x03, 0x0a, 0x09, 0x50, 0x32, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x12, 0x14, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x02,
+	0x2d, 0x37, 0x52, 0x03, 0x69, 0x33, 0x32, 0x12, 0x1f, 0x0a, 0x03, 0x69, 0x36, 0x34, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x3a, 0x0d, 0x31, 0x30, 0x39, 0x39, 0x35, 0x31, 0x31, 0x36, 0x32, 0x37,
+	0x37, 0x37, 0x36, 0x52, 0x03, 0x69, 0x36, 0x34, 0x12, 0x14, 0x0a, 0x03, 0x75, 0x33, 0x32, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x3a, 0x02, 0x34, 0x32, 0x52, 0x03, 0x75, 0x33, 0x32, 0x12, 0x19,
+	0x0a, 0x01, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x0b, 0x68, 0x69, 0x20, 0x22, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x22, 0x0a, 0x52, 0x01, 0x73, 0x12, 0x14, 0x0a, 0x01, 0x62, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x3a, 0x06, 0x61, 0x5c, 0x30, 0x30, 0x30, 0x62, 0x52, 0x01, 0x62, 0x12,
+	0x14, 0x0a, 0x02, 0x62, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75,
+	0x65, 0x52, 0x02, 0x62, 0x6c, 0x12, 0x11, 0x0a, 0x01, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x3a, 0x03, 0x69, 0x6e, 0x66, 0x52, 0x01, 0x64, 0x12, 0x11, 0x0a, 0x01, 0x66, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x02, 0x3a, 0x03, 0x6e, 0x61, 0x6e, 0x52, 0x01, 0x66, 0x12, 0x14, 0x0a, 0x02, 0x64,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x3a, 0x04, 0x2d, 0x69, 0x6e, 0x66, 0x52, 0x02, 0x64,
+	0x6e, 0x12, 0x28, 0x0a, 0x02, 0x6c, 0x76, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x3a, 0x04, 0x48, 0x49, 0x47, 0x48, 0x52, 0x02, 0x6c, 0x76, 0x12, 0x13, 0x0a, 0x02, 0x66,
+	0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x3a, 0x03, 0x31, 0x2e, 0x35, 0x52, 0x02, 0x66, 0x32,
+	0x12, 0x28, 0x0a, 0x04, 0x73, 0x66, 0x36, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x10, 0x3a, 0x14,
+	0x2d, 0x39, 0x32, 0x32, 0x33, 0x33, 0x37, 0x32, 0x30, 0x33, 0x36, 0x38, 0x35, 0x34, 0x37, 0x37,
+	0x35, 0x38, 0x30, 0x38, 0x52, 0x04, 0x73, 0x66, 0x36, 0x34, 0x12, 0x26, 0x0a, 0x03, 0x75, 0x36,
+	0x34, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x3a, 0x14, 0x31, 0x38, 0x34, 0x34, 0x36, 0x37, 0x34,
+	0x34, 0x30, 0x37, 0x33, 0x37, 0x30, 0x39, 0x35, 0x35, 0x31, 0x36, 0x31, 0x35, 0x52, 0x03, 0x75,
+	0x36, 0x34, 0x12, 0x16, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x3a, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1c, 0x0a, 0x05, 0x50, 0x32,
+	0x45, 0x78, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01,
+	0x61, 0x2a, 0x05, 0x08, 0x64, 0x10, 0xc8, 0x01, 0x22, 0xd1, 0x01, 0x0a, 0x07, 0x50, 0x32, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x24, 0x0a, 0x01, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0a, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x47, 0x52, 0x01, 0x67, 0x12, 0x27, 0x0a, 0x02, 0x72, 0x67,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0a, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x52, 0x47, 0x52,
+	0x02, 0x72, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x1a, 0x1f, 0x0a, 0x01, 0x47, 0x12, 0x0c,
+	0x0a, 0x01, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x61, 0x12, 0x0c, 0x0a, 0x01,
+	0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x62, 0x1a, 0x40, 0x0a, 0x02, 0x52, 0x47,
+	0x12, 0x0c, 0x0a, 0x01, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x63, 0x12, 0x2c,
+	0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x22, 0xf2, 0x01, 0x0a,
+	0x05, 0x50, 0x32, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x52, 0x65, 0x71, 0x52, 0x05, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x62, 0x2e, 0x50, 0x32, 0x52, 0x65, 0x71, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x27,
+	0x0a, 0x01, 0x6d, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x70, 0x62,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x52, 0x65, 0x71, 0x2e, 0x4d, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x01, 0x6d, 0x1a, 0x48, 0x0a, 0x06, 0x4d, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62,
+	0x2e, 0x50, 0x32, 0x52, 0x65, 0x71, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x41, 0x0a, 0x0b, 0x50, 0x32, 0x52, 0x65, 0x71, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x12, 0x24, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x52, 0x65,
+	0x71, 0x52, 0x03, 0x72, 0x65, 0x71, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x6e, 0x2a, 0x2a, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x49, 0x47, 0x48, 0x10, 0x02,
+	0x3a, 0x27, 0x0a, 0x05, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x45, 0x78, 0x74, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x65, 0x49, 0x6e, 0x74, 0x3a, 0x27, 0x0a, 0x05, 0x65, 0x5f, 0x73,
+	0x74, 0x72, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62,
+	0x2e, 0x50, 0x32, 0x45, 0x78, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x53,
+	0x74, 0x72, 0x3a, 0x27, 0x0a, 0x05, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x12, 0x12, 0x2e, 0x67, 0x6f,
+	0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x45, 0x78, 0x74, 0x18,
+	0x66, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x65, 0x52, 0x65, 0x70, 0x3a, 0x3b, 0x0a, 0x05, 0x65,
+	0x5f, 0x6d, 0x73, 0x67, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x62, 0x2e, 0x50, 0x32, 0x45, 0x78, 0x74, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x4f,
+	0x70, 0x74, 0x52, 0x04, 0x65, 0x4d, 0x73, 0x67, 0x3a, 0x31, 0x0a, 0x08, 0x65, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x62, 0x2e, 0x50, 0x32, 0x45, 0x78, 0x74, 0x18, 0x68, 0x20, 0x03, 0x28, 0x05, 0x42, 0x02,
+	0x10, 0x01, 0x52, 0x07, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x3a, 0x39, 0x0a, 0x04, 0x65,
+	0x5f, 0x6c, 0x76, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x62, 0x2e, 0x50, 0x32, 0x45, 0x78, 0x74, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x03, 0x65, 0x4c, 0x76, 0x3a, 0x29, 0x0a, 0x06, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x74,
+	0x12, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50,
+	0x32, 0x45, 0x78, 0x74, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x12, 0x52, 0x05, 0x65, 0x53, 0x69, 0x6e,
+	0x74, 0x3a, 0x2b, 0x0a, 0x07, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x12, 0x12, 0x2e, 0x67,
+	0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x45, 0x78, 0x74,
+	0x18, 0x6b, 0x20, 0x01, 0x28, 0x07, 0x52, 0x06, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x42, 0x3e,
+	0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x67, 0x67,
+	0x72, 0x6f, 0x6e, 0x6d, 0x61, 0x67, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
+	0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x70, 0x62, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x3b, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62,
+}
+
+var (
+	file_proto2_proto_rawDescOnce sync.Once
+	file_proto2_proto_rawDescData = file_proto2_proto_rawDesc
+)
+
+func file_proto2_proto_rawDescGZIP() []byte {
+	file_proto2_proto_rawDescOnce.Do(func() {
+		file_proto2_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto2_proto_rawDescData)
+	})
+	return file_proto2_proto_rawDescData
+}
+
+var file_proto2_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto2_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto2_proto_goTypes = []any{
+	(Level)(0),          // 0: gopb.testpb.Level
+	(*P2Oneof)(nil),     // 1: gopb.testpb.P2Oneof
+	(*P2Opt)(nil),       // 2: gopb.testpb.P2Opt
+	(*P2Default)(nil),   // 3: gopb.testpb.P2Default
+	(*P2Ext)(nil),       // 4: gopb.testpb.P2Ext
+	(*P2Group)(nil),     // 5: gopb.testpb.P2Group
+	(*P2Req)(nil),       // 6: gopb.testpb.P2Req
+	(*P2ReqHolder)(nil), // 7: gopb.testpb.P2ReqHolder
+	nil,                 // 8: gopb.testpb.P2Opt.MEntry
+	(*P2Group_G)(nil),   // 9: gopb.testpb.P2Group.G
+	(*P2Group_RG)(nil),  // 10: gopb.testpb.P2Group.RG
+	nil,                 // 11: gopb.testpb.P2Req.MEntry
+}
+var file_proto2_proto_depIdxs = []int32{
+	1,  // 0: gopb.testpb.P2Oneof.msg:type_name -> gopb.testpb.P2Oneof
+	0,  // 1: gopb.testpb.P2Oneof.lv:type_name -> gopb.testpb.Level
+	0,  // 2: gopb.testpb.P2Opt.lv:type_name -> gopb.testpb.Level
+	2,  // 3: gopb.testpb.P2Opt.msg:type_name -> gopb.testpb.P2Opt
+	8,  // 4: gopb.testpb.P2Opt.m:type_name -> gopb.testpb.P2Opt.MEntry
+	0,  // 5: gopb.testpb.P2Default.lv:type_name -> gopb.testpb.Level
+	9,  // 6: gopb.testpb.P2Group.g:type_name -> gopb.testpb.P2Group.G
+	10, // 7: gopb.testpb.P2Group.rg:type_name -> gopb.testpb.P2Group.RG
+	6,  // 8: gopb.testpb.P2Req.child:type_name -> gopb.testpb.P2Req
+	6,  // 9: gopb.testpb.P2Req.items:type_name -> gopb.testpb.P2Req
+	11, // 10: gopb.testpb.P2Req.m:type_name -> gopb.testpb.P2Req.MEntry
+	6,  // 11: gopb.testpb.P2ReqHolder.req:type_name -> gopb.testpb.P2Req
+	0,  // 12: gopb.testpb.P2Opt.MEntry.value:type_name -> gopb.testpb.Level
+	5,  // 13: gopb.testpb.P2Group.RG.nested:type_name -> gopb.testpb.P2Group
+	6,  // 14: gopb.testpb.P2Req.MEntry.value:type_name -> gopb.testpb.P2Req
+	4,  // 15: gopb.testpb.e_int:extendee -> gopb.testpb.P2Ext
+	4,  // 16: gopb.testpb.e_str:extendee -> gopb.testpb.P2Ext
+	4,  // 17: gopb.testpb.e_rep:extendee -> gopb.testpb.P2Ext
+	4,  // 18: gopb.testpb.e_msg:extendee -> gopb.testpb.P2Ext
+	4,  // 19: gopb.testpb.e_packed:extendee -> gopb.testpb.P2Ext
+	4,  // 20: gopb.testpb.e_lv:extendee -> gopb.testpb.P2Ext
+	4,  // 21: gopb.testpb.e_sint:extendee -> gopb.testpb.P2Ext
+	4,  // 22: gopb.testpb.e_fixed:extendee -> gopb.testpb.P2Ext
+	2,  // 23: gopb.testpb.e_msg:type_name -> gopb.testpb.P2Opt
+	0,  // 24: gopb.testpb.e_lv:type_name -> gopb.testpb.Level
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	23, // [23:25] is the sub-list for extension type_name
+	15, // [15:23] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_proto2_proto_init() }
+func file_proto2_proto_init() {
+	if File_proto2_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto2_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*P2Oneof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto2_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*P2Opt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto2_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*P2Default); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto2_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*P2Ext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.extensionFields
+			default:
+				return nil
+			}
+		}
+		file_proto2_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*P2Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto2_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*P2Req); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto2_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*P2ReqHolder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto2_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*P2Group_G); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto2_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*P2Group_RG); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto2_proto_msgTypes[0].OneofWrappers = []any{
+		(*P2Oneof_A)(nil),
+		(*P2Oneof_B)(nil),
+		(*P2Oneof_Msg)(nil),
+		(*P2Oneof_Lv)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto2_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 8,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto2_proto_goTypes,
+		DependencyIndexes: file_proto2_proto_depIdxs,
+		EnumInfos:         file_proto2_proto_enumTypes,
+		MessageInfos:      file_proto2_proto_msgTypes,
+		ExtensionInfos:    file_proto2_proto_extTypes,
+	}.Build()
+	File_proto2_proto = out.File
+	file_proto2_proto_rawDesc = nil
+	file_proto2_proto_goTypes = nil
+	file_proto2_proto_depIdxs = nil
+}
@@ -0,0 +1,1209 @@
+// 测试使用的消息. 修改后需要重新生成, 见 gen.go
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: testpb.proto
+
+package golden
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Color int32
+
+const (
+	Color_COLOR_UNSPECIFIED Color = 0
+	Color_RED               Color = 1
+	Color_GREEN             Color = 2
+)
+
+// Enum value maps for Color.
+var (
+	Color_name = map[int32]string{
+		0: "COLOR_UNSPECIFIED",
+		1: "RED",
+		2: "GREEN",
+	}
+	Color_value = map[string]int32{
+		"COLOR_UNSPECIFIED": 0,
+		"RED":               1,
+		"GREEN":             2,
+	}
+)
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Color) Descriptor() protoreflect.EnumDescriptor {
+	return file_testpb_proto_enumTypes[0].Descriptor()
+}
+
+func (Color) Type() protoreflect.EnumType {
+	return &file_testpb_proto_enumTypes[0]
+}
+
+func (x Color) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Color.Descriptor instead.
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return file_testpb_proto_rawDescGZIP(), []int{0}
+}
+
+type Inner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Nums  []int32 `protobuf:"varint,3,rep,packed,name=nums,proto3" json:"nums,omitempty"`
+	Child *Inner  `protobuf:"bytes,4,opt,name=child,proto3" json:"child,omitempty"`
+}
+
+func (x *Inner) Reset() {
+	*x = Inner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Inner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Inner) ProtoMessage() {}
+
+func (x *Inner) ProtoReflect() protoreflect.Message {
+	mi := &file_testpb_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Inner.ProtoReflect.Descriptor instead.
+func (*Inner) Descriptor() ([]byte, []int) {
+	return file_testpb_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Inner) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Inner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Inner) GetNums() []int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return nil
+}
+
+func (x *Inner) GetChild() *Inner {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+type All struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FInt32    int32                             `protobuf:"varint,1,opt,name=f_int32,json=fInt32,proto3" json:"f_int32,omitempty"`
+	FInt64    int64                             `protobuf:"varint,2,opt,name=f_int64,json=fInt64,proto3" json:"f_int64,omitempty"`
+	FString   string                            `protobuf:"bytes,3,opt,name=f_string,json=fString,proto3" json:"f_string,omitempty"`
+	FBytes    []byte                            `protobuf:"bytes,4,opt,name=f_bytes,json=fBytes,proto3" json:"f_bytes,omitempty"`
+	FBool     bool                              `protobuf:"varint,5,opt,name=f_bool,json=fBool,proto3" json:"f_bool,omitempty"`
+	FDouble   float64                           `protobuf:"fixed64,6,opt,name=f_double,json=fDouble,proto3" json:"f_double,omitempty"`
+	FEnum     Color                             `protobuf:"varint,7,opt,name=f_enum,json=fEnum,proto3,enum=gopb.testpb.Color" json:"f_enum,omitempty"`
+	PInt32    *int32                            `protobuf:"varint,8,opt,name=p_int32,json=pInt32,proto3,oneof" json:"p_int32,omitempty"`
+	FMsg      *Inner                            `protobuf:"bytes,9,opt,name=f_msg,json=fMsg,proto3" json:"f_msg,omitempty"`
+	FUint32   uint32                            `protobuf:"varint,10,opt,name=f_uint32,json=fUint32,proto3" json:"f_uint32,omitempty"`
+	FUint64   uint64                            `protobuf:"varint,11,opt,name=f_uint64,json=fUint64,proto3" json:"f_uint64,omitempty"`
+	FSint32   int32                             `protobuf:"zigzag32,12,opt,name=f_sint32,json=fSint32,proto3" json:"f_sint32,omitempty"`
+	FSint64   int64                             `protobuf:"zigzag64,13,opt,name=f_sint64,json=fSint64,proto3" json:"f_sint64,omitempty"`
+	FFixed32  uint32                            `protobuf:"fixed32,14,opt,name=f_fixed32,json=fFixed32,proto3" json:"f_fixed32,omitempty"`
+	FFixed64  uint64                            `protobuf:"fixed64,15,opt,name=f_fixed64,json=fFixed64,proto3" json:"f_fixed64,omitempty"`
+	FSfixed32 int32                             `protobuf:"fixed32,16,opt,name=f_sfixed32,json=fSfixed32,proto3" json:"f_sfixed32,omitempty"`
+	FSfixed64 int64                             `protobuf:"fixed64,17,opt,name=f_sfixed64,json=fSfixed64,proto3" json:"f_sfixed64,omitempty"`
+	FFloat    float32                           `protobuf:"fixed32,18,opt,name=f_float,json=fFloat,proto3" json:"f_float,omitempty"`
+	RInt32    []int32                           `protobuf:"varint,20,rep,packed,name=r_int32,json=rInt32,proto3" json:"r_int32,omitempty"`
+	RString   []string                          `protobuf:"bytes,21,rep,name=r_string,json=rString,proto3" json:"r_string,omitempty"`
+	RMsg      []*Inner                          `protobuf:"bytes,22,rep,name=r_msg,json=rMsg,proto3" json:"r_msg,omitempty"`
+	RBytes    [][]byte                          `protobuf:"bytes,23,rep,name=r_bytes,json=rBytes,proto3" json:"r_bytes,omitempty"`
+	RDouble   []float64                         `protobuf:"fixed64,24,rep,packed,name=r_double,json=rDouble,proto3" json:"r_double,omitempty"`
+	REnum     []Color                           `protobuf:"varint,25,rep,packed,name=r_enum,json=rEnum,proto3,enum=gopb.testpb.Color" json:"r_enum,omitempty"`
+	RSint64   []int64                           `protobuf:"zigzag64,26,rep,packed,name=r_sint64,json=rSint64,proto3" json:"r_sint64,omitempty"`
+	RUnpacked []int32                           `protobuf:"varint,27,rep,name=r_unpacked,json=rUnpacked,proto3" json:"r_unpacked,omitempty"`
+	MStrInt   map[string]int32                  `protobuf:"bytes,30,rep,name=m_str_int,json=mStrInt,proto3" json:"m_str_int,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	MIntStr   map[int32]string                  `protobuf:"bytes,31,rep,name=m_int_str,json=mIntStr,proto3" json:"m_int_str,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MStrMsg   map[string]*Inner                 `protobuf:"bytes,32,rep,name=m_str_msg,json=mStrMsg,proto3" json:"m_str_msg,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MTs       map[string]*timestamppb.Timestamp `protobuf:"bytes,33,rep,name=m_ts,json=mTs,proto3" json:"m_ts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MDur      map[string]*durationpb.Duration   `protobuf:"bytes,34,rep,name=m_dur,json=mDur,proto3" json:"m_dur,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MI32      map[string]*wrapperspb.Int32Value `protobuf:"bytes,35,rep,name=m_i32,json=mI32,proto3" json:"m_i32,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MBytes    map[string]*wrapperspb.BytesValue `protobuf:"bytes,36,rep,name=m_bytes,json=mBytes,proto3" json:"m_bytes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to O:
+	//	*All_OMsg
+	//	*All_OStr
+	//	*All_OInt
+	O       isAll_O                   `protobuf_oneof:"o"`
+	FAny    *anypb.Any                `protobuf:"bytes,50,opt,name=f_any,json=fAny,proto3" json:"f_any,omitempty"`
+	FTs     *timestamppb.Timestamp    `protobuf:"bytes,51,opt,name=f_ts,json=fTs,proto3" json:"f_ts,omitempty"`
+	FDur    *durationpb.Duration      `protobuf:"bytes,52,opt,name=f_dur,json=fDur,proto3" json:"f_dur,omitempty"`
+	FI64    *wrapperspb.Int64Value    `protobuf:"bytes,53,opt,name=f_i64,json=fI64,proto3" json:"f_i64,omitempty"`
+	FSv     *wrapperspb.StringValue   `protobuf:"bytes,54,opt,name=f_sv,json=fSv,proto3" json:"f_sv,omitempty"`
+	FBv     *wrapperspb.BytesValue    `protobuf:"bytes,55,opt,name=f_bv,json=fBv,proto3" json:"f_bv,omitempty"`
+	FBoolv  *wrapperspb.BoolValue     `protobuf:"bytes,56,opt,name=f_boolv,json=fBoolv,proto3" json:"f_boolv,omitempty"`
+	FDblv   *wrapperspb.DoubleValue   `protobuf:"bytes,57,opt,name=f_dblv,json=fDblv,proto3" json:"f_dblv,omitempty"`
+	RTs     []*timestamppb.Timestamp  `protobuf:"bytes,58,rep,name=r_ts,json=rTs,proto3" json:"r_ts,omitempty"`
+	RU32    []*wrapperspb.UInt32Value `protobuf:"bytes,59,rep,name=r_u32,json=rU32,proto3" json:"r_u32,omitempty"`
+	PString *string                   `protobuf:"bytes,60,opt,name=p_string,json=pString,proto3,oneof" json:"p_string,omitempty"`
+	PBytes  []byte                    `protobuf:"bytes,61,opt,name=p_bytes,json=pBytes,proto3,oneof" json:"p_bytes,omitempty"`
+	PEnum   *Color                    `protobuf:"varint,62,opt,name=p_enum,json=pEnum,proto3,enum=gopb.testpb.Color,oneof" json:"p_enum,omitempty"`
+	PDouble *float64                  `protobuf:"fixed64,63,opt,name=p_double,json=pDouble,proto3,oneof" json:"p_double,omitempty"`
+	PUint64 *uint64                   `protobuf:"varint,64,opt,name=p_uint64,json=pUint64,proto3,oneof" json:"p_uint64,omitempty"`
+}
+
+func (x *All) Reset() {
+	*x = All{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *All) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*All) ProtoMessage() {}
+
+func (x *All) ProtoReflect() protoreflect.Message {
+	mi := &file_testpb_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use All.ProtoReflect.Descriptor instead.
+func (*All) Descriptor() ([]byte, []int) {
+	return file_testpb_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *All) GetFInt32() int32 {
+	if x != nil {
+		return x.FInt32
+	}
+	return 0
+}
+
+func (x *All) GetFInt64() int64 {
+	if x != nil {
+		return x.FInt64
+	}
+	return 0
+}
+
+func (x *All) GetFString() string {
+	if x != nil {
+		return x.FString
+	}
+	return ""
+}
+
+func (x *All) GetFBytes() []byte {
+	if x != nil {
+		return x.FBytes
+	}
+	return nil
+}
+
+func (x *All) GetFBool() bool {
+	if x != nil {
+		return x.FBool
+	}
+	return false
+}
+
+func (x *All) GetFDouble() float64 {
+	if x != nil {
+		return x.FDouble
+	}
+	return 0
+}
+
+func (x *All) GetFEnum() Color {
+	if x != nil {
+		return x.FEnum
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+func (x *All) GetPInt32() int32 {
+	if x != nil && x.PInt32 != nil {
+		return *x.PInt32
+	}
+	return 0
+}
+
+func (x *All) GetFMsg() *Inner {
+	if x != nil {
+		return x.FMsg
+	}
+	return nil
+}
+
+func (x *All) GetFUint32() uint32 {
+	if x != nil {
+		return x.FUint32
+	}
+	return 0
+}
+
+func (x *All) GetFUint64() uint64 {
+	if x != nil {
+		return x.FUint64
+	}
+	return 0
+}
+
+func (x *All) GetFSint32() int32 {
+	if x != nil {
+		return x.FSint32
+	}
+	return 0
+}
+
+func (x *All) GetFSint64() int64 {
+	if x != nil {
+		return x.FSint64
+	}
+	return 0
+}
+
+func (x *All) GetFFixed32() uint32 {
+	if x != nil {
+		return x.FFixed32
+	}
+	return 0
+}
+
+func (x *All) GetFFixed64() uint64 {
+	if x != nil {
+		return x.FFixed64
+	}
+	return 0
+}
+
+func (x *All) GetFSfixed32() int32 {
+	if x != nil {
+		return x.FSfixed32
+	}
+	return 0
+}
+
+func (x *All) GetFSfixed64() int64 {
+	if x != nil {
+		return x.FSfixed64
+	}
+	return 0
+}
+
+func (x *All) GetFFloat() float32 {
+	if x != nil {
+		return x.FFloat
+	}
+	return 0
+}
+
+func (x *All) GetRInt32() []int32 {
+	if x != nil {
+		return x.RInt32
+	}
+	return nil
+}
+
+func (x *All) GetRString() []string {
+	if x != nil {
+		return x.RString
+	}
+	return nil
+}
+
+func (x *All) GetRMsg() []*Inner {
+	if x != nil {
+		return x.RMsg
+	}
+	return nil
+}
+
+func (x *All) GetRBytes() [][]byte {
+	if x != nil {
+		return x.RBytes
+	}
+	return nil
+}
+
+func (x *All) GetRDouble() []float64 {
+	if x != nil {
+		return x.RDouble
+	}
+	return nil
+}
+
+func (x *All) GetREnum() []Color {
+	if x != nil {
+		return x.REnum
+	}
+	return nil
+}
+
+func (x *All) GetRSint64() []int64 {
+	if x != nil {
+		return x.RSint64
+	}
+	return nil
+}
+
+func (x *All) GetRUnpacked() []int32 {
+	if x != nil {
+		return x.RUnpacked
+	}
+	return nil
+}
+
+func (x *All) GetMStrInt() map[string]int32 {
+	if x != nil {
+		return x.MStrInt
+	}
+	return nil
+}
+
+func (x *All) GetMIntStr() map[int32]string {
+	if x != nil {
+		return x.MIntStr
+	}
+	return nil
+}
+
+func (x *All) GetMStrMsg() map[string]*Inner {
+	if x != nil {
+		return x.MStrMsg
+	}
+	return nil
+}
+
+func (x *All) GetMTs() map[string]*timestamppb.Timestamp {
+	if x != nil {
+		return x.MTs
+	}
+	return nil
+}
+
+func (x *All) GetMDur() map[string]*durationpb.Duration {
+	if x != nil {
+		return x.MDur
+	}
+	return nil
+}
+
+func (x *All) GetMI32() map[string]*wrapperspb.Int32Value {
+	if x != nil {
+		return x.MI32
+	}
+	return nil
+}
+
+func (x *All) GetMBytes() map[string]*wrapperspb.BytesValue {
+	if x != nil {
+		return x.MBytes
+	}
+	return nil
+}
+
+func (m *All) GetO() isAll_O {
+	if m != nil {
+		return m.O
+	}
+	return nil
+}
+
+func (x *All) GetOMsg() *Inner {
+	if x, ok := x.GetO().(*All_OMsg); ok {
+		return x.OMsg
+	}
+	return nil
+}
+
+func (x *All) GetOStr() string {
+	if x, ok := x.GetO().(*All_OStr); ok {
+		return x.OStr
+	}
+	return ""
+}
+
+func (x *All) GetOInt() int32 {
+	if x, ok := x.GetO().(*All_OInt); ok {
+		return x.OInt
+	}
+	return 0
+}
+
+func (x *All) GetFAny() *anypb.Any {
+	if x != nil {
+		return x.FAny
+	}
+	return nil
+}
+
+func (x *All) GetFTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FTs
+	}
+	return nil
+}
+
+func (x *All) GetFDur() *durationpb.Duration {
+	if x != nil {
+		return x.FDur
+	}
+	return nil
+}
+
+func (x *All) GetFI64() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.FI64
+	}
+	return nil
+}
+
+func (x *All) GetFSv() *wrapperspb.StringValue {
+	if x != nil {
+		return x.FSv
+	}
+	return nil
+}
+
+func (x *All) GetFBv() *wrapperspb.BytesValue {
+	if x != nil {
+		return x.FBv
+	}
+	return nil
+}
+
+func (x *All) GetFBoolv() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.FBoolv
+	}
+	return nil
+}
+
+func (x *All) GetFDblv() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.FDblv
+	}
+	return nil
+}
+
+func (x *All) GetRTs() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.RTs
+	}
+	return nil
+}
+
+func (x *All) GetRU32() []*wrapperspb.UInt32Value {
+	if x != nil {
+		return x.RU32
+	}
+	return nil
+}
+
+func (x *All) GetPString() string {
+	if x != nil && x.PString != nil {
+		return *x.PString
+	}
+	return ""
+}
+
+func (x *All) GetPBytes() []byte {
+	if x != nil {
+		return x.PBytes
+	}
+	return nil
+}
+
+func (x *All) GetPEnum() Color {
+	if x != nil && x.PEnum != nil {
+		return *x.PEnum
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+func (x *All) GetPDouble() float64 {
+	if x != nil && x.PDouble != nil {
+		return *x.PDouble
+	}
+	return 0
+}
+
+func (x *All) GetPUint64() uint64 {
+	if x != nil && x.PUint64 != nil {
+		return *x.PUint64
+	}
+	return 0
+}
+
+type isAll_O interface {
+	isAll_O()
+}
+
+type All_OMsg struct {
+	OMsg *Inner `protobuf:"bytes,40,opt,name=o_msg,json=oMsg,proto3,oneof"`
+}
+
+type All_OStr struct {
+	OStr string `protobuf:"bytes,41,opt,name=o_str,json=oStr,proto3,oneof"`
+}
+
+type All_OInt struct {
+	OInt int32 `protobuf:"varint,42,opt,name=o_int,json=oInt,proto3,oneof"`
+}
+
+func (*All_OMsg) isAll_O() {}
+
+func (*All_OStr) isAll_O() {}
+
+func (*All_OInt) isAll_O() {}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_testpb_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_testpb_proto_rawDescGZIP(), []int{2}
+}
+
+// All 的部分字段, 其余字段作为未知字段解析.
+type AllSubset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FInt32  int32            `protobuf:"varint,1,opt,name=f_int32,json=fInt32,proto3" json:"f_int32,omitempty"`
+	FMsg    *Inner           `protobuf:"bytes,9,opt,name=f_msg,json=fMsg,proto3" json:"f_msg,omitempty"`
+	RString []string         `protobuf:"bytes,21,rep,name=r_string,json=rString,proto3" json:"r_string,omitempty"`
+	MStrInt map[string]int32 `protobuf:"bytes,30,rep,name=m_str_int,json=mStrInt,proto3" json:"m_str_int,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *AllSubset) Reset() {
+	*x = AllSubset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllSubset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllSubset) ProtoMessage() {}
+
+func (x *AllSubset) ProtoReflect() protoreflect.Message {
+	mi := &file_testpb_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllSubset.ProtoReflect.Descriptor instead.
+func (*AllSubset) Descriptor() ([]byte, []int) {
+	return file_testpb_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AllSubset) GetFInt32() int32 {
+	if x != nil {
+		return x.FInt32
+	}
+	return 0
+}
+
+func (x *AllSubset) GetFMsg() *Inner {
+	if x != nil {
+		return x.FMsg
+	}
+	return nil
+}
+
+func (x *AllSubset) GetRString() []string {
+	if x != nil {
+		return x.RString
+	}
+	return nil
+}
+
+func (x *AllSubset) GetMStrInt() map[string]int32 {
+	if x != nil {
+		return x.MStrInt
+	}
+	return nil
+}
+
+// 树形消息, 用于测试深层嵌套.
+type Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	V     int64            `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+	Name  string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Child *Node            `protobuf:"bytes,3,opt,name=child,proto3" json:"child,omitempty"`
+	Kids  []*Node          `protobuf:"bytes,4,rep,name=kids,proto3" json:"kids,omitempty"`
+	M     map[string]*Node `protobuf:"bytes,5,rep,name=m,proto3" json:"m,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testpb_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_testpb_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_testpb_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Node) GetV() int64 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *Node) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Node) GetChild() *Node {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *Node) GetKids() []*Node {
+	if x != nil {
+		return x.Kids
+	}
+	return nil
+}
+
+func (x *Node) GetM() map[string]*Node {
+	if x != nil {
+		return x.M
+	}
+	return nil
+}
+
+var File_testpb_proto protoreflect.FileDescriptor
+
+var file_testpb_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b,
+	0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x1a, 0x19, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x69, 0x0a, 0x05, 0x49, 0x6e, 0x6e, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x04, 0x6e, 0x75, 0x6d, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x22, 0xdb, 0x13, 0x0a, 0x03, 0x41, 0x6c, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x5f,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x19, 0x0a, 0x08,
+	0x66, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x15, 0x0a, 0x06, 0x66, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x66, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x5f, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x66, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x05, 0x66, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x1c, 0x0a,
+	0x07, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01,
+	0x52, 0x06, 0x70, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x05, 0x66,
+	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70,
+	0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x04,
+	0x66, 0x4d, 0x73, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12,
+	0x19, 0x0a, 0x08, 0x66, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x66, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x5f,
+	0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x11, 0x52, 0x07, 0x66, 0x53,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x12, 0x52, 0x07, 0x66, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x07, 0x52, 0x08, 0x66, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x06,
+	0x52, 0x08, 0x66, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x5f,
+	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x09,
+	0x66, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x5f, 0x73,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x11, 0x20, 0x01, 0x28, 0x10, 0x52, 0x09, 0x66,
+	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x5f, 0x66, 0x6c,
+	0x6f, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x66, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x14, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x06, 0x72, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x15, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x16,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x62, 0x2e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x04, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x06, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x5f, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x18, 0x18, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x72, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x19, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x05, 0x72, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x12, 0x52,
+	0x07, 0x72, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x21, 0x0a, 0x0a, 0x72, 0x5f, 0x75, 0x6e,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x05, 0x42, 0x02, 0x10, 0x00,
+	0x52, 0x09, 0x72, 0x55, 0x6e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x6d,
+	0x5f, 0x73, 0x74, 0x72, 0x5f, 0x69, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c,
+	0x2e, 0x4d, 0x53, 0x74, 0x72, 0x49, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d,
+	0x53, 0x74, 0x72, 0x49, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x6d, 0x5f, 0x69, 0x6e, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x70, 0x62,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x2e, 0x4d, 0x49, 0x6e, 0x74,
+	0x53, 0x74, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x49, 0x6e, 0x74, 0x53, 0x74,
+	0x72, 0x12, 0x39, 0x0a, 0x09, 0x6d, 0x5f, 0x73, 0x74, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x20,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x2e, 0x4d, 0x53, 0x74, 0x72, 0x4d, 0x73, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x53, 0x74, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x2c, 0x0a, 0x04,
+	0x6d, 0x5f, 0x74, 0x73, 0x18, 0x21, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x70,
+	0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x2e, 0x4d, 0x54, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x6d, 0x54, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x6d, 0x5f,
+	0x64, 0x75, 0x72, 0x18, 0x22, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x70, 0x62,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x2e, 0x4d, 0x44, 0x75, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6d, 0x44, 0x75, 0x72, 0x12, 0x2f, 0x0a, 0x05, 0x6d,
+	0x5f, 0x69, 0x33, 0x32, 0x18, 0x23, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x70,
+	0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x2e, 0x4d, 0x49, 0x33,
+	0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6d, 0x49, 0x33, 0x32, 0x12, 0x35, 0x0a, 0x07,
+	0x6d, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x24, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x2e,
+	0x4d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x6f, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x28, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62,
+	0x2e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x6f, 0x4d, 0x73, 0x67, 0x12, 0x15,
+	0x0a, 0x05, 0x6f, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x04, 0x6f, 0x53, 0x74, 0x72, 0x12, 0x15, 0x0a, 0x05, 0x6f, 0x5f, 0x69, 0x6e, 0x74, 0x18, 0x2a,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x6f, 0x49, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x05,
+	0x66, 0x5f, 0x61, 0x6e, 0x79, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x52, 0x04, 0x66, 0x41, 0x6e, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x66, 0x5f, 0x74, 0x73, 0x18,
+	0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x03, 0x66, 0x54, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x66, 0x5f, 0x64, 0x75, 0x72, 0x18,
+	0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x04, 0x66, 0x44, 0x75, 0x72, 0x12, 0x30, 0x0a, 0x05, 0x66, 0x5f, 0x69, 0x36, 0x34, 0x18,
+	0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x04, 0x66, 0x49, 0x36, 0x34, 0x12, 0x2f, 0x0a, 0x04, 0x66, 0x5f, 0x73, 0x76,
+	0x18, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x66, 0x53, 0x76, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x5f, 0x62,
+	0x76, 0x18, 0x37, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x66, 0x42, 0x76, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x5f, 0x62,
+	0x6f, 0x6f, 0x6c, 0x76, 0x18, 0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x66, 0x42, 0x6f, 0x6f, 0x6c, 0x76, 0x12, 0x33,
+	0x0a, 0x06, 0x66, 0x5f, 0x64, 0x62, 0x6c, 0x76, 0x18, 0x39, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x66, 0x44,
+	0x62, 0x6c, 0x76, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x5f, 0x74, 0x73, 0x18, 0x3a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x72,
+	0x54, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x5f, 0x75, 0x33, 0x32, 0x18, 0x3b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x04, 0x72, 0x55, 0x33, 0x32, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x70, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x70, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x3d, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x03, 0x52, 0x06, 0x70, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x3e, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x48, 0x04, 0x52, 0x05, 0x70, 0x45, 0x6e, 0x75, 0x6d,
+	0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18,
+	0x3f, 0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52, 0x07, 0x70, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18,
+	0x40, 0x20, 0x01, 0x28, 0x04, 0x48, 0x06, 0x52, 0x07, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x88, 0x01, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x53, 0x74, 0x72, 0x49, 0x6e, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x3a, 0x0a, 0x0c, 0x4d, 0x49, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4e, 0x0a, 0x0c, 0x4d,
+	0x53, 0x74, 0x72, 0x4d, 0x73, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
+	0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x6e, 0x65, 0x72,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x52, 0x0a, 0x08, 0x4d,
+	0x54, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x52, 0x0a, 0x09, 0x4d, 0x44, 0x75, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x54, 0x0a, 0x09, 0x4d, 0x49, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x56, 0x0a, 0x0b, 0x4d, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x03, 0x0a, 0x01, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x5f, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x70, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x5f, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xe5, 0x01, 0x0a, 0x09, 0x41, 0x6c,
+	0x6c, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x5f, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x12, 0x27, 0x0a, 0x05, 0x66, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6e,
+	0x6e, 0x65, 0x72, 0x52, 0x04, 0x66, 0x4d, 0x73, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x15, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x12, 0x3f, 0x0a, 0x09, 0x6d, 0x5f, 0x73, 0x74, 0x72, 0x5f, 0x69, 0x6e,
+	0x74, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x2e,
+	0x4d, 0x53, 0x74, 0x72, 0x49, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x53,
+	0x74, 0x72, 0x49, 0x6e, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x53, 0x74, 0x72, 0x49, 0x6e, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xe9, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x76, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x01, 0x76, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x05,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f,
+	0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6b, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6b, 0x69, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x01,
+	0x6d, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4d, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x01, 0x6d, 0x1a, 0x47, 0x0a, 0x06, 0x4d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x32, 0x0a,
+	0x05, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x45, 0x45, 0x4e, 0x10,
+	0x02, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x67, 0x67, 0x72, 0x6f, 0x6e, 0x6d, 0x61, 0x67, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x70, 0x62, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x3b, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_testpb_proto_rawDescOnce sync.Once
+	file_testpb_proto_rawDescData = file_testpb_proto_rawDesc
+)
+
+func file_testpb_proto_rawDescGZIP() []byte {
+	file_testpb_proto_rawDescOnce.Do(func() {
+		file_testpb_proto_rawDescData = protoimpl.X.CompressGZIP(file_testpb_proto_rawDescData)
+	})
+	return file_testpb_proto_rawDescData
+}
+
+var file_testpb_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_testpb_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_testpb_proto_goTypes = []any{
+	(Color)(0),                     // 0: gopb.testpb.Color
+	(*Inner)(nil),                  // 1: gopb.testpb.Inner
+	(*All)(nil),                    // 2: gopb.testpb.All
+	(*Empty)(nil),                  // 3: gopb.testpb.Empty
+	(*AllSubset)(nil),              // 4: gopb.testpb.AllSubset
+	(*Node)(nil),                   // 5: gopb.testpb.Node
+	nil,                            // 6: gopb.testpb.All.MStrIntEntry
+	nil,                            // 7: gopb.testpb.All.MIntStrEntry
+	nil,                            // 8: gopb.testpb.All.MStrMsgEntry
+	nil,                            // 9: gopb.testpb.All.MTsEntry
+	nil,                            // 10: gopb.testpb.All.MDurEntry
+	nil,                            // 11: gopb.testpb.All.MI32Entry
+	nil,                            // 12: gopb.testpb.All.MBytesEntry
+	nil,                            // 13: gopb.testpb.AllSubset.MStrIntEntry
+	nil,                            // 14: gopb.testpb.Node.MEntry
+	(*anypb.Any)(nil),              // 15: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),  // 16: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 17: google.protobuf.Duration
+	(*wrapperspb.Int64Value)(nil),  // 18: google.protobuf.Int64Value
+	(*wrapperspb.StringValue)(nil), // 19: google.protobuf.StringValue
+	(*wrapperspb.BytesValue)(nil),  // 20: google.protobuf.BytesValue
+	(*wrapperspb.BoolValue)(nil),   // 21: google.protobuf.BoolValue
+	(*wrapperspb.DoubleValue)(nil), // 22: google.protobuf.DoubleValue
+	(*wrapperspb.UInt32Value)(nil), // 23: google.protobuf.UInt32Value
+	(*wrapperspb.Int32Value)(nil),  // 24: google.protobuf.Int32Value
+}
+var file_testpb_proto_depIdxs = []int32{
+	1,  // 0: gopb.testpb.Inner.child:type_name -> gopb.testpb.Inner
+	0,  // 1: gopb.testpb.All.f_enum:type_name -> gopb.testpb.Color
+	1,  // 2: gopb.testpb.All.f_msg:type_name -> gopb.testpb.Inner
+	1,  // 3: gopb.testpb.All.r_msg:type_name -> gopb.testpb.Inner
+	0,  // 4: gopb.testpb.All.r_enum:type_name -> gopb.testpb.Color
+	6,  // 5: gopb.testpb.All.m_str_int:type_name -> gopb.testpb.All.MStrIntEntry
+	7,  // 6: gopb.testpb.All.m_int_str:type_name -> gopb.testpb.All.MIntStrEntry
+	8,  // 7: gopb.testpb.All.m_str_msg:type_name -> gopb.testpb.All.MStrMsgEntry
+	9,  // 8: gopb.testpb.All.m_ts:type_name -> gopb.testpb.All.MTsEntry
+	10, // 9: gopb.testpb.All.m_dur:type_name -> gopb.testpb.All.MDurEntry
+	11, // 10: gopb.testpb.All.m_i32:type_name -> gopb.testpb.All.MI32Entry
+	12, // 11: gopb.testpb.All.m_bytes:type_name -> gopb.testpb.All.MBytesEntry
+	1,  // 12: gopb.testpb.All.o_msg:type_name -> gopb.testpb.Inner
+	15, // 13: gopb.testpb.All.f_any:type_name -> google.protobuf.Any
+	16, // 14: gopb.testpb.All.f_ts:type_name -> google.protobuf.Timestamp
+	17, // 15: gopb.testpb.All.f_dur:type_name -> google.protobuf.Duration
+	18, // 16: gopb.testpb.All.f_i64:type_name -> google.protobuf.Int64Value
+	19, // 17: gopb.testpb.All.f_sv:type_name -> google.protobuf.StringValue
+	20, // 18: gopb.testpb.All.f_bv:type_name -> google.protobuf.BytesValue
+	21, // 19: gopb.testpb.All.f_boolv:type_name -> google.protobuf.BoolValue
+	22, // 20: gopb.testpb.All.f_dblv:type_name -> google.protobuf.DoubleValue
+	16, // 21: gopb.testpb.All.r_ts:type_name -> google.protobuf.Timestamp
+	23, // 22: gopb.testpb.All.r_u32:type_name -> google.protobuf.UInt32Value
+	0,  // 23: gopb.testpb.All.p_enum:type_name -> gopb.testpb.Color
+	1,  // 24: gopb.testpb.AllSubset.f_msg:type_name -> gopb.testpb.Inner
+	13, // 25: gopb.testpb.AllSubset.m_str_int:type_name -> gopb.testpb.AllSubset.MStrIntEntry
+	5,  // 26: gopb.testpb.Node.child:type_name -> gopb.testpb.Node
+	5,  // 27: gopb.testpb.Node.kids:type_name -> gopb.testpb.Node
+	14, // 28: gopb.testpb.Node.m:type_name -> gopb.testpb.Node.MEntry
+	1,  // 29: gopb.testpb.All.MStrMsgEntry.value:type_name -> gopb.testpb.Inner
+	16, // 30: gopb.testpb.All.MTsEntry.value:type_name -> google.protobuf.Timestamp
+	17, // 31: gopb.testpb.All.MDurEntry.value:type_name -> google.protobuf.Duration
+	24, // 32: gopb.testpb.All.MI32Entry.value:type_name -> google.protobuf.Int32Value
+	20, // 33: gopb.testpb.All.MBytesEntry.value:type_name -> google.protobuf.BytesValue
+	5,  // 34: gopb.testpb.Node.MEntry.value:type_name -> gopb.testpb.Node
+	35, // [35:35] is the sub-list for method output_type
+	35, // [35:35] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
+}
+
+func init() { file_testpb_proto_init() }
+func file_testpb_proto_init() {
+	if File_testpb_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_testpb_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Inner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testpb_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*All); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testpb_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testpb_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*AllSubset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testpb_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_testpb_proto_msgTypes[1].OneofWrappers = []any{
+		(*All_OMsg)(nil),
+		(*All_OStr)(nil),
+		(*All_OInt)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_testpb_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testpb_proto_goTypes,
+		DependencyIndexes: file_testpb_proto_depIdxs,
+		EnumInfos:         file_testpb_proto_enumTypes,
+		MessageInfos:      file_testpb_proto_msgTypes,
+	}.Build()
+	File_testpb_proto = out.File
+	file_testpb_proto_rawDesc = nil
+	file_testpb_proto_goTypes = nil
+	file_testpb_proto_depIdxs = nil
+}
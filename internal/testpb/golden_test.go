@@ -0,0 +1,171 @@
+package testpb
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aggronmagi/protoc-gen-gopb/gopb"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type message interface {
+	MarshalObject() ([]byte, error)
+	UnmarshalObject(data []byte) error
+}
+
+// checkGolden 检查生成的消息 x 与 protobuf-go 的消息 want 的 wire 格式互通:
+// x 序列化后由 protobuf-go 解析等于 want, want 序列化后由空消息 y 解析,
+// 再序列化由 protobuf-go 解析仍然等于 want.
+func checkGolden(t *testing.T, x, y message, want proto.Message) {
+	t.Helper()
+	data, err := x.MarshalObject()
+	if err != nil {
+		t.Fatalf("MarshalObject: %v", err)
+	}
+	got := want.ProtoReflect().New().Interface()
+	if err := proto.Unmarshal(data, got); err != nil {
+		t.Fatalf("proto.Unmarshal: %v", err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("MarshalObject = %v, want %v", got, want)
+	}
+
+	data, err = proto.Marshal(want)
+	if err != nil {
+		t.Fatalf("proto.Marshal: %v", err)
+	}
+	if err := y.UnmarshalObject(data); err != nil {
+		t.Fatalf("UnmarshalObject: %v", err)
+	}
+	if data, err = y.MarshalObject(); err != nil {
+		t.Fatalf("MarshalObject: %v", err)
+	}
+	got = want.ProtoReflect().New().Interface()
+	if err := proto.Unmarshal(data, got); err != nil {
+		t.Fatalf("proto.Unmarshal: %v", err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("UnmarshalObject then MarshalObject = %v, want %v", got, want)
+	}
+}
+
+func TestGoldenAll(t *testing.T) {
+	x := &All{
+		FInt32: -1, FInt64: math.MinInt64, FString: "s", FBytes: []byte{0, 1}, FBool: true, FDouble: 1.5, FEnum: Color_GREEN,
+		FMsg:    &Inner{Id: 1, Child: &Inner{Name: "c", Nums: []int32{1, -1}}},
+		FUint32: math.MaxUint32, FUint64: math.MaxUint64, FSint32: -2, FSint64: math.MinInt64,
+		FFixed32: 3, FFixed64: 4, FSfixed32: -5, FSfixed64: -6, FFloat: -0.5,
+		RInt32: []int32{1, -1, 0}, RString: []string{"a", ""}, RMsg: []*Inner{{Id: 1}, {}},
+		RBytes: [][]byte{{1}, {}}, RDouble: []float64{1, math.Inf(-1)}, REnum: []Color{Color_RED, 9},
+		RSint64: []int64{-1, 1}, RUnpacked: []int32{7, -7},
+		MStrInt: map[string]int32{"a": 1, "": 0},
+		MIntStr: map[int32]string{-1: "x"},
+		MStrMsg: map[string]*Inner{"m": {Name: "n"}},
+		O:       &All_OMsg{OMsg: &Inner{Id: 2}},
+	}
+	want := &golden.All{
+		FInt32: -1, FInt64: math.MinInt64, FString: "s", FBytes: []byte{0, 1}, FBool: true, FDouble: 1.5, FEnum: golden.Color_GREEN,
+		FMsg:    &golden.Inner{Id: 1, Child: &golden.Inner{Name: "c", Nums: []int32{1, -1}}},
+		FUint32: math.MaxUint32, FUint64: math.MaxUint64, FSint32: -2, FSint64: math.MinInt64,
+		FFixed32: 3, FFixed64: 4, FSfixed32: -5, FSfixed64: -6, FFloat: -0.5,
+		RInt32: []int32{1, -1, 0}, RString: []string{"a", ""}, RMsg: []*golden.Inner{{Id: 1}, {}},
+		RBytes: [][]byte{{1}, {}}, RDouble: []float64{1, math.Inf(-1)}, REnum: []golden.Color{golden.Color_RED, 9},
+		RSint64: []int64{-1, 1}, RUnpacked: []int32{7, -7},
+		MStrInt: map[string]int32{"a": 1, "": 0},
+		MIntStr: map[int32]string{-1: "x"},
+		MStrMsg: map[string]*golden.Inner{"m": {Name: "n"}},
+		O:       &golden.All_OMsg{OMsg: &golden.Inner{Id: 2}},
+	}
+	checkGolden(t, x, &All{}, want)
+}
+
+func TestGoldenOneof(t *testing.T) {
+	tests := []struct {
+		name string
+		x    message
+		want proto.Message
+		y    message
+	}{
+		{"proto3 empty message", &All{O: &All_OMsg{OMsg: &Inner{}}}, &golden.All{O: &golden.All_OMsg{OMsg: &golden.Inner{}}}, &All{}},
+		{"proto3 empty string", &All{O: &All_OStr{}}, &golden.All{O: &golden.All_OStr{}}, &All{}},
+		{"proto3 zero int", &All{O: &All_OInt{}}, &golden.All{O: &golden.All_OInt{}}, &All{}},
+		{"proto3 unset", &All{}, &golden.All{}, &All{}},
+		{"proto2 zero int", &P2Oneof{O: &P2Oneof_A{}}, &golden.P2Oneof{O: &golden.P2Oneof_A{}}, &P2Oneof{}},
+		{"proto2 string", &P2Oneof{O: &P2Oneof_B{B: "b"}, R: []int32{1}}, &golden.P2Oneof{O: &golden.P2Oneof_B{B: "b"}, R: []int32{1}}, &P2Oneof{}},
+		{"proto2 enum", &P2Oneof{O: &P2Oneof_Lv{Lv: Level_LEVEL_ZERO}}, &golden.P2Oneof{O: &golden.P2Oneof_Lv{Lv: golden.Level_LEVEL_ZERO}}, &P2Oneof{}},
+		{
+			"proto2 nested",
+			&P2Oneof{O: &P2Oneof_Msg{Msg: &P2Oneof{O: &P2Oneof_A{A: -1}}}},
+			&golden.P2Oneof{O: &golden.P2Oneof_Msg{Msg: &golden.P2Oneof{O: &golden.P2Oneof_A{A: -1}}}},
+			&P2Oneof{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkGolden(t, tt.x, tt.y, tt.want)
+		})
+	}
+}
+
+// 同一个 oneof 的多个成员出现在 wire 数据中时, 最后一个生效
+func TestOneofLastWins(t *testing.T) {
+	data := protowire.AppendTag(nil, 41, protowire.BytesType)
+	data = protowire.AppendString(data, "s")
+	data = protowire.AppendTag(data, 42, protowire.VarintType)
+	data = protowire.AppendVarint(data, 5)
+
+	want := &golden.All{}
+	if err := proto.Unmarshal(data, want); err != nil {
+		t.Fatal(err)
+	}
+	got := &All{}
+	if err := got.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := got.O.(*All_OInt); !ok || got.GetOInt() != want.GetOInt() || got.GetOStr() != want.GetOStr() {
+		t.Errorf("UnmarshalObject = %v, want %v", got.O, want.O)
+	}
+}
+
+// sampleAll 返回设置了各类字段的消息及对应的 protobuf-go 消息
+func sampleAll(t *testing.T) (*All, *golden.All) {
+	t.Helper()
+	ts := time.Unix(1, 2).UTC()
+	dur := time.Second
+	any, err := gopb.MarshalAny(&Inner{Id: 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := &All{
+		FInt32: 1, FString: "s", FBytes: []byte{1, 2}, FEnum: Color_RED, PInt32: ptr[int32](0),
+		FMsg:    &Inner{Id: 1, Nums: []int32{1}, Child: &Inner{Name: "c"}},
+		RString: []string{"a"}, RMsg: []*Inner{{Id: 2}, {Child: &Inner{}}}, RBytes: [][]byte{{3}},
+		MStrInt: map[string]int32{"a": 1}, MStrMsg: map[string]*Inner{"m": {Nums: []int32{2}}},
+		MTs: map[string]*time.Time{"t": &ts}, MBytes: map[string][]byte{"b": {4}},
+		O:    &All_OMsg{OMsg: &Inner{Name: "o"}},
+		FAny: any, FTs: &ts, FDur: &dur, FSv: ptr("v"), FBv: []byte{5},
+		RTs: []*time.Time{&ts}, PBytes: []byte{6},
+	}
+	wantAny, err := anypb.New(&golden.Inner{Id: 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &golden.All{
+		FInt32: 1, FString: "s", FBytes: []byte{1, 2}, FEnum: golden.Color_RED, PInt32: proto.Int32(0),
+		FMsg:    &golden.Inner{Id: 1, Nums: []int32{1}, Child: &golden.Inner{Name: "c"}},
+		RString: []string{"a"}, RMsg: []*golden.Inner{{Id: 2}, {Child: &golden.Inner{}}}, RBytes: [][]byte{{3}},
+		MStrInt: map[string]int32{"a": 1}, MStrMsg: map[string]*golden.Inner{"m": {Nums: []int32{2}}},
+		MTs: map[string]*timestamppb.Timestamp{"t": timestamppb.New(ts)}, MBytes: map[string]*wrapperspb.BytesValue{"b": wrapperspb.Bytes([]byte{4})},
+		O:    &golden.All_OMsg{OMsg: &golden.Inner{Name: "o"}},
+		FAny: wantAny, FTs: timestamppb.New(ts), FDur: durationpb.New(dur), FSv: wrapperspb.String("v"), FBv: wrapperspb.Bytes([]byte{5}),
+		RTs: []*timestamppb.Timestamp{timestamppb.New(ts)}, PBytes: []byte{6},
+	}
+	return x, want
+}
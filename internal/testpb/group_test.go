@@ -0,0 +1,52 @@
+package testpb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGoldenGroup(t *testing.T) {
+	x := &P2Group{
+		G:     &P2Group_G{A: ptr[int32](1), B: ptr("b")},
+		Rg:    []*P2Group_RG{{C: ptr[int32](2)}, {}, {Nested: &P2Group{G: &P2Group_G{}, After: ptr[int32](3)}}},
+		After: ptr[int32](4),
+	}
+	want := &golden.P2Group{
+		G:     &golden.P2Group_G{A: proto.Int32(1), B: proto.String("b")},
+		Rg:    []*golden.P2Group_RG{{C: proto.Int32(2)}, {}, {Nested: &golden.P2Group{G: &golden.P2Group_G{}, After: proto.Int32(3)}}},
+		After: proto.Int32(4),
+	}
+	checkGolden(t, x, &P2Group{}, want)
+
+	// 未知的 group 整体保留
+	data, err := proto.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y := &Empty{}
+	if err := y.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := y.MarshalObject(); !bytes.Equal(got, data) {
+		t.Errorf("MarshalObject = %x, want %x", got, data)
+	}
+}
+
+// 缺少 EndGroup 或 EndGroup 字段号不匹配时返回错误
+func TestUnmarshalGroupError(t *testing.T) {
+	data, err := proto.Marshal(&golden.P2Group{G: &golden.P2Group_G{A: proto.Int32(1)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := (&P2Group{}).UnmarshalObject(data[:len(data)-1]); err == nil {
+		t.Error("UnmarshalObject without EndGroup = nil, want error")
+	}
+	bad := append([]byte(nil), data...)
+	bad[len(bad)-1] = 0x24 // EndGroup 4
+	if err := (&P2Group{}).UnmarshalObject(bad); err == nil {
+		t.Error("UnmarshalObject with mismatched EndGroup = nil, want error")
+	}
+}
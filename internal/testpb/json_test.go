@@ -0,0 +1,160 @@
+package testpb
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type jsonMessage interface {
+	message
+	MarshalJSON() ([]byte, error)
+	UnmarshalJSON(data []byte) error
+}
+
+// checkGoldenJSON 检查 x 与 protobuf-go 的消息 want 的 JSON 格式互通
+func checkGoldenJSON(t *testing.T, x, y jsonMessage, want proto.Message) {
+	t.Helper()
+	data, err := x.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	got := want.ProtoReflect().New().Interface()
+	if err := protojson.Unmarshal(data, got); err != nil {
+		t.Fatalf("protojson.Unmarshal(%s): %v", data, err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("MarshalJSON = %s, want %v", data, want)
+	}
+
+	for _, opts := range []protojson.MarshalOptions{{}, {UseProtoNames: true, UseEnumNumbers: true}} {
+		data, err = opts.Marshal(want)
+		if err != nil {
+			t.Fatalf("protojson.Marshal: %v", err)
+		}
+		if err := y.UnmarshalJSON(data); err != nil {
+			t.Fatalf("UnmarshalJSON(%s): %v", data, err)
+		}
+		if got := toGolden(t, y, want); !proto.Equal(got, want) {
+			t.Errorf("UnmarshalJSON(%s) = %v, want %v", data, got, want)
+		}
+	}
+}
+
+func TestGoldenJSON(t *testing.T) {
+	x, want := sampleAll(t)
+	checkGoldenJSON(t, x, &All{}, want)
+
+	special := &All{
+		FInt64: math.MinInt64, FUint64: math.MaxUint64, FDouble: math.Inf(-1), FFloat: float32(math.NaN()),
+		FBytes: []byte{0xfb, 0xff}, RDouble: []float64{math.Inf(1), -0.5}, FEnum: 9,
+		MIntStr: map[int32]string{-1: "a"}, O: &All_OInt{},
+	}
+	checkGoldenJSON(t, special, &All{}, &golden.All{
+		FInt64: math.MinInt64, FUint64: math.MaxUint64, FDouble: math.Inf(-1), FFloat: float32(math.NaN()),
+		FBytes: []byte{0xfb, 0xff}, RDouble: []float64{math.Inf(1), -0.5}, FEnum: 9,
+		MIntStr: map[int32]string{-1: "a"}, O: &golden.All_OInt{},
+	})
+
+	checkGoldenJSON(t, &P2Opt{I32: ptr[int32](0), Lv: ptr(Level_HIGH), M: map[string]Level{"a": Level_LOW}, Msg: &P2Opt{}},
+		&P2Opt{}, &golden.P2Opt{I32: proto.Int32(0), Lv: golden.Level_HIGH.Enum(), M: map[string]golden.Level{"a": golden.Level_LOW}, Msg: &golden.P2Opt{}})
+	checkGoldenJSON(t, &Edition{Req: ptr[int32](1), Closed: ptr(EdClosed_ED_CLOSED_B), Delimited: &Edition_Child{A: ptr[int32](1)}},
+		&Edition{}, &golden.Edition{Req: proto.Int32(1), Closed: golden.EdClosed_ED_CLOSED_B.Enum(), Delimited: &golden.Edition_Child{A: proto.Int32(1)}})
+}
+
+// protojson 接受的其他输入格式
+func TestUnmarshalJSONInput(t *testing.T) {
+	for _, data := range []string{
+		`{"fInt64":1,"f_uint64":"2","fDouble":"NaN","fBytes":"-_8","fEnum":"RED","rInt32":[],"fMsg":null}`,
+		`{"fInt64":"1","fUint64":2e0,"fDouble":"NaN","fBytes":"+/8=","fEnum":1,"mStrInt":{}}`,
+	} {
+		want := &golden.All{}
+		if err := protojson.Unmarshal([]byte(data), want); err != nil {
+			t.Fatalf("protojson.Unmarshal(%s): %v", data, err)
+		}
+		x := &All{}
+		if err := x.UnmarshalJSON([]byte(data)); err != nil {
+			t.Fatalf("UnmarshalJSON(%s): %v", data, err)
+		}
+		if got := toGolden(t, x, want); !proto.Equal(got, want) {
+			t.Errorf("UnmarshalJSON(%s) = %v, want %v", data, got, want)
+		}
+	}
+}
+
+func TestUnmarshalJSONReject(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{"duplicate field", `{"fInt32":1,"fInt32":2}`, `duplicate field "fInt32"`},
+		{"duplicate proto name", `{"fInt32":1,"f_int32":2}`, `duplicate field "f_int32"`},
+		{"duplicate null", `{"fMsg":null,"fMsg":{}}`, `duplicate field "fMsg"`},
+		{"duplicate list", `{"rInt32":[1],"rInt32":[2]}`, `duplicate field "rInt32"`},
+		{"duplicate oneof member", `{"oStr":"a","oStr":"b"}`, `duplicate field "oStr"`},
+		{"oneof conflict", `{"oStr":"a","oInt":1}`, `oneof gopb.testpb.All.o is already set`},
+		{"oneof conflict message", `{"oMsg":{},"o_str":"a"}`, `oneof gopb.testpb.All.o is already set`},
+		{"duplicate map key", `{"mStrInt":{"a":1,"a":2}}`, `duplicate map key "a"`},
+		{"duplicate int map key", `{"mIntStr":{"1":"a","1":"b"}}`, `duplicate map key "1"`},
+		{"nested duplicate", `{"fMsg":{"id":1,"id":2}}`, `duplicate field "id"`},
+		{"unknown null", `{"unknown":null}`, `unknown field "unknown"`},
+		{"lone high surrogate", `{"fString":"\ud800"}`, `invalid surrogate`},
+		{"lone low surrogate", `{"fString":"a\udc00b"}`, `invalid surrogate`},
+		{"reversed surrogates", `{"fString":"\udc00\ud800"}`, `invalid surrogate`},
+		{"high surrogate then char", `{"fString":"\ud800\u0041"}`, `invalid surrogate`},
+		{"surrogate in list", `{"rString":["\ud83d"]}`, `invalid surrogate`},
+		{"surrogate map key", `{"mStrInt":{"\udfff":1}}`, `invalid surrogate`},
+		{"surrogate field name", `{"\ud800":1}`, `invalid surrogate`},
+		{"invalid utf8", "{\"fString\":\"a\xffb\"}", `invalid UTF-8`},
+		{"invalid utf8 after escape", "{\"fString\":\"\\n\xc3\"}", `invalid UTF-8`},
+		{"invalid utf8 map key", "{\"mStrInt\":{\"\xff\":1}}", `invalid UTF-8`},
+		{"invalid utf8 nested", "{\"fMsg\":{\"name\":\"\xed\xa0\x80\"}}", `invalid UTF-8`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&All{}).UnmarshalJSON([]byte(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("UnmarshalJSON(%s) = %v, want %q", tt.data, err, tt.err)
+			}
+			if err := protojson.Unmarshal([]byte(tt.data), &golden.All{}); err == nil {
+				t.Errorf("protojson.Unmarshal(%s) = nil, want error", tt.data)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONAccept(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want *All
+	}{
+		{"oneof null", `{"oStr":null,"oInt":1}`, &All{O: &All_OInt{OInt: 1}}},
+		{"fields", `{"fInt32":1,"f_string":"a","mStrInt":{"a":1,"b":2}}`, &All{FInt32: 1, FString: "a", MStrInt: map[string]int32{"a": 1, "b": 2}}},
+		{"surrogate pair", `{"fString":"\ud83d\ude00","mStrInt":{"\u00e9":1}}`, &All{FString: "\U0001F600", MStrInt: map[string]int32{"é": 1}}},
+		{"sibling messages", `{"rMsg":[{"id":1},{"id":1}],"fMsg":{"id":2}}`, &All{RMsg: []*Inner{{Id: 1}, {Id: 1}}, FMsg: &Inner{Id: 2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &All{}
+			if err := got.UnmarshalJSON([]byte(tt.data)); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("UnmarshalJSON(%s) = %v, want %v", tt.data, got, tt.want)
+			}
+			want := &golden.All{}
+			if err := protojson.Unmarshal([]byte(tt.data), want); err != nil {
+				t.Fatal(err)
+			}
+			if m := toGolden(t, got, want); !proto.Equal(m, want) {
+				t.Errorf("UnmarshalJSON(%s) = %v, want %v", tt.data, m, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package testpb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/gopb"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/proto"
+)
+
+// nestedNode 返回 depth 层 child 嵌套的消息数据, 最外层消息的深度为1
+func nestedNode(t testing.TB, depth int) []byte {
+	var x *Node
+	for i := 0; i < depth; i++ {
+		x = &Node{V: int64(i), Child: x}
+	}
+	data, err := x.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func checkLimitError(t *testing.T, err error, field string) {
+	t.Helper()
+	var de *gopb.DecodeError
+	if !errors.As(err, &de) || !errors.Is(err, gopb.ErrLimit) {
+		t.Fatalf("UnmarshalObjectWith = %v, want *gopb.DecodeError", err)
+	}
+	if de.Kind != gopb.KindLimit || de.Field != field {
+		t.Errorf("UnmarshalObjectWith = %+v, want field %q", de, field)
+	}
+}
+
+func TestLimitDepth(t *testing.T) {
+	data := nestedNode(t, 5)
+	if err := (&Node{}).UnmarshalObjectWith(data, gopb.UnmarshalOptions{MaxDepth: 5}); err != nil {
+		t.Fatal(err)
+	}
+	err := (&Node{}).UnmarshalObjectWith(data, gopb.UnmarshalOptions{MaxDepth: 4})
+	checkLimitError(t, err, "child.child.child.child")
+
+	// 默认深度与 protobuf-go 一致, UnmarshalObject 同样限制
+	data = nestedNode(t, gopb.DefaultMaxDepth)
+	if err := (&Node{}).UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.Unmarshal(data, &golden.Node{}); err != nil {
+		t.Fatal(err)
+	}
+	data = nestedNode(t, gopb.DefaultMaxDepth+1)
+	if err := (&Node{}).UnmarshalObject(data); !errors.Is(err, gopb.ErrLimit) {
+		t.Errorf("UnmarshalObject = %v, want ErrLimit", err)
+	}
+	if err := proto.Unmarshal(data, &golden.Node{}); err == nil {
+		t.Error("proto.Unmarshal = nil, want error")
+	}
+
+	// group 同样计算深度
+	g := &P2Group{}
+	for i := 0; i < 3; i++ {
+		g = &P2Group{Rg: []*P2Group_RG{{Nested: g}}}
+	}
+	data, err = g.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := (&P2Group{}).UnmarshalObjectWith(data, gopb.UnmarshalOptions{MaxDepth: 7}); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&P2Group{}).UnmarshalObjectWith(data, gopb.UnmarshalOptions{MaxDepth: 6}); !errors.Is(err, gopb.ErrLimit) {
+		t.Errorf("UnmarshalObjectWith = %v, want ErrLimit", err)
+	}
+}
+
+func TestLimitElements(t *testing.T) {
+	x := &Node{
+		Kids: []*Node{{}, {Kids: []*Node{{}}}},
+		M:    map[string]*Node{"a": {}},
+	}
+	data, err := x.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 消息5个, 列表元素3个, map 条目1个
+	y := &Node{}
+	if err := y.UnmarshalObjectWith(data, gopb.UnmarshalOptions{MaxElements: 9}); err != nil {
+		t.Fatal(err)
+	}
+	if !y.Equal(x) {
+		t.Errorf("UnmarshalObjectWith = %v, want %v", y, x)
+	}
+	if err := (&Node{}).UnmarshalObjectWith(data, gopb.UnmarshalOptions{MaxElements: 8}); !errors.Is(err, gopb.ErrLimit) {
+		t.Errorf("UnmarshalObjectWith = %v, want ErrLimit", err)
+	}
+}
+
+func TestLimitLength(t *testing.T) {
+	x := &Node{Kids: []*Node{{}, {}, {}}, M: map[string]*Node{"a": {}, "b": {}}}
+	data, err := x.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := (&Node{}).UnmarshalObjectWith(data, gopb.UnmarshalOptions{MaxLength: 3}); err != nil {
+		t.Fatal(err)
+	}
+	err = (&Node{}).UnmarshalObjectWith(data, gopb.UnmarshalOptions{MaxLength: 2})
+	checkLimitError(t, err, "kids")
+
+	x = &Node{M: map[string]*Node{"a": {}, "b": {}, "c": {}}}
+	data, err = x.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = (&Node{}).UnmarshalObjectWith(data, gopb.UnmarshalOptions{MaxLength: 2})
+	checkLimitError(t, err, "m")
+
+	// packed 列表按元素计算长度
+	y := &All{RInt32: []int32{1, 2, 3}}
+	data, err = y.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := (&All{}).UnmarshalObjectWith(data, gopb.UnmarshalOptions{MaxLength: 3}); err != nil {
+		t.Fatal(err)
+	}
+	err = (&All{}).UnmarshalObjectWith(data, gopb.UnmarshalOptions{MaxLength: 2})
+	checkLimitError(t, err, "r_int32")
+}
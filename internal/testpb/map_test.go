@@ -0,0 +1,129 @@
+package testpb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/wiretest"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestUnmarshalMapEntry(t *testing.T) {
+	epoch := time.Unix(0, 0).UTC()
+	tests := []struct {
+		name string
+		data [][]byte
+		want *All
+	}{
+		{
+			name: "unknown field skipped",
+			data: [][]byte{wiretest.Bytes(30, wiretest.String(1, "a"), wiretest.Varint(3, 9), wiretest.Fixed32(4, 1), wiretest.Varint(2, 1))},
+			want: &All{MStrInt: map[string]int32{"a": 1}},
+		},
+		{
+			name: "unknown message field skipped",
+			data: [][]byte{wiretest.Bytes(32, wiretest.Bytes(3, wiretest.Varint(1, 1)), wiretest.String(1, "a"), wiretest.Bytes(2, wiretest.Varint(1, 2)))},
+			want: &All{MStrMsg: map[string]*Inner{"a": {Id: 2}}},
+		},
+		{
+			name: "value wire type skipped",
+			data: [][]byte{wiretest.Bytes(30, wiretest.String(1, "a"), wiretest.Fixed32(2, 1))},
+			want: &All{MStrInt: map[string]int32{"a": 0}},
+		},
+		{
+			name: "key wire type skipped",
+			data: [][]byte{wiretest.Bytes(30, wiretest.Varint(1, 1), wiretest.Varint(2, 1))},
+			want: &All{MStrInt: map[string]int32{"": 1}},
+		},
+		{
+			name: "message value wire type skipped",
+			data: [][]byte{wiretest.Bytes(32, wiretest.String(1, "a"), wiretest.Varint(2, 1))},
+			want: &All{MStrMsg: map[string]*Inner{"a": {}}},
+		},
+		{
+			name: "missing message value",
+			data: [][]byte{wiretest.Bytes(32, wiretest.String(1, "a"))},
+			want: &All{MStrMsg: map[string]*Inner{"a": {}}},
+		},
+		{
+			name: "missing wkt values",
+			data: [][]byte{
+				wiretest.Bytes(33, wiretest.String(1, "a")),
+				wiretest.Bytes(34, wiretest.String(1, "a")),
+				wiretest.Bytes(35, wiretest.String(1, "a")),
+				wiretest.Bytes(36, wiretest.String(1, "a")),
+			},
+			want: &All{
+				MTs:    map[string]*time.Time{"a": &epoch},
+				MDur:   map[string]*time.Duration{"a": new(time.Duration)},
+				MI32:   map[string]*int32{"a": new(int32)},
+				MBytes: map[string][]byte{"a": {}},
+			},
+		},
+		{
+			name: "missing keys",
+			data: [][]byte{wiretest.Bytes(31, wiretest.String(2, "x")), wiretest.Bytes(31, wiretest.String(2, "y"))},
+			want: &All{MIntStr: map[int32]string{0: "y"}},
+		},
+		{
+			name: "missing string keys",
+			data: [][]byte{wiretest.Bytes(30, wiretest.Varint(2, 1)), wiretest.Bytes(30, wiretest.Varint(2, 2))},
+			want: &All{MStrInt: map[string]int32{"": 2}},
+		},
+		{
+			name: "empty entry",
+			data: [][]byte{wiretest.Bytes(31)},
+			want: &All{MIntStr: map[int32]string{0: ""}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data []byte
+			for _, b := range tt.data {
+				data = append(data, b...)
+			}
+			got := &All{}
+			if err := got.UnmarshalObject(data); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("UnmarshalObject = %v, want %v", got, tt.want)
+			}
+			// 与 protobuf-go 的解析结果一致
+			want := &golden.All{}
+			if err := proto.Unmarshal(data, want); err != nil {
+				t.Fatal(err)
+			}
+			if m := toGolden(t, got, want); !proto.Equal(m, want) {
+				t.Errorf("UnmarshalObject = %v, want %v", m, want)
+			}
+			// 缺少值时也是非nil的空消息
+			for k, v := range got.MStrMsg {
+				if v == nil {
+					t.Errorf("MStrMsg[%q] = nil", k)
+				}
+			}
+			for k, v := range got.MTs {
+				if v == nil || !v.Equal(epoch) {
+					t.Errorf("MTs[%q] = %v, want %v", k, v, epoch)
+				}
+			}
+			for k, v := range got.MDur {
+				if v == nil || *v != 0 {
+					t.Errorf("MDur[%q] = %v, want 0", k, v)
+				}
+			}
+			for k, v := range got.MI32 {
+				if v == nil || *v != 0 {
+					t.Errorf("MI32[%q] = %v, want 0", k, v)
+				}
+			}
+			for k, v := range got.MBytes {
+				if v == nil || len(v) != 0 {
+					t.Errorf("MBytes[%q] = %v, want empty", k, v)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package testpb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/proto"
+)
+
+// Merge 与 proto.Merge 的结果一致, 不调用 Reset 直接 UnmarshalObject 与 Merge 结果一致.
+func TestUnmarshalMerge(t *testing.T) {
+	ts1 := time.Unix(100, 1).UTC()
+	ts2 := time.Unix(200, 2).UTC()
+	tests := []struct {
+		name string
+		dst  *All
+		src  *All
+		want *All
+	}{
+		{
+			name: "scalar",
+			dst:  &All{FInt32: 1, FInt64: 2, FString: "a", FBytes: []byte("a"), PInt32: ptr[int32](5), FTs: &ts1},
+			src:  &All{FInt64: 3, FString: "b", FBool: true, PInt32: ptr[int32](0), FTs: &ts2},
+			want: &All{FInt32: 1, FInt64: 3, FString: "b", FBytes: []byte("a"), FBool: true, PInt32: ptr[int32](0), FTs: &ts2},
+		},
+		{
+			// 存在性字段的零值也覆盖
+			name: "presence",
+			dst:  &All{PString: ptr("a"), PBytes: []byte("a"), FSv: ptr("a")},
+			src:  &All{PString: ptr(""), PBytes: []byte{}, FBv: []byte{}, FI64: ptr[int64](0), FDur: new(time.Duration)},
+			want: &All{PString: ptr(""), PBytes: []byte{}, FBv: []byte{}, FI64: ptr[int64](0), FSv: ptr("a"), FDur: new(time.Duration)},
+		},
+		{
+			name: "list",
+			dst:  &All{RInt32: []int32{1, 2}, RString: []string{"a"}, RMsg: []*Inner{{Id: 1}}},
+			src:  &All{RInt32: []int32{3}, RString: []string{"b", "c"}, RMsg: []*Inner{{Id: 2}, {}}},
+			want: &All{RInt32: []int32{1, 2, 3}, RString: []string{"a", "b", "c"}, RMsg: []*Inner{{Id: 1}, {Id: 2}, {}}},
+		},
+		{
+			name: "map",
+			dst: &All{
+				MStrInt: map[string]int32{"a": 1, "b": 2},
+				MStrMsg: map[string]*Inner{"a": {Id: 1, Name: "a"}, "b": {Id: 2}},
+				MTs:     map[string]*time.Time{"a": &ts1},
+			},
+			src: &All{
+				MStrInt: map[string]int32{"b": 3, "c": 4},
+				MStrMsg: map[string]*Inner{"a": {Name: "x"}},
+				MTs:     map[string]*time.Time{"a": &ts2, "b": &ts1},
+			},
+			want: &All{
+				MStrInt: map[string]int32{"a": 1, "b": 3, "c": 4},
+				MStrMsg: map[string]*Inner{"a": {Name: "x"}, "b": {Id: 2}},
+				MTs:     map[string]*time.Time{"a": &ts2, "b": &ts1},
+			},
+		},
+		{
+			name: "message",
+			dst:  &All{FMsg: &Inner{Id: 1, Nums: []int32{1}, Child: &Inner{Name: "c"}}},
+			src:  &All{FMsg: &Inner{Name: "n", Nums: []int32{2}, Child: &Inner{Id: 3}}},
+			want: &All{FMsg: &Inner{Id: 1, Name: "n", Nums: []int32{1, 2}, Child: &Inner{Id: 3, Name: "c"}}},
+		},
+		{
+			name: "oneof same message",
+			dst:  &All{O: &All_OMsg{OMsg: &Inner{Id: 1, Nums: []int32{1}}}},
+			src:  &All{O: &All_OMsg{OMsg: &Inner{Name: "n", Nums: []int32{2}}}},
+			want: &All{O: &All_OMsg{OMsg: &Inner{Id: 1, Name: "n", Nums: []int32{1, 2}}}},
+		},
+		{
+			name: "oneof other member",
+			dst:  &All{O: &All_OStr{OStr: "s"}},
+			src:  &All{O: &All_OMsg{OMsg: &Inner{Id: 1}}},
+			want: &All{O: &All_OMsg{OMsg: &Inner{Id: 1}}},
+		},
+		{
+			name: "oneof message replaced",
+			dst:  &All{O: &All_OMsg{OMsg: &Inner{Id: 1}}},
+			src:  &All{O: &All_OInt{OInt: 2}},
+			want: &All{O: &All_OInt{OInt: 2}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.src.MarshalObject()
+			if err != nil {
+				t.Fatal(err)
+			}
+			y := &All{}
+			if err := y.UnmarshalObject(data); err != nil {
+				t.Fatal(err)
+			}
+			merged := tt.dst.Clone()
+			merged.Merge(y)
+			if !merged.Equal(tt.want) {
+				t.Errorf("Merge = %v, want %v", merged, tt.want)
+			}
+			gm := toGolden(t, tt.dst, &golden.All{})
+			proto.Merge(gm, toGolden(t, tt.src, &golden.All{}))
+			if got := toGolden(t, merged, &golden.All{}); !proto.Equal(got, gm) {
+				t.Errorf("Merge = %v, proto.Merge = %v", got, gm)
+			}
+
+			got := tt.dst.Clone()
+			if err := got.UnmarshalObject(data); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(merged) {
+				t.Errorf("UnmarshalObject = %v, Merge = %v", got, merged)
+			}
+		})
+	}
+}
+
+// Merge 复制存在性字段的值, 不与 src 共享内存
+func TestMergePresenceCopies(t *testing.T) {
+	src := &All{PInt32: ptr[int32](1), PBytes: []byte{1}, FBv: []byte{2}, FI64: ptr[int64](3), FMsg: &Inner{Id: 4}}
+	x := &All{}
+	x.Merge(src)
+	*src.PInt32, src.PBytes[0], src.FBv[0], *src.FI64, src.FMsg.Id = 0, 0, 0, 0, 0
+	want := &All{PInt32: ptr[int32](1), PBytes: []byte{1}, FBv: []byte{2}, FI64: ptr[int64](3), FMsg: &Inner{Id: 4}}
+	if !x.Equal(want) {
+		t.Errorf("Merge = %v, want %v", x, want)
+	}
+}
+
+func TestGoldenMergeProto2(t *testing.T) {
+	ext := &P2Ext{A: ptr[int32](1)}
+	if err := E_ERep.Set(ext, []int32{1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := E_EInt.Set(ext, 1); err != nil {
+		t.Fatal(err)
+	}
+	ext2 := &P2Ext{}
+	if err := E_ERep.Set(ext2, []int32{2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := E_EStr.Set(ext2, "s"); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		dst, src message
+		typ      proto.Message
+	}{
+		{
+			"presence", &P2Opt{I32: ptr[int32](1), S: ptr("a"), Msg: &P2Opt{D: ptr(1.0)}},
+			&P2Opt{I32: ptr[int32](0), B: []byte{}, Msg: &P2Opt{F: ptr[float32](2)}, R: []int32{1}}, &golden.P2Opt{},
+		},
+		{"extension", ext, ext2, &golden.P2Ext{}},
+		{
+			"group", &P2Group{G: &P2Group_G{A: ptr[int32](1)}, Rg: []*P2Group_RG{{}}},
+			&P2Group{G: &P2Group_G{B: ptr("b")}, Rg: []*P2Group_RG{{C: ptr[int32](1)}}}, &golden.P2Group{},
+		},
+		{"oneof", &P2Oneof{O: &P2Oneof_Msg{Msg: &P2Oneof{R: []int32{1}}}}, &P2Oneof{O: &P2Oneof_Msg{Msg: &P2Oneof{O: &P2Oneof_B{}}}}, &golden.P2Oneof{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := toGolden(t, tt.dst, tt.typ)
+			proto.Merge(want, toGolden(t, tt.src, tt.typ))
+			var merged message
+			switch dst := tt.dst.(type) {
+			case *P2Opt:
+				x := dst.Clone()
+				x.Merge(tt.src.(*P2Opt))
+				merged = x
+			case *P2Ext:
+				x := dst.Clone()
+				x.Merge(tt.src.(*P2Ext))
+				merged = x
+			case *P2Group:
+				x := dst.Clone()
+				x.Merge(tt.src.(*P2Group))
+				merged = x
+			case *P2Oneof:
+				x := dst.Clone()
+				x.Merge(tt.src.(*P2Oneof))
+				merged = x
+			}
+			if got := toGolden(t, merged, tt.typ); !proto.Equal(got, want) {
+				t.Errorf("Merge = %v, proto.Merge = %v", got, want)
+			}
+		})
+	}
+}
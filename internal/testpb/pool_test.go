@@ -0,0 +1,53 @@
+package testpb
+
+import (
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGoldenPool(t *testing.T) {
+	_, want := sampleAll(t)
+	data, err := proto.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		x := AcquireAll()
+		if size := x.MarshalSize(); size != 0 {
+			t.Fatalf("AcquireAll returned a message of size %d", size)
+		}
+		if err := x.UnmarshalObject(data); err != nil {
+			t.Fatal(err)
+		}
+		if got := toGolden(t, x, want); !proto.Equal(got, want) {
+			t.Errorf("UnmarshalObject = %v, want %v", got, want)
+		}
+		x.Release()
+	}
+
+	p := AcquireP2Group()
+	checkGolden(t, &P2Group{Rg: []*P2Group_RG{{Nested: &P2Group{}}}}, p, &golden.P2Group{Rg: []*golden.P2Group_RG{{Nested: &golden.P2Group{}}}})
+	p.Release()
+}
+
+// Release 清空消息, 列表保留容量, map 保留
+func TestRelease(t *testing.T) {
+	x, _ := sampleAll(t)
+	x.RInt32 = make([]int32, 3, 8)
+	rmsg, mstr := x.RMsg, x.MStrInt
+	x.Release()
+	if size := x.MarshalSize(); size != 0 {
+		t.Errorf("MarshalSize after Release = %d, want 0", size)
+	}
+	if cap(x.RInt32) != 8 || x.MStrInt == nil || len(x.MStrInt) != 0 || len(mstr) != 0 {
+		t.Errorf("Release = %+v, want list capacity and map kept", x)
+	}
+	for i, v := range rmsg {
+		if v != nil {
+			t.Errorf("RMsg[%d] = %v after Release, want nil", i, v)
+		}
+	}
+	(*All)(nil).Release()
+}
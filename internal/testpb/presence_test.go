@@ -0,0 +1,84 @@
+package testpb
+
+import (
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/proto"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestGoldenPresence(t *testing.T) {
+	tests := []struct {
+		name string
+		x    message
+		want proto.Message
+		y    message
+	}{
+		{
+			"proto3 zero values",
+			&All{PInt32: ptr[int32](0), PString: ptr(""), PBytes: []byte{}, PEnum: ptr(Color_COLOR_UNSPECIFIED), PDouble: ptr(0.0), PUint64: ptr[uint64](0)},
+			&golden.All{PInt32: proto.Int32(0), PString: proto.String(""), PBytes: []byte{}, PEnum: golden.Color_COLOR_UNSPECIFIED.Enum(), PDouble: proto.Float64(0), PUint64: proto.Uint64(0)},
+			&All{},
+		},
+		{
+			"proto3 values",
+			&All{PInt32: ptr[int32](-1), PString: ptr("s"), PBytes: []byte{1}, PEnum: ptr(Color_RED), PDouble: ptr(1.5), PUint64: ptr[uint64](1 << 63)},
+			&golden.All{PInt32: proto.Int32(-1), PString: proto.String("s"), PBytes: []byte{1}, PEnum: golden.Color_RED.Enum(), PDouble: proto.Float64(1.5), PUint64: proto.Uint64(1 << 63)},
+			&All{},
+		},
+		{
+			"proto2 zero values",
+			&P2Opt{I32: ptr[int32](0), I64: ptr[int64](0), S: ptr(""), B: []byte{}, Bl: ptr(false), D: ptr(0.0), F: ptr[float32](0), Lv: ptr(Level_LEVEL_ZERO), Msg: &P2Opt{}, S32: ptr[int32](0), F64: ptr[uint64](0)},
+			&golden.P2Opt{I32: proto.Int32(0), I64: proto.Int64(0), S: proto.String(""), B: []byte{}, Bl: proto.Bool(false), D: proto.Float64(0), F: proto.Float32(0), Lv: golden.Level_LEVEL_ZERO.Enum(), Msg: &golden.P2Opt{}, S32: proto.Int32(0), F64: proto.Uint64(0)},
+			&P2Opt{},
+		},
+		{
+			"proto2 values",
+			&P2Opt{
+				I32: ptr[int32](-1), S: ptr("s"), Lv: ptr(Level_HIGH), Msg: &P2Opt{I64: ptr[int64](2)}, S32: ptr[int32](-3),
+				R: []int32{1, 2}, Rp: []int32{3, -4}, M: map[string]Level{"a": Level_LOW, "": Level_LEVEL_ZERO},
+			},
+			&golden.P2Opt{
+				I32: proto.Int32(-1), S: proto.String("s"), Lv: golden.Level_HIGH.Enum(), Msg: &golden.P2Opt{I64: proto.Int64(2)}, S32: proto.Int32(-3),
+				R: []int32{1, 2}, Rp: []int32{3, -4}, M: map[string]golden.Level{"a": golden.Level_LOW, "": golden.Level_LEVEL_ZERO},
+			},
+			&P2Opt{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkGolden(t, tt.x, tt.y, tt.want)
+		})
+	}
+}
+
+// 显式存在性字段设置为零值时同样序列化, 解析后 Has 返回 true
+func TestPresenceHasClear(t *testing.T) {
+	data, err := proto.Marshal(&golden.P2Opt{I32: proto.Int32(0), S: proto.String(""), B: []byte{}, Lv: golden.Level_LEVEL_ZERO.Enum()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := &P2Opt{}
+	if err := x.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	if !x.HasI32() || !x.HasS() || !x.HasB() || !x.HasLv() {
+		t.Fatalf("UnmarshalObject = %+v, want zero values present", x)
+	}
+	if x.HasD() || x.HasMsg() || x.GetD() != 0 || x.GetMsg() != nil {
+		t.Errorf("UnmarshalObject = %+v, want d and msg absent", x)
+	}
+
+	x.ClearI32()
+	x.ClearS()
+	x.ClearB()
+	x.ClearLv()
+	if x.HasI32() || x.HasS() || x.HasB() || x.HasLv() {
+		t.Errorf("Clear = %+v, want all fields absent", x)
+	}
+	if size := x.MarshalSize(); size != 0 {
+		t.Errorf("MarshalSize after Clear = %d, want 0", size)
+	}
+}
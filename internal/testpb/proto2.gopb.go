@@ -0,0 +1,7692 @@
+// Code generated by protoc-gen-gopb. DO NOT EDIT.
+// Code generated by protoc-gen-gopb. DO NOT EDIT.
+// versions:
+// 	protoc-gen-gopb 0.0.5
+// 	protoc          (unknown)
+// source:  proto2.proto
+
+package testpb
+
+import (
+	bytes "bytes"
+	errors "errors"
+	fmt "fmt"
+	gopb "github.com/aggronmagi/protoc-gen-gopb/gopb"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	math "math"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+)
+
+type Level int32
+
+const (
+	Level_LEVEL_ZERO Level = 0
+	Level_LOW        Level = 1
+	Level_HIGH       Level = 2
+)
+
+// Enum value maps for Level.
+var (
+	Level_name = map[int32]string{
+		0: "LEVEL_ZERO",
+		1: "LOW",
+		2: "HIGH",
+	}
+	Level_value = map[string]int32{
+		"LEVEL_ZERO": 0,
+		"LOW":        1,
+		"HIGH":       2,
+	}
+)
+
+func (x Level) Enum() *Level {
+	p := new(Level)
+	*p = x
+	return p
+}
+
+func (x Level) String() string {
+	if name, ok := Level_name[int32(x)]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(x), 10)
+}
+
+type P2Oneof struct {
+	O             isP2Oneof_O `json:"o,omitempty"`
+	R             []int32     `json:"r,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2Oneof) Reset() {
+	*x = P2Oneof{}
+}
+
+var poolP2Oneof = sync.Pool{New: func() any { return &P2Oneof{} }}
+
+// AcquireP2Oneof returns an empty P2Oneof from the pool. call Release to put it back
+func AcquireP2Oneof() *P2Oneof {
+	return poolP2Oneof.Get().(*P2Oneof)
+}
+
+// Release resets x, releases its sub-messages and puts x back to the pool. x must not be used after Release.
+// 列表截断保留容量, map 清空后保留
+func (x *P2Oneof) Release() {
+	if x == nil {
+		return
+	}
+	if ov, ok := x.O.(*P2Oneof_Msg); ok {
+		ov.Msg.Release()
+	}
+	*x = P2Oneof{
+		R:             x.R[:0],
+		unknownFields: x.unknownFields[:0],
+	}
+	poolP2Oneof.Put(x)
+}
+
+type isP2Oneof_O interface {
+	isP2Oneof_O()
+	marshalOneofSize() int
+	marshalOneofTo(buf []byte, deterministic bool) ([]byte, error)
+	copyOneof() isP2Oneof_O
+	equalOneof(other isP2Oneof_O) bool
+	mergeOneof(dst isP2Oneof_O) isP2Oneof_O
+}
+
+type P2Oneof_A struct {
+	A int32 `json:"a,omitempty"`
+}
+
+func (*P2Oneof_A) isP2Oneof_O() {}
+
+func (x *P2Oneof_A) marshalOneofSize() (size int) {
+	// 1 = protowire.SizeTag(1)
+	size += 1 + protowire.SizeVarint(uint64(x.A))
+	return
+}
+
+func (x *P2Oneof_A) marshalOneofTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+	// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+	data = append(data, 0x8)
+	data = protowire.AppendVarint(data, uint64(x.A))
+	return
+}
+
+func (x *P2Oneof_A) copyOneof() isP2Oneof_O {
+	y := &P2Oneof_A{}
+	y.A = x.A
+	return y
+}
+
+func (x *P2Oneof_A) equalOneof(other isP2Oneof_O) bool {
+	y, ok := other.(*P2Oneof_A)
+	if !ok {
+		return false
+	}
+	if x.A != y.A {
+		return false
+	}
+	return true
+}
+
+func (x *P2Oneof_A) mergeOneof(dst isP2Oneof_O) isP2Oneof_O {
+	y, ok := dst.(*P2Oneof_A)
+	if !ok {
+		y = &P2Oneof_A{}
+	}
+	y.A = x.A
+	return y
+}
+
+type P2Oneof_B struct {
+	B string `json:"b,omitempty"`
+}
+
+func (*P2Oneof_B) isP2Oneof_O() {}
+
+func (x *P2Oneof_B) marshalOneofSize() (size int) {
+	// 1 = protowire.SizeTag(2)
+	size += 1 + protowire.SizeBytes(len(x.B))
+	return
+}
+
+func (x *P2Oneof_B) marshalOneofTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+
+	// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+	data = append(data, 0x12)
+	data = protowire.AppendString(data, x.B)
+	return
+}
+
+func (x *P2Oneof_B) copyOneof() isP2Oneof_O {
+	y := &P2Oneof_B{}
+	y.B = x.B
+	return y
+}
+
+func (x *P2Oneof_B) equalOneof(other isP2Oneof_O) bool {
+	y, ok := other.(*P2Oneof_B)
+	if !ok {
+		return false
+	}
+	if x.B != y.B {
+		return false
+	}
+	return true
+}
+
+func (x *P2Oneof_B) mergeOneof(dst isP2Oneof_O) isP2Oneof_O {
+	y, ok := dst.(*P2Oneof_B)
+	if !ok {
+		y = &P2Oneof_B{}
+	}
+	y.B = x.B
+	return y
+}
+
+type P2Oneof_Msg struct {
+	Msg *P2Oneof `json:"msg,omitempty"`
+}
+
+func (*P2Oneof_Msg) isP2Oneof_O() {}
+
+func (x *P2Oneof_Msg) marshalOneofSize() (size int) {
+	if x.Msg != nil {
+		// 1 = protowire.SizeTag(3)
+		size += 1 + protowire.SizeBytes(x.Msg.MarshalSize())
+	}
+	return
+}
+
+func (x *P2Oneof_Msg) marshalOneofTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+	if x.Msg != nil {
+		// data = protowire.AppendTag(data, 3, protowire.BytesType) => 00011010
+		data = append(data, 0x1a)
+		data = protowire.AppendVarint(data, uint64(x.Msg.MarshalSize()))
+		data, err = x.Msg.marshalTo(data, deterministic)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (x *P2Oneof_Msg) copyOneof() isP2Oneof_O {
+	y := &P2Oneof_Msg{}
+	if x.Msg != nil {
+		if y.Msg == nil {
+			y.Msg = &P2Oneof{}
+		}
+		y.Msg.CopyFrom(x.Msg)
+	} else {
+		y.Msg = nil
+	}
+	return y
+}
+
+func (x *P2Oneof_Msg) equalOneof(other isP2Oneof_O) bool {
+	y, ok := other.(*P2Oneof_Msg)
+	if !ok {
+		return false
+	}
+	if !x.Msg.Equal(y.Msg) {
+		return false
+	}
+	return true
+}
+
+func (x *P2Oneof_Msg) mergeOneof(dst isP2Oneof_O) isP2Oneof_O {
+	y, ok := dst.(*P2Oneof_Msg)
+	if !ok {
+		y = &P2Oneof_Msg{}
+	}
+	if x.Msg != nil {
+		if y.Msg == nil {
+			y.Msg = &P2Oneof{}
+		}
+		y.Msg.Merge(x.Msg)
+	}
+	return y
+}
+
+type P2Oneof_Lv struct {
+	Lv Level `json:"lv,omitempty"`
+}
+
+func (*P2Oneof_Lv) isP2Oneof_O() {}
+
+func (x *P2Oneof_Lv) marshalOneofSize() (size int) {
+	// 1 = protowire.SizeTag(4)
+	size += 1 + protowire.SizeVarint(uint64(x.Lv))
+	return
+}
+
+func (x *P2Oneof_Lv) marshalOneofTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+	// data = protowire.AppendTag(data, 4, protowire.VarintType) => 00100000
+	data = append(data, 0x20)
+	data = protowire.AppendVarint(data, uint64(x.Lv))
+	return
+}
+
+func (x *P2Oneof_Lv) copyOneof() isP2Oneof_O {
+	y := &P2Oneof_Lv{}
+	y.Lv = x.Lv
+	return y
+}
+
+func (x *P2Oneof_Lv) equalOneof(other isP2Oneof_O) bool {
+	y, ok := other.(*P2Oneof_Lv)
+	if !ok {
+		return false
+	}
+	if x.Lv != y.Lv {
+		return false
+	}
+	return true
+}
+
+func (x *P2Oneof_Lv) mergeOneof(dst isP2Oneof_O) isP2Oneof_O {
+	y, ok := dst.(*P2Oneof_Lv)
+	if !ok {
+		y = &P2Oneof_Lv{}
+	}
+	y.Lv = x.Lv
+	return y
+}
+
+func (x *P2Oneof) GetO() isP2Oneof_O {
+	if x != nil {
+		return x.O
+	}
+	return nil
+}
+
+func (x *P2Oneof) GetA() int32 {
+	if x, ok := x.GetO().(*P2Oneof_A); ok {
+		return x.A
+	}
+	return 0
+}
+
+func (x *P2Oneof) GetB() string {
+	if x, ok := x.GetO().(*P2Oneof_B); ok {
+		return x.B
+	}
+	return ""
+}
+
+func (x *P2Oneof) GetMsg() *P2Oneof {
+	if x, ok := x.GetO().(*P2Oneof_Msg); ok {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *P2Oneof) GetLv() Level {
+	if x, ok := x.GetO().(*P2Oneof_Lv); ok {
+		return x.Lv
+	}
+	return Level_LEVEL_ZERO
+}
+
+func (x *P2Oneof) GetR() []int32 {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Oneof) Clone() *P2Oneof {
+	if x == nil {
+		return nil
+	}
+	y := &P2Oneof{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Oneof) CopyFrom(src *P2Oneof) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.O != nil {
+		x.O = src.O.copyOneof()
+	} else {
+		x.O = nil
+	}
+	x.R = append(x.R[:0], src.R...)
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Oneof) Equal(other *P2Oneof) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.O == nil) != (other.O == nil) || x.O != nil && !x.O.equalOneof(other.O) {
+		return false
+	}
+	if len(x.R) != len(other.R) {
+		return false
+	}
+	for k := range x.R {
+		if x.R[k] != other.R[k] {
+			return false
+		}
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Oneof) Merge(src *P2Oneof) {
+	if src == nil {
+		return
+	}
+	if src.O != nil {
+		x.O = src.O.mergeOneof(x.O)
+	}
+	if len(src.R) > 0 {
+		x.R = append(x.R, src.R...)
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// XXX_MessageName returns the full name of P2Oneof
+func (x *P2Oneof) XXX_MessageName() string {
+	return "gopb.testpb.P2Oneof"
+}
+
+// ProtoReflect returns a protoreflect.Message view of x, which implements proto.Message.
+// proto.Marshal/proto.Unmarshal use MarshalObjectTo/UnmarshalObject
+func (x *P2Oneof) ProtoReflect() protoreflect.Message {
+	return file_proto2_proto_msgTypes[0].MessageOf(x)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Oneof) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, false)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Oneof) MarshalSize() (size int) {
+	if x.O != nil {
+		size += x.O.marshalOneofSize()
+	}
+	if len(x.R) > 0 {
+		// 1 = protowire.SizeTag(5)
+		size += 1 * len(x.R)
+		for k := 0; k < len(x.R); k++ {
+			size += protowire.SizeVarint(uint64(x.R[k]))
+		}
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Oneof) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, false)
+}
+
+// MarshalObjectDeterministic marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Oneof) MarshalObjectDeterministic() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, true)
+}
+
+// MarshalObjectDeterministicTo marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Oneof) MarshalObjectDeterministicTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, true)
+}
+
+// marshalTo marshal data to []byte. deterministic 时 map 按键排序
+func (x *P2Oneof) marshalTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+	if x.O != nil {
+		data, err = x.O.marshalOneofTo(data, deterministic)
+		if err != nil {
+			return
+		}
+	}
+	if len(x.R) > 0 {
+		for _, item := range x.R {
+			// data = protowire.AppendTag(data, 5, protowire.VarintType) => 00101000
+			data = append(data, 0x28)
+			data = protowire.AppendVarint(data, uint64(item))
+		}
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte. 只限制嵌套深度(gopb.DefaultMaxDepth)
+func (x *P2Oneof) UnmarshalObject(data []byte) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{})
+}
+
+// UnmarshalObjectWith unmarshal data from []byte with the limits of opts
+func (x *P2Oneof) UnmarshalObjectWith(data []byte, opts gopb.UnmarshalOptions) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{UnmarshalOptions: opts})
+}
+
+// UnmarshalObjectState unmarshal data from []byte. st 在嵌套消息间传递, 由生成的代码使用
+func (x *P2Oneof) UnmarshalObjectState(data []byte, st *gopb.UnmarshalState) (err error) {
+	if !st.Enter() {
+		return gopb.LimitError("gopb.testpb.P2Oneof", "", 0, 0)
+	}
+
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Oneof", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			ov, ok := x.O.(*P2Oneof_A)
+			if !ok {
+				ov = &P2Oneof_A{}
+			}
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Oneof", "a", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			ov.A = int32(v)
+			x.O = ov
+		case 2:
+			ov, ok := x.O.(*P2Oneof_B)
+			if !ok {
+				ov = &P2Oneof_B{}
+			}
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Oneof", "b", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			ov.B = v
+			x.O = ov
+		case 3:
+			ov, ok := x.O.(*P2Oneof_Msg)
+			if !ok {
+				ov = &P2Oneof_Msg{}
+			}
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.P2Oneof", "msg", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if ov.Msg == nil {
+				ov.Msg = AcquireP2Oneof()
+			}
+			err = ov.Msg.UnmarshalObjectState(v, st)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2Oneof", "msg", -1, cap(data)-cap(v))
+				return
+			}
+			x.O = ov
+		case 4:
+			ov, ok := x.O.(*P2Oneof_Lv)
+			if !ok {
+				ov = &P2Oneof_Lv{}
+			}
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Oneof", "lv", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if _, ok := Level_name[int32(v)]; !ok {
+				x.unknownFields = protowire.AppendTag(x.unknownFields, 4, protowire.VarintType)
+				x.unknownFields = protowire.AppendVarint(x.unknownFields, v)
+				continue
+			}
+			ov.Lv = Level(v)
+			x.O = ov
+		case 5:
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Oneof", "r", 5, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				x.R = append(x.R, int32(v))
+
+				if !st.Grow(len(x.R)) {
+					err = gopb.LimitError("gopb.testpb.P2Oneof", "r", 5, cap(data)-cap(data[index:]))
+					return
+				}
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Oneof", "r", 5, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.P2Oneof", "r", 5, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.R == nil {
+				x.R = make([]int32, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Oneof", "r", 5, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.R = append(x.R, int32(v))
+
+				if !st.Grow(len(x.R)) {
+					err = gopb.LimitError("gopb.testpb.P2Oneof", "r", 5, cap(data)-cap(data[index:]))
+					return
+				}
+			}
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Oneof", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+	st.Leave()
+
+	return
+}
+
+// MarshalJSON marshal to protobuf canonical JSON
+func (x *P2Oneof) MarshalJSON() ([]byte, error) {
+	return x.AppendJSON(nil)
+}
+
+// AppendJSON appends the protobuf canonical JSON of x to b. nil is appended as empty object
+func (x *P2Oneof) AppendJSON(b []byte) (_ []byte, err error) {
+	if x == nil {
+		return append(b, '{', '}'), nil
+	}
+	b = append(b, '{')
+	switch ov := x.O.(type) {
+	case *P2Oneof_A:
+		b = append(b, `"a":`...)
+		b = strconv.AppendInt(b, int64(ov.A), 10)
+		b = append(b, ',')
+	case *P2Oneof_B:
+		b = append(b, `"b":`...)
+		b = gopb.AppendJSONString(b, ov.B)
+		b = append(b, ',')
+	case *P2Oneof_Msg:
+		b = append(b, `"msg":`...)
+		if b, err = ov.Msg.AppendJSON(b); err != nil {
+			return
+		}
+		b = append(b, ',')
+	case *P2Oneof_Lv:
+		b = append(b, `"lv":`...)
+		b = gopb.AppendJSONEnum(b, int32(ov.Lv), Level_name)
+		b = append(b, ',')
+	}
+	if len(x.R) > 0 {
+		b = append(b, `"r":`...)
+		b = append(b, '[')
+		for _, item := range x.R {
+			b = strconv.AppendInt(b, int64(item), 10)
+			b = append(b, ',')
+		}
+		b = gopb.CloseJSON(b, ']')
+		b = append(b, ',')
+	}
+	return gopb.CloseJSON(b, '}'), nil
+}
+
+// UnmarshalJSON unmarshal from protobuf canonical JSON. both json name and proto name of fields are accepted
+func (x *P2Oneof) UnmarshalJSON(data []byte) error {
+	x.Reset()
+	d := gopb.NewJSONDecoder(data)
+	if err := x.UnmarshalJSONFrom(d); err != nil {
+		return err
+	}
+	return d.End()
+}
+
+// UnmarshalJSONFrom reads a JSON object from d and merges it into x. null values are skipped.
+// duplicate fields, duplicate map keys and more than one member of a oneof are rejected as protojson
+func (x *P2Oneof) UnmarshalJSONFrom(d *gopb.JSONDecoder) error {
+	// 重复的字段及同一个oneof的多个成员返回错误
+	var seen, oneofs gopb.FieldSet
+	return d.ReadObject(func(name string) (err error) {
+		switch name {
+		case "a":
+			if err = d.CheckField(&seen, 1, name); err != nil || d.ReadNull() {
+				return
+			}
+			if err = d.CheckOneof(&oneofs, 0, name, "gopb.testpb.P2Oneof.o"); err != nil {
+				return
+			}
+			ov := &P2Oneof_A{}
+			ov.A, err = d.ReadInt32()
+			x.O = ov
+		case "b":
+			if err = d.CheckField(&seen, 2, name); err != nil || d.ReadNull() {
+				return
+			}
+			if err = d.CheckOneof(&oneofs, 0, name, "gopb.testpb.P2Oneof.o"); err != nil {
+				return
+			}
+			ov := &P2Oneof_B{}
+			ov.B, err = d.ReadString()
+			x.O = ov
+		case "msg":
+			if err = d.CheckField(&seen, 3, name); err != nil || d.ReadNull() {
+				return
+			}
+			if err = d.CheckOneof(&oneofs, 0, name, "gopb.testpb.P2Oneof.o"); err != nil {
+				return
+			}
+			ov := &P2Oneof_Msg{}
+			if ov.Msg == nil {
+				ov.Msg = &P2Oneof{}
+			}
+			err = ov.Msg.UnmarshalJSONFrom(d)
+			x.O = ov
+		case "lv":
+			if err = d.CheckField(&seen, 4, name); err != nil || d.ReadNull() {
+				return
+			}
+			if err = d.CheckOneof(&oneofs, 0, name, "gopb.testpb.P2Oneof.o"); err != nil {
+				return
+			}
+			ov := &P2Oneof_Lv{}
+			ev, err := d.ReadEnum(Level_value)
+			if err != nil {
+				return err
+			}
+			ov.Lv = Level(ev)
+			x.O = ov
+		case "r":
+			if err = d.CheckField(&seen, 5, name); err != nil || d.ReadNull() {
+				return
+			}
+			err = d.ReadArray(func() (err error) {
+				var item int32
+				item, err = d.ReadInt32()
+				x.R = append(x.R, item)
+				return
+			})
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+// String returns the protobuf text format of x
+func (x *P2Oneof) String() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return string(x.AppendText(nil))
+}
+
+// AppendText appends the fields of x in protobuf text format to b
+func (x *P2Oneof) AppendText(b []byte) []byte {
+	if x == nil {
+		return b
+	}
+	start := len(b)
+	switch ov := x.O.(type) {
+	case *P2Oneof_A:
+		b = gopb.AppendTextName(b, start, "a")
+		b = strconv.AppendInt(b, int64(ov.A), 10)
+	case *P2Oneof_B:
+		b = gopb.AppendTextName(b, start, "b")
+		b = gopb.AppendTextString(b, ov.B)
+	case *P2Oneof_Msg:
+		b = gopb.AppendTextName(b, start, "msg")
+		b = append(b, '{')
+		b = ov.Msg.AppendText(b)
+		b = append(b, '}')
+	case *P2Oneof_Lv:
+		b = gopb.AppendTextName(b, start, "lv")
+		b = gopb.AppendTextEnum(b, int32(ov.Lv), Level_name)
+	}
+	if len(x.R) > 0 {
+		for _, item := range x.R {
+			b = gopb.AppendTextName(b, start, "r")
+			b = strconv.AppendInt(b, int64(item), 10)
+		}
+	}
+	return b
+}
+
+// UnmarshalText unmarshal from protobuf text format
+func (x *P2Oneof) UnmarshalText(data []byte) error {
+	x.Reset()
+	return x.UnmarshalTextFrom(gopb.NewTextDecoder(data))
+}
+
+// UnmarshalTextFrom reads the fields of a message from d and merges them into x.
+// non-repeated fields appeared twice and more than one member of a oneof are rejected as prototext
+func (x *P2Oneof) UnmarshalTextFrom(d *gopb.TextDecoder) error {
+	// 重复的非repeated字段及同一个oneof的多个成员返回错误
+	var seen, oneofs gopb.FieldSet
+	return d.ReadMessage(func(name string) (err error) {
+		switch name {
+		case "a":
+			if err = d.CheckField(&seen, 1, name); err != nil {
+				return
+			}
+			if err = d.CheckOneof(&oneofs, 0, name, "gopb.testpb.P2Oneof.o"); err != nil {
+				return
+			}
+			ov := &P2Oneof_A{}
+			ov.A, err = d.ReadInt32()
+			x.O = ov
+		case "b":
+			if err = d.CheckField(&seen, 2, name); err != nil {
+				return
+			}
+			if err = d.CheckOneof(&oneofs, 0, name, "gopb.testpb.P2Oneof.o"); err != nil {
+				return
+			}
+			ov := &P2Oneof_B{}
+			ov.B, err = d.ReadString()
+			x.O = ov
+		case "msg":
+			if err = d.CheckField(&seen, 3, name); err != nil {
+				return
+			}
+			if err = d.CheckOneof(&oneofs, 0, name, "gopb.testpb.P2Oneof.o"); err != nil {
+				return
+			}
+			ov := &P2Oneof_Msg{}
+			if ov.Msg == nil {
+				ov.Msg = &P2Oneof{}
+			}
+			err = ov.Msg.UnmarshalTextFrom(d)
+			x.O = ov
+		case "lv":
+			if err = d.CheckField(&seen, 4, name); err != nil {
+				return
+			}
+			if err = d.CheckOneof(&oneofs, 0, name, "gopb.testpb.P2Oneof.o"); err != nil {
+				return
+			}
+			ov := &P2Oneof_Lv{}
+			ev, err := d.ReadEnum(Level_value)
+			if err != nil {
+				return err
+			}
+			ov.Lv = Level(ev)
+			x.O = ov
+		case "r":
+			err = d.ReadRepeated(func() (err error) {
+				var item int32
+				item, err = d.ReadInt32()
+				x.R = append(x.R, item)
+				return
+			})
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+type P2Opt struct {
+	I32           *int32           `json:"i32,omitempty"`
+	I64           *int64           `json:"i64,omitempty"`
+	S             *string          `json:"s,omitempty"`
+	B             []byte           `json:"b,omitempty"`
+	Bl            *bool            `json:"bl,omitempty"`
+	D             *float64         `json:"d,omitempty"`
+	F             *float32         `json:"f,omitempty"`
+	Lv            *Level           `json:"lv,omitempty"`
+	Msg           *P2Opt           `json:"msg,omitempty"`
+	R             []int32          `json:"r,omitempty"`
+	Rp            []int32          `json:"rp,omitempty"`
+	M             map[string]Level `json:"m,omitempty"`
+	S32           *int32           `json:"s32,omitempty"`
+	F64           *uint64          `json:"f64,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2Opt) Reset() {
+	*x = P2Opt{}
+}
+
+var poolP2Opt = sync.Pool{New: func() any { return &P2Opt{} }}
+
+// AcquireP2Opt returns an empty P2Opt from the pool. call Release to put it back
+func AcquireP2Opt() *P2Opt {
+	return poolP2Opt.Get().(*P2Opt)
+}
+
+// Release resets x, releases its sub-messages and puts x back to the pool. x must not be used after Release.
+// 列表截断保留容量, map 清空后保留
+func (x *P2Opt) Release() {
+	if x == nil {
+		return
+	}
+	x.Msg.Release()
+	for mk := range x.M {
+		delete(x.M, mk)
+	}
+	*x = P2Opt{
+		R:             x.R[:0],
+		Rp:            x.Rp[:0],
+		M:             x.M,
+		unknownFields: x.unknownFields[:0],
+	}
+	poolP2Opt.Put(x)
+}
+
+func (x *P2Opt) GetI32() int32 {
+	if x != nil && x.I32 != nil {
+		return *x.I32
+	}
+	return 0
+}
+
+// HasI32 report whether the field is set
+func (x *P2Opt) HasI32() bool {
+	return x != nil && x.I32 != nil
+}
+
+// ClearI32 clear the field
+func (x *P2Opt) ClearI32() {
+	x.I32 = nil
+}
+
+func (x *P2Opt) GetI64() int64 {
+	if x != nil && x.I64 != nil {
+		return *x.I64
+	}
+	return 0
+}
+
+// HasI64 report whether the field is set
+func (x *P2Opt) HasI64() bool {
+	return x != nil && x.I64 != nil
+}
+
+// ClearI64 clear the field
+func (x *P2Opt) ClearI64() {
+	x.I64 = nil
+}
+
+func (x *P2Opt) GetS() string {
+	if x != nil && x.S != nil {
+		return *x.S
+	}
+	return ""
+}
+
+// HasS report whether the field is set
+func (x *P2Opt) HasS() bool {
+	return x != nil && x.S != nil
+}
+
+// ClearS clear the field
+func (x *P2Opt) ClearS() {
+	x.S = nil
+}
+
+func (x *P2Opt) GetB() []byte {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+// HasB report whether the field is set
+func (x *P2Opt) HasB() bool {
+	return x != nil && x.B != nil
+}
+
+// ClearB clear the field
+func (x *P2Opt) ClearB() {
+	x.B = nil
+}
+
+func (x *P2Opt) GetBl() bool {
+	if x != nil && x.Bl != nil {
+		return *x.Bl
+	}
+	return false
+}
+
+// HasBl report whether the field is set
+func (x *P2Opt) HasBl() bool {
+	return x != nil && x.Bl != nil
+}
+
+// ClearBl clear the field
+func (x *P2Opt) ClearBl() {
+	x.Bl = nil
+}
+
+func (x *P2Opt) GetD() float64 {
+	if x != nil && x.D != nil {
+		return *x.D
+	}
+	return 0
+}
+
+// HasD report whether the field is set
+func (x *P2Opt) HasD() bool {
+	return x != nil && x.D != nil
+}
+
+// ClearD clear the field
+func (x *P2Opt) ClearD() {
+	x.D = nil
+}
+
+func (x *P2Opt) GetF() float32 {
+	if x != nil && x.F != nil {
+		return *x.F
+	}
+	return 0
+}
+
+// HasF report whether the field is set
+func (x *P2Opt) HasF() bool {
+	return x != nil && x.F != nil
+}
+
+// ClearF clear the field
+func (x *P2Opt) ClearF() {
+	x.F = nil
+}
+
+func (x *P2Opt) GetLv() Level {
+	if x != nil && x.Lv != nil {
+		return *x.Lv
+	}
+	return Level_LEVEL_ZERO
+}
+
+// HasLv report whether the field is set
+func (x *P2Opt) HasLv() bool {
+	return x != nil && x.Lv != nil
+}
+
+// ClearLv clear the field
+func (x *P2Opt) ClearLv() {
+	x.Lv = nil
+}
+
+func (x *P2Opt) GetMsg() *P2Opt {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+// HasMsg report whether the field is set
+func (x *P2Opt) HasMsg() bool {
+	return x != nil && x.Msg != nil
+}
+
+// ClearMsg clear the field
+func (x *P2Opt) ClearMsg() {
+	x.Msg = nil
+}
+
+func (x *P2Opt) GetR() []int32 {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *P2Opt) GetRp() []int32 {
+	if x != nil {
+		return x.Rp
+	}
+	return nil
+}
+
+func (x *P2Opt) GetM() map[string]Level {
+	if x != nil {
+		return x.M
+	}
+	return nil
+}
+
+func (x *P2Opt) GetS32() int32 {
+	if x != nil && x.S32 != nil {
+		return *x.S32
+	}
+	return 0
+}
+
+// HasS32 report whether the field is set
+func (x *P2Opt) HasS32() bool {
+	return x != nil && x.S32 != nil
+}
+
+// ClearS32 clear the field
+func (x *P2Opt) ClearS32() {
+	x.S32 = nil
+}
+
+func (x *P2Opt) GetF64() uint64 {
+	if x != nil && x.F64 != nil {
+		return *x.F64
+	}
+	return 0
+}
+
+// HasF64 report whether the field is set
+func (x *P2Opt) HasF64() bool {
+	return x != nil && x.F64 != nil
+}
+
+// ClearF64 clear the field
+func (x *P2Opt) ClearF64() {
+	x.F64 = nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Opt) Clone() *P2Opt {
+	if x == nil {
+		return nil
+	}
+	y := &P2Opt{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Opt) CopyFrom(src *P2Opt) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.I32 != nil {
+		pv := *src.I32
+		x.I32 = &pv
+	} else {
+		x.I32 = nil
+	}
+	if src.I64 != nil {
+		pv := *src.I64
+		x.I64 = &pv
+	} else {
+		x.I64 = nil
+	}
+	if src.S != nil {
+		pv := *src.S
+		x.S = &pv
+	} else {
+		x.S = nil
+	}
+	if src.B != nil {
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入.
+		// 空的值为 []byte{}, 保留存在性
+		x.B = append([]byte{}, src.B...)
+	} else {
+		x.B = nil
+	}
+	if src.Bl != nil {
+		pv := *src.Bl
+		x.Bl = &pv
+	} else {
+		x.Bl = nil
+	}
+	if src.D != nil {
+		pv := *src.D
+		x.D = &pv
+	} else {
+		x.D = nil
+	}
+	if src.F != nil {
+		pv := *src.F
+		x.F = &pv
+	} else {
+		x.F = nil
+	}
+	if src.Lv != nil {
+		pv := *src.Lv
+		x.Lv = &pv
+	} else {
+		x.Lv = nil
+	}
+	if src.Msg != nil {
+		if x.Msg == nil {
+			x.Msg = &P2Opt{}
+		}
+		x.Msg.CopyFrom(src.Msg)
+	} else {
+		x.Msg = nil
+	}
+	x.R = append(x.R[:0], src.R...)
+	x.Rp = append(x.Rp[:0], src.Rp...)
+	if x.M == nil && src.M != nil {
+		x.M = make(map[string]Level, len(src.M))
+	}
+	for mk := range x.M {
+		delete(x.M, mk)
+	}
+	for mk, mv := range src.M {
+		x.M[mk] = mv
+	}
+	if src.S32 != nil {
+		pv := *src.S32
+		x.S32 = &pv
+	} else {
+		x.S32 = nil
+	}
+	if src.F64 != nil {
+		pv := *src.F64
+		x.F64 = &pv
+	} else {
+		x.F64 = nil
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Opt) Equal(other *P2Opt) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.I32 == nil) != (other.I32 == nil) {
+		return false
+	}
+	if x.I32 != nil {
+		if *x.I32 != *other.I32 {
+			return false
+		}
+	}
+	if (x.I64 == nil) != (other.I64 == nil) {
+		return false
+	}
+	if x.I64 != nil {
+		if *x.I64 != *other.I64 {
+			return false
+		}
+	}
+	if (x.S == nil) != (other.S == nil) {
+		return false
+	}
+	if x.S != nil {
+		if *x.S != *other.S {
+			return false
+		}
+	}
+	if (x.B == nil) != (other.B == nil) || !bytes.Equal(x.B, other.B) {
+		return false
+	}
+	if (x.Bl == nil) != (other.Bl == nil) {
+		return false
+	}
+	if x.Bl != nil {
+		if *x.Bl != *other.Bl {
+			return false
+		}
+	}
+	if (x.D == nil) != (other.D == nil) {
+		return false
+	}
+	if x.D != nil {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if *x.D != *other.D && !(math.IsNaN(float64(*x.D)) && math.IsNaN(float64(*other.D))) {
+			return false
+		}
+	}
+	if (x.F == nil) != (other.F == nil) {
+		return false
+	}
+	if x.F != nil {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if *x.F != *other.F && !(math.IsNaN(float64(*x.F)) && math.IsNaN(float64(*other.F))) {
+			return false
+		}
+	}
+	if (x.Lv == nil) != (other.Lv == nil) {
+		return false
+	}
+	if x.Lv != nil {
+		if *x.Lv != *other.Lv {
+			return false
+		}
+	}
+	if !x.Msg.Equal(other.Msg) {
+		return false
+	}
+	if len(x.R) != len(other.R) {
+		return false
+	}
+	for k := range x.R {
+		if x.R[k] != other.R[k] {
+			return false
+		}
+	}
+	if len(x.Rp) != len(other.Rp) {
+		return false
+	}
+	for k := range x.Rp {
+		if x.Rp[k] != other.Rp[k] {
+			return false
+		}
+	}
+	if len(x.M) != len(other.M) {
+		return false
+	}
+	for mk, mv := range x.M {
+		ov, ok := other.M[mk]
+		if !ok {
+			return false
+		}
+		if mv != ov {
+			return false
+		}
+	}
+	if (x.S32 == nil) != (other.S32 == nil) {
+		return false
+	}
+	if x.S32 != nil {
+		if *x.S32 != *other.S32 {
+			return false
+		}
+	}
+	if (x.F64 == nil) != (other.F64 == nil) {
+		return false
+	}
+	if x.F64 != nil {
+		if *x.F64 != *other.F64 {
+			return false
+		}
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Opt) Merge(src *P2Opt) {
+	if src == nil {
+		return
+	}
+	if src.I32 != nil {
+		pv := *src.I32
+		x.I32 = &pv
+	}
+	if src.I64 != nil {
+		pv := *src.I64
+		x.I64 = &pv
+	}
+	if src.S != nil {
+		pv := *src.S
+		x.S = &pv
+	}
+	if src.B != nil {
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入
+		x.B = append([]byte{}, src.B...)
+	}
+	if src.Bl != nil {
+		pv := *src.Bl
+		x.Bl = &pv
+	}
+	if src.D != nil {
+		pv := *src.D
+		x.D = &pv
+	}
+	if src.F != nil {
+		pv := *src.F
+		x.F = &pv
+	}
+	if src.Lv != nil {
+		pv := *src.Lv
+		x.Lv = &pv
+	}
+	if src.Msg != nil {
+		if x.Msg == nil {
+			x.Msg = &P2Opt{}
+		}
+		x.Msg.Merge(src.Msg)
+	}
+	if len(src.R) > 0 {
+		x.R = append(x.R, src.R...)
+	}
+	if len(src.Rp) > 0 {
+		x.Rp = append(x.Rp, src.Rp...)
+	}
+	if len(src.M) > 0 {
+		if x.M == nil {
+			x.M = make(map[string]Level, len(src.M))
+		}
+		for mk, mv := range src.M {
+			x.M[mk] = mv
+		}
+	}
+	if src.S32 != nil {
+		pv := *src.S32
+		x.S32 = &pv
+	}
+	if src.F64 != nil {
+		pv := *src.F64
+		x.F64 = &pv
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// XXX_MessageName returns the full name of P2Opt
+func (x *P2Opt) XXX_MessageName() string {
+	return "gopb.testpb.P2Opt"
+}
+
+// ProtoReflect returns a protoreflect.Message view of x, which implements proto.Message.
+// proto.Marshal/proto.Unmarshal use MarshalObjectTo/UnmarshalObject
+func (x *P2Opt) ProtoReflect() protoreflect.Message {
+	return file_proto2_proto_msgTypes[1].MessageOf(x)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Opt) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, false)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Opt) MarshalSize() (size int) {
+	if x.I32 != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(*x.I32))
+	}
+	if x.I64 != nil {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeVarint(uint64(*x.I64))
+	}
+	if x.S != nil {
+		// 1 = protowire.SizeTag(3)
+		size += 1 + protowire.SizeBytes(len(*x.S))
+	}
+	if x.B != nil {
+		// 1 = protowire.SizeTag(4)
+		size += 1 + protowire.SizeBytes(len(x.B))
+	}
+	if x.Bl != nil {
+		// 1 = protowire.SizeTag(5)
+		size += 1 + 1
+	}
+	if x.D != nil {
+		// 1 = protowire.SizeTag(6)
+		size += 1 + 8
+	}
+	if x.F != nil {
+		// 1 = protowire.SizeTag(7)
+		size += 1 + 4
+	}
+	if x.Lv != nil {
+		// 1 = protowire.SizeTag(8)
+		size += 1 + protowire.SizeVarint(uint64(*x.Lv))
+	}
+	if x.Msg != nil {
+		// 1 = protowire.SizeTag(9)
+		size += 1 + protowire.SizeBytes(x.Msg.MarshalSize())
+	}
+	if len(x.R) > 0 {
+		// 1 = protowire.SizeTag(10)
+		size += 1 * len(x.R)
+		for k := 0; k < len(x.R); k++ {
+			size += protowire.SizeVarint(uint64(x.R[k]))
+		}
+	}
+	if len(x.Rp) > 0 {
+		size += 1 // size += protowire.SizeTag(11)
+		if len(x.Rp) > 0 {
+			fsize := 0
+			for _, item := range x.Rp {
+				fsize += protowire.SizeVarint(uint64(item))
+			}
+			size += protowire.SizeBytes(fsize)
+		}
+	}
+	if len(x.M) > 0 {
+		for mk, mv := range x.M {
+			_ = mk
+			_ = mv
+			// 1 = protowire.SizeTag(12)
+			size += 1
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeVarint(uint64(mv))
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	if x.S32 != nil {
+		// 1 = protowire.SizeTag(13)
+		size += 1 + protowire.SizeVarint(protowire.EncodeZigZag(int64(*x.S32)))
+	}
+	if x.F64 != nil {
+		// 1 = protowire.SizeTag(14)
+		size += 1 + 8
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Opt) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, false)
+}
+
+// MarshalObjectDeterministic marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Opt) MarshalObjectDeterministic() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, true)
+}
+
+// MarshalObjectDeterministicTo marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Opt) MarshalObjectDeterministicTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, true)
+}
+
+// marshalTo marshal data to []byte. deterministic 时 map 按键排序
+func (x *P2Opt) marshalTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+	if x.I32 != nil {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(*x.I32))
+	}
+	if x.I64 != nil {
+		// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+		data = append(data, 0x10)
+		data = protowire.AppendVarint(data, uint64(*x.I64))
+	}
+	if x.S != nil {
+
+		// data = protowire.AppendTag(data, 3, protowire.BytesType) => 00011010
+		data = append(data, 0x1a)
+		data = protowire.AppendString(data, *x.S)
+	}
+	if x.B != nil {
+		// data = protowire.AppendTag(data, 4, protowire.BytesType) => 00100010
+		data = append(data, 0x22)
+		data = protowire.AppendBytes(data, x.B)
+	}
+	if x.Bl != nil {
+		// data = protowire.AppendTag(data, 5, protowire.VarintType) => 00101000
+		data = append(data, 0x28)
+		data = protowire.AppendVarint(data, protowire.EncodeBool(*x.Bl))
+	}
+	if x.D != nil {
+		// data = protowire.AppendTag(data, 6, protowire.Fixed64Type) => 00110001
+		data = append(data, 0x31)
+		data = protowire.AppendFixed64(data, math.Float64bits(*x.D))
+	}
+	if x.F != nil {
+		// data = protowire.AppendTag(data, 7, protowire.Fixed32Type) => 00111101
+		data = append(data, 0x3d)
+		data = protowire.AppendFixed32(data, math.Float32bits(*x.F))
+	}
+	if x.Lv != nil {
+		// data = protowire.AppendTag(data, 8, protowire.VarintType) => 01000000
+		data = append(data, 0x40)
+		data = protowire.AppendVarint(data, uint64(*x.Lv))
+	}
+	if x.Msg != nil {
+		// data = protowire.AppendTag(data, 9, protowire.BytesType) => 01001010
+		data = append(data, 0x4a)
+		data = protowire.AppendVarint(data, uint64(x.Msg.MarshalSize()))
+		data, err = x.Msg.marshalTo(data, deterministic)
+		if err != nil {
+			return
+		}
+	}
+	if len(x.R) > 0 {
+		for _, item := range x.R {
+			// data = protowire.AppendTag(data, 10, protowire.VarintType) => 01010000
+			data = append(data, 0x50)
+			data = protowire.AppendVarint(data, uint64(item))
+		}
+	}
+	if len(x.Rp) > 0 {
+		// data = protowire.AppendTag(data, 11, protowire.BytesType) => 01011010
+		data = append(data, 0x5a)
+		size := 0
+		for _, v := range x.Rp {
+			size += protowire.SizeVarint(uint64(v))
+		}
+		data = protowire.AppendVarint(data, uint64(size))
+		for _, v := range x.Rp {
+			data = protowire.AppendVarint(data, uint64(v))
+		}
+	}
+	if len(x.M) > 0 {
+
+		if deterministic {
+			for _, mk := range gopb.SortedMapKeys(x.M) {
+				mv := x.M[mk]
+				// data = protowire.AppendTag(data, 12, protowire.BytesType) => 01100010
+				data = append(data, 0x62)
+				msize := 0
+				// 1 = protowire.SizeTag(1)
+				msize += 1 + protowire.SizeBytes(len(mk))
+				// 1 = protowire.SizeTag(2)
+				msize += 1 + protowire.SizeVarint(uint64(mv))
+				data = protowire.AppendVarint(data, uint64(msize))
+
+				// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+				data = append(data, 0xa)
+				data = protowire.AppendString(data, mk)
+				// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+				data = append(data, 0x10)
+				data = protowire.AppendVarint(data, uint64(mv))
+			}
+		} else {
+			for mk, mv := range x.M {
+				// data = protowire.AppendTag(data, 12, protowire.BytesType) => 01100010
+				data = append(data, 0x62)
+				msize := 0
+				// 1 = protowire.SizeTag(1)
+				msize += 1 + protowire.SizeBytes(len(mk))
+				// 1 = protowire.SizeTag(2)
+				msize += 1 + protowire.SizeVarint(uint64(mv))
+				data = protowire.AppendVarint(data, uint64(msize))
+
+				// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+				data = append(data, 0xa)
+				data = protowire.AppendString(data, mk)
+				// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+				data = append(data, 0x10)
+				data = protowire.AppendVarint(data, uint64(mv))
+			}
+		}
+	}
+	if x.S32 != nil {
+		// data = protowire.AppendTag(data, 13, protowire.VarintType) => 01101000
+		data = append(data, 0x68)
+		data = protowire.AppendVarint(data, protowire.EncodeZigZag(int64(*x.S32)))
+	}
+	if x.F64 != nil {
+		// data = protowire.AppendTag(data, 14, protowire.Fixed64Type) => 01110001
+		data = append(data, 0x71)
+		data = protowire.AppendFixed64(data, uint64(*x.F64))
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte. 只限制嵌套深度(gopb.DefaultMaxDepth)
+func (x *P2Opt) UnmarshalObject(data []byte) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{})
+}
+
+// UnmarshalObjectWith unmarshal data from []byte with the limits of opts
+func (x *P2Opt) UnmarshalObjectWith(data []byte, opts gopb.UnmarshalOptions) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{UnmarshalOptions: opts})
+}
+
+// UnmarshalObjectState unmarshal data from []byte. st 在嵌套消息间传递, 由生成的代码使用
+func (x *P2Opt) UnmarshalObjectState(data []byte, st *gopb.UnmarshalState) (err error) {
+	if !st.Enter() {
+		return gopb.LimitError("gopb.testpb.P2Opt", "", 0, 0)
+	}
+
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Opt", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "i32", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.I32 = &pv
+		case 2:
+			var pv int64
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "i64", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int64(v)
+			x.I64 = &pv
+		case 3:
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "s", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = v
+			x.S = &pv
+		case 4:
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "b", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.B = make([]byte, len(v))
+			copy(x.B, v)
+		case 5:
+			var pv bool
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "bl", 5, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = protowire.DecodeBool(v)
+			x.Bl = &pv
+		case 6:
+			var pv float64
+			v, cnt := protowire.ConsumeFixed64(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "d", 6, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = math.Float64frombits(v)
+			x.D = &pv
+		case 7:
+			var pv float32
+			v, cnt := protowire.ConsumeFixed32(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "f", 7, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = math.Float32frombits(v)
+			x.F = &pv
+		case 8:
+			var pv Level
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "lv", 8, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if _, ok := Level_name[int32(v)]; !ok {
+				x.unknownFields = protowire.AppendTag(x.unknownFields, 8, protowire.VarintType)
+				x.unknownFields = protowire.AppendVarint(x.unknownFields, v)
+				continue
+			}
+			pv = Level(v)
+			x.Lv = &pv
+		case 9:
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "msg", 9, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.Msg == nil {
+				x.Msg = AcquireP2Opt()
+			}
+			err = x.Msg.UnmarshalObjectState(v, st)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2Opt", "msg", -1, cap(data)-cap(v))
+				return
+			}
+		case 10:
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Opt", "r", 10, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				x.R = append(x.R, int32(v))
+
+				if !st.Grow(len(x.R)) {
+					err = gopb.LimitError("gopb.testpb.P2Opt", "r", 10, cap(data)-cap(data[index:]))
+					return
+				}
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "r", 10, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "r", 10, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.R == nil {
+				x.R = make([]int32, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Opt", "r", 10, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.R = append(x.R, int32(v))
+
+				if !st.Grow(len(x.R)) {
+					err = gopb.LimitError("gopb.testpb.P2Opt", "r", 10, cap(data)-cap(data[index:]))
+					return
+				}
+			}
+		case 11:
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Opt", "rp", 11, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				x.Rp = append(x.Rp, int32(v))
+
+				if !st.Grow(len(x.Rp)) {
+					err = gopb.LimitError("gopb.testpb.P2Opt", "rp", 11, cap(data)-cap(data[index:]))
+					return
+				}
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "rp", 11, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "rp", 11, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.Rp == nil {
+				x.Rp = make([]int32, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Opt", "rp", 11, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.Rp = append(x.Rp, int32(v))
+
+				if !st.Grow(len(x.Rp)) {
+					err = gopb.LimitError("gopb.testpb.P2Opt", "rp", 11, cap(data)-cap(data[index:]))
+					return
+				}
+			}
+		case 12:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "m", 12, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "m", 12, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.M == nil {
+				x.M = make(map[string]Level)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk string
+			var mv Level
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Opt", "m", 12, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1 && typ == protowire.BytesType:
+
+					v, cnt := protowire.ConsumeString(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.P2Opt", "m.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					mk = v
+				case mi == 2 && typ == protowire.VarintType:
+					v, cnt := protowire.ConsumeVarint(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.P2Opt", "m.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					mv = Level(v)
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.P2Opt", "m", 12, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			// 值为封闭枚举的未知值时, 整个条目保存在未知字段中
+			if _, ok := Level_name[int32(mv)]; !ok {
+				x.unknownFields = protowire.AppendTag(x.unknownFields, 12, protowire.BytesType)
+				x.unknownFields = protowire.AppendBytes(x.unknownFields, buf)
+				continue
+			}
+			x.M[mk] = mv
+
+			if !st.Grow(len(x.M)) {
+				err = gopb.LimitError("gopb.testpb.P2Opt", "m", 12, cap(data)-cap(data[index:]))
+				return
+			}
+		case 13:
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "s32", 13, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(protowire.DecodeZigZag(v))
+			x.S32 = &pv
+		case 14:
+			var pv uint64
+			v, cnt := protowire.ConsumeFixed64(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "f64", 14, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = uint64(v)
+			x.F64 = &pv
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Opt", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+	st.Leave()
+
+	return
+}
+
+// MarshalJSON marshal to protobuf canonical JSON
+func (x *P2Opt) MarshalJSON() ([]byte, error) {
+	return x.AppendJSON(nil)
+}
+
+// AppendJSON appends the protobuf canonical JSON of x to b. nil is appended as empty object
+func (x *P2Opt) AppendJSON(b []byte) (_ []byte, err error) {
+	if x == nil {
+		return append(b, '{', '}'), nil
+	}
+	b = append(b, '{')
+	if x.I32 != nil {
+		b = append(b, `"i32":`...)
+		b = strconv.AppendInt(b, int64(*x.I32), 10)
+		b = append(b, ',')
+	}
+	if x.I64 != nil {
+		b = append(b, `"i64":`...)
+		b = gopb.AppendJSONInt64(b, int64(*x.I64))
+		b = append(b, ',')
+	}
+	if x.S != nil {
+		b = append(b, `"s":`...)
+		b = gopb.AppendJSONString(b, *x.S)
+		b = append(b, ',')
+	}
+	if x.B != nil {
+		b = append(b, `"b":`...)
+		b = gopb.AppendJSONBytes(b, x.B)
+		b = append(b, ',')
+	}
+	if x.Bl != nil {
+		b = append(b, `"bl":`...)
+		b = strconv.AppendBool(b, *x.Bl)
+		b = append(b, ',')
+	}
+	if x.D != nil {
+		b = append(b, `"d":`...)
+		b = gopb.AppendJSONFloat(b, *x.D, 64)
+		b = append(b, ',')
+	}
+	if x.F != nil {
+		b = append(b, `"f":`...)
+		b = gopb.AppendJSONFloat(b, float64(*x.F), 32)
+		b = append(b, ',')
+	}
+	if x.Lv != nil {
+		b = append(b, `"lv":`...)
+		b = gopb.AppendJSONEnum(b, int32(*x.Lv), Level_name)
+		b = append(b, ',')
+	}
+	if x.Msg != nil {
+		b = append(b, `"msg":`...)
+		if b, err = x.Msg.AppendJSON(b); err != nil {
+			return
+		}
+		b = append(b, ',')
+	}
+	if len(x.R) > 0 {
+		b = append(b, `"r":`...)
+		b = append(b, '[')
+		for _, item := range x.R {
+			b = strconv.AppendInt(b, int64(item), 10)
+			b = append(b, ',')
+		}
+		b = gopb.CloseJSON(b, ']')
+		b = append(b, ',')
+	}
+	if len(x.Rp) > 0 {
+		b = append(b, `"rp":`...)
+		b = append(b, '[')
+		for _, item := range x.Rp {
+			b = strconv.AppendInt(b, int64(item), 10)
+			b = append(b, ',')
+		}
+		b = gopb.CloseJSON(b, ']')
+		b = append(b, ',')
+	}
+	if len(x.M) > 0 {
+		b = append(b, `"m":`...)
+		b = append(b, '{')
+		for mk, mv := range x.M {
+			b = gopb.AppendJSONString(b, mk)
+			b = append(b, ':')
+			b = gopb.AppendJSONEnum(b, int32(mv), Level_name)
+			b = append(b, ',')
+		}
+		b = gopb.CloseJSON(b, '}')
+		b = append(b, ',')
+	}
+	if x.S32 != nil {
+		b = append(b, `"s32":`...)
+		b = strconv.AppendInt(b, int64(*x.S32), 10)
+		b = append(b, ',')
+	}
+	if x.F64 != nil {
+		b = append(b, `"f64":`...)
+		b = gopb.AppendJSONUint64(b, uint64(*x.F64))
+		b = append(b, ',')
+	}
+	return gopb.CloseJSON(b, '}'), nil
+}
+
+// UnmarshalJSON unmarshal from protobuf canonical JSON. both json name and proto name of fields are accepted
+func (x *P2Opt) UnmarshalJSON(data []byte) error {
+	x.Reset()
+	d := gopb.NewJSONDecoder(data)
+	if err := x.UnmarshalJSONFrom(d); err != nil {
+		return err
+	}
+	return d.End()
+}
+
+// UnmarshalJSONFrom reads a JSON object from d and merges it into x. null values are skipped.
+// duplicate fields, duplicate map keys and more than one member of a oneof are rejected as protojson
+func (x *P2Opt) UnmarshalJSONFrom(d *gopb.JSONDecoder) error {
+	// 重复的字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadObject(func(name string) (err error) {
+		switch name {
+		case "i32":
+			if err = d.CheckField(&seen, 1, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.I32 = &pv
+		case "i64":
+			if err = d.CheckField(&seen, 2, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int64
+			pv, err = d.ReadInt64()
+			x.I64 = &pv
+		case "s":
+			if err = d.CheckField(&seen, 3, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv string
+			pv, err = d.ReadString()
+			x.S = &pv
+		case "b":
+			if err = d.CheckField(&seen, 4, name); err != nil || d.ReadNull() {
+				return
+			}
+			x.B, err = d.ReadBytes()
+		case "bl":
+			if err = d.CheckField(&seen, 5, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv bool
+			pv, err = d.ReadBool()
+			x.Bl = &pv
+		case "d":
+			if err = d.CheckField(&seen, 6, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv float64
+			pv, err = d.ReadFloat64()
+			x.D = &pv
+		case "f":
+			if err = d.CheckField(&seen, 7, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv float32
+			pv, err = d.ReadFloat32()
+			x.F = &pv
+		case "lv":
+			if err = d.CheckField(&seen, 8, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv Level
+			ev, err := d.ReadEnum(Level_value)
+			if err != nil {
+				return err
+			}
+			pv = Level(ev)
+			x.Lv = &pv
+		case "msg":
+			if err = d.CheckField(&seen, 9, name); err != nil || d.ReadNull() {
+				return
+			}
+			if x.Msg == nil {
+				x.Msg = &P2Opt{}
+			}
+			err = x.Msg.UnmarshalJSONFrom(d)
+		case "r":
+			if err = d.CheckField(&seen, 10, name); err != nil || d.ReadNull() {
+				return
+			}
+			err = d.ReadArray(func() (err error) {
+				var item int32
+				item, err = d.ReadInt32()
+				x.R = append(x.R, item)
+				return
+			})
+		case "rp":
+			if err = d.CheckField(&seen, 11, name); err != nil || d.ReadNull() {
+				return
+			}
+			err = d.ReadArray(func() (err error) {
+				var item int32
+				item, err = d.ReadInt32()
+				x.Rp = append(x.Rp, item)
+				return
+			})
+		case "m":
+			if err = d.CheckField(&seen, 12, name); err != nil || d.ReadNull() {
+				return
+			}
+			if x.M == nil {
+				x.M = make(map[string]Level)
+			}
+			err = d.ReadObject(func(key string) (err error) {
+				mk := key
+				if _, ok := x.M[mk]; ok {
+					return d.DuplicateMapKey(key)
+				}
+				var mv Level
+				ev, err := d.ReadEnum(Level_value)
+				if err != nil {
+					return err
+				}
+				mv = Level(ev)
+				x.M[mk] = mv
+				return
+			})
+		case "s32":
+			if err = d.CheckField(&seen, 13, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.S32 = &pv
+		case "f64":
+			if err = d.CheckField(&seen, 14, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv uint64
+			pv, err = d.ReadUint64()
+			x.F64 = &pv
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+// String returns the protobuf text format of x
+func (x *P2Opt) String() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return string(x.AppendText(nil))
+}
+
+// AppendText appends the fields of x in protobuf text format to b
+func (x *P2Opt) AppendText(b []byte) []byte {
+	if x == nil {
+		return b
+	}
+	start := len(b)
+	if x.I32 != nil {
+		b = gopb.AppendTextName(b, start, "i32")
+		b = strconv.AppendInt(b, int64(*x.I32), 10)
+	}
+	if x.I64 != nil {
+		b = gopb.AppendTextName(b, start, "i64")
+		b = strconv.AppendInt(b, int64(*x.I64), 10)
+	}
+	if x.S != nil {
+		b = gopb.AppendTextName(b, start, "s")
+		b = gopb.AppendTextString(b, *x.S)
+	}
+	if x.B != nil {
+		b = gopb.AppendTextName(b, start, "b")
+		b = gopb.AppendTextBytes(b, x.B)
+	}
+	if x.Bl != nil {
+		b = gopb.AppendTextName(b, start, "bl")
+		b = strconv.AppendBool(b, *x.Bl)
+	}
+	if x.D != nil {
+		b = gopb.AppendTextName(b, start, "d")
+		b = gopb.AppendTextFloat(b, *x.D, 64)
+	}
+	if x.F != nil {
+		b = gopb.AppendTextName(b, start, "f")
+		b = gopb.AppendTextFloat(b, float64(*x.F), 32)
+	}
+	if x.Lv != nil {
+		b = gopb.AppendTextName(b, start, "lv")
+		b = gopb.AppendTextEnum(b, int32(*x.Lv), Level_name)
+	}
+	if x.Msg != nil {
+		b = gopb.AppendTextName(b, start, "msg")
+		b = append(b, '{')
+		b = x.Msg.AppendText(b)
+		b = append(b, '}')
+	}
+	if len(x.R) > 0 {
+		for _, item := range x.R {
+			b = gopb.AppendTextName(b, start, "r")
+			b = strconv.AppendInt(b, int64(item), 10)
+		}
+	}
+	if len(x.Rp) > 0 {
+		for _, item := range x.Rp {
+			b = gopb.AppendTextName(b, start, "rp")
+			b = strconv.AppendInt(b, int64(item), 10)
+		}
+	}
+	if len(x.M) > 0 {
+		for mk, mv := range x.M {
+			b = gopb.AppendTextName(b, start, "m")
+			b = append(b, "{key:"...)
+			b = gopb.AppendTextString(b, mk)
+			b = append(b, " value:"...)
+			b = gopb.AppendTextEnum(b, int32(mv), Level_name)
+			b = append(b, '}')
+		}
+	}
+	if x.S32 != nil {
+		b = gopb.AppendTextName(b, start, "s32")
+		b = strconv.AppendInt(b, int64(*x.S32), 10)
+	}
+	if x.F64 != nil {
+		b = gopb.AppendTextName(b, start, "f64")
+		b = strconv.AppendUint(b, uint64(*x.F64), 10)
+	}
+	return b
+}
+
+// UnmarshalText unmarshal from protobuf text format
+func (x *P2Opt) UnmarshalText(data []byte) error {
+	x.Reset()
+	return x.UnmarshalTextFrom(gopb.NewTextDecoder(data))
+}
+
+// UnmarshalTextFrom reads the fields of a message from d and merges them into x.
+// non-repeated fields appeared twice and more than one member of a oneof are rejected as prototext
+func (x *P2Opt) UnmarshalTextFrom(d *gopb.TextDecoder) error {
+	// 重复的非repeated字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadMessage(func(name string) (err error) {
+		switch name {
+		case "i32":
+			if err = d.CheckField(&seen, 1, name); err != nil {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.I32 = &pv
+		case "i64":
+			if err = d.CheckField(&seen, 2, name); err != nil {
+				return
+			}
+			var pv int64
+			pv, err = d.ReadInt64()
+			x.I64 = &pv
+		case "s":
+			if err = d.CheckField(&seen, 3, name); err != nil {
+				return
+			}
+			var pv string
+			pv, err = d.ReadString()
+			x.S = &pv
+		case "b":
+			if err = d.CheckField(&seen, 4, name); err != nil {
+				return
+			}
+			x.B, err = d.ReadBytes()
+		case "bl":
+			if err = d.CheckField(&seen, 5, name); err != nil {
+				return
+			}
+			var pv bool
+			pv, err = d.ReadBool()
+			x.Bl = &pv
+		case "d":
+			if err = d.CheckField(&seen, 6, name); err != nil {
+				return
+			}
+			var pv float64
+			pv, err = d.ReadFloat64()
+			x.D = &pv
+		case "f":
+			if err = d.CheckField(&seen, 7, name); err != nil {
+				return
+			}
+			var pv float32
+			pv, err = d.ReadFloat32()
+			x.F = &pv
+		case "lv":
+			if err = d.CheckField(&seen, 8, name); err != nil {
+				return
+			}
+			var pv Level
+			ev, err := d.ReadEnum(Level_value)
+			if err != nil {
+				return err
+			}
+			pv = Level(ev)
+			x.Lv = &pv
+		case "msg":
+			if err = d.CheckField(&seen, 9, name); err != nil {
+				return
+			}
+			if x.Msg == nil {
+				x.Msg = &P2Opt{}
+			}
+			err = x.Msg.UnmarshalTextFrom(d)
+		case "r":
+			err = d.ReadRepeated(func() (err error) {
+				var item int32
+				item, err = d.ReadInt32()
+				x.R = append(x.R, item)
+				return
+			})
+		case "rp":
+			err = d.ReadRepeated(func() (err error) {
+				var item int32
+				item, err = d.ReadInt32()
+				x.Rp = append(x.Rp, item)
+				return
+			})
+		case "m":
+			if x.M == nil {
+				x.M = make(map[string]Level)
+			}
+			err = d.ReadRepeated(func() error {
+				var mk string
+				var mv Level
+				var seen gopb.FieldSet
+				err := d.ReadMessage(func(name string) (err error) {
+					switch name {
+					case "key":
+						if err = d.CheckField(&seen, 1, name); err != nil {
+							return
+						}
+						mk, err = d.ReadString()
+					case "value":
+						if err = d.CheckField(&seen, 2, name); err != nil {
+							return
+						}
+						ev, err := d.ReadEnum(Level_value)
+						if err != nil {
+							return err
+						}
+						mv = Level(ev)
+					default:
+						err = d.UnknownField(name)
+					}
+					return
+				})
+				x.M[mk] = mv
+				return err
+			})
+		case "s32":
+			if err = d.CheckField(&seen, 13, name); err != nil {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.S32 = &pv
+		case "f64":
+			if err = d.CheckField(&seen, 14, name); err != nil {
+				return
+			}
+			var pv uint64
+			pv, err = d.ReadUint64()
+			x.F64 = &pv
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+type P2Default struct {
+	I32           *int32   `json:"i32,omitempty"`
+	I64           *int64   `json:"i64,omitempty"`
+	U32           *uint32  `json:"u32,omitempty"`
+	S             *string  `json:"s,omitempty"`
+	B             []byte   `json:"b,omitempty"`
+	Bl            *bool    `json:"bl,omitempty"`
+	D             *float64 `json:"d,omitempty"`
+	F             *float32 `json:"f,omitempty"`
+	Dn            *float64 `json:"dn,omitempty"`
+	Lv            *Level   `json:"lv,omitempty"`
+	F2            *float32 `json:"f2,omitempty"`
+	Sf64          *int64   `json:"sf64,omitempty"`
+	U64           *uint64  `json:"u64,omitempty"`
+	Empty         *string  `json:"empty,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2Default) Reset() {
+	*x = P2Default{}
+}
+
+var poolP2Default = sync.Pool{New: func() any { return &P2Default{} }}
+
+// AcquireP2Default returns an empty P2Default from the pool. call Release to put it back
+func AcquireP2Default() *P2Default {
+	return poolP2Default.Get().(*P2Default)
+}
+
+// Release resets x, releases its sub-messages and puts x back to the pool. x must not be used after Release.
+// 列表截断保留容量, map 清空后保留
+func (x *P2Default) Release() {
+	if x == nil {
+		return
+	}
+	*x = P2Default{
+		unknownFields: x.unknownFields[:0],
+	}
+	poolP2Default.Put(x)
+}
+
+// Default values for P2Default fields.
+const (
+	Default_P2Default_I32   = int32(-7)
+	Default_P2Default_I64   = int64(1099511627776)
+	Default_P2Default_U32   = uint32(42)
+	Default_P2Default_S     = "hi \"there\"\n"
+	Default_P2Default_Bl    = bool(true)
+	Default_P2Default_Lv    = Level_HIGH
+	Default_P2Default_F2    = float32(1.5)
+	Default_P2Default_Sf64  = int64(-9223372036854775808)
+	Default_P2Default_U64   = uint64(18446744073709551615)
+	Default_P2Default_Empty = ""
+)
+
+// Default values for P2Default fields.
+var (
+	Default_P2Default_B  = []byte("a\x00b")
+	Default_P2Default_D  = float64(math.Inf(+1))
+	Default_P2Default_F  = float32(math.NaN())
+	Default_P2Default_Dn = float64(math.Inf(-1))
+)
+
+func (x *P2Default) GetI32() int32 {
+	if x != nil && x.I32 != nil {
+		return *x.I32
+	}
+	return Default_P2Default_I32
+}
+
+// HasI32 report whether the field is set
+func (x *P2Default) HasI32() bool {
+	return x != nil && x.I32 != nil
+}
+
+// ClearI32 clear the field
+func (x *P2Default) ClearI32() {
+	x.I32 = nil
+}
+
+func (x *P2Default) GetI64() int64 {
+	if x != nil && x.I64 != nil {
+		return *x.I64
+	}
+	return Default_P2Default_I64
+}
+
+// HasI64 report whether the field is set
+func (x *P2Default) HasI64() bool {
+	return x != nil && x.I64 != nil
+}
+
+// ClearI64 clear the field
+func (x *P2Default) ClearI64() {
+	x.I64 = nil
+}
+
+func (x *P2Default) GetU32() uint32 {
+	if x != nil && x.U32 != nil {
+		return *x.U32
+	}
+	return Default_P2Default_U32
+}
+
+// HasU32 report whether the field is set
+func (x *P2Default) HasU32() bool {
+	return x != nil && x.U32 != nil
+}
+
+// ClearU32 clear the field
+func (x *P2Default) ClearU32() {
+	x.U32 = nil
+}
+
+func (x *P2Default) GetS() string {
+	if x != nil && x.S != nil {
+		return *x.S
+	}
+	return Default_P2Default_S
+}
+
+// HasS report whether the field is set
+func (x *P2Default) HasS() bool {
+	return x != nil && x.S != nil
+}
+
+// ClearS clear the field
+func (x *P2Default) ClearS() {
+	x.S = nil
+}
+
+func (x *P2Default) GetB() []byte {
+	if x != nil && x.B != nil {
+		return x.B
+	}
+	return append([]byte(nil), Default_P2Default_B...)
+}
+
+// HasB report whether the field is set
+func (x *P2Default) HasB() bool {
+	return x != nil && x.B != nil
+}
+
+// ClearB clear the field
+func (x *P2Default) ClearB() {
+	x.B = nil
+}
+
+func (x *P2Default) GetBl() bool {
+	if x != nil && x.Bl != nil {
+		return *x.Bl
+	}
+	return Default_P2Default_Bl
+}
+
+// HasBl report whether the field is set
+func (x *P2Default) HasBl() bool {
+	return x != nil && x.Bl != nil
+}
+
+// ClearBl clear the field
+func (x *P2Default) ClearBl() {
+	x.Bl = nil
+}
+
+func (x *P2Default) GetD() float64 {
+	if x != nil && x.D != nil {
+		return *x.D
+	}
+	return Default_P2Default_D
+}
+
+// HasD report whether the field is set
+func (x *P2Default) HasD() bool {
+	return x != nil && x.D != nil
+}
+
+// ClearD clear the field
+func (x *P2Default) ClearD() {
+	x.D = nil
+}
+
+func (x *P2Default) GetF() float32 {
+	if x != nil && x.F != nil {
+		return *x.F
+	}
+	return Default_P2Default_F
+}
+
+// HasF report whether the field is set
+func (x *P2Default) HasF() bool {
+	return x != nil && x.F != nil
+}
+
+// ClearF clear the field
+func (x *P2Default) ClearF() {
+	x.F = nil
+}
+
+func (x *P2Default) GetDn() float64 {
+	if x != nil && x.Dn != nil {
+		return *x.Dn
+	}
+	return Default_P2Default_Dn
+}
+
+// HasDn report whether the field is set
+func (x *P2Default) HasDn() bool {
+	return x != nil && x.Dn != nil
+}
+
+// ClearDn clear the field
+func (x *P2Default) ClearDn() {
+	x.Dn = nil
+}
+
+func (x *P2Default) GetLv() Level {
+	if x != nil && x.Lv != nil {
+		return *x.Lv
+	}
+	return Default_P2Default_Lv
+}
+
+// HasLv report whether the field is set
+func (x *P2Default) HasLv() bool {
+	return x != nil && x.Lv != nil
+}
+
+// ClearLv clear the field
+func (x *P2Default) ClearLv() {
+	x.Lv = nil
+}
+
+func (x *P2Default) GetF2() float32 {
+	if x != nil && x.F2 != nil {
+		return *x.F2
+	}
+	return Default_P2Default_F2
+}
+
+// HasF2 report whether the field is set
+func (x *P2Default) HasF2() bool {
+	return x != nil && x.F2 != nil
+}
+
+// ClearF2 clear the field
+func (x *P2Default) ClearF2() {
+	x.F2 = nil
+}
+
+func (x *P2Default) GetSf64() int64 {
+	if x != nil && x.Sf64 != nil {
+		return *x.Sf64
+	}
+	return Default_P2Default_Sf64
+}
+
+// HasSf64 report whether the field is set
+func (x *P2Default) HasSf64() bool {
+	return x != nil && x.Sf64 != nil
+}
+
+// ClearSf64 clear the field
+func (x *P2Default) ClearSf64() {
+	x.Sf64 = nil
+}
+
+func (x *P2Default) GetU64() uint64 {
+	if x != nil && x.U64 != nil {
+		return *x.U64
+	}
+	return Default_P2Default_U64
+}
+
+// HasU64 report whether the field is set
+func (x *P2Default) HasU64() bool {
+	return x != nil && x.U64 != nil
+}
+
+// ClearU64 clear the field
+func (x *P2Default) ClearU64() {
+	x.U64 = nil
+}
+
+func (x *P2Default) GetEmpty() string {
+	if x != nil && x.Empty != nil {
+		return *x.Empty
+	}
+	return Default_P2Default_Empty
+}
+
+// HasEmpty report whether the field is set
+func (x *P2Default) HasEmpty() bool {
+	return x != nil && x.Empty != nil
+}
+
+// ClearEmpty clear the field
+func (x *P2Default) ClearEmpty() {
+	x.Empty = nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Default) Clone() *P2Default {
+	if x == nil {
+		return nil
+	}
+	y := &P2Default{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Default) CopyFrom(src *P2Default) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.I32 != nil {
+		pv := *src.I32
+		x.I32 = &pv
+	} else {
+		x.I32 = nil
+	}
+	if src.I64 != nil {
+		pv := *src.I64
+		x.I64 = &pv
+	} else {
+		x.I64 = nil
+	}
+	if src.U32 != nil {
+		pv := *src.U32
+		x.U32 = &pv
+	} else {
+		x.U32 = nil
+	}
+	if src.S != nil {
+		pv := *src.S
+		x.S = &pv
+	} else {
+		x.S = nil
+	}
+	if src.B != nil {
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入.
+		// 空的值为 []byte{}, 保留存在性
+		x.B = append([]byte{}, src.B...)
+	} else {
+		x.B = nil
+	}
+	if src.Bl != nil {
+		pv := *src.Bl
+		x.Bl = &pv
+	} else {
+		x.Bl = nil
+	}
+	if src.D != nil {
+		pv := *src.D
+		x.D = &pv
+	} else {
+		x.D = nil
+	}
+	if src.F != nil {
+		pv := *src.F
+		x.F = &pv
+	} else {
+		x.F = nil
+	}
+	if src.Dn != nil {
+		pv := *src.Dn
+		x.Dn = &pv
+	} else {
+		x.Dn = nil
+	}
+	if src.Lv != nil {
+		pv := *src.Lv
+		x.Lv = &pv
+	} else {
+		x.Lv = nil
+	}
+	if src.F2 != nil {
+		pv := *src.F2
+		x.F2 = &pv
+	} else {
+		x.F2 = nil
+	}
+	if src.Sf64 != nil {
+		pv := *src.Sf64
+		x.Sf64 = &pv
+	} else {
+		x.Sf64 = nil
+	}
+	if src.U64 != nil {
+		pv := *src.U64
+		x.U64 = &pv
+	} else {
+		x.U64 = nil
+	}
+	if src.Empty != nil {
+		pv := *src.Empty
+		x.Empty = &pv
+	} else {
+		x.Empty = nil
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Default) Equal(other *P2Default) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.I32 == nil) != (other.I32 == nil) {
+		return false
+	}
+	if x.I32 != nil {
+		if *x.I32 != *other.I32 {
+			return false
+		}
+	}
+	if (x.I64 == nil) != (other.I64 == nil) {
+		return false
+	}
+	if x.I64 != nil {
+		if *x.I64 != *other.I64 {
+			return false
+		}
+	}
+	if (x.U32 == nil) != (other.U32 == nil) {
+		return false
+	}
+	if x.U32 != nil {
+		if *x.U32 != *other.U32 {
+			return false
+		}
+	}
+	if (x.S == nil) != (other.S == nil) {
+		return false
+	}
+	if x.S != nil {
+		if *x.S != *other.S {
+			return false
+		}
+	}
+	if (x.B == nil) != (other.B == nil) || !bytes.Equal(x.B, other.B) {
+		return false
+	}
+	if (x.Bl == nil) != (other.Bl == nil) {
+		return false
+	}
+	if x.Bl != nil {
+		if *x.Bl != *other.Bl {
+			return false
+		}
+	}
+	if (x.D == nil) != (other.D == nil) {
+		return false
+	}
+	if x.D != nil {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if *x.D != *other.D && !(math.IsNaN(float64(*x.D)) && math.IsNaN(float64(*other.D))) {
+			return false
+		}
+	}
+	if (x.F == nil) != (other.F == nil) {
+		return false
+	}
+	if x.F != nil {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if *x.F != *other.F && !(math.IsNaN(float64(*x.F)) && math.IsNaN(float64(*other.F))) {
+			return false
+		}
+	}
+	if (x.Dn == nil) != (other.Dn == nil) {
+		return false
+	}
+	if x.Dn != nil {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if *x.Dn != *other.Dn && !(math.IsNaN(float64(*x.Dn)) && math.IsNaN(float64(*other.Dn))) {
+			return false
+		}
+	}
+	if (x.Lv == nil) != (other.Lv == nil) {
+		return false
+	}
+	if x.Lv != nil {
+		if *x.Lv != *other.Lv {
+			return false
+		}
+	}
+	if (x.F2 == nil) != (other.F2 == nil) {
+		return false
+	}
+	if x.F2 != nil {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if *x.F2 != *other.F2 && !(math.IsNaN(float64(*x.F2)) && math.IsNaN(float64(*other.F2))) {
+			return false
+		}
+	}
+	if (x.Sf64 == nil) != (other.Sf64 == nil) {
+		return false
+	}
+	if x.Sf64 != nil {
+		if *x.Sf64 != *other.Sf64 {
+			return false
+		}
+	}
+	if (x.U64 == nil) != (other.U64 == nil) {
+		return false
+	}
+	if x.U64 != nil {
+		if *x.U64 != *other.U64 {
+			return false
+		}
+	}
+	if (x.Empty == nil) != (other.Empty == nil) {
+		return false
+	}
+	if x.Empty != nil {
+		if *x.Empty != *other.Empty {
+			return false
+		}
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Default) Merge(src *P2Default) {
+	if src == nil {
+		return
+	}
+	if src.I32 != nil {
+		pv := *src.I32
+		x.I32 = &pv
+	}
+	if src.I64 != nil {
+		pv := *src.I64
+		x.I64 = &pv
+	}
+	if src.U32 != nil {
+		pv := *src.U32
+		x.U32 = &pv
+	}
+	if src.S != nil {
+		pv := *src.S
+		x.S = &pv
+	}
+	if src.B != nil {
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入
+		x.B = append([]byte{}, src.B...)
+	}
+	if src.Bl != nil {
+		pv := *src.Bl
+		x.Bl = &pv
+	}
+	if src.D != nil {
+		pv := *src.D
+		x.D = &pv
+	}
+	if src.F != nil {
+		pv := *src.F
+		x.F = &pv
+	}
+	if src.Dn != nil {
+		pv := *src.Dn
+		x.Dn = &pv
+	}
+	if src.Lv != nil {
+		pv := *src.Lv
+		x.Lv = &pv
+	}
+	if src.F2 != nil {
+		pv := *src.F2
+		x.F2 = &pv
+	}
+	if src.Sf64 != nil {
+		pv := *src.Sf64
+		x.Sf64 = &pv
+	}
+	if src.U64 != nil {
+		pv := *src.U64
+		x.U64 = &pv
+	}
+	if src.Empty != nil {
+		pv := *src.Empty
+		x.Empty = &pv
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// XXX_MessageName returns the full name of P2Default
+func (x *P2Default) XXX_MessageName() string {
+	return "gopb.testpb.P2Default"
+}
+
+// ProtoReflect returns a protoreflect.Message view of x, which implements proto.Message.
+// proto.Marshal/proto.Unmarshal use MarshalObjectTo/UnmarshalObject
+func (x *P2Default) ProtoReflect() protoreflect.Message {
+	return file_proto2_proto_msgTypes[2].MessageOf(x)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Default) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, false)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Default) MarshalSize() (size int) {
+	if x.I32 != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(*x.I32))
+	}
+	if x.I64 != nil {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeVarint(uint64(*x.I64))
+	}
+	if x.U32 != nil {
+		// 1 = protowire.SizeTag(3)
+		size += 1 + protowire.SizeVarint(uint64(*x.U32))
+	}
+	if x.S != nil {
+		// 1 = protowire.SizeTag(4)
+		size += 1 + protowire.SizeBytes(len(*x.S))
+	}
+	if x.B != nil {
+		// 1 = protowire.SizeTag(5)
+		size += 1 + protowire.SizeBytes(len(x.B))
+	}
+	if x.Bl != nil {
+		// 1 = protowire.SizeTag(6)
+		size += 1 + 1
+	}
+	if x.D != nil {
+		// 1 = protowire.SizeTag(7)
+		size += 1 + 8
+	}
+	if x.F != nil {
+		// 1 = protowire.SizeTag(8)
+		size += 1 + 4
+	}
+	if x.Dn != nil {
+		// 1 = protowire.SizeTag(9)
+		size += 1 + 8
+	}
+	if x.Lv != nil {
+		// 1 = protowire.SizeTag(10)
+		size += 1 + protowire.SizeVarint(uint64(*x.Lv))
+	}
+	if x.F2 != nil {
+		// 1 = protowire.SizeTag(11)
+		size += 1 + 4
+	}
+	if x.Sf64 != nil {
+		// 1 = protowire.SizeTag(12)
+		size += 1 + 8
+	}
+	if x.U64 != nil {
+		// 1 = protowire.SizeTag(13)
+		size += 1 + protowire.SizeVarint(uint64(*x.U64))
+	}
+	if x.Empty != nil {
+		// 1 = protowire.SizeTag(14)
+		size += 1 + protowire.SizeBytes(len(*x.Empty))
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Default) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, false)
+}
+
+// MarshalObjectDeterministic marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Default) MarshalObjectDeterministic() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, true)
+}
+
+// MarshalObjectDeterministicTo marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Default) MarshalObjectDeterministicTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, true)
+}
+
+// marshalTo marshal data to []byte. deterministic 时 map 按键排序
+func (x *P2Default) marshalTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+	if x.I32 != nil {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(*x.I32))
+	}
+	if x.I64 != nil {
+		// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+		data = append(data, 0x10)
+		data = protowire.AppendVarint(data, uint64(*x.I64))
+	}
+	if x.U32 != nil {
+		// data = protowire.AppendTag(data, 3, protowire.VarintType) => 00011000
+		data = append(data, 0x18)
+		data = protowire.AppendVarint(data, uint64(*x.U32))
+	}
+	if x.S != nil {
+
+		// data = protowire.AppendTag(data, 4, protowire.BytesType) => 00100010
+		data = append(data, 0x22)
+		data = protowire.AppendString(data, *x.S)
+	}
+	if x.B != nil {
+		// data = protowire.AppendTag(data, 5, protowire.BytesType) => 00101010
+		data = append(data, 0x2a)
+		data = protowire.AppendBytes(data, x.B)
+	}
+	if x.Bl != nil {
+		// data = protowire.AppendTag(data, 6, protowire.VarintType) => 00110000
+		data = append(data, 0x30)
+		data = protowire.AppendVarint(data, protowire.EncodeBool(*x.Bl))
+	}
+	if x.D != nil {
+		// data = protowire.AppendTag(data, 7, protowire.Fixed64Type) => 00111001
+		data = append(data, 0x39)
+		data = protowire.AppendFixed64(data, math.Float64bits(*x.D))
+	}
+	if x.F != nil {
+		// data = protowire.AppendTag(data, 8, protowire.Fixed32Type) => 01000101
+		data = append(data, 0x45)
+		data = protowire.AppendFixed32(data, math.Float32bits(*x.F))
+	}
+	if x.Dn != nil {
+		// data = protowire.AppendTag(data, 9, protowire.Fixed64Type) => 01001001
+		data = append(data, 0x49)
+		data = protowire.AppendFixed64(data, math.Float64bits(*x.Dn))
+	}
+	if x.Lv != nil {
+		// data = protowire.AppendTag(data, 10, protowire.VarintType) => 01010000
+		data = append(data, 0x50)
+		data = protowire.AppendVarint(data, uint64(*x.Lv))
+	}
+	if x.F2 != nil {
+		// data = protowire.AppendTag(data, 11, protowire.Fixed32Type) => 01011101
+		data = append(data, 0x5d)
+		data = protowire.AppendFixed32(data, math.Float32bits(*x.F2))
+	}
+	if x.Sf64 != nil {
+		// data = protowire.AppendTag(data, 12, protowire.Fixed64Type) => 01100001
+		data = append(data, 0x61)
+		data = protowire.AppendFixed64(data, uint64(*x.Sf64))
+	}
+	if x.U64 != nil {
+		// data = protowire.AppendTag(data, 13, protowire.VarintType) => 01101000
+		data = append(data, 0x68)
+		data = protowire.AppendVarint(data, uint64(*x.U64))
+	}
+	if x.Empty != nil {
+
+		// data = protowire.AppendTag(data, 14, protowire.BytesType) => 01110010
+		data = append(data, 0x72)
+		data = protowire.AppendString(data, *x.Empty)
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte. 只限制嵌套深度(gopb.DefaultMaxDepth)
+func (x *P2Default) UnmarshalObject(data []byte) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{})
+}
+
+// UnmarshalObjectWith unmarshal data from []byte with the limits of opts
+func (x *P2Default) UnmarshalObjectWith(data []byte, opts gopb.UnmarshalOptions) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{UnmarshalOptions: opts})
+}
+
+// UnmarshalObjectState unmarshal data from []byte. st 在嵌套消息间传递, 由生成的代码使用
+func (x *P2Default) UnmarshalObjectState(data []byte, st *gopb.UnmarshalState) (err error) {
+	if !st.Enter() {
+		return gopb.LimitError("gopb.testpb.P2Default", "", 0, 0)
+	}
+
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Default", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "i32", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.I32 = &pv
+		case 2:
+			var pv int64
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "i64", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int64(v)
+			x.I64 = &pv
+		case 3:
+			var pv uint32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "u32", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = uint32(v)
+			x.U32 = &pv
+		case 4:
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "s", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = v
+			x.S = &pv
+		case 5:
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.P2Default", "b", 5, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.B = make([]byte, len(v))
+			copy(x.B, v)
+		case 6:
+			var pv bool
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "bl", 6, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = protowire.DecodeBool(v)
+			x.Bl = &pv
+		case 7:
+			var pv float64
+			v, cnt := protowire.ConsumeFixed64(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "d", 7, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = math.Float64frombits(v)
+			x.D = &pv
+		case 8:
+			var pv float32
+			v, cnt := protowire.ConsumeFixed32(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "f", 8, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = math.Float32frombits(v)
+			x.F = &pv
+		case 9:
+			var pv float64
+			v, cnt := protowire.ConsumeFixed64(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "dn", 9, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = math.Float64frombits(v)
+			x.Dn = &pv
+		case 10:
+			var pv Level
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "lv", 10, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if _, ok := Level_name[int32(v)]; !ok {
+				x.unknownFields = protowire.AppendTag(x.unknownFields, 10, protowire.VarintType)
+				x.unknownFields = protowire.AppendVarint(x.unknownFields, v)
+				continue
+			}
+			pv = Level(v)
+			x.Lv = &pv
+		case 11:
+			var pv float32
+			v, cnt := protowire.ConsumeFixed32(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "f2", 11, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = math.Float32frombits(v)
+			x.F2 = &pv
+		case 12:
+			var pv int64
+			v, cnt := protowire.ConsumeFixed64(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "sf64", 12, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int64(v)
+			x.Sf64 = &pv
+		case 13:
+			var pv uint64
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "u64", 13, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = uint64(v)
+			x.U64 = &pv
+		case 14:
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "empty", 14, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = v
+			x.Empty = &pv
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Default", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+	st.Leave()
+
+	return
+}
+
+// MarshalJSON marshal to protobuf canonical JSON
+func (x *P2Default) MarshalJSON() ([]byte, error) {
+	return x.AppendJSON(nil)
+}
+
+// AppendJSON appends the protobuf canonical JSON of x to b. nil is appended as empty object
+func (x *P2Default) AppendJSON(b []byte) (_ []byte, err error) {
+	if x == nil {
+		return append(b, '{', '}'), nil
+	}
+	b = append(b, '{')
+	if x.I32 != nil {
+		b = append(b, `"i32":`...)
+		b = strconv.AppendInt(b, int64(*x.I32), 10)
+		b = append(b, ',')
+	}
+	if x.I64 != nil {
+		b = append(b, `"i64":`...)
+		b = gopb.AppendJSONInt64(b, int64(*x.I64))
+		b = append(b, ',')
+	}
+	if x.U32 != nil {
+		b = append(b, `"u32":`...)
+		b = strconv.AppendUint(b, uint64(*x.U32), 10)
+		b = append(b, ',')
+	}
+	if x.S != nil {
+		b = append(b, `"s":`...)
+		b = gopb.AppendJSONString(b, *x.S)
+		b = append(b, ',')
+	}
+	if x.B != nil {
+		b = append(b, `"b":`...)
+		b = gopb.AppendJSONBytes(b, x.B)
+		b = append(b, ',')
+	}
+	if x.Bl != nil {
+		b = append(b, `"bl":`...)
+		b = strconv.AppendBool(b, *x.Bl)
+		b = append(b, ',')
+	}
+	if x.D != nil {
+		b = append(b, `"d":`...)
+		b = gopb.AppendJSONFloat(b, *x.D, 64)
+		b = append(b, ',')
+	}
+	if x.F != nil {
+		b = append(b, `"f":`...)
+		b = gopb.AppendJSONFloat(b, float64(*x.F), 32)
+		b = append(b, ',')
+	}
+	if x.Dn != nil {
+		b = append(b, `"dn":`...)
+		b = gopb.AppendJSONFloat(b, *x.Dn, 64)
+		b = append(b, ',')
+	}
+	if x.Lv != nil {
+		b = append(b, `"lv":`...)
+		b = gopb.AppendJSONEnum(b, int32(*x.Lv), Level_name)
+		b = append(b, ',')
+	}
+	if x.F2 != nil {
+		b = append(b, `"f2":`...)
+		b = gopb.AppendJSONFloat(b, float64(*x.F2), 32)
+		b = append(b, ',')
+	}
+	if x.Sf64 != nil {
+		b = append(b, `"sf64":`...)
+		b = gopb.AppendJSONInt64(b, int64(*x.Sf64))
+		b = append(b, ',')
+	}
+	if x.U64 != nil {
+		b = append(b, `"u64":`...)
+		b = gopb.AppendJSONUint64(b, uint64(*x.U64))
+		b = append(b, ',')
+	}
+	if x.Empty != nil {
+		b = append(b, `"empty":`...)
+		b = gopb.AppendJSONString(b, *x.Empty)
+		b = append(b, ',')
+	}
+	return gopb.CloseJSON(b, '}'), nil
+}
+
+// UnmarshalJSON unmarshal from protobuf canonical JSON. both json name and proto name of fields are accepted
+func (x *P2Default) UnmarshalJSON(data []byte) error {
+	x.Reset()
+	d := gopb.NewJSONDecoder(data)
+	if err := x.UnmarshalJSONFrom(d); err != nil {
+		return err
+	}
+	return d.End()
+}
+
+// UnmarshalJSONFrom reads a JSON object from d and merges it into x. null values are skipped.
+// duplicate fields, duplicate map keys and more than one member of a oneof are rejected as protojson
+func (x *P2Default) UnmarshalJSONFrom(d *gopb.JSONDecoder) error {
+	// 重复的字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadObject(func(name string) (err error) {
+		switch name {
+		case "i32":
+			if err = d.CheckField(&seen, 1, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.I32 = &pv
+		case "i64":
+			if err = d.CheckField(&seen, 2, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int64
+			pv, err = d.ReadInt64()
+			x.I64 = &pv
+		case "u32":
+			if err = d.CheckField(&seen, 3, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv uint32
+			pv, err = d.ReadUint32()
+			x.U32 = &pv
+		case "s":
+			if err = d.CheckField(&seen, 4, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv string
+			pv, err = d.ReadString()
+			x.S = &pv
+		case "b":
+			if err = d.CheckField(&seen, 5, name); err != nil || d.ReadNull() {
+				return
+			}
+			x.B, err = d.ReadBytes()
+		case "bl":
+			if err = d.CheckField(&seen, 6, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv bool
+			pv, err = d.ReadBool()
+			x.Bl = &pv
+		case "d":
+			if err = d.CheckField(&seen, 7, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv float64
+			pv, err = d.ReadFloat64()
+			x.D = &pv
+		case "f":
+			if err = d.CheckField(&seen, 8, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv float32
+			pv, err = d.ReadFloat32()
+			x.F = &pv
+		case "dn":
+			if err = d.CheckField(&seen, 9, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv float64
+			pv, err = d.ReadFloat64()
+			x.Dn = &pv
+		case "lv":
+			if err = d.CheckField(&seen, 10, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv Level
+			ev, err := d.ReadEnum(Level_value)
+			if err != nil {
+				return err
+			}
+			pv = Level(ev)
+			x.Lv = &pv
+		case "f2":
+			if err = d.CheckField(&seen, 11, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv float32
+			pv, err = d.ReadFloat32()
+			x.F2 = &pv
+		case "sf64":
+			if err = d.CheckField(&seen, 12, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int64
+			pv, err = d.ReadInt64()
+			x.Sf64 = &pv
+		case "u64":
+			if err = d.CheckField(&seen, 13, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv uint64
+			pv, err = d.ReadUint64()
+			x.U64 = &pv
+		case "empty":
+			if err = d.CheckField(&seen, 14, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv string
+			pv, err = d.ReadString()
+			x.Empty = &pv
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+// String returns the protobuf text format of x
+func (x *P2Default) String() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return string(x.AppendText(nil))
+}
+
+// AppendText appends the fields of x in protobuf text format to b
+func (x *P2Default) AppendText(b []byte) []byte {
+	if x == nil {
+		return b
+	}
+	start := len(b)
+	if x.I32 != nil {
+		b = gopb.AppendTextName(b, start, "i32")
+		b = strconv.AppendInt(b, int64(*x.I32), 10)
+	}
+	if x.I64 != nil {
+		b = gopb.AppendTextName(b, start, "i64")
+		b = strconv.AppendInt(b, int64(*x.I64), 10)
+	}
+	if x.U32 != nil {
+		b = gopb.AppendTextName(b, start, "u32")
+		b = strconv.AppendUint(b, uint64(*x.U32), 10)
+	}
+	if x.S != nil {
+		b = gopb.AppendTextName(b, start, "s")
+		b = gopb.AppendTextString(b, *x.S)
+	}
+	if x.B != nil {
+		b = gopb.AppendTextName(b, start, "b")
+		b = gopb.AppendTextBytes(b, x.B)
+	}
+	if x.Bl != nil {
+		b = gopb.AppendTextName(b, start, "bl")
+		b = strconv.AppendBool(b, *x.Bl)
+	}
+	if x.D != nil {
+		b = gopb.AppendTextName(b, start, "d")
+		b = gopb.AppendTextFloat(b, *x.D, 64)
+	}
+	if x.F != nil {
+		b = gopb.AppendTextName(b, start, "f")
+		b = gopb.AppendTextFloat(b, float64(*x.F), 32)
+	}
+	if x.Dn != nil {
+		b = gopb.AppendTextName(b, start, "dn")
+		b = gopb.AppendTextFloat(b, *x.Dn, 64)
+	}
+	if x.Lv != nil {
+		b = gopb.AppendTextName(b, start, "lv")
+		b = gopb.AppendTextEnum(b, int32(*x.Lv), Level_name)
+	}
+	if x.F2 != nil {
+		b = gopb.AppendTextName(b, start, "f2")
+		b = gopb.AppendTextFloat(b, float64(*x.F2), 32)
+	}
+	if x.Sf64 != nil {
+		b = gopb.AppendTextName(b, start, "sf64")
+		b = strconv.AppendInt(b, int64(*x.Sf64), 10)
+	}
+	if x.U64 != nil {
+		b = gopb.AppendTextName(b, start, "u64")
+		b = strconv.AppendUint(b, uint64(*x.U64), 10)
+	}
+	if x.Empty != nil {
+		b = gopb.AppendTextName(b, start, "empty")
+		b = gopb.AppendTextString(b, *x.Empty)
+	}
+	return b
+}
+
+// UnmarshalText unmarshal from protobuf text format
+func (x *P2Default) UnmarshalText(data []byte) error {
+	x.Reset()
+	return x.UnmarshalTextFrom(gopb.NewTextDecoder(data))
+}
+
+// UnmarshalTextFrom reads the fields of a message from d and merges them into x.
+// non-repeated fields appeared twice and more than one member of a oneof are rejected as prototext
+func (x *P2Default) UnmarshalTextFrom(d *gopb.TextDecoder) error {
+	// 重复的非repeated字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadMessage(func(name string) (err error) {
+		switch name {
+		case "i32":
+			if err = d.CheckField(&seen, 1, name); err != nil {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.I32 = &pv
+		case "i64":
+			if err = d.CheckField(&seen, 2, name); err != nil {
+				return
+			}
+			var pv int64
+			pv, err = d.ReadInt64()
+			x.I64 = &pv
+		case "u32":
+			if err = d.CheckField(&seen, 3, name); err != nil {
+				return
+			}
+			var pv uint32
+			pv, err = d.ReadUint32()
+			x.U32 = &pv
+		case "s":
+			if err = d.CheckField(&seen, 4, name); err != nil {
+				return
+			}
+			var pv string
+			pv, err = d.ReadString()
+			x.S = &pv
+		case "b":
+			if err = d.CheckField(&seen, 5, name); err != nil {
+				return
+			}
+			x.B, err = d.ReadBytes()
+		case "bl":
+			if err = d.CheckField(&seen, 6, name); err != nil {
+				return
+			}
+			var pv bool
+			pv, err = d.ReadBool()
+			x.Bl = &pv
+		case "d":
+			if err = d.CheckField(&seen, 7, name); err != nil {
+				return
+			}
+			var pv float64
+			pv, err = d.ReadFloat64()
+			x.D = &pv
+		case "f":
+			if err = d.CheckField(&seen, 8, name); err != nil {
+				return
+			}
+			var pv float32
+			pv, err = d.ReadFloat32()
+			x.F = &pv
+		case "dn":
+			if err = d.CheckField(&seen, 9, name); err != nil {
+				return
+			}
+			var pv float64
+			pv, err = d.ReadFloat64()
+			x.Dn = &pv
+		case "lv":
+			if err = d.CheckField(&seen, 10, name); err != nil {
+				return
+			}
+			var pv Level
+			ev, err := d.ReadEnum(Level_value)
+			if err != nil {
+				return err
+			}
+			pv = Level(ev)
+			x.Lv = &pv
+		case "f2":
+			if err = d.CheckField(&seen, 11, name); err != nil {
+				return
+			}
+			var pv float32
+			pv, err = d.ReadFloat32()
+			x.F2 = &pv
+		case "sf64":
+			if err = d.CheckField(&seen, 12, name); err != nil {
+				return
+			}
+			var pv int64
+			pv, err = d.ReadInt64()
+			x.Sf64 = &pv
+		case "u64":
+			if err = d.CheckField(&seen, 13, name); err != nil {
+				return
+			}
+			var pv uint64
+			pv, err = d.ReadUint64()
+			x.U64 = &pv
+		case "empty":
+			if err = d.CheckField(&seen, 14, name); err != nil {
+				return
+			}
+			var pv string
+			pv, err = d.ReadString()
+			x.Empty = &pv
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+type P2Ext struct {
+	A               *int32 `json:"a,omitempty"`
+	extensionFields []byte
+	unknownFields   []byte
+}
+
+func (x *P2Ext) Reset() {
+	*x = P2Ext{}
+}
+
+var poolP2Ext = sync.Pool{New: func() any { return &P2Ext{} }}
+
+// AcquireP2Ext returns an empty P2Ext from the pool. call Release to put it back
+func AcquireP2Ext() *P2Ext {
+	return poolP2Ext.Get().(*P2Ext)
+}
+
+// Release resets x, releases its sub-messages and puts x back to the pool. x must not be used after Release.
+// 列表截断保留容量, map 清空后保留
+func (x *P2Ext) Release() {
+	if x == nil {
+		return
+	}
+	*x = P2Ext{
+		extensionFields: x.extensionFields[:0],
+		unknownFields:   x.unknownFields[:0],
+	}
+	poolP2Ext.Put(x)
+}
+
+func (x *P2Ext) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+// HasA report whether the field is set
+func (x *P2Ext) HasA() bool {
+	return x != nil && x.A != nil
+}
+
+// ClearA clear the field
+func (x *P2Ext) ClearA() {
+	x.A = nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Ext) Clone() *P2Ext {
+	if x == nil {
+		return nil
+	}
+	y := &P2Ext{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Ext) CopyFrom(src *P2Ext) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.A != nil {
+		pv := *src.A
+		x.A = &pv
+	} else {
+		x.A = nil
+	}
+	x.extensionFields = append(x.extensionFields[:0], src.extensionFields...)
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Ext) Equal(other *P2Ext) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.A == nil) != (other.A == nil) {
+		return false
+	}
+	if x.A != nil {
+		if *x.A != *other.A {
+			return false
+		}
+	}
+	if !gopb.EqualExtensions(x.extensionFields, other.extensionFields) {
+		return false
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Ext) Merge(src *P2Ext) {
+	if src == nil {
+		return
+	}
+	if src.A != nil {
+		pv := *src.A
+		x.A = &pv
+	}
+	x.extensionFields = append(x.extensionFields, src.extensionFields...)
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// XXX_MessageName returns the full name of P2Ext
+func (x *P2Ext) XXX_MessageName() string {
+	return "gopb.testpb.P2Ext"
+}
+
+// ProtoReflect returns a protoreflect.Message view of x, which implements proto.Message.
+// proto.Marshal/proto.Unmarshal use MarshalObjectTo/UnmarshalObject
+func (x *P2Ext) ProtoReflect() protoreflect.Message {
+	return file_proto2_proto_msgTypes[3].MessageOf(x)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Ext) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, false)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Ext) MarshalSize() (size int) {
+	if x.A != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(*x.A))
+	}
+	size += len(x.extensionFields)
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Ext) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, false)
+}
+
+// MarshalObjectDeterministic marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Ext) MarshalObjectDeterministic() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, true)
+}
+
+// MarshalObjectDeterministicTo marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Ext) MarshalObjectDeterministicTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, true)
+}
+
+// marshalTo marshal data to []byte. deterministic 时 map 按键排序
+func (x *P2Ext) marshalTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+	if x.A != nil {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(*x.A))
+	}
+	data = append(data, x.extensionFields...)
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte. 只限制嵌套深度(gopb.DefaultMaxDepth)
+func (x *P2Ext) UnmarshalObject(data []byte) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{})
+}
+
+// UnmarshalObjectWith unmarshal data from []byte with the limits of opts
+func (x *P2Ext) UnmarshalObjectWith(data []byte, opts gopb.UnmarshalOptions) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{UnmarshalOptions: opts})
+}
+
+// UnmarshalObjectState unmarshal data from []byte. st 在嵌套消息间传递, 由生成的代码使用
+func (x *P2Ext) UnmarshalObjectState(data []byte, st *gopb.UnmarshalState) (err error) {
+	if !st.Enter() {
+		return gopb.LimitError("gopb.testpb.P2Ext", "", 0, 0)
+	}
+
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Ext", "a", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.A = &pv
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Ext", "", num, index, cnt)
+			}
+			if 100 <= num && num < 200 {
+				x.extensionFields = protowire.AppendTag(x.extensionFields, num, typ)
+				x.extensionFields = append(x.extensionFields, data[index:index+cnt]...)
+				index += cnt
+				continue
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+	st.Leave()
+
+	return
+}
+
+// HasExtension report whether the extension field num is set
+func (x *P2Ext) HasExtension(num protowire.Number) bool {
+	return len(x.GetExtension(num)) > 0
+}
+
+// GetExtension return the encoded records(tag and value) of the extension field num
+func (x *P2Ext) GetExtension(num protowire.Number) (raw []byte) {
+	if x == nil {
+		return
+	}
+	for index := 0; index < len(x.extensionFields); {
+		n, typ, cnt := protowire.ConsumeTag(x.extensionFields[index:])
+		if cnt < 0 {
+			return
+		}
+		vcnt := protowire.ConsumeFieldValue(n, typ, x.extensionFields[index+cnt:])
+		if vcnt < 0 {
+			return
+		}
+		if n == num {
+			raw = append(raw, x.extensionFields[index:index+cnt+vcnt]...)
+		}
+		index += cnt + vcnt
+	}
+	return
+}
+
+// SetExtension replace the encoded records(tag and value) of the extension field num. nil raw clear the field
+func (x *P2Ext) SetExtension(num protowire.Number, raw []byte) {
+	var fields []byte
+	for index := 0; index < len(x.extensionFields); {
+		n, typ, cnt := protowire.ConsumeTag(x.extensionFields[index:])
+		if cnt < 0 {
+			break
+		}
+		vcnt := protowire.ConsumeFieldValue(n, typ, x.extensionFields[index+cnt:])
+		if vcnt < 0 {
+			break
+		}
+		if n != num {
+			fields = append(fields, x.extensionFields[index:index+cnt+vcnt]...)
+		}
+		index += cnt + vcnt
+	}
+	x.extensionFields = append(fields, raw...)
+}
+
+// MarshalJSON marshal to protobuf canonical JSON
+func (x *P2Ext) MarshalJSON() ([]byte, error) {
+	return x.AppendJSON(nil)
+}
+
+// AppendJSON appends the protobuf canonical JSON of x to b. nil is appended as empty object
+func (x *P2Ext) AppendJSON(b []byte) (_ []byte, err error) {
+	if x == nil {
+		return append(b, '{', '}'), nil
+	}
+	b = append(b, '{')
+	if x.A != nil {
+		b = append(b, `"a":`...)
+		b = strconv.AppendInt(b, int64(*x.A), 10)
+		b = append(b, ',')
+	}
+	return gopb.CloseJSON(b, '}'), nil
+}
+
+// UnmarshalJSON unmarshal from protobuf canonical JSON. both json name and proto name of fields are accepted
+func (x *P2Ext) UnmarshalJSON(data []byte) error {
+	x.Reset()
+	d := gopb.NewJSONDecoder(data)
+	if err := x.UnmarshalJSONFrom(d); err != nil {
+		return err
+	}
+	return d.End()
+}
+
+// UnmarshalJSONFrom reads a JSON object from d and merges it into x. null values are skipped.
+// duplicate fields, duplicate map keys and more than one member of a oneof are rejected as protojson
+func (x *P2Ext) UnmarshalJSONFrom(d *gopb.JSONDecoder) error {
+	// 重复的字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadObject(func(name string) (err error) {
+		switch name {
+		case "a":
+			if err = d.CheckField(&seen, 1, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.A = &pv
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+// String returns the protobuf text format of x
+func (x *P2Ext) String() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return string(x.AppendText(nil))
+}
+
+// AppendText appends the fields of x in protobuf text format to b
+func (x *P2Ext) AppendText(b []byte) []byte {
+	if x == nil {
+		return b
+	}
+	start := len(b)
+	if x.A != nil {
+		b = gopb.AppendTextName(b, start, "a")
+		b = strconv.AppendInt(b, int64(*x.A), 10)
+	}
+	return b
+}
+
+// UnmarshalText unmarshal from protobuf text format
+func (x *P2Ext) UnmarshalText(data []byte) error {
+	x.Reset()
+	return x.UnmarshalTextFrom(gopb.NewTextDecoder(data))
+}
+
+// UnmarshalTextFrom reads the fields of a message from d and merges them into x.
+// non-repeated fields appeared twice and more than one member of a oneof are rejected as prototext
+func (x *P2Ext) UnmarshalTextFrom(d *gopb.TextDecoder) error {
+	// 重复的非repeated字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadMessage(func(name string) (err error) {
+		switch name {
+		case "a":
+			if err = d.CheckField(&seen, 1, name); err != nil {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.A = &pv
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+type P2Group struct {
+	G             *P2Group_G    `json:"g,omitempty"`
+	Rg            []*P2Group_RG `json:"rg,omitempty"`
+	After         *int32        `json:"after,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2Group) Reset() {
+	*x = P2Group{}
+}
+
+var poolP2Group = sync.Pool{New: func() any { return &P2Group{} }}
+
+// AcquireP2Group returns an empty P2Group from the pool. call Release to put it back
+func AcquireP2Group() *P2Group {
+	return poolP2Group.Get().(*P2Group)
+}
+
+// Release resets x, releases its sub-messages and puts x back to the pool. x must not be used after Release.
+// 列表截断保留容量, map 清空后保留
+func (x *P2Group) Release() {
+	if x == nil {
+		return
+	}
+	x.G.Release()
+	for i, v := range x.Rg {
+		v.Release()
+		x.Rg[i] = nil
+	}
+	*x = P2Group{
+		Rg:            x.Rg[:0],
+		unknownFields: x.unknownFields[:0],
+	}
+	poolP2Group.Put(x)
+}
+
+func (x *P2Group) GetG() *P2Group_G {
+	if x != nil {
+		return x.G
+	}
+	return nil
+}
+
+// HasG report whether the field is set
+func (x *P2Group) HasG() bool {
+	return x != nil && x.G != nil
+}
+
+// ClearG clear the field
+func (x *P2Group) ClearG() {
+	x.G = nil
+}
+
+func (x *P2Group) GetRg() []*P2Group_RG {
+	if x != nil {
+		return x.Rg
+	}
+	return nil
+}
+
+func (x *P2Group) GetAfter() int32 {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return 0
+}
+
+// HasAfter report whether the field is set
+func (x *P2Group) HasAfter() bool {
+	return x != nil && x.After != nil
+}
+
+// ClearAfter clear the field
+func (x *P2Group) ClearAfter() {
+	x.After = nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Group) Clone() *P2Group {
+	if x == nil {
+		return nil
+	}
+	y := &P2Group{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Group) CopyFrom(src *P2Group) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.G != nil {
+		if x.G == nil {
+			x.G = &P2Group_G{}
+		}
+		x.G.CopyFrom(src.G)
+	} else {
+		x.G = nil
+	}
+	// 只复用原来长度内的元素. 超出长度的部分(例如 Release 截断的列表)可能已被其他消息使用, 置为零值后重新分配
+	if n, old := len(src.Rg), len(x.Rg); n <= old {
+		x.Rg = x.Rg[:n]
+	} else {
+		x.Rg = append(x.Rg, make([]*P2Group_RG, n-old)...)
+	}
+	for k, item := range src.Rg {
+		if item != nil {
+			if x.Rg[k] == nil {
+				x.Rg[k] = &P2Group_RG{}
+			}
+			x.Rg[k].CopyFrom(item)
+		} else {
+			x.Rg[k] = nil
+		}
+	}
+	if src.After != nil {
+		pv := *src.After
+		x.After = &pv
+	} else {
+		x.After = nil
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Group) Equal(other *P2Group) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if !x.G.Equal(other.G) {
+		return false
+	}
+	if len(x.Rg) != len(other.Rg) {
+		return false
+	}
+	for k := range x.Rg {
+		if !x.Rg[k].Equal(other.Rg[k]) {
+			return false
+		}
+	}
+	if (x.After == nil) != (other.After == nil) {
+		return false
+	}
+	if x.After != nil {
+		if *x.After != *other.After {
+			return false
+		}
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Group) Merge(src *P2Group) {
+	if src == nil {
+		return
+	}
+	if src.G != nil {
+		if x.G == nil {
+			x.G = &P2Group_G{}
+		}
+		x.G.Merge(src.G)
+	}
+	if src.Rg != nil {
+		for _, item := range src.Rg {
+			x.Rg = append(x.Rg, nil)
+			if item != nil {
+				if x.Rg[len(x.Rg)-1] == nil {
+					x.Rg[len(x.Rg)-1] = &P2Group_RG{}
+				}
+				x.Rg[len(x.Rg)-1].CopyFrom(item)
+			} else {
+				x.Rg[len(x.Rg)-1] = nil
+			}
+		}
+	}
+	if src.After != nil {
+		pv := *src.After
+		x.After = &pv
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// XXX_MessageName returns the full name of P2Group
+func (x *P2Group) XXX_MessageName() string {
+	return "gopb.testpb.P2Group"
+}
+
+// ProtoReflect returns a protoreflect.Message view of x, which implements proto.Message.
+// proto.Marshal/proto.Unmarshal use MarshalObjectTo/UnmarshalObject
+func (x *P2Group) ProtoReflect() protoreflect.Message {
+	return file_proto2_proto_msgTypes[4].MessageOf(x)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Group) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, false)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Group) MarshalSize() (size int) {
+	if x.G != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 2*1 + x.G.MarshalSize()
+	}
+	if x.Rg != nil {
+		// 1 = protowire.SizeTag(4)
+		size += 2 * 1 * len(x.Rg)
+		for k := 0; k < len(x.Rg); k++ {
+			size += x.Rg[k].MarshalSize()
+		}
+	}
+	if x.After != nil {
+		// 1 = protowire.SizeTag(7)
+		size += 1 + protowire.SizeVarint(uint64(*x.After))
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Group) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, false)
+}
+
+// MarshalObjectDeterministic marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Group) MarshalObjectDeterministic() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, true)
+}
+
+// MarshalObjectDeterministicTo marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Group) MarshalObjectDeterministicTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, true)
+}
+
+// marshalTo marshal data to []byte. deterministic 时 map 按键排序
+func (x *P2Group) marshalTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+	if x.G != nil {
+		// data = protowire.AppendTag(data, 1, protowire.StartGroupType) => 00001011
+		data = append(data, 0xb)
+		data, err = x.G.marshalTo(data, deterministic)
+		if err != nil {
+			return
+		}
+		// data = protowire.AppendTag(data, 1, protowire.EndGroupType) => 00001100
+		data = append(data, 0xc)
+	}
+	if x.Rg != nil {
+		for _, item := range x.Rg {
+			// data = protowire.AppendTag(data, 4, protowire.StartGroupType) => 00100011
+			data = append(data, 0x23)
+			data, err = item.marshalTo(data, deterministic)
+			if err != nil {
+				return
+			}
+			// data = protowire.AppendTag(data, 4, protowire.EndGroupType) => 00100100
+			data = append(data, 0x24)
+		}
+	}
+	if x.After != nil {
+		// data = protowire.AppendTag(data, 7, protowire.VarintType) => 00111000
+		data = append(data, 0x38)
+		data = protowire.AppendVarint(data, uint64(*x.After))
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte. 只限制嵌套深度(gopb.DefaultMaxDepth)
+func (x *P2Group) UnmarshalObject(data []byte) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{})
+}
+
+// UnmarshalObjectWith unmarshal data from []byte with the limits of opts
+func (x *P2Group) UnmarshalObjectWith(data []byte, opts gopb.UnmarshalOptions) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{UnmarshalOptions: opts})
+}
+
+// UnmarshalObjectState unmarshal data from []byte. st 在嵌套消息间传递, 由生成的代码使用
+func (x *P2Group) UnmarshalObjectState(data []byte, st *gopb.UnmarshalState) (err error) {
+	if !st.Enter() {
+		return gopb.LimitError("gopb.testpb.P2Group", "", 0, 0)
+	}
+
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Group", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			v, cnt := protowire.ConsumeGroup(1, data[index:])
+			if cnt < 0 {
+				err = gopb.ParseError("gopb.testpb.P2Group", "g", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.G == nil {
+				x.G = AcquireP2Group_G()
+			}
+			err = x.G.UnmarshalObjectState(v, st)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2Group", "g", -1, cap(data)-cap(v))
+				return
+			}
+		case 4:
+			if typ != protowire.StartGroupType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Group", "rg", 4, cap(data)-cap(data[index:]), typ, protowire.StartGroupType)
+				return
+			}
+			buf, cnt := protowire.ConsumeGroup(4, data[index:])
+			if cnt < 0 {
+				err = gopb.ParseError("gopb.testpb.P2Group", "rg", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.Rg == nil {
+				x.Rg = make([]*P2Group_RG, 0, 2)
+			}
+			item := AcquireP2Group_RG()
+			err = item.UnmarshalObjectState(buf, st)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2Group", "rg", len(x.Rg), cap(data)-cap(buf))
+				return
+			}
+			x.Rg = append(x.Rg, item)
+
+			if !st.Grow(len(x.Rg)) {
+				err = gopb.LimitError("gopb.testpb.P2Group", "rg", 4, cap(data)-cap(data[index:]))
+				return
+			}
+		case 7:
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Group", "after", 7, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.After = &pv
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Group", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+	st.Leave()
+
+	return
+}
+
+// MarshalJSON marshal to protobuf canonical JSON
+func (x *P2Group) MarshalJSON() ([]byte, error) {
+	return x.AppendJSON(nil)
+}
+
+// AppendJSON appends the protobuf canonical JSON of x to b. nil is appended as empty object
+func (x *P2Group) AppendJSON(b []byte) (_ []byte, err error) {
+	if x == nil {
+		return append(b, '{', '}'), nil
+	}
+	b = append(b, '{')
+	if x.G != nil {
+		b = append(b, `"g":`...)
+		if b, err = x.G.AppendJSON(b); err != nil {
+			return
+		}
+		b = append(b, ',')
+	}
+	if x.Rg != nil {
+		b = append(b, `"rg":`...)
+		b = append(b, '[')
+		for _, item := range x.Rg {
+			if b, err = item.AppendJSON(b); err != nil {
+				return
+			}
+			b = append(b, ',')
+		}
+		b = gopb.CloseJSON(b, ']')
+		b = append(b, ',')
+	}
+	if x.After != nil {
+		b = append(b, `"after":`...)
+		b = strconv.AppendInt(b, int64(*x.After), 10)
+		b = append(b, ',')
+	}
+	return gopb.CloseJSON(b, '}'), nil
+}
+
+// UnmarshalJSON unmarshal from protobuf canonical JSON. both json name and proto name of fields are accepted
+func (x *P2Group) UnmarshalJSON(data []byte) error {
+	x.Reset()
+	d := gopb.NewJSONDecoder(data)
+	if err := x.UnmarshalJSONFrom(d); err != nil {
+		return err
+	}
+	return d.End()
+}
+
+// UnmarshalJSONFrom reads a JSON object from d and merges it into x. null values are skipped.
+// duplicate fields, duplicate map keys and more than one member of a oneof are rejected as protojson
+func (x *P2Group) UnmarshalJSONFrom(d *gopb.JSONDecoder) error {
+	// 重复的字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadObject(func(name string) (err error) {
+		switch name {
+		case "g":
+			if err = d.CheckField(&seen, 1, name); err != nil || d.ReadNull() {
+				return
+			}
+			if x.G == nil {
+				x.G = &P2Group_G{}
+			}
+			err = x.G.UnmarshalJSONFrom(d)
+		case "rg":
+			if err = d.CheckField(&seen, 4, name); err != nil || d.ReadNull() {
+				return
+			}
+			err = d.ReadArray(func() (err error) {
+				var item *P2Group_RG
+				if item == nil {
+					item = &P2Group_RG{}
+				}
+				err = item.UnmarshalJSONFrom(d)
+				x.Rg = append(x.Rg, item)
+				return
+			})
+		case "after":
+			if err = d.CheckField(&seen, 7, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.After = &pv
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+// String returns the protobuf text format of x
+func (x *P2Group) String() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return string(x.AppendText(nil))
+}
+
+// AppendText appends the fields of x in protobuf text format to b
+func (x *P2Group) AppendText(b []byte) []byte {
+	if x == nil {
+		return b
+	}
+	start := len(b)
+	if x.G != nil {
+		b = gopb.AppendTextName(b, start, "G")
+		b = append(b, '{')
+		b = x.G.AppendText(b)
+		b = append(b, '}')
+	}
+	if x.Rg != nil {
+		for _, item := range x.Rg {
+			b = gopb.AppendTextName(b, start, "RG")
+			b = append(b, '{')
+			b = item.AppendText(b)
+			b = append(b, '}')
+		}
+	}
+	if x.After != nil {
+		b = gopb.AppendTextName(b, start, "after")
+		b = strconv.AppendInt(b, int64(*x.After), 10)
+	}
+	return b
+}
+
+// UnmarshalText unmarshal from protobuf text format
+func (x *P2Group) UnmarshalText(data []byte) error {
+	x.Reset()
+	return x.UnmarshalTextFrom(gopb.NewTextDecoder(data))
+}
+
+// UnmarshalTextFrom reads the fields of a message from d and merges them into x.
+// non-repeated fields appeared twice and more than one member of a oneof are rejected as prototext
+func (x *P2Group) UnmarshalTextFrom(d *gopb.TextDecoder) error {
+	// 重复的非repeated字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadMessage(func(name string) (err error) {
+		switch name {
+		case "G":
+			if err = d.CheckField(&seen, 1, name); err != nil {
+				return
+			}
+			if x.G == nil {
+				x.G = &P2Group_G{}
+			}
+			err = x.G.UnmarshalTextFrom(d)
+		case "RG":
+			err = d.ReadRepeated(func() (err error) {
+				var item *P2Group_RG
+				if item == nil {
+					item = &P2Group_RG{}
+				}
+				err = item.UnmarshalTextFrom(d)
+				x.Rg = append(x.Rg, item)
+				return
+			})
+		case "after":
+			if err = d.CheckField(&seen, 7, name); err != nil {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.After = &pv
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+type P2Group_G struct {
+	A             *int32  `json:"a,omitempty"`
+	B             *string `json:"b,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2Group_G) Reset() {
+	*x = P2Group_G{}
+}
+
+var poolP2Group_G = sync.Pool{New: func() any { return &P2Group_G{} }}
+
+// AcquireP2Group_G returns an empty P2Group_G from the pool. call Release to put it back
+func AcquireP2Group_G() *P2Group_G {
+	return poolP2Group_G.Get().(*P2Group_G)
+}
+
+// Release resets x, releases its sub-messages and puts x back to the pool. x must not be used after Release.
+// 列表截断保留容量, map 清空后保留
+func (x *P2Group_G) Release() {
+	if x == nil {
+		return
+	}
+	*x = P2Group_G{
+		unknownFields: x.unknownFields[:0],
+	}
+	poolP2Group_G.Put(x)
+}
+
+func (x *P2Group_G) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+// HasA report whether the field is set
+func (x *P2Group_G) HasA() bool {
+	return x != nil && x.A != nil
+}
+
+// ClearA clear the field
+func (x *P2Group_G) ClearA() {
+	x.A = nil
+}
+
+func (x *P2Group_G) GetB() string {
+	if x != nil && x.B != nil {
+		return *x.B
+	}
+	return ""
+}
+
+// HasB report whether the field is set
+func (x *P2Group_G) HasB() bool {
+	return x != nil && x.B != nil
+}
+
+// ClearB clear the field
+func (x *P2Group_G) ClearB() {
+	x.B = nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Group_G) Clone() *P2Group_G {
+	if x == nil {
+		return nil
+	}
+	y := &P2Group_G{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Group_G) CopyFrom(src *P2Group_G) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.A != nil {
+		pv := *src.A
+		x.A = &pv
+	} else {
+		x.A = nil
+	}
+	if src.B != nil {
+		pv := *src.B
+		x.B = &pv
+	} else {
+		x.B = nil
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Group_G) Equal(other *P2Group_G) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.A == nil) != (other.A == nil) {
+		return false
+	}
+	if x.A != nil {
+		if *x.A != *other.A {
+			return false
+		}
+	}
+	if (x.B == nil) != (other.B == nil) {
+		return false
+	}
+	if x.B != nil {
+		if *x.B != *other.B {
+			return false
+		}
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Group_G) Merge(src *P2Group_G) {
+	if src == nil {
+		return
+	}
+	if src.A != nil {
+		pv := *src.A
+		x.A = &pv
+	}
+	if src.B != nil {
+		pv := *src.B
+		x.B = &pv
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// XXX_MessageName returns the full name of P2Group_G
+func (x *P2Group_G) XXX_MessageName() string {
+	return "gopb.testpb.P2Group.G"
+}
+
+// ProtoReflect returns a protoreflect.Message view of x, which implements proto.Message.
+// proto.Marshal/proto.Unmarshal use MarshalObjectTo/UnmarshalObject
+func (x *P2Group_G) ProtoReflect() protoreflect.Message {
+	return file_proto2_proto_msgTypes[5].MessageOf(x)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Group_G) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, false)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Group_G) MarshalSize() (size int) {
+	if x.A != nil {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeVarint(uint64(*x.A))
+	}
+	if x.B != nil {
+		// 1 = protowire.SizeTag(3)
+		size += 1 + protowire.SizeBytes(len(*x.B))
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Group_G) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, false)
+}
+
+// MarshalObjectDeterministic marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Group_G) MarshalObjectDeterministic() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, true)
+}
+
+// MarshalObjectDeterministicTo marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Group_G) MarshalObjectDeterministicTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, true)
+}
+
+// marshalTo marshal data to []byte. deterministic 时 map 按键排序
+func (x *P2Group_G) marshalTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+	if x.A != nil {
+		// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+		data = append(data, 0x10)
+		data = protowire.AppendVarint(data, uint64(*x.A))
+	}
+	if x.B != nil {
+
+		// data = protowire.AppendTag(data, 3, protowire.BytesType) => 00011010
+		data = append(data, 0x1a)
+		data = protowire.AppendString(data, *x.B)
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte. 只限制嵌套深度(gopb.DefaultMaxDepth)
+func (x *P2Group_G) UnmarshalObject(data []byte) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{})
+}
+
+// UnmarshalObjectWith unmarshal data from []byte with the limits of opts
+func (x *P2Group_G) UnmarshalObjectWith(data []byte, opts gopb.UnmarshalOptions) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{UnmarshalOptions: opts})
+}
+
+// UnmarshalObjectState unmarshal data from []byte. st 在嵌套消息间传递, 由生成的代码使用
+func (x *P2Group_G) UnmarshalObjectState(data []byte, st *gopb.UnmarshalState) (err error) {
+	if !st.Enter() {
+		return gopb.LimitError("gopb.testpb.P2Group.G", "", 0, 0)
+	}
+
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Group.G", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 2:
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Group.G", "a", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.A = &pv
+		case 3:
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Group.G", "b", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = v
+			x.B = &pv
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Group.G", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+	st.Leave()
+
+	return
+}
+
+// MarshalJSON marshal to protobuf canonical JSON
+func (x *P2Group_G) MarshalJSON() ([]byte, error) {
+	return x.AppendJSON(nil)
+}
+
+// AppendJSON appends the protobuf canonical JSON of x to b. nil is appended as empty object
+func (x *P2Group_G) AppendJSON(b []byte) (_ []byte, err error) {
+	if x == nil {
+		return append(b, '{', '}'), nil
+	}
+	b = append(b, '{')
+	if x.A != nil {
+		b = append(b, `"a":`...)
+		b = strconv.AppendInt(b, int64(*x.A), 10)
+		b = append(b, ',')
+	}
+	if x.B != nil {
+		b = append(b, `"b":`...)
+		b = gopb.AppendJSONString(b, *x.B)
+		b = append(b, ',')
+	}
+	return gopb.CloseJSON(b, '}'), nil
+}
+
+// UnmarshalJSON unmarshal from protobuf canonical JSON. both json name and proto name of fields are accepted
+func (x *P2Group_G) UnmarshalJSON(data []byte) error {
+	x.Reset()
+	d := gopb.NewJSONDecoder(data)
+	if err := x.UnmarshalJSONFrom(d); err != nil {
+		return err
+	}
+	return d.End()
+}
+
+// UnmarshalJSONFrom reads a JSON object from d and merges it into x. null values are skipped.
+// duplicate fields, duplicate map keys and more than one member of a oneof are rejected as protojson
+func (x *P2Group_G) UnmarshalJSONFrom(d *gopb.JSONDecoder) error {
+	// 重复的字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadObject(func(name string) (err error) {
+		switch name {
+		case "a":
+			if err = d.CheckField(&seen, 2, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.A = &pv
+		case "b":
+			if err = d.CheckField(&seen, 3, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv string
+			pv, err = d.ReadString()
+			x.B = &pv
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+// String returns the protobuf text format of x
+func (x *P2Group_G) String() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return string(x.AppendText(nil))
+}
+
+// AppendText appends the fields of x in protobuf text format to b
+func (x *P2Group_G) AppendText(b []byte) []byte {
+	if x == nil {
+		return b
+	}
+	start := len(b)
+	if x.A != nil {
+		b = gopb.AppendTextName(b, start, "a")
+		b = strconv.AppendInt(b, int64(*x.A), 10)
+	}
+	if x.B != nil {
+		b = gopb.AppendTextName(b, start, "b")
+		b = gopb.AppendTextString(b, *x.B)
+	}
+	return b
+}
+
+// UnmarshalText unmarshal from protobuf text format
+func (x *P2Group_G) UnmarshalText(data []byte) error {
+	x.Reset()
+	return x.UnmarshalTextFrom(gopb.NewTextDecoder(data))
+}
+
+// UnmarshalTextFrom reads the fields of a message from d and merges them into x.
+// non-repeated fields appeared twice and more than one member of a oneof are rejected as prototext
+func (x *P2Group_G) UnmarshalTextFrom(d *gopb.TextDecoder) error {
+	// 重复的非repeated字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadMessage(func(name string) (err error) {
+		switch name {
+		case "a":
+			if err = d.CheckField(&seen, 2, name); err != nil {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.A = &pv
+		case "b":
+			if err = d.CheckField(&seen, 3, name); err != nil {
+				return
+			}
+			var pv string
+			pv, err = d.ReadString()
+			x.B = &pv
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+type P2Group_RG struct {
+	C             *int32   `json:"c,omitempty"`
+	Nested        *P2Group `json:"nested,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2Group_RG) Reset() {
+	*x = P2Group_RG{}
+}
+
+var poolP2Group_RG = sync.Pool{New: func() any { return &P2Group_RG{} }}
+
+// AcquireP2Group_RG returns an empty P2Group_RG from the pool. call Release to put it back
+func AcquireP2Group_RG() *P2Group_RG {
+	return poolP2Group_RG.Get().(*P2Group_RG)
+}
+
+// Release resets x, releases its sub-messages and puts x back to the pool. x must not be used after Release.
+// 列表截断保留容量, map 清空后保留
+func (x *P2Group_RG) Release() {
+	if x == nil {
+		return
+	}
+	x.Nested.Release()
+	*x = P2Group_RG{
+		unknownFields: x.unknownFields[:0],
+	}
+	poolP2Group_RG.Put(x)
+}
+
+func (x *P2Group_RG) GetC() int32 {
+	if x != nil && x.C != nil {
+		return *x.C
+	}
+	return 0
+}
+
+// HasC report whether the field is set
+func (x *P2Group_RG) HasC() bool {
+	return x != nil && x.C != nil
+}
+
+// ClearC clear the field
+func (x *P2Group_RG) ClearC() {
+	x.C = nil
+}
+
+func (x *P2Group_RG) GetNested() *P2Group {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+// HasNested report whether the field is set
+func (x *P2Group_RG) HasNested() bool {
+	return x != nil && x.Nested != nil
+}
+
+// ClearNested clear the field
+func (x *P2Group_RG) ClearNested() {
+	x.Nested = nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Group_RG) Clone() *P2Group_RG {
+	if x == nil {
+		return nil
+	}
+	y := &P2Group_RG{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Group_RG) CopyFrom(src *P2Group_RG) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.C != nil {
+		pv := *src.C
+		x.C = &pv
+	} else {
+		x.C = nil
+	}
+	if src.Nested != nil {
+		if x.Nested == nil {
+			x.Nested = &P2Group{}
+		}
+		x.Nested.CopyFrom(src.Nested)
+	} else {
+		x.Nested = nil
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Group_RG) Equal(other *P2Group_RG) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.C == nil) != (other.C == nil) {
+		return false
+	}
+	if x.C != nil {
+		if *x.C != *other.C {
+			return false
+		}
+	}
+	if !x.Nested.Equal(other.Nested) {
+		return false
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Group_RG) Merge(src *P2Group_RG) {
+	if src == nil {
+		return
+	}
+	if src.C != nil {
+		pv := *src.C
+		x.C = &pv
+	}
+	if src.Nested != nil {
+		if x.Nested == nil {
+			x.Nested = &P2Group{}
+		}
+		x.Nested.Merge(src.Nested)
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// XXX_MessageName returns the full name of P2Group_RG
+func (x *P2Group_RG) XXX_MessageName() string {
+	return "gopb.testpb.P2Group.RG"
+}
+
+// ProtoReflect returns a protoreflect.Message view of x, which implements proto.Message.
+// proto.Marshal/proto.Unmarshal use MarshalObjectTo/UnmarshalObject
+func (x *P2Group_RG) ProtoReflect() protoreflect.Message {
+	return file_proto2_proto_msgTypes[6].MessageOf(x)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Group_RG) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, false)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Group_RG) MarshalSize() (size int) {
+	if x.C != nil {
+		// 1 = protowire.SizeTag(5)
+		size += 1 + protowire.SizeVarint(uint64(*x.C))
+	}
+	if x.Nested != nil {
+		// 1 = protowire.SizeTag(6)
+		size += 1 + protowire.SizeBytes(x.Nested.MarshalSize())
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Group_RG) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, false)
+}
+
+// MarshalObjectDeterministic marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Group_RG) MarshalObjectDeterministic() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, true)
+}
+
+// MarshalObjectDeterministicTo marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Group_RG) MarshalObjectDeterministicTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, true)
+}
+
+// marshalTo marshal data to []byte. deterministic 时 map 按键排序
+func (x *P2Group_RG) marshalTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+	if x.C != nil {
+		// data = protowire.AppendTag(data, 5, protowire.VarintType) => 00101000
+		data = append(data, 0x28)
+		data = protowire.AppendVarint(data, uint64(*x.C))
+	}
+	if x.Nested != nil {
+		// data = protowire.AppendTag(data, 6, protowire.BytesType) => 00110010
+		data = append(data, 0x32)
+		data = protowire.AppendVarint(data, uint64(x.Nested.MarshalSize()))
+		data, err = x.Nested.marshalTo(data, deterministic)
+		if err != nil {
+			return
+		}
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte. 只限制嵌套深度(gopb.DefaultMaxDepth)
+func (x *P2Group_RG) UnmarshalObject(data []byte) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{})
+}
+
+// UnmarshalObjectWith unmarshal data from []byte with the limits of opts
+func (x *P2Group_RG) UnmarshalObjectWith(data []byte, opts gopb.UnmarshalOptions) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{UnmarshalOptions: opts})
+}
+
+// UnmarshalObjectState unmarshal data from []byte. st 在嵌套消息间传递, 由生成的代码使用
+func (x *P2Group_RG) UnmarshalObjectState(data []byte, st *gopb.UnmarshalState) (err error) {
+	if !st.Enter() {
+		return gopb.LimitError("gopb.testpb.P2Group.RG", "", 0, 0)
+	}
+
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Group.RG", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 5:
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Group.RG", "c", 5, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.C = &pv
+		case 6:
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.P2Group.RG", "nested", 6, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.Nested == nil {
+				x.Nested = AcquireP2Group()
+			}
+			err = x.Nested.UnmarshalObjectState(v, st)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2Group.RG", "nested", -1, cap(data)-cap(v))
+				return
+			}
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Group.RG", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+	st.Leave()
+
+	return
+}
+
+// MarshalJSON marshal to protobuf canonical JSON
+func (x *P2Group_RG) MarshalJSON() ([]byte, error) {
+	return x.AppendJSON(nil)
+}
+
+// AppendJSON appends the protobuf canonical JSON of x to b. nil is appended as empty object
+func (x *P2Group_RG) AppendJSON(b []byte) (_ []byte, err error) {
+	if x == nil {
+		return append(b, '{', '}'), nil
+	}
+	b = append(b, '{')
+	if x.C != nil {
+		b = append(b, `"c":`...)
+		b = strconv.AppendInt(b, int64(*x.C), 10)
+		b = append(b, ',')
+	}
+	if x.Nested != nil {
+		b = append(b, `"nested":`...)
+		if b, err = x.Nested.AppendJSON(b); err != nil {
+			return
+		}
+		b = append(b, ',')
+	}
+	return gopb.CloseJSON(b, '}'), nil
+}
+
+// UnmarshalJSON unmarshal from protobuf canonical JSON. both json name and proto name of fields are accepted
+func (x *P2Group_RG) UnmarshalJSON(data []byte) error {
+	x.Reset()
+	d := gopb.NewJSONDecoder(data)
+	if err := x.UnmarshalJSONFrom(d); err != nil {
+		return err
+	}
+	return d.End()
+}
+
+// UnmarshalJSONFrom reads a JSON object from d and merges it into x. null values are skipped.
+// duplicate fields, duplicate map keys and more than one member of a oneof are rejected as protojson
+func (x *P2Group_RG) UnmarshalJSONFrom(d *gopb.JSONDecoder) error {
+	// 重复的字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadObject(func(name string) (err error) {
+		switch name {
+		case "c":
+			if err = d.CheckField(&seen, 5, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.C = &pv
+		case "nested":
+			if err = d.CheckField(&seen, 6, name); err != nil || d.ReadNull() {
+				return
+			}
+			if x.Nested == nil {
+				x.Nested = &P2Group{}
+			}
+			err = x.Nested.UnmarshalJSONFrom(d)
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+// String returns the protobuf text format of x
+func (x *P2Group_RG) String() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return string(x.AppendText(nil))
+}
+
+// AppendText appends the fields of x in protobuf text format to b
+func (x *P2Group_RG) AppendText(b []byte) []byte {
+	if x == nil {
+		return b
+	}
+	start := len(b)
+	if x.C != nil {
+		b = gopb.AppendTextName(b, start, "c")
+		b = strconv.AppendInt(b, int64(*x.C), 10)
+	}
+	if x.Nested != nil {
+		b = gopb.AppendTextName(b, start, "nested")
+		b = append(b, '{')
+		b = x.Nested.AppendText(b)
+		b = append(b, '}')
+	}
+	return b
+}
+
+// UnmarshalText unmarshal from protobuf text format
+func (x *P2Group_RG) UnmarshalText(data []byte) error {
+	x.Reset()
+	return x.UnmarshalTextFrom(gopb.NewTextDecoder(data))
+}
+
+// UnmarshalTextFrom reads the fields of a message from d and merges them into x.
+// non-repeated fields appeared twice and more than one member of a oneof are rejected as prototext
+func (x *P2Group_RG) UnmarshalTextFrom(d *gopb.TextDecoder) error {
+	// 重复的非repeated字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadMessage(func(name string) (err error) {
+		switch name {
+		case "c":
+			if err = d.CheckField(&seen, 5, name); err != nil {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.C = &pv
+		case "nested":
+			if err = d.CheckField(&seen, 6, name); err != nil {
+				return
+			}
+			if x.Nested == nil {
+				x.Nested = &P2Group{}
+			}
+			err = x.Nested.UnmarshalTextFrom(d)
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+type P2Req struct {
+	Id            *int32            `json:"id,omitempty"`
+	Name          *string           `json:"name,omitempty"`
+	Child         *P2Req            `json:"child,omitempty"`
+	Items         []*P2Req          `json:"items,omitempty"`
+	M             map[string]*P2Req `json:"m,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2Req) Reset() {
+	*x = P2Req{}
+}
+
+var poolP2Req = sync.Pool{New: func() any { return &P2Req{} }}
+
+// AcquireP2Req returns an empty P2Req from the pool. call Release to put it back
+func AcquireP2Req() *P2Req {
+	return poolP2Req.Get().(*P2Req)
+}
+
+// Release resets x, releases its sub-messages and puts x back to the pool. x must not be used after Release.
+// 列表截断保留容量, map 清空后保留
+func (x *P2Req) Release() {
+	if x == nil {
+		return
+	}
+	x.Child.Release()
+	for i, v := range x.Items {
+		v.Release()
+		x.Items[i] = nil
+	}
+	for mk, mv := range x.M {
+		mv.Release()
+		delete(x.M, mk)
+	}
+	*x = P2Req{
+		Items:         x.Items[:0],
+		M:             x.M,
+		unknownFields: x.unknownFields[:0],
+	}
+	poolP2Req.Put(x)
+}
+
+func (x *P2Req) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+// HasId report whether the field is set
+func (x *P2Req) HasId() bool {
+	return x != nil && x.Id != nil
+}
+
+// ClearId clear the field
+func (x *P2Req) ClearId() {
+	x.Id = nil
+}
+
+func (x *P2Req) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// HasName report whether the field is set
+func (x *P2Req) HasName() bool {
+	return x != nil && x.Name != nil
+}
+
+// ClearName clear the field
+func (x *P2Req) ClearName() {
+	x.Name = nil
+}
+
+func (x *P2Req) GetChild() *P2Req {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+// HasChild report whether the field is set
+func (x *P2Req) HasChild() bool {
+	return x != nil && x.Child != nil
+}
+
+// ClearChild clear the field
+func (x *P2Req) ClearChild() {
+	x.Child = nil
+}
+
+func (x *P2Req) GetItems() []*P2Req {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *P2Req) GetM() map[string]*P2Req {
+	if x != nil {
+		return x.M
+	}
+	return nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Req) Clone() *P2Req {
+	if x == nil {
+		return nil
+	}
+	y := &P2Req{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Req) CopyFrom(src *P2Req) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.Id != nil {
+		pv := *src.Id
+		x.Id = &pv
+	} else {
+		x.Id = nil
+	}
+	if src.Name != nil {
+		pv := *src.Name
+		x.Name = &pv
+	} else {
+		x.Name = nil
+	}
+	if src.Child != nil {
+		if x.Child == nil {
+			x.Child = &P2Req{}
+		}
+		x.Child.CopyFrom(src.Child)
+	} else {
+		x.Child = nil
+	}
+	// 只复用原来长度内的元素. 超出长度的部分(例如 Release 截断的列表)可能已被其他消息使用, 置为零值后重新分配
+	if n, old := len(src.Items), len(x.Items); n <= old {
+		x.Items = x.Items[:n]
+	} else {
+		x.Items = append(x.Items, make([]*P2Req, n-old)...)
+	}
+	for k, item := range src.Items {
+		if item != nil {
+			if x.Items[k] == nil {
+				x.Items[k] = &P2Req{}
+			}
+			x.Items[k].CopyFrom(item)
+		} else {
+			x.Items[k] = nil
+		}
+	}
+	if x.M == nil && src.M != nil {
+		x.M = make(map[string]*P2Req, len(src.M))
+	}
+	for mk := range x.M {
+		delete(x.M, mk)
+	}
+	for mk, mv := range src.M {
+		if mv != nil {
+			if x.M[mk] == nil {
+				x.M[mk] = &P2Req{}
+			}
+			x.M[mk].CopyFrom(mv)
+		} else {
+			x.M[mk] = nil
+		}
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Req) Equal(other *P2Req) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.Id == nil) != (other.Id == nil) {
+		return false
+	}
+	if x.Id != nil {
+		if *x.Id != *other.Id {
+			return false
+		}
+	}
+	if (x.Name == nil) != (other.Name == nil) {
+		return false
+	}
+	if x.Name != nil {
+		if *x.Name != *other.Name {
+			return false
+		}
+	}
+	if !x.Child.Equal(other.Child) {
+		return false
+	}
+	if len(x.Items) != len(other.Items) {
+		return false
+	}
+	for k := range x.Items {
+		if !x.Items[k].Equal(other.Items[k]) {
+			return false
+		}
+	}
+	if len(x.M) != len(other.M) {
+		return false
+	}
+	for mk, mv := range x.M {
+		ov, ok := other.M[mk]
+		if !ok {
+			return false
+		}
+		if !mv.Equal(ov) {
+			return false
+		}
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Req) Merge(src *P2Req) {
+	if src == nil {
+		return
+	}
+	if src.Id != nil {
+		pv := *src.Id
+		x.Id = &pv
+	}
+	if src.Name != nil {
+		pv := *src.Name
+		x.Name = &pv
+	}
+	if src.Child != nil {
+		if x.Child == nil {
+			x.Child = &P2Req{}
+		}
+		x.Child.Merge(src.Child)
+	}
+	if src.Items != nil {
+		for _, item := range src.Items {
+			x.Items = append(x.Items, nil)
+			if item != nil {
+				if x.Items[len(x.Items)-1] == nil {
+					x.Items[len(x.Items)-1] = &P2Req{}
+				}
+				x.Items[len(x.Items)-1].CopyFrom(item)
+			} else {
+				x.Items[len(x.Items)-1] = nil
+			}
+		}
+	}
+	if len(src.M) > 0 {
+		if x.M == nil {
+			x.M = make(map[string]*P2Req, len(src.M))
+		}
+		for mk, mv := range src.M {
+			if mv != nil {
+				if x.M[mk] == nil {
+					x.M[mk] = &P2Req{}
+				}
+				x.M[mk].CopyFrom(mv)
+			} else {
+				x.M[mk] = nil
+			}
+		}
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// XXX_MessageName returns the full name of P2Req
+func (x *P2Req) XXX_MessageName() string {
+	return "gopb.testpb.P2Req"
+}
+
+// ProtoReflect returns a protoreflect.Message view of x, which implements proto.Message.
+// proto.Marshal/proto.Unmarshal use MarshalObjectTo/UnmarshalObject
+func (x *P2Req) ProtoReflect() protoreflect.Message {
+	return file_proto2_proto_msgTypes[7].MessageOf(x)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Req) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, false)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Req) MarshalSize() (size int) {
+	if x.Id != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(*x.Id))
+	}
+	if x.Name != nil {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeBytes(len(*x.Name))
+	}
+	if x.Child != nil {
+		// 1 = protowire.SizeTag(3)
+		size += 1 + protowire.SizeBytes(x.Child.MarshalSize())
+	}
+	if x.Items != nil {
+		// 1 = protowire.SizeTag(4)
+		size += 1 * len(x.Items)
+		for k := 0; k < len(x.Items); k++ {
+			size += protowire.SizeBytes(x.Items[k].MarshalSize())
+		}
+	}
+	if len(x.M) > 0 {
+		for mk, mv := range x.M {
+			_ = mk
+			_ = mv
+			// 1 = protowire.SizeTag(5)
+			size += 1
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeBytes(mv.MarshalSize())
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Req) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, false)
+}
+
+// MarshalObjectDeterministic marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Req) MarshalObjectDeterministic() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, true)
+}
+
+// MarshalObjectDeterministicTo marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2Req) MarshalObjectDeterministicTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, true)
+}
+
+// marshalTo marshal data to []byte. deterministic 时 map 按键排序
+func (x *P2Req) marshalTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+	if x.Id != nil {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(*x.Id))
+	}
+	if x.Name != nil {
+
+		// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+		data = append(data, 0x12)
+		data = protowire.AppendString(data, *x.Name)
+	}
+	if x.Child != nil {
+		// data = protowire.AppendTag(data, 3, protowire.BytesType) => 00011010
+		data = append(data, 0x1a)
+		data = protowire.AppendVarint(data, uint64(x.Child.MarshalSize()))
+		data, err = x.Child.marshalTo(data, deterministic)
+		if err != nil {
+			return
+		}
+	}
+	if x.Items != nil {
+		for _, item := range x.Items {
+			// data = protowire.AppendTag(data, 4, protowire.BytesType) => 00100010
+			data = append(data, 0x22)
+			data = protowire.AppendVarint(data, uint64(item.MarshalSize()))
+			data, err = item.marshalTo(data, deterministic)
+			if err != nil {
+				return
+			}
+		}
+	}
+	if len(x.M) > 0 {
+
+		if deterministic {
+			for _, mk := range gopb.SortedMapKeys(x.M) {
+				mv := x.M[mk]
+				// data = protowire.AppendTag(data, 5, protowire.BytesType) => 00101010
+				data = append(data, 0x2a)
+				msize := 0
+				// 1 = protowire.SizeTag(1)
+				msize += 1 + protowire.SizeBytes(len(mk))
+				// 1 = protowire.SizeTag(2)
+				msize += 1 + protowire.SizeBytes(mv.MarshalSize())
+				data = protowire.AppendVarint(data, uint64(msize))
+
+				// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+				data = append(data, 0xa)
+				data = protowire.AppendString(data, mk)
+				// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+				data = append(data, 0x12)
+				data = protowire.AppendVarint(data, uint64(mv.MarshalSize()))
+				data, err = mv.marshalTo(data, deterministic)
+				if err != nil {
+					return
+				}
+			}
+		} else {
+			for mk, mv := range x.M {
+				// data = protowire.AppendTag(data, 5, protowire.BytesType) => 00101010
+				data = append(data, 0x2a)
+				msize := 0
+				// 1 = protowire.SizeTag(1)
+				msize += 1 + protowire.SizeBytes(len(mk))
+				// 1 = protowire.SizeTag(2)
+				msize += 1 + protowire.SizeBytes(mv.MarshalSize())
+				data = protowire.AppendVarint(data, uint64(msize))
+
+				// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+				data = append(data, 0xa)
+				data = protowire.AppendString(data, mk)
+				// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+				data = append(data, 0x12)
+				data = protowire.AppendVarint(data, uint64(mv.MarshalSize()))
+				data, err = mv.marshalTo(data, deterministic)
+				if err != nil {
+					return
+				}
+			}
+		}
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte. 只限制嵌套深度(gopb.DefaultMaxDepth)
+func (x *P2Req) UnmarshalObject(data []byte) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{})
+}
+
+// UnmarshalObjectWith unmarshal data from []byte with the limits of opts
+func (x *P2Req) UnmarshalObjectWith(data []byte, opts gopb.UnmarshalOptions) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{UnmarshalOptions: opts})
+}
+
+// UnmarshalObjectState unmarshal data from []byte. st 在嵌套消息间传递, 由生成的代码使用
+func (x *P2Req) UnmarshalObjectState(data []byte, st *gopb.UnmarshalState) (err error) {
+	if !st.Enter() {
+		return gopb.LimitError("gopb.testpb.P2Req", "", 0, 0)
+	}
+
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Req", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Req", "id", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.Id = &pv
+		case 2:
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Req", "name", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = v
+			x.Name = &pv
+		case 3:
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.P2Req", "child", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.Child == nil {
+				x.Child = AcquireP2Req()
+			}
+			err = x.Child.UnmarshalObjectState(v, st)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2Req", "child", -1, cap(data)-cap(v))
+				return
+			}
+		case 4:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Req", "items", 4, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.P2Req", "items", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.Items == nil {
+				x.Items = make([]*P2Req, 0, 2)
+			}
+			item := AcquireP2Req()
+			err = item.UnmarshalObjectState(buf, st)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2Req", "items", len(x.Items), cap(data)-cap(buf))
+				return
+			}
+			x.Items = append(x.Items, item)
+
+			if !st.Grow(len(x.Items)) {
+				err = gopb.LimitError("gopb.testpb.P2Req", "items", 4, cap(data)-cap(data[index:]))
+				return
+			}
+		case 5:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Req", "m", 5, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.P2Req", "m", 5, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.M == nil {
+				x.M = make(map[string]*P2Req)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk string
+			var mv *P2Req
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Req", "m", 5, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1 && typ == protowire.BytesType:
+
+					v, cnt := protowire.ConsumeString(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.P2Req", "m.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					mk = v
+				case mi == 2 && typ == protowire.BytesType:
+					v, cnt := protowire.ConsumeBytes(buf[sindex:])
+					if v == nil {
+						err = gopb.ParseError("gopb.testpb.P2Req", "m.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					// 多次出现时合并
+					if mv == nil {
+						mv = AcquireP2Req()
+					}
+					err = mv.UnmarshalObjectState(v, st)
+					if err != nil {
+						err = gopb.WrapDecodeError(err, "gopb.testpb.P2Req", "m.value", -1, cap(data)-cap(v))
+						return
+					}
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.P2Req", "m", 5, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			if mv == nil {
+
+				mv = AcquireP2Req()
+			}
+			x.M[mk] = mv
+
+			if !st.Grow(len(x.M)) {
+				err = gopb.LimitError("gopb.testpb.P2Req", "m", 5, cap(data)-cap(data[index:]))
+				return
+			}
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Req", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+	st.Leave()
+
+	return
+}
+
+// IsInitialized check all required fields are set, including nested messages
+func (x *P2Req) IsInitialized() error {
+	if missing := x.AppendMissingRequired(nil, "P2Req"); len(missing) > 0 {
+		return errors.New("required fields not set: " + strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// AppendMissingRequired append the path of required fields not set to missing. path is the path of x
+func (x *P2Req) AppendMissingRequired(missing []string, path string) []string {
+	if x.Id == nil {
+		missing = append(missing, path+".id")
+	}
+	if x.Child != nil {
+		missing = x.Child.AppendMissingRequired(missing, path+".child")
+	}
+	for k, item := range x.Items {
+		if item != nil {
+			missing = item.AppendMissingRequired(missing, path+".items["+strconv.Itoa(k)+"]")
+		}
+	}
+	for k, item := range x.M {
+		if item != nil {
+			missing = item.AppendMissingRequired(missing, path+".m["+fmt.Sprint(k)+"]")
+		}
+	}
+	return missing
+}
+
+// MarshalObjectChecked marshal data to []byte. return error if any required field is not set
+func (x *P2Req) MarshalObjectChecked() (data []byte, err error) {
+	if err = x.IsInitialized(); err != nil {
+		return
+	}
+	return x.MarshalObject()
+}
+
+// UnmarshalObjectChecked unmarshal data from []byte. return error if any required field is not set
+func (x *P2Req) UnmarshalObjectChecked(data []byte) (err error) {
+	if err = x.UnmarshalObject(data); err != nil {
+		return
+	}
+	return x.IsInitialized()
+}
+
+// MarshalJSON marshal to protobuf canonical JSON
+func (x *P2Req) MarshalJSON() ([]byte, error) {
+	return x.AppendJSON(nil)
+}
+
+// AppendJSON appends the protobuf canonical JSON of x to b. nil is appended as empty object
+func (x *P2Req) AppendJSON(b []byte) (_ []byte, err error) {
+	if x == nil {
+		return append(b, '{', '}'), nil
+	}
+	b = append(b, '{')
+	if x.Id != nil {
+		b = append(b, `"id":`...)
+		b = strconv.AppendInt(b, int64(*x.Id), 10)
+		b = append(b, ',')
+	}
+	if x.Name != nil {
+		b = append(b, `"name":`...)
+		b = gopb.AppendJSONString(b, *x.Name)
+		b = append(b, ',')
+	}
+	if x.Child != nil {
+		b = append(b, `"child":`...)
+		if b, err = x.Child.AppendJSON(b); err != nil {
+			return
+		}
+		b = append(b, ',')
+	}
+	if x.Items != nil {
+		b = append(b, `"items":`...)
+		b = append(b, '[')
+		for _, item := range x.Items {
+			if b, err = item.AppendJSON(b); err != nil {
+				return
+			}
+			b = append(b, ',')
+		}
+		b = gopb.CloseJSON(b, ']')
+		b = append(b, ',')
+	}
+	if len(x.M) > 0 {
+		b = append(b, `"m":`...)
+		b = append(b, '{')
+		for mk, mv := range x.M {
+			b = gopb.AppendJSONString(b, mk)
+			b = append(b, ':')
+			if b, err = mv.AppendJSON(b); err != nil {
+				return
+			}
+			b = append(b, ',')
+		}
+		b = gopb.CloseJSON(b, '}')
+		b = append(b, ',')
+	}
+	return gopb.CloseJSON(b, '}'), nil
+}
+
+// UnmarshalJSON unmarshal from protobuf canonical JSON. both json name and proto name of fields are accepted
+func (x *P2Req) UnmarshalJSON(data []byte) error {
+	x.Reset()
+	d := gopb.NewJSONDecoder(data)
+	if err := x.UnmarshalJSONFrom(d); err != nil {
+		return err
+	}
+	return d.End()
+}
+
+// UnmarshalJSONFrom reads a JSON object from d and merges it into x. null values are skipped.
+// duplicate fields, duplicate map keys and more than one member of a oneof are rejected as protojson
+func (x *P2Req) UnmarshalJSONFrom(d *gopb.JSONDecoder) error {
+	// 重复的字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadObject(func(name string) (err error) {
+		switch name {
+		case "id":
+			if err = d.CheckField(&seen, 1, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.Id = &pv
+		case "name":
+			if err = d.CheckField(&seen, 2, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv string
+			pv, err = d.ReadString()
+			x.Name = &pv
+		case "child":
+			if err = d.CheckField(&seen, 3, name); err != nil || d.ReadNull() {
+				return
+			}
+			if x.Child == nil {
+				x.Child = &P2Req{}
+			}
+			err = x.Child.UnmarshalJSONFrom(d)
+		case "items":
+			if err = d.CheckField(&seen, 4, name); err != nil || d.ReadNull() {
+				return
+			}
+			err = d.ReadArray(func() (err error) {
+				var item *P2Req
+				if item == nil {
+					item = &P2Req{}
+				}
+				err = item.UnmarshalJSONFrom(d)
+				x.Items = append(x.Items, item)
+				return
+			})
+		case "m":
+			if err = d.CheckField(&seen, 5, name); err != nil || d.ReadNull() {
+				return
+			}
+			if x.M == nil {
+				x.M = make(map[string]*P2Req)
+			}
+			err = d.ReadObject(func(key string) (err error) {
+				mk := key
+				if _, ok := x.M[mk]; ok {
+					return d.DuplicateMapKey(key)
+				}
+				var mv *P2Req
+				if mv == nil {
+					mv = &P2Req{}
+				}
+				err = mv.UnmarshalJSONFrom(d)
+				x.M[mk] = mv
+				return
+			})
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+// String returns the protobuf text format of x
+func (x *P2Req) String() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return string(x.AppendText(nil))
+}
+
+// AppendText appends the fields of x in protobuf text format to b
+func (x *P2Req) AppendText(b []byte) []byte {
+	if x == nil {
+		return b
+	}
+	start := len(b)
+	if x.Id != nil {
+		b = gopb.AppendTextName(b, start, "id")
+		b = strconv.AppendInt(b, int64(*x.Id), 10)
+	}
+	if x.Name != nil {
+		b = gopb.AppendTextName(b, start, "name")
+		b = gopb.AppendTextString(b, *x.Name)
+	}
+	if x.Child != nil {
+		b = gopb.AppendTextName(b, start, "child")
+		b = append(b, '{')
+		b = x.Child.AppendText(b)
+		b = append(b, '}')
+	}
+	if x.Items != nil {
+		for _, item := range x.Items {
+			b = gopb.AppendTextName(b, start, "items")
+			b = append(b, '{')
+			b = item.AppendText(b)
+			b = append(b, '}')
+		}
+	}
+	if len(x.M) > 0 {
+		for mk, mv := range x.M {
+			b = gopb.AppendTextName(b, start, "m")
+			b = append(b, "{key:"...)
+			b = gopb.AppendTextString(b, mk)
+			b = append(b, " value:"...)
+			b = append(b, '{')
+			b = mv.AppendText(b)
+			b = append(b, '}')
+			b = append(b, '}')
+		}
+	}
+	return b
+}
+
+// UnmarshalText unmarshal from protobuf text format
+func (x *P2Req) UnmarshalText(data []byte) error {
+	x.Reset()
+	return x.UnmarshalTextFrom(gopb.NewTextDecoder(data))
+}
+
+// UnmarshalTextFrom reads the fields of a message from d and merges them into x.
+// non-repeated fields appeared twice and more than one member of a oneof are rejected as prototext
+func (x *P2Req) UnmarshalTextFrom(d *gopb.TextDecoder) error {
+	// 重复的非repeated字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadMessage(func(name string) (err error) {
+		switch name {
+		case "id":
+			if err = d.CheckField(&seen, 1, name); err != nil {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.Id = &pv
+		case "name":
+			if err = d.CheckField(&seen, 2, name); err != nil {
+				return
+			}
+			var pv string
+			pv, err = d.ReadString()
+			x.Name = &pv
+		case "child":
+			if err = d.CheckField(&seen, 3, name); err != nil {
+				return
+			}
+			if x.Child == nil {
+				x.Child = &P2Req{}
+			}
+			err = x.Child.UnmarshalTextFrom(d)
+		case "items":
+			err = d.ReadRepeated(func() (err error) {
+				var item *P2Req
+				if item == nil {
+					item = &P2Req{}
+				}
+				err = item.UnmarshalTextFrom(d)
+				x.Items = append(x.Items, item)
+				return
+			})
+		case "m":
+			if x.M == nil {
+				x.M = make(map[string]*P2Req)
+			}
+			err = d.ReadRepeated(func() error {
+				var mk string
+				var mv *P2Req
+				var seen gopb.FieldSet
+				err := d.ReadMessage(func(name string) (err error) {
+					switch name {
+					case "key":
+						if err = d.CheckField(&seen, 1, name); err != nil {
+							return
+						}
+						mk, err = d.ReadString()
+					case "value":
+						if err = d.CheckField(&seen, 2, name); err != nil {
+							return
+						}
+						if mv == nil {
+							mv = &P2Req{}
+						}
+						err = mv.UnmarshalTextFrom(d)
+					default:
+						err = d.UnknownField(name)
+					}
+					return
+				})
+				x.M[mk] = mv
+				return err
+			})
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+// 不直接包含 required 字段, 通过子消息间接包含.
+type P2ReqHolder struct {
+	Req           *P2Req `json:"req,omitempty"`
+	N             *int32 `json:"n,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2ReqHolder) Reset() {
+	*x = P2ReqHolder{}
+}
+
+var poolP2ReqHolder = sync.Pool{New: func() any { return &P2ReqHolder{} }}
+
+// AcquireP2ReqHolder returns an empty P2ReqHolder from the pool. call Release to put it back
+func AcquireP2ReqHolder() *P2ReqHolder {
+	return poolP2ReqHolder.Get().(*P2ReqHolder)
+}
+
+// Release resets x, releases its sub-messages and puts x back to the pool. x must not be used after Release.
+// 列表截断保留容量, map 清空后保留
+func (x *P2ReqHolder) Release() {
+	if x == nil {
+		return
+	}
+	x.Req.Release()
+	*x = P2ReqHolder{
+		unknownFields: x.unknownFields[:0],
+	}
+	poolP2ReqHolder.Put(x)
+}
+
+func (x *P2ReqHolder) GetReq() *P2Req {
+	if x != nil {
+		return x.Req
+	}
+	return nil
+}
+
+// HasReq report whether the field is set
+func (x *P2ReqHolder) HasReq() bool {
+	return x != nil && x.Req != nil
+}
+
+// ClearReq clear the field
+func (x *P2ReqHolder) ClearReq() {
+	x.Req = nil
+}
+
+func (x *P2ReqHolder) GetN() int32 {
+	if x != nil && x.N != nil {
+		return *x.N
+	}
+	return 0
+}
+
+// HasN report whether the field is set
+func (x *P2ReqHolder) HasN() bool {
+	return x != nil && x.N != nil
+}
+
+// ClearN clear the field
+func (x *P2ReqHolder) ClearN() {
+	x.N = nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2ReqHolder) Clone() *P2ReqHolder {
+	if x == nil {
+		return nil
+	}
+	y := &P2ReqHolder{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2ReqHolder) CopyFrom(src *P2ReqHolder) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.Req != nil {
+		if x.Req == nil {
+			x.Req = &P2Req{}
+		}
+		x.Req.CopyFrom(src.Req)
+	} else {
+		x.Req = nil
+	}
+	if src.N != nil {
+		pv := *src.N
+		x.N = &pv
+	} else {
+		x.N = nil
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2ReqHolder) Equal(other *P2ReqHolder) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if !x.Req.Equal(other.Req) {
+		return false
+	}
+	if (x.N == nil) != (other.N == nil) {
+		return false
+	}
+	if x.N != nil {
+		if *x.N != *other.N {
+			return false
+		}
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2ReqHolder) Merge(src *P2ReqHolder) {
+	if src == nil {
+		return
+	}
+	if src.Req != nil {
+		if x.Req == nil {
+			x.Req = &P2Req{}
+		}
+		x.Req.Merge(src.Req)
+	}
+	if src.N != nil {
+		pv := *src.N
+		x.N = &pv
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// XXX_MessageName returns the full name of P2ReqHolder
+func (x *P2ReqHolder) XXX_MessageName() string {
+	return "gopb.testpb.P2ReqHolder"
+}
+
+// ProtoReflect returns a protoreflect.Message view of x, which implements proto.Message.
+// proto.Marshal/proto.Unmarshal use MarshalObjectTo/UnmarshalObject
+func (x *P2ReqHolder) ProtoReflect() protoreflect.Message {
+	return file_proto2_proto_msgTypes[8].MessageOf(x)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2ReqHolder) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, false)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2ReqHolder) MarshalSize() (size int) {
+	if x.Req != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeBytes(x.Req.MarshalSize())
+	}
+	if x.N != nil {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeVarint(uint64(*x.N))
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2ReqHolder) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, false)
+}
+
+// MarshalObjectDeterministic marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2ReqHolder) MarshalObjectDeterministic() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalTo(data, true)
+}
+
+// MarshalObjectDeterministicTo marshal data to []byte. map entries are sorted by key, including sub-messages
+func (x *P2ReqHolder) MarshalObjectDeterministicTo(buf []byte) (data []byte, err error) {
+	return x.marshalTo(buf, true)
+}
+
+// marshalTo marshal data to []byte. deterministic 时 map 按键排序
+func (x *P2ReqHolder) marshalTo(buf []byte, deterministic bool) (data []byte, err error) {
+	data = buf
+	if x.Req != nil {
+		// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+		data = append(data, 0xa)
+		data = protowire.AppendVarint(data, uint64(x.Req.MarshalSize()))
+		data, err = x.Req.marshalTo(data, deterministic)
+		if err != nil {
+			return
+		}
+	}
+	if x.N != nil {
+		// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+		data = append(data, 0x10)
+		data = protowire.AppendVarint(data, uint64(*x.N))
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte. 只限制嵌套深度(gopb.DefaultMaxDepth)
+func (x *P2ReqHolder) UnmarshalObject(data []byte) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{})
+}
+
+// UnmarshalObjectWith unmarshal data from []byte with the limits of opts
+func (x *P2ReqHolder) UnmarshalObjectWith(data []byte, opts gopb.UnmarshalOptions) (err error) {
+	return x.UnmarshalObjectState(data, &gopb.UnmarshalState{UnmarshalOptions: opts})
+}
+
+// UnmarshalObjectState unmarshal data from []byte. st 在嵌套消息间传递, 由生成的代码使用
+func (x *P2ReqHolder) UnmarshalObjectState(data []byte, st *gopb.UnmarshalState) (err error) {
+	if !st.Enter() {
+		return gopb.LimitError("gopb.testpb.P2ReqHolder", "", 0, 0)
+	}
+
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2ReqHolder", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.P2ReqHolder", "req", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.Req == nil {
+				x.Req = AcquireP2Req()
+			}
+			err = x.Req.UnmarshalObjectState(v, st)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2ReqHolder", "req", -1, cap(data)-cap(v))
+				return
+			}
+		case 2:
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2ReqHolder", "n", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.N = &pv
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2ReqHolder", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+	st.Leave()
+
+	return
+}
+
+// IsInitialized check all required fields are set, including nested messages
+func (x *P2ReqHolder) IsInitialized() error {
+	if missing := x.AppendMissingRequired(nil, "P2ReqHolder"); len(missing) > 0 {
+		return errors.New("required fields not set: " + strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// AppendMissingRequired append the path of required fields not set to missing. path is the path of x
+func (x *P2ReqHolder) AppendMissingRequired(missing []string, path string) []string {
+	if x.Req != nil {
+		missing = x.Req.AppendMissingRequired(missing, path+".req")
+	}
+	return missing
+}
+
+// MarshalObjectChecked marshal data to []byte. return error if any required field is not set
+func (x *P2ReqHolder) MarshalObjectChecked() (data []byte, err error) {
+	if err = x.IsInitialized(); err != nil {
+		return
+	}
+	return x.MarshalObject()
+}
+
+// UnmarshalObjectChecked unmarshal data from []byte. return error if any required field is not set
+func (x *P2ReqHolder) UnmarshalObjectChecked(data []byte) (err error) {
+	if err = x.UnmarshalObject(data); err != nil {
+		return
+	}
+	return x.IsInitialized()
+}
+
+// MarshalJSON marshal to protobuf canonical JSON
+func (x *P2ReqHolder) MarshalJSON() ([]byte, error) {
+	return x.AppendJSON(nil)
+}
+
+// AppendJSON appends the protobuf canonical JSON of x to b. nil is appended as empty object
+func (x *P2ReqHolder) AppendJSON(b []byte) (_ []byte, err error) {
+	if x == nil {
+		return append(b, '{', '}'), nil
+	}
+	b = append(b, '{')
+	if x.Req != nil {
+		b = append(b, `"req":`...)
+		if b, err = x.Req.AppendJSON(b); err != nil {
+			return
+		}
+		b = append(b, ',')
+	}
+	if x.N != nil {
+		b = append(b, `"n":`...)
+		b = strconv.AppendInt(b, int64(*x.N), 10)
+		b = append(b, ',')
+	}
+	return gopb.CloseJSON(b, '}'), nil
+}
+
+// UnmarshalJSON unmarshal from protobuf canonical JSON. both json name and proto name of fields are accepted
+func (x *P2ReqHolder) UnmarshalJSON(data []byte) error {
+	x.Reset()
+	d := gopb.NewJSONDecoder(data)
+	if err := x.UnmarshalJSONFrom(d); err != nil {
+		return err
+	}
+	return d.End()
+}
+
+// UnmarshalJSONFrom reads a JSON object from d and merges it into x. null values are skipped.
+// duplicate fields, duplicate map keys and more than one member of a oneof are rejected as protojson
+func (x *P2ReqHolder) UnmarshalJSONFrom(d *gopb.JSONDecoder) error {
+	// 重复的字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadObject(func(name string) (err error) {
+		switch name {
+		case "req":
+			if err = d.CheckField(&seen, 1, name); err != nil || d.ReadNull() {
+				return
+			}
+			if x.Req == nil {
+				x.Req = &P2Req{}
+			}
+			err = x.Req.UnmarshalJSONFrom(d)
+		case "n":
+			if err = d.CheckField(&seen, 2, name); err != nil || d.ReadNull() {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.N = &pv
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+// String returns the protobuf text format of x
+func (x *P2ReqHolder) String() string {
+	if x == nil {
+		return "<nil>"
+	}
+	return string(x.AppendText(nil))
+}
+
+// AppendText appends the fields of x in protobuf text format to b
+func (x *P2ReqHolder) AppendText(b []byte) []byte {
+	if x == nil {
+		return b
+	}
+	start := len(b)
+	if x.Req != nil {
+		b = gopb.AppendTextName(b, start, "req")
+		b = append(b, '{')
+		b = x.Req.AppendText(b)
+		b = append(b, '}')
+	}
+	if x.N != nil {
+		b = gopb.AppendTextName(b, start, "n")
+		b = strconv.AppendInt(b, int64(*x.N), 10)
+	}
+	return b
+}
+
+// UnmarshalText unmarshal from protobuf text format
+func (x *P2ReqHolder) UnmarshalText(data []byte) error {
+	x.Reset()
+	return x.UnmarshalTextFrom(gopb.NewTextDecoder(data))
+}
+
+// UnmarshalTextFrom reads the fields of a message from d and merges them into x.
+// non-repeated fields appeared twice and more than one member of a oneof are rejected as prototext
+func (x *P2ReqHolder) UnmarshalTextFrom(d *gopb.TextDecoder) error {
+	// 重复的非repeated字段及同一个oneof的多个成员返回错误
+	var seen gopb.FieldSet
+	return d.ReadMessage(func(name string) (err error) {
+		switch name {
+		case "req":
+			if err = d.CheckField(&seen, 1, name); err != nil {
+				return
+			}
+			if x.Req == nil {
+				x.Req = &P2Req{}
+			}
+			err = x.Req.UnmarshalTextFrom(d)
+		case "n":
+			if err = d.CheckField(&seen, 2, name); err != nil {
+				return
+			}
+			var pv int32
+			pv, err = d.ReadInt32()
+			x.N = &pv
+		default:
+			err = d.UnknownField(name)
+		}
+		return
+	})
+}
+
+// extensionDesc_EInt is the descriptor type of extension gopb.testpb.e_int
+type extensionDesc_EInt struct{}
+
+var E_EInt extensionDesc_EInt
+
+// Number return the field number of extension
+func (extensionDesc_EInt) Number() protowire.Number {
+	return 100
+}
+
+// Name return the full name of extension
+func (extensionDesc_EInt) Name() string {
+	return "gopb.testpb.e_int"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_EInt) Has(x *P2Ext) bool {
+	return x.HasExtension(100)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_EInt) Clear(x *P2Ext) {
+	x.SetExtension(100, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_EInt) Get(x *P2Ext) (val int32, err error) {
+	data := x.GetExtension(100)
+	st := &gopb.UnmarshalState{}
+	_ = st
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_int]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.VarintType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_int]", 100, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+			return
+		}
+		v, cnt := protowire.ConsumeVarint(data[index:])
+		if cnt < 1 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_int]", 100, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		val = int32(v)
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_EInt) Set(x *P2Ext, val int32) (err error) {
+	var data []byte
+	// data = protowire.AppendTag(data, 100, protowire.VarintType) => 10100000 00000110
+	data = append(data, 0xa0, 0x6)
+	data = protowire.AppendVarint(data, uint64(val))
+	x.SetExtension(100, data)
+	return
+}
+
+// extensionDesc_EStr is the descriptor type of extension gopb.testpb.e_str
+type extensionDesc_EStr struct{}
+
+var E_EStr extensionDesc_EStr
+
+// Number return the field number of extension
+func (extensionDesc_EStr) Number() protowire.Number {
+	return 101
+}
+
+// Name return the full name of extension
+func (extensionDesc_EStr) Name() string {
+	return "gopb.testpb.e_str"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_EStr) Has(x *P2Ext) bool {
+	return x.HasExtension(101)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_EStr) Clear(x *P2Ext) {
+	x.SetExtension(101, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_EStr) Get(x *P2Ext) (val string, err error) {
+	data := x.GetExtension(101)
+	st := &gopb.UnmarshalState{}
+	_ = st
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_str]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.BytesType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_str]", 101, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+			return
+		}
+
+		v, cnt := protowire.ConsumeString(data[index:])
+		if cnt < 1 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_str]", 101, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		val = v
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_EStr) Set(x *P2Ext, val string) (err error) {
+	var data []byte
+
+	// data = protowire.AppendTag(data, 101, protowire.BytesType) => 10101010 00000110
+	data = append(data, 0xaa, 0x6)
+	data = protowire.AppendString(data, val)
+	x.SetExtension(101, data)
+	return
+}
+
+// extensionDesc_ERep is the descriptor type of extension gopb.testpb.e_rep
+type extensionDesc_ERep struct{}
+
+var E_ERep extensionDesc_ERep
+
+// Number return the field number of extension
+func (extensionDesc_ERep) Number() protowire.Number {
+	return 102
+}
+
+// Name return the full name of extension
+func (extensionDesc_ERep) Name() string {
+	return "gopb.testpb.e_rep"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_ERep) Has(x *P2Ext) bool {
+	return x.HasExtension(102)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_ERep) Clear(x *P2Ext) {
+	x.SetExtension(102, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_ERep) Get(x *P2Ext) (val []int32, err error) {
+	data := x.GetExtension(102)
+	st := &gopb.UnmarshalState{}
+	_ = st
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_rep]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.BytesType && typ != protowire.VarintType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_rep]", 102, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+			return
+		}
+		// packed=false
+		if typ == protowire.VarintType {
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_rep]", 102, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+
+			val = append(val, int32(v))
+
+			if !st.Grow(len(val)) {
+				err = gopb.LimitError("gopb.testpb.P2Ext", "[gopb.testpb.e_rep]", 102, cap(data)-cap(data[index:]))
+				return
+			}
+			index += cnt
+			continue
+		}
+		// packed = true
+		if typ != protowire.BytesType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_rep]", 102, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+			return
+		}
+		buf, cnt := protowire.ConsumeBytes(data[index:])
+		if buf == nil {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_rep]", 102, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		if val == nil {
+			val = make([]int32, 0, 2)
+		}
+		sub := 0
+		for sub < len(buf) {
+			v, cnt := protowire.ConsumeVarint(buf[sub:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_rep]", 102, cap(data)-cap(buf[sub:]), cnt)
+				return
+			}
+			sub += cnt
+			val = append(val, int32(v))
+
+			if !st.Grow(len(val)) {
+				err = gopb.LimitError("gopb.testpb.P2Ext", "[gopb.testpb.e_rep]", 102, cap(data)-cap(data[index:]))
+				return
+			}
+		}
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_ERep) Set(x *P2Ext, val []int32) (err error) {
+	var data []byte
+	if len(val) > 0 {
+		for _, item := range val {
+			// data = protowire.AppendTag(data, 102, protowire.VarintType) => 10110000 00000110
+			data = append(data, 0xb0, 0x6)
+			data = protowire.AppendVarint(data, uint64(item))
+		}
+	}
+	x.SetExtension(102, data)
+	return
+}
+
+// extensionDesc_EMsg is the descriptor type of extension gopb.testpb.e_msg
+type extensionDesc_EMsg struct{}
+
+var E_EMsg extensionDesc_EMsg
+
+// Number return the field number of extension
+func (extensionDesc_EMsg) Number() protowire.Number {
+	return 103
+}
+
+// Name return the full name of extension
+func (extensionDesc_EMsg) Name() string {
+	return "gopb.testpb.e_msg"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_EMsg) Has(x *P2Ext) bool {
+	return x.HasExtension(103)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_EMsg) Clear(x *P2Ext) {
+	x.SetExtension(103, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_EMsg) Get(x *P2Ext) (val *P2Opt, err error) {
+	data := x.GetExtension(103)
+	st := &gopb.UnmarshalState{}
+	_ = st
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_msg]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.BytesType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_msg]", 103, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+			return
+		}
+		v, cnt := protowire.ConsumeBytes(data[index:])
+		if v == nil {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_msg]", 103, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		// 多次出现时合并
+		if val == nil {
+			val = AcquireP2Opt()
+		}
+		err = val.UnmarshalObjectState(v, st)
+		if err != nil {
+			err = gopb.WrapDecodeError(err, "gopb.testpb.P2Ext", "[gopb.testpb.e_msg]", -1, cap(data)-cap(v))
+			return
+		}
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_EMsg) Set(x *P2Ext, val *P2Opt) (err error) {
+	var data []byte
+	if val != nil {
+		// data = protowire.AppendTag(data, 103, protowire.BytesType) => 10111010 00000110
+		data = append(data, 0xba, 0x6)
+		data = protowire.AppendVarint(data, uint64(val.MarshalSize()))
+		data, err = val.MarshalObjectTo(data)
+		if err != nil {
+			return
+		}
+	}
+	x.SetExtension(103, data)
+	return
+}
+
+// extensionDesc_EPacked is the descriptor type of extension gopb.testpb.e_packed
+type extensionDesc_EPacked struct{}
+
+var E_EPacked extensionDesc_EPacked
+
+// Number return the field number of extension
+func (extensionDesc_EPacked) Number() protowire.Number {
+	return 104
+}
+
+// Name return the full name of extension
+func (extensionDesc_EPacked) Name() string {
+	return "gopb.testpb.e_packed"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_EPacked) Has(x *P2Ext) bool {
+	return x.HasExtension(104)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_EPacked) Clear(x *P2Ext) {
+	x.SetExtension(104, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_EPacked) Get(x *P2Ext) (val []int32, err error) {
+	data := x.GetExtension(104)
+	st := &gopb.UnmarshalState{}
+	_ = st
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_packed]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.BytesType && typ != protowire.VarintType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_packed]", 104, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+			return
+		}
+		// packed=false
+		if typ == protowire.VarintType {
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_packed]", 104, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+
+			val = append(val, int32(v))
+
+			if !st.Grow(len(val)) {
+				err = gopb.LimitError("gopb.testpb.P2Ext", "[gopb.testpb.e_packed]", 104, cap(data)-cap(data[index:]))
+				return
+			}
+			index += cnt
+			continue
+		}
+		// packed = true
+		if typ != protowire.BytesType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_packed]", 104, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+			return
+		}
+		buf, cnt := protowire.ConsumeBytes(data[index:])
+		if buf == nil {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_packed]", 104, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		if val == nil {
+			val = make([]int32, 0, 2)
+		}
+		sub := 0
+		for sub < len(buf) {
+			v, cnt := protowire.ConsumeVarint(buf[sub:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_packed]", 104, cap(data)-cap(buf[sub:]), cnt)
+				return
+			}
+			sub += cnt
+			val = append(val, int32(v))
+
+			if !st.Grow(len(val)) {
+				err = gopb.LimitError("gopb.testpb.P2Ext", "[gopb.testpb.e_packed]", 104, cap(data)-cap(data[index:]))
+				return
+			}
+		}
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_EPacked) Set(x *P2Ext, val []int32) (err error) {
+	var data []byte
+	if len(val) > 0 {
+		// data = protowire.AppendTag(data, 104, protowire.BytesType) => 11000010 00000110
+		data = append(data, 0xc2, 0x6)
+		size := 0
+		for _, v := range val {
+			size += protowire.SizeVarint(uint64(v))
+		}
+		data = protowire.AppendVarint(data, uint64(size))
+		for _, v := range val {
+			data = protowire.AppendVarint(data, uint64(v))
+		}
+	}
+	x.SetExtension(104, data)
+	return
+}
+
+// extensionDesc_ELv is the descriptor type of extension gopb.testpb.e_lv
+type extensionDesc_ELv struct{}
+
+var E_ELv extensionDesc_ELv
+
+// Number return the field number of extension
+func (extensionDesc_ELv) Number() protowire.Number {
+	return 105
+}
+
+// Name return the full name of extension
+func (extensionDesc_ELv) Name() string {
+	return "gopb.testpb.e_lv"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_ELv) Has(x *P2Ext) bool {
+	return x.HasExtension(105)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_ELv) Clear(x *P2Ext) {
+	x.SetExtension(105, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_ELv) Get(x *P2Ext) (val Level, err error) {
+	data := x.GetExtension(105)
+	st := &gopb.UnmarshalState{}
+	_ = st
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_lv]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.VarintType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_lv]", 105, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+			return
+		}
+		v, cnt := protowire.ConsumeVarint(data[index:])
+		if cnt < 1 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_lv]", 105, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		val = Level(v)
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_ELv) Set(x *P2Ext, val Level) (err error) {
+	var data []byte
+	// data = protowire.AppendTag(data, 105, protowire.VarintType) => 11001000 00000110
+	data = append(data, 0xc8, 0x6)
+	data = protowire.AppendVarint(data, uint64(val))
+	x.SetExtension(105, data)
+	return
+}
+
+// extensionDesc_ESint is the descriptor type of extension gopb.testpb.e_sint
+type extensionDesc_ESint struct{}
+
+var E_ESint extensionDesc_ESint
+
+// Number return the field number of extension
+func (extensionDesc_ESint) Number() protowire.Number {
+	return 106
+}
+
+// Name return the full name of extension
+func (extensionDesc_ESint) Name() string {
+	return "gopb.testpb.e_sint"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_ESint) Has(x *P2Ext) bool {
+	return x.HasExtension(106)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_ESint) Clear(x *P2Ext) {
+	x.SetExtension(106, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_ESint) Get(x *P2Ext) (val int64, err error) {
+	data := x.GetExtension(106)
+	st := &gopb.UnmarshalState{}
+	_ = st
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_sint]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.VarintType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_sint]", 106, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+			return
+		}
+		v, cnt := protowire.ConsumeVarint(data[index:])
+		if cnt < 1 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_sint]", 106, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		val = int64(protowire.DecodeZigZag(v))
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_ESint) Set(x *P2Ext, val int64) (err error) {
+	var data []byte
+	// data = protowire.AppendTag(data, 106, protowire.VarintType) => 11010000 00000110
+	data = append(data, 0xd0, 0x6)
+	data = protowire.AppendVarint(data, protowire.EncodeZigZag(int64(val)))
+	x.SetExtension(106, data)
+	return
+}
+
+// extensionDesc_EFixed is the descriptor type of extension gopb.testpb.e_fixed
+type extensionDesc_EFixed struct{}
+
+var E_EFixed extensionDesc_EFixed
+
+// Number return the field number of extension
+func (extensionDesc_EFixed) Number() protowire.Number {
+	return 107
+}
+
+// Name return the full name of extension
+func (extensionDesc_EFixed) Name() string {
+	return "gopb.testpb.e_fixed"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_EFixed) Has(x *P2Ext) bool {
+	return x.HasExtension(107)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_EFixed) Clear(x *P2Ext) {
+	x.SetExtension(107, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_EFixed) Get(x *P2Ext) (val uint32, err error) {
+	data := x.GetExtension(107)
+	st := &gopb.UnmarshalState{}
+	_ = st
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_fixed]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.Fixed32Type {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_fixed]", 107, cap(data)-cap(data[index:]), typ, protowire.Fixed32Type)
+			return
+		}
+		v, cnt := protowire.ConsumeFixed32(data[index:])
+		if cnt < 1 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_fixed]", 107, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		val = uint32(v)
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_EFixed) Set(x *P2Ext, val uint32) (err error) {
+	var data []byte
+	// data = protowire.AppendTag(data, 107, protowire.Fixed32Type) => 11011101 00000110
+	data = append(data, 0xdd, 0x6)
+	data = protowire.AppendFixed32(data, uint32(val))
+	x.SetExtension(107, data)
+	return
+}
+
+var file_proto2_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b,
+	0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x22, 0x8c, 0x01, 0x0a, 0x07,
+	0x50, 0x32, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x0e, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x00, 0x52, 0x01, 0x61, 0x12, 0x0e, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x01, 0x62, 0x12, 0x28, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x62, 0x2e, 0x50, 0x32, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x12, 0x24, 0x0a, 0x02, 0x6c, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x48, 0x00, 0x52, 0x02, 0x6c, 0x76, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x01, 0x72, 0x42, 0x03, 0x0a, 0x01, 0x6f, 0x22, 0xf6, 0x02, 0x0a, 0x05, 0x50,
+	0x32, 0x4f, 0x70, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x69, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x36, 0x34, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x69, 0x36, 0x34, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x01, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x01, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x62, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x02, 0x62, 0x6c, 0x12, 0x0c, 0x0a, 0x01, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x01, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x66,
+	0x12, 0x22, 0x0a, 0x02, 0x6c, 0x76, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67,
+	0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x02, 0x6c, 0x76, 0x12, 0x24, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e,
+	0x50, 0x32, 0x4f, 0x70, 0x74, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x05, 0x52, 0x01, 0x72, 0x12, 0x12, 0x0a, 0x02, 0x72, 0x70, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x05, 0x42, 0x02, 0x10, 0x01, 0x52, 0x02, 0x72, 0x70, 0x12, 0x27, 0x0a, 0x01,
+	0x6d, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x4f, 0x70, 0x74, 0x2e, 0x4d, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x01, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x33, 0x32, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x11, 0x52, 0x03, 0x73, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x36, 0x34, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x66, 0x36, 0x34, 0x1a, 0x48, 0x0a, 0x06, 0x4d, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x62, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x84, 0x03, 0x0a, 0x09, 0x50, 0x32, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x12, 0x14, 0x0a, 0x03, 0x69, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x02,
+	0x2d, 0x37, 0x52, 0x03, 0x69, 0x33, 0x32, 0x12, 0x1f, 0x0a, 0x03, 0x69, 0x36, 0x34, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x3a, 0x0d, 0x31, 0x30, 0x39, 0x39, 0x35, 0x31, 0x31, 0x36, 0x32, 0x37,
+	0x37, 0x37, 0x36, 0x52, 0x03, 0x69, 0x36, 0x34, 0x12, 0x14, 0x0a, 0x03, 0x75, 0x33, 0x32, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x3a, 0x02, 0x34, 0x32, 0x52, 0x03, 0x75, 0x33, 0x32, 0x12, 0x19,
+	0x0a, 0x01, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x0b, 0x68, 0x69, 0x20, 0x22, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x22, 0x0a, 0x52, 0x01, 0x73, 0x12, 0x14, 0x0a, 0x01, 0x62, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x3a, 0x06, 0x61, 0x5c, 0x30, 0x30, 0x30, 0x62, 0x52, 0x01, 0x62, 0x12,
+	0x14, 0x0a, 0x02, 0x62, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75,
+	0x65, 0x52, 0x02, 0x62, 0x6c, 0x12, 0x11, 0x0a, 0x01, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x3a, 0x03, 0x69, 0x6e, 0x66, 0x52, 0x01, 0x64, 0x12, 0x11, 0x0a, 0x01, 0x66, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x02, 0x3a, 0x03, 0x6e, 0x61, 0x6e, 0x52, 0x01, 0x66, 0x12, 0x14, 0x0a, 0x02, 0x64,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x3a, 0x04, 0x2d, 0x69, 0x6e, 0x66, 0x52, 0x02, 0x64,
+	0x6e, 0x12, 0x28, 0x0a, 0x02, 0x6c, 0x76, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x3a, 0x04, 0x48, 0x49, 0x47, 0x48, 0x52, 0x02, 0x6c, 0x76, 0x12, 0x13, 0x0a, 0x02, 0x66,
+	0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x3a, 0x03, 0x31, 0x2e, 0x35, 0x52, 0x02, 0x66, 0x32,
+	0x12, 0x28, 0x0a, 0x04, 0x73, 0x66, 0x36, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x10, 0x3a, 0x14,
+	0x2d, 0x39, 0x32, 0x32, 0x33, 0x33, 0x37, 0x32, 0x30, 0x33, 0x36, 0x38, 0x35, 0x34, 0x37, 0x37,
+	0x35, 0x38, 0x30, 0x38, 0x52, 0x04, 0x73, 0x66, 0x36, 0x34, 0x12, 0x26, 0x0a, 0x03, 0x75, 0x36,
+	0x34, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x3a, 0x14, 0x31, 0x38, 0x34, 0x34, 0x36, 0x37, 0x34,
+	0x34, 0x30, 0x37, 0x33, 0x37, 0x30, 0x39, 0x35, 0x35, 0x31, 0x36, 0x31, 0x35, 0x52, 0x03, 0x75,
+	0x36, 0x34, 0x12, 0x16, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x3a, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1c, 0x0a, 0x05, 0x50, 0x32,
+	0x45, 0x78, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01,
+	0x61, 0x2a, 0x05, 0x08, 0x64, 0x10, 0xc8, 0x01, 0x22, 0xd1, 0x01, 0x0a, 0x07, 0x50, 0x32, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x24, 0x0a, 0x01, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0a, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x47, 0x52, 0x01, 0x67, 0x12, 0x27, 0x0a, 0x02, 0x72, 0x67,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0a, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x52, 0x47, 0x52,
+	0x02, 0x72, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x1a, 0x1f, 0x0a, 0x01, 0x47, 0x12, 0x0c,
+	0x0a, 0x01, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x61, 0x12, 0x0c, 0x0a, 0x01,
+	0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x62, 0x1a, 0x40, 0x0a, 0x02, 0x52, 0x47,
+	0x12, 0x0c, 0x0a, 0x01, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x63, 0x12, 0x2c,
+	0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x22, 0xf2, 0x01, 0x0a,
+	0x05, 0x50, 0x32, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x02,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x52, 0x65, 0x71, 0x52, 0x05, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x62, 0x2e, 0x50, 0x32, 0x52, 0x65, 0x71, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x27,
+	0x0a, 0x01, 0x6d, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x70, 0x62,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x52, 0x65, 0x71, 0x2e, 0x4d, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x01, 0x6d, 0x1a, 0x48, 0x0a, 0x06, 0x4d, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62,
+	0x2e, 0x50, 0x32, 0x52, 0x65, 0x71, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x41, 0x0a, 0x0b, 0x50, 0x32, 0x52, 0x65, 0x71, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x12, 0x24, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x52, 0x65,
+	0x71, 0x52, 0x03, 0x72, 0x65, 0x71, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x6e, 0x2a, 0x2a, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x49, 0x47, 0x48, 0x10, 0x02,
+	0x3a, 0x27, 0x0a, 0x05, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x45, 0x78, 0x74, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x65, 0x49, 0x6e, 0x74, 0x3a, 0x27, 0x0a, 0x05, 0x65, 0x5f, 0x73,
+	0x74, 0x72, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62,
+	0x2e, 0x50, 0x32, 0x45, 0x78, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x53,
+	0x74, 0x72, 0x3a, 0x27, 0x0a, 0x05, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x12, 0x12, 0x2e, 0x67, 0x6f,
+	0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x45, 0x78, 0x74, 0x18,
+	0x66, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x65, 0x52, 0x65, 0x70, 0x3a, 0x3b, 0x0a, 0x05, 0x65,
+	0x5f, 0x6d, 0x73, 0x67, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x62, 0x2e, 0x50, 0x32, 0x45, 0x78, 0x74, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x4f,
+	0x70, 0x74, 0x52, 0x04, 0x65, 0x4d, 0x73, 0x67, 0x3a, 0x31, 0x0a, 0x08, 0x65, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x62, 0x2e, 0x50, 0x32, 0x45, 0x78, 0x74, 0x18, 0x68, 0x20, 0x03, 0x28, 0x05, 0x42, 0x02,
+	0x10, 0x01, 0x52, 0x07, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x3a, 0x39, 0x0a, 0x04, 0x65,
+	0x5f, 0x6c, 0x76, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x62, 0x2e, 0x50, 0x32, 0x45, 0x78, 0x74, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x03, 0x65, 0x4c, 0x76, 0x3a, 0x29, 0x0a, 0x06, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x74,
+	0x12, 0x12, 0x2e, 0x67, 0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50,
+	0x32, 0x45, 0x78, 0x74, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x12, 0x52, 0x05, 0x65, 0x53, 0x69, 0x6e,
+	0x74, 0x3a, 0x2b, 0x0a, 0x07, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x12, 0x12, 0x2e, 0x67,
+	0x6f, 0x70, 0x62, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x32, 0x45, 0x78, 0x74,
+	0x18, 0x6b, 0x20, 0x01, 0x28, 0x07, 0x52, 0x06, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x42, 0x3e,
+	0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x67, 0x67,
+	0x72, 0x6f, 0x6e, 0x6d, 0x61, 0x67, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
+	0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x70, 0x62, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x3b, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62,
+}
+
+var file_proto2_proto = gopb.RegisterFile("proto2.proto", file_proto2_proto_rawDesc)
+
+var file_proto2_proto_msgTypes = [...]*gopb.MessageType{
+	file_proto2_proto.NewMessageType("gopb.testpb.P2Oneof", (*P2Oneof)(nil), []string{"A", "B", "Msg", "Lv", "R"},
+		(*P2Oneof_A)(nil),
+		(*P2Oneof_B)(nil),
+		(*P2Oneof_Msg)(nil),
+		(*P2Oneof_Lv)(nil)),
+	file_proto2_proto.NewMessageType("gopb.testpb.P2Opt", (*P2Opt)(nil), []string{"I32", "I64", "S", "B", "Bl", "D", "F", "Lv", "Msg", "R", "Rp", "M", "S32", "F64"}),
+	file_proto2_proto.NewMessageType("gopb.testpb.P2Default", (*P2Default)(nil), []string{"I32", "I64", "U32", "S", "B", "Bl", "D", "F", "Dn", "Lv", "F2", "Sf64", "U64", "Empty"}),
+	file_proto2_proto.NewMessageType("gopb.testpb.P2Ext", (*P2Ext)(nil), []string{"A"}),
+	file_proto2_proto.NewMessageType("gopb.testpb.P2Group", (*P2Group)(nil), []string{"G", "Rg", "After"}),
+	file_proto2_proto.NewMessageType("gopb.testpb.P2Group.G", (*P2Group_G)(nil), []string{"A", "B"}),
+	file_proto2_proto.NewMessageType("gopb.testpb.P2Group.RG", (*P2Group_RG)(nil), []string{"C", "Nested"}),
+	file_proto2_proto.NewMessageType("gopb.testpb.P2Req", (*P2Req)(nil), []string{"Id", "Name", "Child", "Items", "M"}),
+	file_proto2_proto.NewMessageType("gopb.testpb.P2ReqHolder", (*P2ReqHolder)(nil), []string{"Req", "N"}),
+}
+
+func init() {
+	gopb.RegisterType("gopb.testpb.P2Oneof", func() gopb.Message { return &P2Oneof{} })
+	gopb.RegisterType("gopb.testpb.P2Opt", func() gopb.Message { return &P2Opt{} })
+	gopb.RegisterType("gopb.testpb.P2Default", func() gopb.Message { return &P2Default{} })
+	gopb.RegisterType("gopb.testpb.P2Ext", func() gopb.Message { return &P2Ext{} })
+	gopb.RegisterType("gopb.testpb.P2Group", func() gopb.Message { return &P2Group{} })
+	gopb.RegisterType("gopb.testpb.P2Group.G", func() gopb.Message { return &P2Group_G{} })
+	gopb.RegisterType("gopb.testpb.P2Group.RG", func() gopb.Message { return &P2Group_RG{} })
+	gopb.RegisterType("gopb.testpb.P2Req", func() gopb.Message { return &P2Req{} })
+	gopb.RegisterType("gopb.testpb.P2ReqHolder", func() gopb.Message { return &P2ReqHolder{} })
+}
@@ -0,0 +1,99 @@
+package testpb
+
+import (
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/gopb"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// 生成的消息实现 proto.Message, 可以直接使用 protobuf-go 的 API
+func TestReflectProtoAPI(t *testing.T) {
+	x, want := sampleAll(t)
+	var m proto.Message = x
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := &golden.All{}
+	if err := proto.Unmarshal(data, got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, want) || proto.Size(m) != len(data) {
+		t.Errorf("proto.Marshal = %v, want %v", got, want)
+	}
+
+	y := &All{}
+	if err := proto.Unmarshal(data, y); err != nil {
+		t.Fatal(err)
+	}
+	if !y.Equal(x) || !proto.Equal(y, x) {
+		t.Errorf("proto.Unmarshal = %v, want %v", y, x)
+	}
+	if z := proto.Clone(x).(*All); !z.Equal(x) {
+		t.Errorf("proto.Clone = %v, want %v", z, x)
+	}
+
+	// protojson/prototext 通过反射访问字段
+	js, err := protojson.MarshalOptions{Resolver: gopb.Types}.Marshal(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got.Reset()
+	if err := protojson.Unmarshal(js, got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("protojson.Marshal = %s, want %v", js, want)
+	}
+	y.Reset()
+	if err := (protojson.UnmarshalOptions{Resolver: gopb.Types}).Unmarshal(js, y); err != nil {
+		t.Fatal(err)
+	}
+	if !y.Equal(x) {
+		t.Errorf("protojson.Unmarshal = %v, want %v", y, x)
+	}
+	txt, err := prototext.MarshalOptions{Resolver: gopb.Types}.Marshal(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y.Reset()
+	if err := (prototext.UnmarshalOptions{Resolver: gopb.Types}).Unmarshal(txt, y); err != nil {
+		t.Fatal(err)
+	}
+	if !y.Equal(x) {
+		t.Errorf("prototext.Unmarshal = %v, want %v", y, x)
+	}
+}
+
+func TestReflectFields(t *testing.T) {
+	x := &P2Opt{I32: ptr[int32](0), R: []int32{1, 2}, M: map[string]Level{"a": Level_HIGH}}
+	m := x.ProtoReflect()
+	fds := m.Descriptor().Fields()
+	if m.Descriptor().FullName() != "gopb.testpb.P2Opt" {
+		t.Errorf("FullName = %s", m.Descriptor().FullName())
+	}
+	if fd := fds.ByName("i32"); !m.Has(fd) || m.Get(fd).Int() != 0 {
+		t.Errorf("i32 = %v, has %v", m.Get(fd), m.Has(fd))
+	}
+	if fd := fds.ByName("s"); m.Has(fd) {
+		t.Error("Has(s) = true, want false")
+	}
+	if fd := fds.ByName("r"); m.Get(fd).List().Len() != 2 {
+		t.Errorf("r = %v", m.Get(fd))
+	}
+	if fd := fds.ByName("m"); m.Get(fd).Map().Get(protoreflect.ValueOfString("a").MapKey()).Enum() != protoreflect.EnumNumber(Level_HIGH) {
+		t.Errorf("m = %v", m.Get(fd))
+	}
+
+	m.Set(fds.ByName("s"), protoreflect.ValueOfString("set"))
+	m.Clear(fds.ByName("i32"))
+	m.Get(fds.ByName("r")).List().Append(protoreflect.ValueOfInt32(3))
+	if x.GetS() != "set" || x.HasI32() || len(x.R) != 3 {
+		t.Errorf("after reflect update = %+v", x)
+	}
+}
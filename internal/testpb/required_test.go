@@ -0,0 +1,72 @@
+package testpb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestIsInitialized(t *testing.T) {
+	tests := []struct {
+		name    string
+		x       *P2ReqHolder
+		want    *golden.P2ReqHolder
+		missing string
+	}{
+		{"empty", &P2ReqHolder{}, &golden.P2ReqHolder{}, ""},
+		{"set", &P2ReqHolder{Req: &P2Req{Id: ptr[int32](0)}}, &golden.P2ReqHolder{Req: &golden.P2Req{Id: proto.Int32(0)}}, ""},
+		{"missing", &P2ReqHolder{Req: &P2Req{Name: ptr("n")}}, &golden.P2ReqHolder{Req: &golden.P2Req{Name: proto.String("n")}}, "P2ReqHolder.req.id"},
+		{
+			"nested",
+			&P2ReqHolder{Req: &P2Req{
+				Id:    ptr[int32](1),
+				Child: &P2Req{Child: &P2Req{Id: ptr[int32](2)}},
+				Items: []*P2Req{{Id: ptr[int32](3)}, {}},
+				M:     map[string]*P2Req{"k": {}},
+			}},
+			&golden.P2ReqHolder{Req: &golden.P2Req{
+				Id:    proto.Int32(1),
+				Child: &golden.P2Req{Child: &golden.P2Req{Id: proto.Int32(2)}},
+				Items: []*golden.P2Req{{Id: proto.Int32(3)}, {}},
+				M:     map[string]*golden.P2Req{"k": {}},
+			}},
+			"P2ReqHolder.req.child.id, P2ReqHolder.req.items[1].id, P2ReqHolder.req.m[k].id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.x.IsInitialized()
+			if (err == nil) != (proto.CheckInitialized(tt.want) == nil) {
+				t.Fatalf("IsInitialized = %v, proto.CheckInitialized = %v", err, proto.CheckInitialized(tt.want))
+			}
+			if tt.missing == "" {
+				if err != nil {
+					t.Fatalf("IsInitialized = %v, want nil", err)
+				}
+			} else if err == nil || !strings.HasSuffix(err.Error(), ": "+tt.missing) {
+				t.Fatalf("IsInitialized = %v, want missing %s", err, tt.missing)
+			}
+
+			// 未检查的序列化接口与 protobuf-go 的 AllowPartial 一致
+			data, err := tt.x.MarshalObject()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := &golden.P2ReqHolder{}
+			if err := (proto.UnmarshalOptions{AllowPartial: true}).Unmarshal(data, got); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("MarshalObject = %v, want %v", got, tt.want)
+			}
+			if _, err := tt.x.MarshalObjectChecked(); (err == nil) != (tt.missing == "") {
+				t.Errorf("MarshalObjectChecked error = %v", err)
+			}
+			if err := (&P2ReqHolder{}).UnmarshalObjectChecked(data); (err == nil) != (tt.missing == "") {
+				t.Errorf("UnmarshalObjectChecked error = %v", err)
+			}
+		})
+	}
+}
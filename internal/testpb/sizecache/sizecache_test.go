@@ -0,0 +1,150 @@
+package sizecache
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/proto"
+)
+
+// chain 返回 depth 层 child 嵌套的消息, 每层带一个子节点列表及单个键的map
+func chain(depth int) *Node {
+	var x *Node
+	for i := depth; i > 0; i-- {
+		x = &Node{
+			V:     int64(i),
+			Name:  strconv.Itoa(i),
+			Child: x,
+			Kids:  []*Node{{V: int64(-i)}, {Name: "kid", Child: &Node{V: 1}}},
+			M:     map[string]*Node{"k": {V: int64(i), Kids: []*Node{{}}}},
+		}
+	}
+	return x
+}
+
+// plainChain 与 chain 相同, 不使用 sizecache 生成的消息
+func plainChain(depth int) *testpb.Node {
+	var x *testpb.Node
+	for i := depth; i > 0; i-- {
+		x = &testpb.Node{
+			V:     int64(i),
+			Name:  strconv.Itoa(i),
+			Child: x,
+			Kids:  []*testpb.Node{{V: int64(-i)}, {Name: "kid", Child: &testpb.Node{V: 1}}},
+			M:     map[string]*testpb.Node{"k": {V: int64(i), Kids: []*testpb.Node{{}}}},
+		}
+	}
+	return x
+}
+
+func TestMarshalMatchesPlain(t *testing.T) {
+	for _, depth := range []int{1, 2, 10, 100} {
+		x, y := chain(depth), plainChain(depth)
+		got, err := x.MarshalObject()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := y.MarshalObject()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatalf("depth %d: MarshalObject differs from the plain message", depth)
+		}
+		if size := x.MarshalSize(); size != len(want) {
+			t.Fatalf("depth %d: MarshalSize = %d, want %d", depth, size, len(want))
+		}
+
+		// 修改后再次序列化, 不能使用旧的缓存
+		x.Child = &Node{Name: "changed", Kids: []*Node{{V: 2}}}
+		x.Kids[1].Child.V = 1 << 40
+		y.Child = &testpb.Node{Name: "changed", Kids: []*testpb.Node{{V: 2}}}
+		y.Kids[1].Child.V = 1 << 40
+		got, err = x.MarshalObjectTo(got[:0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, _ = y.MarshalObject()
+		if !bytes.Equal(got, want) {
+			t.Fatalf("depth %d: MarshalObjectTo after change differs from the plain message", depth)
+		}
+	}
+}
+
+// 使用缓存的大小序列化的结果可以由 protobuf-go 解析, 大小与 proto.Size 一致
+func TestGoldenNode(t *testing.T) {
+	x := chain(10)
+	data, err := x.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &golden.Node{}
+	if err := proto.Unmarshal(data, want); err != nil {
+		t.Fatal(err)
+	}
+	if size := proto.Size(want); size != len(data) {
+		t.Fatalf("proto.Size = %d, want %d", size, len(data))
+	}
+	if want.GetChild().GetChild().GetV() != 3 || want.GetKids()[1].GetChild().GetV() != 1 || want.GetM()["k"].GetV() != 1 {
+		t.Fatalf("proto.Unmarshal = %v", want)
+	}
+	y := &Node{}
+	if err := y.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	if !y.Equal(x) {
+		t.Fatal("UnmarshalObject differs from the original message")
+	}
+}
+
+func TestMarshalAllMatchesPlain(t *testing.T) {
+	ts := time.Unix(1, 2).UTC()
+	x := &All{
+		FInt32: 1, FString: "a", FMsg: &Inner{Id: 1, Child: &Inner{Name: "c", Nums: []int32{1, 2}}},
+		RMsg:    []*Inner{{Id: 2}, {}},
+		MStrMsg: map[string]*Inner{"a": {Child: &Inner{Id: 3}}},
+		O:       &All_OMsg{OMsg: &Inner{Child: &Inner{}}},
+		FTs:     &ts,
+	}
+	data, err := x.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	y := &testpb.All{}
+	if err := y.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	want, err := y.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Fatal("MarshalObject differs from the plain message")
+	}
+}
+
+func BenchmarkMarshalDeep(b *testing.B) {
+	for _, depth := range []int{10, 100} {
+		x, y := chain(depth), plainChain(depth)
+		b.Run("sizecache/"+strconv.Itoa(depth), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := x.MarshalObject(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run("plain/"+strconv.Itoa(depth), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := y.MarshalObject(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
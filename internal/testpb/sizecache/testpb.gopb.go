@@ -0,0 +1,5537 @@
+// Code generated by protoc-gen-gopb. DO NOT EDIT.
+// Code generated by protoc-gen-gopb. DO NOT EDIT.
+// versions:
+// 	protoc-gen-gopb 0.0.5
+// 	protoc          (unknown)
+// source:  testpb.proto
+
+package sizecache
+
+import (
+	bytes "bytes"
+	gopb "github.com/aggronmagi/protoc-gen-gopb/gopb"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	math "math"
+	strconv "strconv"
+	atomic "sync/atomic"
+	time "time"
+	utf8 "unicode/utf8"
+)
+
+type Color int32
+
+const (
+	Color_COLOR_UNSPECIFIED Color = 0
+	Color_RED               Color = 1
+	Color_GREEN             Color = 2
+)
+
+// Enum value maps for Color.
+var (
+	Color_name = map[int32]string{
+		0: "COLOR_UNSPECIFIED",
+		1: "RED",
+		2: "GREEN",
+	}
+	Color_value = map[string]int32{
+		"COLOR_UNSPECIFIED": 0,
+		"RED":               1,
+		"GREEN":             2,
+	}
+)
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	if name, ok := Color_name[int32(x)]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(x), 10)
+}
+
+type Inner struct {
+	Id        int32   `json:"id,omitempty"`
+	Name      string  `json:"name,omitempty"`
+	Nums      []int32 `json:"nums,omitempty"`
+	Child     *Inner  `json:"child,omitempty"`
+	sizeCache int32
+}
+
+func (x *Inner) Reset() {
+	*x = Inner{}
+}
+
+func (x *Inner) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Inner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Inner) GetNums() []int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return nil
+}
+
+func (x *Inner) GetChild() *Inner {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+// HasChild report whether the field is set
+func (x *Inner) HasChild() bool {
+	return x != nil && x.Child != nil
+}
+
+// ClearChild clear the field
+func (x *Inner) ClearChild() {
+	x.Child = nil
+}
+
+// Clone returns a deep copy of x
+func (x *Inner) Clone() *Inner {
+	if x == nil {
+		return nil
+	}
+	y := &Inner{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *Inner) CopyFrom(src *Inner) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	x.Id = src.Id
+	x.Name = src.Name
+	x.Nums = append(x.Nums[:0], src.Nums...)
+	if src.Child != nil {
+		if x.Child == nil {
+			x.Child = &Inner{}
+		}
+		x.Child.CopyFrom(src.Child)
+	} else {
+		x.Child = nil
+	}
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *Inner) Equal(other *Inner) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if x.Id != other.Id {
+		return false
+	}
+	if x.Name != other.Name {
+		return false
+	}
+	if len(x.Nums) != len(other.Nums) {
+		return false
+	}
+	for k := range x.Nums {
+		if x.Nums[k] != other.Nums[k] {
+			return false
+		}
+	}
+	if !x.Child.Equal(other.Child) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *Inner) Merge(src *Inner) {
+	if src == nil {
+		return
+	}
+	if src.Id != 0 {
+		x.Id = src.Id
+	}
+	if len(src.Name) > 0 {
+		x.Name = src.Name
+	}
+	if len(src.Nums) > 0 {
+		x.Nums = append(x.Nums, src.Nums...)
+	}
+	if src.Child != nil {
+		if x.Child == nil {
+			x.Child = &Inner{}
+		}
+		x.Child.Merge(src.Child)
+	}
+}
+
+// MarshalObject marshal data to []byte
+func (x *Inner) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalCachedTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *Inner) MarshalSize() (size int) {
+	if x.Id != 0 {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(x.Id))
+	}
+	if len(x.Name) > 0 {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeBytes(len(x.Name))
+	}
+	if len(x.Nums) > 0 {
+		size += 1 // size += protowire.SizeTag(3)
+		if len(x.Nums) > 0 {
+			fsize := 0
+			for _, item := range x.Nums {
+				fsize += protowire.SizeVarint(uint64(item))
+			}
+			size += protowire.SizeBytes(fsize)
+		}
+	}
+	if x.Child != nil {
+		// 1 = protowire.SizeTag(4)
+		size += 1 + protowire.SizeBytes(x.Child.MarshalSize())
+	}
+	atomic.StoreInt32(&x.sizeCache, int32(size))
+	return
+}
+
+// cachedSize returns the size computed by the last MarshalSize
+func (x *Inner) cachedSize() int {
+	return int(atomic.LoadInt32(&x.sizeCache))
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *Inner) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	// 刷新子消息缓存的大小
+	x.MarshalSize()
+	return x.marshalCachedTo(buf)
+}
+
+// marshalCachedTo marshal data to []byte. 子消息使用 MarshalSize 缓存的大小
+func (x *Inner) marshalCachedTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.Id != 0 {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(x.Id))
+	}
+	if len(x.Name) > 0 {
+
+		// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+		data = append(data, 0x12)
+		data = protowire.AppendString(data, x.Name)
+	}
+	if len(x.Nums) > 0 {
+		// data = protowire.AppendTag(data, 3, protowire.BytesType) => 00011010
+		data = append(data, 0x1a)
+		size := 0
+		for _, v := range x.Nums {
+			size += protowire.SizeVarint(uint64(v))
+		}
+		data = protowire.AppendVarint(data, uint64(size))
+		for _, v := range x.Nums {
+			data = protowire.AppendVarint(data, uint64(v))
+		}
+	}
+	if x.Child != nil {
+		// data = protowire.AppendTag(data, 4, protowire.BytesType) => 00100010
+		data = append(data, 0x22)
+		data = protowire.AppendVarint(data, uint64(x.Child.cachedSize()))
+		data, err = x.Child.marshalCachedTo(data)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *Inner) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.Inner", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Inner", "id", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.Id = int32(v)
+		case 2:
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Inner", "name", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			if !utf8.ValidString(v) {
+				err = gopb.UTF8Error("gopb.testpb.Inner", "name", 2, cap(data)-cap(data[index:]))
+				return
+			}
+			index += cnt
+			x.Name = v
+		case 3:
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Inner", "nums", 3, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				x.Nums = append(x.Nums, int32(v))
+
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Inner", "nums", 3, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.Inner", "nums", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.Nums == nil {
+				x.Nums = make([]int32, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Inner", "nums", 3, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.Nums = append(x.Nums, int32(v))
+
+			}
+		case 4:
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.Inner", "child", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.Child == nil {
+				x.Child = &Inner{}
+			}
+			err = x.Child.UnmarshalObject(v)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.Inner", "child", -1, cap(data)-cap(v))
+				return
+			}
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.Inner", "", num, index, cnt)
+			}
+			index += cnt
+		}
+	}
+
+	return
+}
+
+type All struct {
+	FInt32    int32                     `json:"f_int32,omitempty"`
+	FInt64    int64                     `json:"f_int64,omitempty"`
+	FString   string                    `json:"f_string,omitempty"`
+	FBytes    []byte                    `json:"f_bytes,omitempty"`
+	FBool     bool                      `json:"f_bool,omitempty"`
+	FDouble   float64                   `json:"f_double,omitempty"`
+	FEnum     Color                     `json:"f_enum,omitempty"`
+	PInt32    *int32                    `json:"p_int32,omitempty"`
+	FMsg      *Inner                    `json:"f_msg,omitempty"`
+	FUint32   uint32                    `json:"f_uint32,omitempty"`
+	FUint64   uint64                    `json:"f_uint64,omitempty"`
+	FSint32   int32                     `json:"f_sint32,omitempty"`
+	FSint64   int64                     `json:"f_sint64,omitempty"`
+	FFixed32  uint32                    `json:"f_fixed32,omitempty"`
+	FFixed64  uint64                    `json:"f_fixed64,omitempty"`
+	FSfixed32 int32                     `json:"f_sfixed32,omitempty"`
+	FSfixed64 int64                     `json:"f_sfixed64,omitempty"`
+	FFloat    float32                   `json:"f_float,omitempty"`
+	RInt32    []int32                   `json:"r_int32,omitempty"`
+	RString   []string                  `json:"r_string,omitempty"`
+	RMsg      []*Inner                  `json:"r_msg,omitempty"`
+	RBytes    [][]byte                  `json:"r_bytes,omitempty"`
+	RDouble   []float64                 `json:"r_double,omitempty"`
+	REnum     []Color                   `json:"r_enum,omitempty"`
+	RSint64   []int64                   `json:"r_sint64,omitempty"`
+	RUnpacked []int32                   `json:"r_unpacked,omitempty"`
+	MStrInt   map[string]int32          `json:"m_str_int,omitempty"`
+	MIntStr   map[int32]string          `json:"m_int_str,omitempty"`
+	MStrMsg   map[string]*Inner         `json:"m_str_msg,omitempty"`
+	MTs       map[string]*time.Time     `json:"m_ts,omitempty"`
+	MDur      map[string]*time.Duration `json:"m_dur,omitempty"`
+	MI32      map[string]*int32         `json:"m_i32,omitempty"`
+	MBytes    map[string][]byte         `json:"m_bytes,omitempty"`
+	O         isAll_O                   `json:"o,omitempty"`
+	FAny      *gopb.Any                 `json:"f_any,omitempty"`
+	FTs       *time.Time                `json:"f_ts,omitempty"`
+	FDur      *time.Duration            `json:"f_dur,omitempty"`
+	FI64      *int64                    `json:"f_i64,omitempty"`
+	FSv       *string                   `json:"f_sv,omitempty"`
+	FBv       []byte                    `json:"f_bv,omitempty"`
+	FBoolv    *bool                     `json:"f_boolv,omitempty"`
+	FDblv     *float64                  `json:"f_dblv,omitempty"`
+	RTs       []*time.Time              `json:"r_ts,omitempty"`
+	RU32      []*uint32                 `json:"r_u32,omitempty"`
+	PString   *string                   `json:"p_string,omitempty"`
+	PBytes    []byte                    `json:"p_bytes,omitempty"`
+	PEnum     *Color                    `json:"p_enum,omitempty"`
+	PDouble   *float64                  `json:"p_double,omitempty"`
+	PUint64   *uint64                   `json:"p_uint64,omitempty"`
+	sizeCache int32
+}
+
+func (x *All) Reset() {
+	*x = All{}
+}
+
+type isAll_O interface {
+	isAll_O()
+	marshalOneofSize() int
+	marshalOneofTo(buf []byte) ([]byte, error)
+	copyOneof() isAll_O
+	equalOneof(other isAll_O) bool
+	mergeOneof(dst isAll_O) isAll_O
+}
+
+type All_OMsg struct {
+	OMsg *Inner `json:"o_msg,omitempty"`
+}
+
+func (*All_OMsg) isAll_O() {}
+
+func (x *All_OMsg) marshalOneofSize() (size int) {
+	if x.OMsg != nil {
+		// 2 = protowire.SizeTag(40)
+		size += 2 + protowire.SizeBytes(x.OMsg.MarshalSize())
+	}
+	return
+}
+
+func (x *All_OMsg) marshalOneofTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.OMsg != nil {
+		// data = protowire.AppendTag(data, 40, protowire.BytesType) => 11000010 00000010
+		data = append(data, 0xc2, 0x2)
+		data = protowire.AppendVarint(data, uint64(x.OMsg.cachedSize()))
+		data, err = x.OMsg.marshalCachedTo(data)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (x *All_OMsg) copyOneof() isAll_O {
+	y := &All_OMsg{}
+	if x.OMsg != nil {
+		if y.OMsg == nil {
+			y.OMsg = &Inner{}
+		}
+		y.OMsg.CopyFrom(x.OMsg)
+	} else {
+		y.OMsg = nil
+	}
+	return y
+}
+
+func (x *All_OMsg) equalOneof(other isAll_O) bool {
+	y, ok := other.(*All_OMsg)
+	if !ok {
+		return false
+	}
+	if !x.OMsg.Equal(y.OMsg) {
+		return false
+	}
+	return true
+}
+
+func (x *All_OMsg) mergeOneof(dst isAll_O) isAll_O {
+	y, ok := dst.(*All_OMsg)
+	if !ok {
+		y = &All_OMsg{}
+	}
+	if x.OMsg != nil {
+		if y.OMsg == nil {
+			y.OMsg = &Inner{}
+		}
+		y.OMsg.Merge(x.OMsg)
+	}
+	return y
+}
+
+type All_OStr struct {
+	OStr string `json:"o_str,omitempty"`
+}
+
+func (*All_OStr) isAll_O() {}
+
+func (x *All_OStr) marshalOneofSize() (size int) {
+	// 2 = protowire.SizeTag(41)
+	size += 2 + protowire.SizeBytes(len(x.OStr))
+	return
+}
+
+func (x *All_OStr) marshalOneofTo(buf []byte) (data []byte, err error) {
+	data = buf
+
+	// data = protowire.AppendTag(data, 41, protowire.BytesType) => 11001010 00000010
+	data = append(data, 0xca, 0x2)
+	data = protowire.AppendString(data, x.OStr)
+	return
+}
+
+func (x *All_OStr) copyOneof() isAll_O {
+	y := &All_OStr{}
+	y.OStr = x.OStr
+	return y
+}
+
+func (x *All_OStr) equalOneof(other isAll_O) bool {
+	y, ok := other.(*All_OStr)
+	if !ok {
+		return false
+	}
+	if x.OStr != y.OStr {
+		return false
+	}
+	return true
+}
+
+func (x *All_OStr) mergeOneof(dst isAll_O) isAll_O {
+	y, ok := dst.(*All_OStr)
+	if !ok {
+		y = &All_OStr{}
+	}
+	y.OStr = x.OStr
+	return y
+}
+
+type All_OInt struct {
+	OInt int32 `json:"o_int,omitempty"`
+}
+
+func (*All_OInt) isAll_O() {}
+
+func (x *All_OInt) marshalOneofSize() (size int) {
+	// 2 = protowire.SizeTag(42)
+	size += 2 + protowire.SizeVarint(uint64(x.OInt))
+	return
+}
+
+func (x *All_OInt) marshalOneofTo(buf []byte) (data []byte, err error) {
+	data = buf
+	// data = protowire.AppendTag(data, 42, protowire.VarintType) => 11010000 00000010
+	data = append(data, 0xd0, 0x2)
+	data = protowire.AppendVarint(data, uint64(x.OInt))
+	return
+}
+
+func (x *All_OInt) copyOneof() isAll_O {
+	y := &All_OInt{}
+	y.OInt = x.OInt
+	return y
+}
+
+func (x *All_OInt) equalOneof(other isAll_O) bool {
+	y, ok := other.(*All_OInt)
+	if !ok {
+		return false
+	}
+	if x.OInt != y.OInt {
+		return false
+	}
+	return true
+}
+
+func (x *All_OInt) mergeOneof(dst isAll_O) isAll_O {
+	y, ok := dst.(*All_OInt)
+	if !ok {
+		y = &All_OInt{}
+	}
+	y.OInt = x.OInt
+	return y
+}
+
+func (x *All) GetFInt32() int32 {
+	if x != nil {
+		return x.FInt32
+	}
+	return 0
+}
+
+func (x *All) GetFInt64() int64 {
+	if x != nil {
+		return x.FInt64
+	}
+	return 0
+}
+
+func (x *All) GetFString() string {
+	if x != nil {
+		return x.FString
+	}
+	return ""
+}
+
+func (x *All) GetFBytes() []byte {
+	if x != nil {
+		return x.FBytes
+	}
+	return nil
+}
+
+func (x *All) GetFBool() bool {
+	if x != nil {
+		return x.FBool
+	}
+	return false
+}
+
+func (x *All) GetFDouble() float64 {
+	if x != nil {
+		return x.FDouble
+	}
+	return 0
+}
+
+func (x *All) GetFEnum() Color {
+	if x != nil {
+		return x.FEnum
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+func (x *All) GetPInt32() int32 {
+	if x != nil && x.PInt32 != nil {
+		return *x.PInt32
+	}
+	return 0
+}
+
+// HasPInt32 report whether the field is set
+func (x *All) HasPInt32() bool {
+	return x != nil && x.PInt32 != nil
+}
+
+// ClearPInt32 clear the field
+func (x *All) ClearPInt32() {
+	x.PInt32 = nil
+}
+
+func (x *All) GetFMsg() *Inner {
+	if x != nil {
+		return x.FMsg
+	}
+	return nil
+}
+
+// HasFMsg report whether the field is set
+func (x *All) HasFMsg() bool {
+	return x != nil && x.FMsg != nil
+}
+
+// ClearFMsg clear the field
+func (x *All) ClearFMsg() {
+	x.FMsg = nil
+}
+
+func (x *All) GetFUint32() uint32 {
+	if x != nil {
+		return x.FUint32
+	}
+	return 0
+}
+
+func (x *All) GetFUint64() uint64 {
+	if x != nil {
+		return x.FUint64
+	}
+	return 0
+}
+
+func (x *All) GetFSint32() int32 {
+	if x != nil {
+		return x.FSint32
+	}
+	return 0
+}
+
+func (x *All) GetFSint64() int64 {
+	if x != nil {
+		return x.FSint64
+	}
+	return 0
+}
+
+func (x *All) GetFFixed32() uint32 {
+	if x != nil {
+		return x.FFixed32
+	}
+	return 0
+}
+
+func (x *All) GetFFixed64() uint64 {
+	if x != nil {
+		return x.FFixed64
+	}
+	return 0
+}
+
+func (x *All) GetFSfixed32() int32 {
+	if x != nil {
+		return x.FSfixed32
+	}
+	return 0
+}
+
+func (x *All) GetFSfixed64() int64 {
+	if x != nil {
+		return x.FSfixed64
+	}
+	return 0
+}
+
+func (x *All) GetFFloat() float32 {
+	if x != nil {
+		return x.FFloat
+	}
+	return 0
+}
+
+func (x *All) GetRInt32() []int32 {
+	if x != nil {
+		return x.RInt32
+	}
+	return nil
+}
+
+func (x *All) GetRString() []string {
+	if x != nil {
+		return x.RString
+	}
+	return nil
+}
+
+func (x *All) GetRMsg() []*Inner {
+	if x != nil {
+		return x.RMsg
+	}
+	return nil
+}
+
+func (x *All) GetRBytes() [][]byte {
+	if x != nil {
+		return x.RBytes
+	}
+	return nil
+}
+
+func (x *All) GetRDouble() []float64 {
+	if x != nil {
+		return x.RDouble
+	}
+	return nil
+}
+
+func (x *All) GetREnum() []Color {
+	if x != nil {
+		return x.REnum
+	}
+	return nil
+}
+
+func (x *All) GetRSint64() []int64 {
+	if x != nil {
+		return x.RSint64
+	}
+	return nil
+}
+
+func (x *All) GetRUnpacked() []int32 {
+	if x != nil {
+		return x.RUnpacked
+	}
+	return nil
+}
+
+func (x *All) GetMStrInt() map[string]int32 {
+	if x != nil {
+		return x.MStrInt
+	}
+	return nil
+}
+
+func (x *All) GetMIntStr() map[int32]string {
+	if x != nil {
+		return x.MIntStr
+	}
+	return nil
+}
+
+func (x *All) GetMStrMsg() map[string]*Inner {
+	if x != nil {
+		return x.MStrMsg
+	}
+	return nil
+}
+
+func (x *All) GetMTs() map[string]*time.Time {
+	if x != nil {
+		return x.MTs
+	}
+	return nil
+}
+
+func (x *All) GetMDur() map[string]*time.Duration {
+	if x != nil {
+		return x.MDur
+	}
+	return nil
+}
+
+func (x *All) GetMI32() map[string]*int32 {
+	if x != nil {
+		return x.MI32
+	}
+	return nil
+}
+
+func (x *All) GetMBytes() map[string][]byte {
+	if x != nil {
+		return x.MBytes
+	}
+	return nil
+}
+
+func (x *All) GetO() isAll_O {
+	if x != nil {
+		return x.O
+	}
+	return nil
+}
+
+func (x *All) GetOMsg() *Inner {
+	if x, ok := x.GetO().(*All_OMsg); ok {
+		return x.OMsg
+	}
+	return nil
+}
+
+func (x *All) GetOStr() string {
+	if x, ok := x.GetO().(*All_OStr); ok {
+		return x.OStr
+	}
+	return ""
+}
+
+func (x *All) GetOInt() int32 {
+	if x, ok := x.GetO().(*All_OInt); ok {
+		return x.OInt
+	}
+	return 0
+}
+
+func (x *All) GetFAny() *gopb.Any {
+	if x != nil {
+		return x.FAny
+	}
+	return nil
+}
+
+// HasFAny report whether the field is set
+func (x *All) HasFAny() bool {
+	return x != nil && x.FAny != nil
+}
+
+// ClearFAny clear the field
+func (x *All) ClearFAny() {
+	x.FAny = nil
+}
+
+func (x *All) GetFTs() *time.Time {
+	if x != nil {
+		return x.FTs
+	}
+	return nil
+}
+
+// HasFTs report whether the field is set
+func (x *All) HasFTs() bool {
+	return x != nil && x.FTs != nil
+}
+
+// ClearFTs clear the field
+func (x *All) ClearFTs() {
+	x.FTs = nil
+}
+
+func (x *All) GetFDur() *time.Duration {
+	if x != nil {
+		return x.FDur
+	}
+	return nil
+}
+
+// HasFDur report whether the field is set
+func (x *All) HasFDur() bool {
+	return x != nil && x.FDur != nil
+}
+
+// ClearFDur clear the field
+func (x *All) ClearFDur() {
+	x.FDur = nil
+}
+
+func (x *All) GetFI64() *int64 {
+	if x != nil {
+		return x.FI64
+	}
+	return nil
+}
+
+// HasFI64 report whether the field is set
+func (x *All) HasFI64() bool {
+	return x != nil && x.FI64 != nil
+}
+
+// ClearFI64 clear the field
+func (x *All) ClearFI64() {
+	x.FI64 = nil
+}
+
+func (x *All) GetFSv() *string {
+	if x != nil {
+		return x.FSv
+	}
+	return nil
+}
+
+// HasFSv report whether the field is set
+func (x *All) HasFSv() bool {
+	return x != nil && x.FSv != nil
+}
+
+// ClearFSv clear the field
+func (x *All) ClearFSv() {
+	x.FSv = nil
+}
+
+func (x *All) GetFBv() []byte {
+	if x != nil {
+		return x.FBv
+	}
+	return nil
+}
+
+// HasFBv report whether the field is set
+func (x *All) HasFBv() bool {
+	return x != nil && x.FBv != nil
+}
+
+// ClearFBv clear the field
+func (x *All) ClearFBv() {
+	x.FBv = nil
+}
+
+func (x *All) GetFBoolv() *bool {
+	if x != nil {
+		return x.FBoolv
+	}
+	return nil
+}
+
+// HasFBoolv report whether the field is set
+func (x *All) HasFBoolv() bool {
+	return x != nil && x.FBoolv != nil
+}
+
+// ClearFBoolv clear the field
+func (x *All) ClearFBoolv() {
+	x.FBoolv = nil
+}
+
+func (x *All) GetFDblv() *float64 {
+	if x != nil {
+		return x.FDblv
+	}
+	return nil
+}
+
+// HasFDblv report whether the field is set
+func (x *All) HasFDblv() bool {
+	return x != nil && x.FDblv != nil
+}
+
+// ClearFDblv clear the field
+func (x *All) ClearFDblv() {
+	x.FDblv = nil
+}
+
+func (x *All) GetRTs() []*time.Time {
+	if x != nil {
+		return x.RTs
+	}
+	return nil
+}
+
+func (x *All) GetRU32() []*uint32 {
+	if x != nil {
+		return x.RU32
+	}
+	return nil
+}
+
+func (x *All) GetPString() string {
+	if x != nil && x.PString != nil {
+		return *x.PString
+	}
+	return ""
+}
+
+// HasPString report whether the field is set
+func (x *All) HasPString() bool {
+	return x != nil && x.PString != nil
+}
+
+// ClearPString clear the field
+func (x *All) ClearPString() {
+	x.PString = nil
+}
+
+func (x *All) GetPBytes() []byte {
+	if x != nil {
+		return x.PBytes
+	}
+	return nil
+}
+
+// HasPBytes report whether the field is set
+func (x *All) HasPBytes() bool {
+	return x != nil && x.PBytes != nil
+}
+
+// ClearPBytes clear the field
+func (x *All) ClearPBytes() {
+	x.PBytes = nil
+}
+
+func (x *All) GetPEnum() Color {
+	if x != nil && x.PEnum != nil {
+		return *x.PEnum
+	}
+	return Color_COLOR_UNSPECIFIED
+}
+
+// HasPEnum report whether the field is set
+func (x *All) HasPEnum() bool {
+	return x != nil && x.PEnum != nil
+}
+
+// ClearPEnum clear the field
+func (x *All) ClearPEnum() {
+	x.PEnum = nil
+}
+
+func (x *All) GetPDouble() float64 {
+	if x != nil && x.PDouble != nil {
+		return *x.PDouble
+	}
+	return 0
+}
+
+// HasPDouble report whether the field is set
+func (x *All) HasPDouble() bool {
+	return x != nil && x.PDouble != nil
+}
+
+// ClearPDouble clear the field
+func (x *All) ClearPDouble() {
+	x.PDouble = nil
+}
+
+func (x *All) GetPUint64() uint64 {
+	if x != nil && x.PUint64 != nil {
+		return *x.PUint64
+	}
+	return 0
+}
+
+// HasPUint64 report whether the field is set
+func (x *All) HasPUint64() bool {
+	return x != nil && x.PUint64 != nil
+}
+
+// ClearPUint64 clear the field
+func (x *All) ClearPUint64() {
+	x.PUint64 = nil
+}
+
+// Clone returns a deep copy of x
+func (x *All) Clone() *All {
+	if x == nil {
+		return nil
+	}
+	y := &All{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *All) CopyFrom(src *All) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	x.FInt32 = src.FInt32
+	x.FInt64 = src.FInt64
+	x.FString = src.FString
+	if src.FBytes != nil {
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入.
+		// 空的值为 []byte{}, 保留存在性
+		x.FBytes = append([]byte{}, src.FBytes...)
+	} else {
+		x.FBytes = nil
+	}
+	x.FBool = src.FBool
+	x.FDouble = src.FDouble
+	x.FEnum = src.FEnum
+	if src.PInt32 != nil {
+		pv := *src.PInt32
+		x.PInt32 = &pv
+	} else {
+		x.PInt32 = nil
+	}
+	if src.FMsg != nil {
+		if x.FMsg == nil {
+			x.FMsg = &Inner{}
+		}
+		x.FMsg.CopyFrom(src.FMsg)
+	} else {
+		x.FMsg = nil
+	}
+	x.FUint32 = src.FUint32
+	x.FUint64 = src.FUint64
+	x.FSint32 = src.FSint32
+	x.FSint64 = src.FSint64
+	x.FFixed32 = src.FFixed32
+	x.FFixed64 = src.FFixed64
+	x.FSfixed32 = src.FSfixed32
+	x.FSfixed64 = src.FSfixed64
+	x.FFloat = src.FFloat
+	x.RInt32 = append(x.RInt32[:0], src.RInt32...)
+	x.RString = append(x.RString[:0], src.RString...)
+	// 只复用原来长度内的元素. 超出长度的部分(例如 Release 截断的列表)可能已被其他消息使用, 置为零值后重新分配
+	if n, old := len(src.RMsg), len(x.RMsg); n <= old {
+		x.RMsg = x.RMsg[:n]
+	} else {
+		x.RMsg = append(x.RMsg, make([]*Inner, n-old)...)
+	}
+	for k, item := range src.RMsg {
+		if item != nil {
+			if x.RMsg[k] == nil {
+				x.RMsg[k] = &Inner{}
+			}
+			x.RMsg[k].CopyFrom(item)
+		} else {
+			x.RMsg[k] = nil
+		}
+	}
+	// 只复用原来长度内的元素. 超出长度的部分(例如 Release 截断的列表)可能已被其他消息使用, 置为零值后重新分配
+	if n, old := len(src.RBytes), len(x.RBytes); n <= old {
+		x.RBytes = x.RBytes[:n]
+	} else {
+		x.RBytes = append(x.RBytes, make([][]byte, n-old)...)
+	}
+	for k, item := range src.RBytes {
+		if item != nil {
+			// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入.
+			// 空的值为 []byte{}, 保留存在性
+			x.RBytes[k] = append([]byte{}, item...)
+		} else {
+			x.RBytes[k] = nil
+		}
+	}
+	x.RDouble = append(x.RDouble[:0], src.RDouble...)
+	x.REnum = append(x.REnum[:0], src.REnum...)
+	x.RSint64 = append(x.RSint64[:0], src.RSint64...)
+	x.RUnpacked = append(x.RUnpacked[:0], src.RUnpacked...)
+	if x.MStrInt == nil && src.MStrInt != nil {
+		x.MStrInt = make(map[string]int32, len(src.MStrInt))
+	}
+	for mk := range x.MStrInt {
+		delete(x.MStrInt, mk)
+	}
+	for mk, mv := range src.MStrInt {
+		x.MStrInt[mk] = mv
+	}
+	if x.MIntStr == nil && src.MIntStr != nil {
+		x.MIntStr = make(map[int32]string, len(src.MIntStr))
+	}
+	for mk := range x.MIntStr {
+		delete(x.MIntStr, mk)
+	}
+	for mk, mv := range src.MIntStr {
+		x.MIntStr[mk] = mv
+	}
+	if x.MStrMsg == nil && src.MStrMsg != nil {
+		x.MStrMsg = make(map[string]*Inner, len(src.MStrMsg))
+	}
+	for mk := range x.MStrMsg {
+		delete(x.MStrMsg, mk)
+	}
+	for mk, mv := range src.MStrMsg {
+		if mv != nil {
+			if x.MStrMsg[mk] == nil {
+				x.MStrMsg[mk] = &Inner{}
+			}
+			x.MStrMsg[mk].CopyFrom(mv)
+		} else {
+			x.MStrMsg[mk] = nil
+		}
+	}
+	if x.MTs == nil && src.MTs != nil {
+		x.MTs = make(map[string]*time.Time, len(src.MTs))
+	}
+	for mk := range x.MTs {
+		delete(x.MTs, mk)
+	}
+	for mk, mv := range src.MTs {
+		if mv != nil {
+			pv := *mv
+			x.MTs[mk] = &pv
+		} else {
+			x.MTs[mk] = nil
+		}
+	}
+	if x.MDur == nil && src.MDur != nil {
+		x.MDur = make(map[string]*time.Duration, len(src.MDur))
+	}
+	for mk := range x.MDur {
+		delete(x.MDur, mk)
+	}
+	for mk, mv := range src.MDur {
+		if mv != nil {
+			pv := *mv
+			x.MDur[mk] = &pv
+		} else {
+			x.MDur[mk] = nil
+		}
+	}
+	if x.MI32 == nil && src.MI32 != nil {
+		x.MI32 = make(map[string]*int32, len(src.MI32))
+	}
+	for mk := range x.MI32 {
+		delete(x.MI32, mk)
+	}
+	for mk, mv := range src.MI32 {
+		if mv != nil {
+			pv := *mv
+			x.MI32[mk] = &pv
+		} else {
+			x.MI32[mk] = nil
+		}
+	}
+	if x.MBytes == nil && src.MBytes != nil {
+		x.MBytes = make(map[string][]byte, len(src.MBytes))
+	}
+	for mk := range x.MBytes {
+		delete(x.MBytes, mk)
+	}
+	for mk, mv := range src.MBytes {
+		if mv != nil {
+			// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入.
+			// 空的值为 []byte{}, 保留存在性
+			x.MBytes[mk] = append([]byte{}, mv...)
+		} else {
+			x.MBytes[mk] = nil
+		}
+	}
+	if src.O != nil {
+		x.O = src.O.copyOneof()
+	} else {
+		x.O = nil
+	}
+	if src.FAny != nil {
+		if x.FAny == nil {
+			x.FAny = &gopb.Any{}
+		}
+		x.FAny.CopyFrom(src.FAny)
+	} else {
+		x.FAny = nil
+	}
+	if src.FTs != nil {
+		pv := *src.FTs
+		x.FTs = &pv
+	} else {
+		x.FTs = nil
+	}
+	if src.FDur != nil {
+		pv := *src.FDur
+		x.FDur = &pv
+	} else {
+		x.FDur = nil
+	}
+	if src.FI64 != nil {
+		pv := *src.FI64
+		x.FI64 = &pv
+	} else {
+		x.FI64 = nil
+	}
+	if src.FSv != nil {
+		pv := *src.FSv
+		x.FSv = &pv
+	} else {
+		x.FSv = nil
+	}
+	if src.FBv != nil {
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入.
+		// 空的值为 []byte{}, 保留存在性
+		x.FBv = append([]byte{}, src.FBv...)
+	} else {
+		x.FBv = nil
+	}
+	if src.FBoolv != nil {
+		pv := *src.FBoolv
+		x.FBoolv = &pv
+	} else {
+		x.FBoolv = nil
+	}
+	if src.FDblv != nil {
+		pv := *src.FDblv
+		x.FDblv = &pv
+	} else {
+		x.FDblv = nil
+	}
+	// 只复用原来长度内的元素. 超出长度的部分(例如 Release 截断的列表)可能已被其他消息使用, 置为零值后重新分配
+	if n, old := len(src.RTs), len(x.RTs); n <= old {
+		x.RTs = x.RTs[:n]
+	} else {
+		x.RTs = append(x.RTs, make([]*time.Time, n-old)...)
+	}
+	for k, item := range src.RTs {
+		if item != nil {
+			pv := *item
+			x.RTs[k] = &pv
+		} else {
+			x.RTs[k] = nil
+		}
+	}
+	// 只复用原来长度内的元素. 超出长度的部分(例如 Release 截断的列表)可能已被其他消息使用, 置为零值后重新分配
+	if n, old := len(src.RU32), len(x.RU32); n <= old {
+		x.RU32 = x.RU32[:n]
+	} else {
+		x.RU32 = append(x.RU32, make([]*uint32, n-old)...)
+	}
+	for k, item := range src.RU32 {
+		if item != nil {
+			pv := *item
+			x.RU32[k] = &pv
+		} else {
+			x.RU32[k] = nil
+		}
+	}
+	if src.PString != nil {
+		pv := *src.PString
+		x.PString = &pv
+	} else {
+		x.PString = nil
+	}
+	if src.PBytes != nil {
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入.
+		// 空的值为 []byte{}, 保留存在性
+		x.PBytes = append([]byte{}, src.PBytes...)
+	} else {
+		x.PBytes = nil
+	}
+	if src.PEnum != nil {
+		pv := *src.PEnum
+		x.PEnum = &pv
+	} else {
+		x.PEnum = nil
+	}
+	if src.PDouble != nil {
+		pv := *src.PDouble
+		x.PDouble = &pv
+	} else {
+		x.PDouble = nil
+	}
+	if src.PUint64 != nil {
+		pv := *src.PUint64
+		x.PUint64 = &pv
+	} else {
+		x.PUint64 = nil
+	}
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *All) Equal(other *All) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if x.FInt32 != other.FInt32 {
+		return false
+	}
+	if x.FInt64 != other.FInt64 {
+		return false
+	}
+	if x.FString != other.FString {
+		return false
+	}
+	if !bytes.Equal(x.FBytes, other.FBytes) {
+		return false
+	}
+	if x.FBool != other.FBool {
+		return false
+	}
+	// NaN 与 NaN 相等, 与 proto.Equal 一致
+	if x.FDouble != other.FDouble && !(math.IsNaN(float64(x.FDouble)) && math.IsNaN(float64(other.FDouble))) {
+		return false
+	}
+	if x.FEnum != other.FEnum {
+		return false
+	}
+	if (x.PInt32 == nil) != (other.PInt32 == nil) {
+		return false
+	}
+	if x.PInt32 != nil {
+		if *x.PInt32 != *other.PInt32 {
+			return false
+		}
+	}
+	if !x.FMsg.Equal(other.FMsg) {
+		return false
+	}
+	if x.FUint32 != other.FUint32 {
+		return false
+	}
+	if x.FUint64 != other.FUint64 {
+		return false
+	}
+	if x.FSint32 != other.FSint32 {
+		return false
+	}
+	if x.FSint64 != other.FSint64 {
+		return false
+	}
+	if x.FFixed32 != other.FFixed32 {
+		return false
+	}
+	if x.FFixed64 != other.FFixed64 {
+		return false
+	}
+	if x.FSfixed32 != other.FSfixed32 {
+		return false
+	}
+	if x.FSfixed64 != other.FSfixed64 {
+		return false
+	}
+	// NaN 与 NaN 相等, 与 proto.Equal 一致
+	if x.FFloat != other.FFloat && !(math.IsNaN(float64(x.FFloat)) && math.IsNaN(float64(other.FFloat))) {
+		return false
+	}
+	if len(x.RInt32) != len(other.RInt32) {
+		return false
+	}
+	for k := range x.RInt32 {
+		if x.RInt32[k] != other.RInt32[k] {
+			return false
+		}
+	}
+	if len(x.RString) != len(other.RString) {
+		return false
+	}
+	for k := range x.RString {
+		if x.RString[k] != other.RString[k] {
+			return false
+		}
+	}
+	if len(x.RMsg) != len(other.RMsg) {
+		return false
+	}
+	for k := range x.RMsg {
+		if !x.RMsg[k].Equal(other.RMsg[k]) {
+			return false
+		}
+	}
+	if len(x.RBytes) != len(other.RBytes) {
+		return false
+	}
+	for k := range x.RBytes {
+		if !bytes.Equal(x.RBytes[k], other.RBytes[k]) {
+			return false
+		}
+	}
+	if len(x.RDouble) != len(other.RDouble) {
+		return false
+	}
+	for k := range x.RDouble {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if x.RDouble[k] != other.RDouble[k] && !(math.IsNaN(float64(x.RDouble[k])) && math.IsNaN(float64(other.RDouble[k]))) {
+			return false
+		}
+	}
+	if len(x.REnum) != len(other.REnum) {
+		return false
+	}
+	for k := range x.REnum {
+		if x.REnum[k] != other.REnum[k] {
+			return false
+		}
+	}
+	if len(x.RSint64) != len(other.RSint64) {
+		return false
+	}
+	for k := range x.RSint64 {
+		if x.RSint64[k] != other.RSint64[k] {
+			return false
+		}
+	}
+	if len(x.RUnpacked) != len(other.RUnpacked) {
+		return false
+	}
+	for k := range x.RUnpacked {
+		if x.RUnpacked[k] != other.RUnpacked[k] {
+			return false
+		}
+	}
+	if len(x.MStrInt) != len(other.MStrInt) {
+		return false
+	}
+	for mk, mv := range x.MStrInt {
+		ov, ok := other.MStrInt[mk]
+		if !ok {
+			return false
+		}
+		if mv != ov {
+			return false
+		}
+	}
+	if len(x.MIntStr) != len(other.MIntStr) {
+		return false
+	}
+	for mk, mv := range x.MIntStr {
+		ov, ok := other.MIntStr[mk]
+		if !ok {
+			return false
+		}
+		if mv != ov {
+			return false
+		}
+	}
+	if len(x.MStrMsg) != len(other.MStrMsg) {
+		return false
+	}
+	for mk, mv := range x.MStrMsg {
+		ov, ok := other.MStrMsg[mk]
+		if !ok {
+			return false
+		}
+		if !mv.Equal(ov) {
+			return false
+		}
+	}
+	if len(x.MTs) != len(other.MTs) {
+		return false
+	}
+	for mk, mv := range x.MTs {
+		ov, ok := other.MTs[mk]
+		if !ok {
+			return false
+		}
+		if (mv == nil) != (ov == nil) || mv != nil && !mv.Equal(*ov) {
+			return false
+		}
+	}
+	if len(x.MDur) != len(other.MDur) {
+		return false
+	}
+	for mk, mv := range x.MDur {
+		ov, ok := other.MDur[mk]
+		if !ok {
+			return false
+		}
+		if (mv == nil) != (ov == nil) || mv != nil && *mv != *ov {
+			return false
+		}
+	}
+	if len(x.MI32) != len(other.MI32) {
+		return false
+	}
+	for mk, mv := range x.MI32 {
+		ov, ok := other.MI32[mk]
+		if !ok {
+			return false
+		}
+
+		if (mv == nil) != (ov == nil) {
+			return false
+		}
+		if mv != nil {
+			if *mv != *ov {
+				return false
+			}
+		}
+	}
+	if len(x.MBytes) != len(other.MBytes) {
+		return false
+	}
+	for mk, mv := range x.MBytes {
+		ov, ok := other.MBytes[mk]
+		if !ok {
+			return false
+		}
+
+		if (mv == nil) != (ov == nil) || !bytes.Equal(mv, ov) {
+			return false
+		}
+	}
+	if (x.O == nil) != (other.O == nil) || x.O != nil && !x.O.equalOneof(other.O) {
+		return false
+	}
+	if !x.FAny.Equal(other.FAny) {
+		return false
+	}
+	if (x.FTs == nil) != (other.FTs == nil) || x.FTs != nil && !x.FTs.Equal(*other.FTs) {
+		return false
+	}
+	if (x.FDur == nil) != (other.FDur == nil) || x.FDur != nil && *x.FDur != *other.FDur {
+		return false
+	}
+
+	if (x.FI64 == nil) != (other.FI64 == nil) {
+		return false
+	}
+	if x.FI64 != nil {
+		if *x.FI64 != *other.FI64 {
+			return false
+		}
+	}
+
+	if (x.FSv == nil) != (other.FSv == nil) {
+		return false
+	}
+	if x.FSv != nil {
+		if *x.FSv != *other.FSv {
+			return false
+		}
+	}
+
+	if (x.FBv == nil) != (other.FBv == nil) || !bytes.Equal(x.FBv, other.FBv) {
+		return false
+	}
+
+	if (x.FBoolv == nil) != (other.FBoolv == nil) {
+		return false
+	}
+	if x.FBoolv != nil {
+		if *x.FBoolv != *other.FBoolv {
+			return false
+		}
+	}
+
+	if (x.FDblv == nil) != (other.FDblv == nil) {
+		return false
+	}
+	if x.FDblv != nil {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if *x.FDblv != *other.FDblv && !(math.IsNaN(float64(*x.FDblv)) && math.IsNaN(float64(*other.FDblv))) {
+			return false
+		}
+	}
+	if len(x.RTs) != len(other.RTs) {
+		return false
+	}
+	for k := range x.RTs {
+		if (x.RTs[k] == nil) != (other.RTs[k] == nil) || x.RTs[k] != nil && !x.RTs[k].Equal(*other.RTs[k]) {
+			return false
+		}
+	}
+	if len(x.RU32) != len(other.RU32) {
+		return false
+	}
+	for k := range x.RU32 {
+
+		if (x.RU32[k] == nil) != (other.RU32[k] == nil) {
+			return false
+		}
+		if x.RU32[k] != nil {
+			if *x.RU32[k] != *other.RU32[k] {
+				return false
+			}
+		}
+	}
+	if (x.PString == nil) != (other.PString == nil) {
+		return false
+	}
+	if x.PString != nil {
+		if *x.PString != *other.PString {
+			return false
+		}
+	}
+	if (x.PBytes == nil) != (other.PBytes == nil) || !bytes.Equal(x.PBytes, other.PBytes) {
+		return false
+	}
+	if (x.PEnum == nil) != (other.PEnum == nil) {
+		return false
+	}
+	if x.PEnum != nil {
+		if *x.PEnum != *other.PEnum {
+			return false
+		}
+	}
+	if (x.PDouble == nil) != (other.PDouble == nil) {
+		return false
+	}
+	if x.PDouble != nil {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if *x.PDouble != *other.PDouble && !(math.IsNaN(float64(*x.PDouble)) && math.IsNaN(float64(*other.PDouble))) {
+			return false
+		}
+	}
+	if (x.PUint64 == nil) != (other.PUint64 == nil) {
+		return false
+	}
+	if x.PUint64 != nil {
+		if *x.PUint64 != *other.PUint64 {
+			return false
+		}
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *All) Merge(src *All) {
+	if src == nil {
+		return
+	}
+	if src.FInt32 != 0 {
+		x.FInt32 = src.FInt32
+	}
+	if src.FInt64 != 0 {
+		x.FInt64 = src.FInt64
+	}
+	if len(src.FString) > 0 {
+		x.FString = src.FString
+	}
+	if len(src.FBytes) > 0 {
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入
+		x.FBytes = append([]byte{}, src.FBytes...)
+	}
+	if src.FBool {
+		x.FBool = src.FBool
+	}
+	if src.FDouble != 0 {
+		x.FDouble = src.FDouble
+	}
+	if src.FEnum != 0 {
+		x.FEnum = src.FEnum
+	}
+	if src.PInt32 != nil {
+		pv := *src.PInt32
+		x.PInt32 = &pv
+	}
+	if src.FMsg != nil {
+		if x.FMsg == nil {
+			x.FMsg = &Inner{}
+		}
+		x.FMsg.Merge(src.FMsg)
+	}
+	if src.FUint32 != 0 {
+		x.FUint32 = src.FUint32
+	}
+	if src.FUint64 != 0 {
+		x.FUint64 = src.FUint64
+	}
+	if src.FSint32 != 0 {
+		x.FSint32 = src.FSint32
+	}
+	if src.FSint64 != 0 {
+		x.FSint64 = src.FSint64
+	}
+	if src.FFixed32 != 0 {
+		x.FFixed32 = src.FFixed32
+	}
+	if src.FFixed64 != 0 {
+		x.FFixed64 = src.FFixed64
+	}
+	if src.FSfixed32 != 0 {
+		x.FSfixed32 = src.FSfixed32
+	}
+	if src.FSfixed64 != 0 {
+		x.FSfixed64 = src.FSfixed64
+	}
+	if src.FFloat != 0 {
+		x.FFloat = src.FFloat
+	}
+	if len(src.RInt32) > 0 {
+		x.RInt32 = append(x.RInt32, src.RInt32...)
+	}
+	if len(src.RString) > 0 {
+		x.RString = append(x.RString, src.RString...)
+	}
+	if src.RMsg != nil {
+		for _, item := range src.RMsg {
+			x.RMsg = append(x.RMsg, nil)
+			if item != nil {
+				if x.RMsg[len(x.RMsg)-1] == nil {
+					x.RMsg[len(x.RMsg)-1] = &Inner{}
+				}
+				x.RMsg[len(x.RMsg)-1].CopyFrom(item)
+			} else {
+				x.RMsg[len(x.RMsg)-1] = nil
+			}
+		}
+	}
+	if len(src.RBytes) > 0 {
+		for _, item := range src.RBytes {
+			x.RBytes = append(x.RBytes, nil)
+			if item != nil {
+				// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入.
+				// 空的值为 []byte{}, 保留存在性
+				x.RBytes[len(x.RBytes)-1] = append([]byte{}, item...)
+			} else {
+				x.RBytes[len(x.RBytes)-1] = nil
+			}
+		}
+	}
+	if len(src.RDouble) > 0 {
+		x.RDouble = append(x.RDouble, src.RDouble...)
+	}
+	if len(src.REnum) > 0 {
+		x.REnum = append(x.REnum, src.REnum...)
+	}
+	if len(src.RSint64) > 0 {
+		x.RSint64 = append(x.RSint64, src.RSint64...)
+	}
+	if len(src.RUnpacked) > 0 {
+		x.RUnpacked = append(x.RUnpacked, src.RUnpacked...)
+	}
+	if len(src.MStrInt) > 0 {
+		if x.MStrInt == nil {
+			x.MStrInt = make(map[string]int32, len(src.MStrInt))
+		}
+		for mk, mv := range src.MStrInt {
+			x.MStrInt[mk] = mv
+		}
+	}
+	if len(src.MIntStr) > 0 {
+		if x.MIntStr == nil {
+			x.MIntStr = make(map[int32]string, len(src.MIntStr))
+		}
+		for mk, mv := range src.MIntStr {
+			x.MIntStr[mk] = mv
+		}
+	}
+	if len(src.MStrMsg) > 0 {
+		if x.MStrMsg == nil {
+			x.MStrMsg = make(map[string]*Inner, len(src.MStrMsg))
+		}
+		for mk, mv := range src.MStrMsg {
+			if mv != nil {
+				if x.MStrMsg[mk] == nil {
+					x.MStrMsg[mk] = &Inner{}
+				}
+				x.MStrMsg[mk].CopyFrom(mv)
+			} else {
+				x.MStrMsg[mk] = nil
+			}
+		}
+	}
+	if len(src.MTs) > 0 {
+		if x.MTs == nil {
+			x.MTs = make(map[string]*time.Time, len(src.MTs))
+		}
+		for mk, mv := range src.MTs {
+			if mv != nil {
+				pv := *mv
+				x.MTs[mk] = &pv
+			} else {
+				x.MTs[mk] = nil
+			}
+		}
+	}
+	if len(src.MDur) > 0 {
+		if x.MDur == nil {
+			x.MDur = make(map[string]*time.Duration, len(src.MDur))
+		}
+		for mk, mv := range src.MDur {
+			if mv != nil {
+				pv := *mv
+				x.MDur[mk] = &pv
+			} else {
+				x.MDur[mk] = nil
+			}
+		}
+	}
+	if len(src.MI32) > 0 {
+		if x.MI32 == nil {
+			x.MI32 = make(map[string]*int32, len(src.MI32))
+		}
+		for mk, mv := range src.MI32 {
+			if mv != nil {
+				pv := *mv
+				x.MI32[mk] = &pv
+			} else {
+				x.MI32[mk] = nil
+			}
+		}
+	}
+	if len(src.MBytes) > 0 {
+		if x.MBytes == nil {
+			x.MBytes = make(map[string][]byte, len(src.MBytes))
+		}
+		for mk, mv := range src.MBytes {
+			if mv != nil {
+				// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入.
+				// 空的值为 []byte{}, 保留存在性
+				x.MBytes[mk] = append([]byte{}, mv...)
+			} else {
+				x.MBytes[mk] = nil
+			}
+		}
+	}
+	if src.O != nil {
+		x.O = src.O.mergeOneof(x.O)
+	}
+	if src.FAny != nil {
+		if x.FAny == nil {
+			x.FAny = &gopb.Any{}
+		}
+		x.FAny.Merge(src.FAny)
+	}
+	if src.FTs != nil {
+		pv := *src.FTs
+		x.FTs = &pv
+	}
+	if src.FDur != nil {
+		pv := *src.FDur
+		x.FDur = &pv
+	}
+	if src.FI64 != nil {
+		pv := *src.FI64
+		x.FI64 = &pv
+	}
+	if src.FSv != nil {
+		pv := *src.FSv
+		x.FSv = &pv
+	}
+	if src.FBv != nil {
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入
+		x.FBv = append([]byte{}, src.FBv...)
+	}
+	if src.FBoolv != nil {
+		pv := *src.FBoolv
+		x.FBoolv = &pv
+	}
+	if src.FDblv != nil {
+		pv := *src.FDblv
+		x.FDblv = &pv
+	}
+	if len(src.RTs) > 0 {
+		for _, item := range src.RTs {
+			x.RTs = append(x.RTs, nil)
+			if item != nil {
+				pv := *item
+				x.RTs[len(x.RTs)-1] = &pv
+			} else {
+				x.RTs[len(x.RTs)-1] = nil
+			}
+		}
+	}
+	if len(src.RU32) > 0 {
+		for _, item := range src.RU32 {
+			x.RU32 = append(x.RU32, nil)
+			if item != nil {
+				pv := *item
+				x.RU32[len(x.RU32)-1] = &pv
+			} else {
+				x.RU32[len(x.RU32)-1] = nil
+			}
+		}
+	}
+	if src.PString != nil {
+		pv := *src.PString
+		x.PString = &pv
+	}
+	if src.PBytes != nil {
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入
+		x.PBytes = append([]byte{}, src.PBytes...)
+	}
+	if src.PEnum != nil {
+		pv := *src.PEnum
+		x.PEnum = &pv
+	}
+	if src.PDouble != nil {
+		pv := *src.PDouble
+		x.PDouble = &pv
+	}
+	if src.PUint64 != nil {
+		pv := *src.PUint64
+		x.PUint64 = &pv
+	}
+}
+
+// MarshalObject marshal data to []byte
+func (x *All) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalCachedTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *All) MarshalSize() (size int) {
+	if x.FInt32 != 0 {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(x.FInt32))
+	}
+	if x.FInt64 != 0 {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeVarint(uint64(x.FInt64))
+	}
+	if len(x.FString) > 0 {
+		// 1 = protowire.SizeTag(3)
+		size += 1 + protowire.SizeBytes(len(x.FString))
+	}
+	if len(x.FBytes) > 0 {
+		// 1 = protowire.SizeTag(4)
+		size += 1 + protowire.SizeBytes(len(x.FBytes))
+	}
+	if x.FBool {
+		// 1 = protowire.SizeTag(5)
+		size += 1 + 1
+	}
+	if x.FDouble != 0 {
+		// 1 = protowire.SizeTag(6)
+		size += 1 + 8
+	}
+	if x.FEnum != 0 {
+		// 1 = protowire.SizeTag(7)
+		size += 1 + protowire.SizeVarint(uint64(x.FEnum))
+	}
+	if x.PInt32 != nil {
+		// 1 = protowire.SizeTag(8)
+		size += 1 + protowire.SizeVarint(uint64(*x.PInt32))
+	}
+	if x.FMsg != nil {
+		// 1 = protowire.SizeTag(9)
+		size += 1 + protowire.SizeBytes(x.FMsg.MarshalSize())
+	}
+	if x.FUint32 != 0 {
+		// 1 = protowire.SizeTag(10)
+		size += 1 + protowire.SizeVarint(uint64(x.FUint32))
+	}
+	if x.FUint64 != 0 {
+		// 1 = protowire.SizeTag(11)
+		size += 1 + protowire.SizeVarint(uint64(x.FUint64))
+	}
+	if x.FSint32 != 0 {
+		// 1 = protowire.SizeTag(12)
+		size += 1 + protowire.SizeVarint(protowire.EncodeZigZag(int64(x.FSint32)))
+	}
+	if x.FSint64 != 0 {
+		// 1 = protowire.SizeTag(13)
+		size += 1 + protowire.SizeVarint(protowire.EncodeZigZag(int64(x.FSint64)))
+	}
+	if x.FFixed32 != 0 {
+		// 1 = protowire.SizeTag(14)
+		size += 1 + 4
+	}
+	if x.FFixed64 != 0 {
+		// 1 = protowire.SizeTag(15)
+		size += 1 + 8
+	}
+	if x.FSfixed32 != 0 {
+		// 2 = protowire.SizeTag(16)
+		size += 2 + 4
+	}
+	if x.FSfixed64 != 0 {
+		// 2 = protowire.SizeTag(17)
+		size += 2 + 8
+	}
+	if x.FFloat != 0 {
+		// 2 = protowire.SizeTag(18)
+		size += 2 + 4
+	}
+	if len(x.RInt32) > 0 {
+		size += 2 // size += protowire.SizeTag(20)
+		if len(x.RInt32) > 0 {
+			fsize := 0
+			for _, item := range x.RInt32 {
+				fsize += protowire.SizeVarint(uint64(item))
+			}
+			size += protowire.SizeBytes(fsize)
+		}
+	}
+	if len(x.RString) > 0 {
+		// 2 = protowire.SizeTag(21)
+		size += 2 * len(x.RString)
+		for k := 0; k < len(x.RString); k++ {
+			size += protowire.SizeBytes(len(x.RString[k]))
+		}
+	}
+	if x.RMsg != nil {
+		// 2 = protowire.SizeTag(22)
+		size += 2 * len(x.RMsg)
+		for k := 0; k < len(x.RMsg); k++ {
+			size += protowire.SizeBytes(x.RMsg[k].MarshalSize())
+		}
+	}
+	if len(x.RBytes) > 0 {
+		// 2 = protowire.SizeTag(23)
+		size += 2 * len(x.RBytes)
+		for k := 0; k < len(x.RBytes); k++ {
+			size += protowire.SizeBytes(len(x.RBytes[k]))
+		}
+	}
+	if len(x.RDouble) > 0 {
+		size += 2 // size += protowire.SizeTag(24)
+		size += protowire.SizeBytes(len(x.RDouble) * 8)
+	}
+	if len(x.REnum) > 0 {
+		size += 2 // size += protowire.SizeTag(25)
+		if len(x.REnum) > 0 {
+			fsize := 0
+			for _, item := range x.REnum {
+				fsize += protowire.SizeVarint(uint64(item))
+			}
+			size += protowire.SizeBytes(fsize)
+		}
+	}
+	if len(x.RSint64) > 0 {
+		size += 2 // size += protowire.SizeTag(26)
+		if len(x.RSint64) > 0 {
+			fsize := 0
+			for _, item := range x.RSint64 {
+				fsize += protowire.SizeVarint(protowire.EncodeZigZag(int64(item)))
+			}
+			size += protowire.SizeBytes(fsize)
+		}
+	}
+	if len(x.RUnpacked) > 0 {
+		// 2 = protowire.SizeTag(27)
+		size += 2 * len(x.RUnpacked)
+		for k := 0; k < len(x.RUnpacked); k++ {
+			size += protowire.SizeVarint(uint64(x.RUnpacked[k]))
+		}
+	}
+	if len(x.MStrInt) > 0 {
+		for mk, mv := range x.MStrInt {
+			_ = mk
+			_ = mv
+			// 2 = protowire.SizeTag(30)
+			size += 2
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeVarint(uint64(mv))
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	if len(x.MIntStr) > 0 {
+		for mk, mv := range x.MIntStr {
+			_ = mk
+			_ = mv
+			// 2 = protowire.SizeTag(31)
+			size += 2
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeVarint(uint64(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeBytes(len(mv))
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	if len(x.MStrMsg) > 0 {
+		for mk, mv := range x.MStrMsg {
+			_ = mk
+			_ = mv
+			// 2 = protowire.SizeTag(32)
+			size += 2
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeBytes(mv.MarshalSize())
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	if len(x.MTs) > 0 {
+		for mk, mv := range x.MTs {
+			_ = mk
+			_ = mv
+			// 2 = protowire.SizeTag(33)
+			size += 2
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			{
+				wsecs, wnanos := mv.Unix(), int64(mv.Nanosecond())
+				wsize := 0
+				if wsecs != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wsecs))
+				}
+				if wnanos != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wnanos))
+				}
+				// 1 = protowire.SizeTag(2)
+				msize += 1 + protowire.SizeBytes(wsize)
+			}
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	if len(x.MDur) > 0 {
+		for mk, mv := range x.MDur {
+			_ = mk
+			_ = mv
+			// 2 = protowire.SizeTag(34)
+			size += 2
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			{
+				wsecs, wnanos := int64(*mv/time.Second), int64(*mv%time.Second)
+				wsize := 0
+				if wsecs != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wsecs))
+				}
+				if wnanos != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wnanos))
+				}
+				// 1 = protowire.SizeTag(2)
+				msize += 1 + protowire.SizeBytes(wsize)
+			}
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	if len(x.MI32) > 0 {
+		for mk, mv := range x.MI32 {
+			_ = mk
+			_ = mv
+			// 2 = protowire.SizeTag(35)
+			size += 2
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			{
+				wsize := 0
+				if *mv != 0 {
+					// 1 = protowire.SizeTag(1)
+					wsize += 1 + protowire.SizeVarint(uint64(*mv))
+				}
+				// 1 = protowire.SizeTag(2)
+				msize += 1 + protowire.SizeBytes(wsize)
+			}
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	if len(x.MBytes) > 0 {
+		for mk, mv := range x.MBytes {
+			_ = mk
+			_ = mv
+			// 2 = protowire.SizeTag(36)
+			size += 2
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			{
+				wsize := 0
+				if len(mv) > 0 {
+					// 1 = protowire.SizeTag(1)
+					wsize += 1 + protowire.SizeBytes(len(mv))
+				}
+				// 1 = protowire.SizeTag(2)
+				msize += 1 + protowire.SizeBytes(wsize)
+			}
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	if x.O != nil {
+		size += x.O.marshalOneofSize()
+	}
+	if x.FAny != nil {
+		// 2 = protowire.SizeTag(50)
+		size += 2 + protowire.SizeBytes(x.FAny.MarshalSize())
+	}
+	if x.FTs != nil {
+		{
+			wsecs, wnanos := x.FTs.Unix(), int64(x.FTs.Nanosecond())
+			wsize := 0
+			if wsecs != 0 {
+				wsize += 1 + protowire.SizeVarint(uint64(wsecs))
+			}
+			if wnanos != 0 {
+				wsize += 1 + protowire.SizeVarint(uint64(wnanos))
+			}
+			// 2 = protowire.SizeTag(51)
+			size += 2 + protowire.SizeBytes(wsize)
+		}
+	}
+	if x.FDur != nil {
+		{
+			wsecs, wnanos := int64(*x.FDur/time.Second), int64(*x.FDur%time.Second)
+			wsize := 0
+			if wsecs != 0 {
+				wsize += 1 + protowire.SizeVarint(uint64(wsecs))
+			}
+			if wnanos != 0 {
+				wsize += 1 + protowire.SizeVarint(uint64(wnanos))
+			}
+			// 2 = protowire.SizeTag(52)
+			size += 2 + protowire.SizeBytes(wsize)
+		}
+	}
+	if x.FI64 != nil {
+		{
+			wsize := 0
+			if *x.FI64 != 0 {
+				// 1 = protowire.SizeTag(1)
+				wsize += 1 + protowire.SizeVarint(uint64(*x.FI64))
+			}
+			// 2 = protowire.SizeTag(53)
+			size += 2 + protowire.SizeBytes(wsize)
+		}
+	}
+	if x.FSv != nil {
+		{
+			wsize := 0
+			if len(*x.FSv) > 0 {
+				// 1 = protowire.SizeTag(1)
+				wsize += 1 + protowire.SizeBytes(len(*x.FSv))
+			}
+			// 2 = protowire.SizeTag(54)
+			size += 2 + protowire.SizeBytes(wsize)
+		}
+	}
+	if x.FBv != nil {
+		{
+			wsize := 0
+			if len(x.FBv) > 0 {
+				// 1 = protowire.SizeTag(1)
+				wsize += 1 + protowire.SizeBytes(len(x.FBv))
+			}
+			// 2 = protowire.SizeTag(55)
+			size += 2 + protowire.SizeBytes(wsize)
+		}
+	}
+	if x.FBoolv != nil {
+		{
+			wsize := 0
+			if *x.FBoolv {
+				// 1 = protowire.SizeTag(1)
+				wsize += 1 + 1
+			}
+			// 2 = protowire.SizeTag(56)
+			size += 2 + protowire.SizeBytes(wsize)
+		}
+	}
+	if x.FDblv != nil {
+		{
+			wsize := 0
+			if *x.FDblv != 0 {
+				// 1 = protowire.SizeTag(1)
+				wsize += 1 + 8
+			}
+			// 2 = protowire.SizeTag(57)
+			size += 2 + protowire.SizeBytes(wsize)
+		}
+	}
+	if len(x.RTs) > 0 {
+		for _, item := range x.RTs {
+			{
+				wsecs, wnanos := item.Unix(), int64(item.Nanosecond())
+				wsize := 0
+				if wsecs != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wsecs))
+				}
+				if wnanos != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wnanos))
+				}
+				// 2 = protowire.SizeTag(58)
+				size += 2 + protowire.SizeBytes(wsize)
+			}
+		}
+	}
+	if len(x.RU32) > 0 {
+		for _, item := range x.RU32 {
+			{
+				wsize := 0
+				if *item != 0 {
+					// 1 = protowire.SizeTag(1)
+					wsize += 1 + protowire.SizeVarint(uint64(*item))
+				}
+				// 2 = protowire.SizeTag(59)
+				size += 2 + protowire.SizeBytes(wsize)
+			}
+		}
+	}
+	if x.PString != nil {
+		// 2 = protowire.SizeTag(60)
+		size += 2 + protowire.SizeBytes(len(*x.PString))
+	}
+	if x.PBytes != nil {
+		// 2 = protowire.SizeTag(61)
+		size += 2 + protowire.SizeBytes(len(x.PBytes))
+	}
+	if x.PEnum != nil {
+		// 2 = protowire.SizeTag(62)
+		size += 2 + protowire.SizeVarint(uint64(*x.PEnum))
+	}
+	if x.PDouble != nil {
+		// 2 = protowire.SizeTag(63)
+		size += 2 + 8
+	}
+	if x.PUint64 != nil {
+		// 2 = protowire.SizeTag(64)
+		size += 2 + protowire.SizeVarint(uint64(*x.PUint64))
+	}
+	atomic.StoreInt32(&x.sizeCache, int32(size))
+	return
+}
+
+// cachedSize returns the size computed by the last MarshalSize
+func (x *All) cachedSize() int {
+	return int(atomic.LoadInt32(&x.sizeCache))
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *All) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	// 刷新子消息缓存的大小
+	x.MarshalSize()
+	return x.marshalCachedTo(buf)
+}
+
+// marshalCachedTo marshal data to []byte. 子消息使用 MarshalSize 缓存的大小
+func (x *All) marshalCachedTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.FInt32 != 0 {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(x.FInt32))
+	}
+	if x.FInt64 != 0 {
+		// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+		data = append(data, 0x10)
+		data = protowire.AppendVarint(data, uint64(x.FInt64))
+	}
+	if len(x.FString) > 0 {
+
+		// data = protowire.AppendTag(data, 3, protowire.BytesType) => 00011010
+		data = append(data, 0x1a)
+		data = protowire.AppendString(data, x.FString)
+	}
+	if len(x.FBytes) > 0 {
+		// data = protowire.AppendTag(data, 4, protowire.BytesType) => 00100010
+		data = append(data, 0x22)
+		data = protowire.AppendBytes(data, x.FBytes)
+	}
+	if x.FBool {
+		// data = protowire.AppendTag(data, 5, protowire.VarintType) => 00101000
+		data = append(data, 0x28)
+		data = protowire.AppendVarint(data, protowire.EncodeBool(x.FBool))
+	}
+	if x.FDouble != 0 {
+		// data = protowire.AppendTag(data, 6, protowire.Fixed64Type) => 00110001
+		data = append(data, 0x31)
+		data = protowire.AppendFixed64(data, math.Float64bits(x.FDouble))
+	}
+	if x.FEnum != 0 {
+		// data = protowire.AppendTag(data, 7, protowire.VarintType) => 00111000
+		data = append(data, 0x38)
+		data = protowire.AppendVarint(data, uint64(x.FEnum))
+	}
+	if x.PInt32 != nil {
+		// data = protowire.AppendTag(data, 8, protowire.VarintType) => 01000000
+		data = append(data, 0x40)
+		data = protowire.AppendVarint(data, uint64(*x.PInt32))
+	}
+	if x.FMsg != nil {
+		// data = protowire.AppendTag(data, 9, protowire.BytesType) => 01001010
+		data = append(data, 0x4a)
+		data = protowire.AppendVarint(data, uint64(x.FMsg.cachedSize()))
+		data, err = x.FMsg.marshalCachedTo(data)
+		if err != nil {
+			return
+		}
+	}
+	if x.FUint32 != 0 {
+		// data = protowire.AppendTag(data, 10, protowire.VarintType) => 01010000
+		data = append(data, 0x50)
+		data = protowire.AppendVarint(data, uint64(x.FUint32))
+	}
+	if x.FUint64 != 0 {
+		// data = protowire.AppendTag(data, 11, protowire.VarintType) => 01011000
+		data = append(data, 0x58)
+		data = protowire.AppendVarint(data, uint64(x.FUint64))
+	}
+	if x.FSint32 != 0 {
+		// data = protowire.AppendTag(data, 12, protowire.VarintType) => 01100000
+		data = append(data, 0x60)
+		data = protowire.AppendVarint(data, protowire.EncodeZigZag(int64(x.FSint32)))
+	}
+	if x.FSint64 != 0 {
+		// data = protowire.AppendTag(data, 13, protowire.VarintType) => 01101000
+		data = append(data, 0x68)
+		data = protowire.AppendVarint(data, protowire.EncodeZigZag(int64(x.FSint64)))
+	}
+	if x.FFixed32 != 0 {
+		// data = protowire.AppendTag(data, 14, protowire.Fixed32Type) => 01110101
+		data = append(data, 0x75)
+		data = protowire.AppendFixed32(data, uint32(x.FFixed32))
+	}
+	if x.FFixed64 != 0 {
+		// data = protowire.AppendTag(data, 15, protowire.Fixed64Type) => 01111001
+		data = append(data, 0x79)
+		data = protowire.AppendFixed64(data, uint64(x.FFixed64))
+	}
+	if x.FSfixed32 != 0 {
+		// data = protowire.AppendTag(data, 16, protowire.Fixed32Type) => 10000101 00000001
+		data = append(data, 0x85, 0x1)
+		data = protowire.AppendFixed32(data, uint32(x.FSfixed32))
+	}
+	if x.FSfixed64 != 0 {
+		// data = protowire.AppendTag(data, 17, protowire.Fixed64Type) => 10001001 00000001
+		data = append(data, 0x89, 0x1)
+		data = protowire.AppendFixed64(data, uint64(x.FSfixed64))
+	}
+	if x.FFloat != 0 {
+		// data = protowire.AppendTag(data, 18, protowire.Fixed32Type) => 10010101 00000001
+		data = append(data, 0x95, 0x1)
+		data = protowire.AppendFixed32(data, math.Float32bits(x.FFloat))
+	}
+	if len(x.RInt32) > 0 {
+		// data = protowire.AppendTag(data, 20, protowire.BytesType) => 10100010 00000001
+		data = append(data, 0xa2, 0x1)
+		size := 0
+		for _, v := range x.RInt32 {
+			size += protowire.SizeVarint(uint64(v))
+		}
+		data = protowire.AppendVarint(data, uint64(size))
+		for _, v := range x.RInt32 {
+			data = protowire.AppendVarint(data, uint64(v))
+		}
+	}
+	if len(x.RString) > 0 {
+		for k := 0; k < len(x.RString); k++ {
+
+			// data = protowire.AppendTag(data, 21, protowire.BytesType) => 10101010 00000001
+			data = append(data, 0xaa, 0x1)
+			data = protowire.AppendString(data, x.RString[k])
+		}
+	}
+	if x.RMsg != nil {
+		for _, item := range x.RMsg {
+			// data = protowire.AppendTag(data, 22, protowire.BytesType) => 10110010 00000001
+			data = append(data, 0xb2, 0x1)
+			data = protowire.AppendVarint(data, uint64(item.cachedSize()))
+			data, err = item.marshalCachedTo(data)
+			if err != nil {
+				return
+			}
+		}
+	}
+	if len(x.RBytes) > 0 {
+		for k := 0; k < len(x.RBytes); k++ {
+			// data = protowire.AppendTag(data, 23, protowire.BytesType) => 10111010 00000001
+			data = append(data, 0xba, 0x1)
+			data = protowire.AppendBytes(data, x.RBytes[k])
+		}
+	}
+	if len(x.RDouble) > 0 {
+		// data = protowire.AppendTag(data, 24, protowire.BytesType) => 11000010 00000001
+		data = append(data, 0xc2, 0x1)
+		data = protowire.AppendVarint(data, uint64(8*len(x.RDouble)))
+		for _, v := range x.RDouble {
+			data = protowire.AppendFixed64(data, math.Float64bits(v))
+		}
+	}
+	if len(x.REnum) > 0 {
+		// data = protowire.AppendTag(data, 25, protowire.BytesType) => 11001010 00000001
+		data = append(data, 0xca, 0x1)
+		size := 0
+		for _, v := range x.REnum {
+			size += protowire.SizeVarint(uint64(v))
+		}
+		data = protowire.AppendVarint(data, uint64(size))
+		for _, v := range x.REnum {
+			data = protowire.AppendVarint(data, uint64(v))
+		}
+	}
+	if len(x.RSint64) > 0 {
+		// data = protowire.AppendTag(data, 26, protowire.BytesType) => 11010010 00000001
+		data = append(data, 0xd2, 0x1)
+		size := 0
+		for _, v := range x.RSint64 {
+			size += protowire.SizeVarint(protowire.EncodeZigZag(int64(v)))
+		}
+		data = protowire.AppendVarint(data, uint64(size))
+		for _, v := range x.RSint64 {
+			data = protowire.AppendVarint(data, protowire.EncodeZigZag(int64(v)))
+		}
+	}
+	if len(x.RUnpacked) > 0 {
+		for _, item := range x.RUnpacked {
+			// data = protowire.AppendTag(data, 27, protowire.VarintType) => 11011000 00000001
+			data = append(data, 0xd8, 0x1)
+			data = protowire.AppendVarint(data, uint64(item))
+		}
+	}
+	if len(x.MStrInt) > 0 {
+
+		for mk, mv := range x.MStrInt {
+			// data = protowire.AppendTag(data, 30, protowire.BytesType) => 11110010 00000001
+			data = append(data, 0xf2, 0x1)
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeVarint(uint64(mv))
+			data = protowire.AppendVarint(data, uint64(msize))
+
+			// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+			data = append(data, 0xa)
+			data = protowire.AppendString(data, mk)
+			// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+			data = append(data, 0x10)
+			data = protowire.AppendVarint(data, uint64(mv))
+		}
+	}
+	if len(x.MIntStr) > 0 {
+
+		for mk, mv := range x.MIntStr {
+			// data = protowire.AppendTag(data, 31, protowire.BytesType) => 11111010 00000001
+			data = append(data, 0xfa, 0x1)
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeVarint(uint64(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeBytes(len(mv))
+			data = protowire.AppendVarint(data, uint64(msize))
+			// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+			data = append(data, 0x8)
+			data = protowire.AppendVarint(data, uint64(mk))
+
+			// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+			data = append(data, 0x12)
+			data = protowire.AppendString(data, mv)
+		}
+	}
+	if len(x.MStrMsg) > 0 {
+
+		for mk, mv := range x.MStrMsg {
+			// data = protowire.AppendTag(data, 32, protowire.BytesType) => 10000010 00000010
+			data = append(data, 0x82, 0x2)
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeBytes(mv.cachedSize())
+			data = protowire.AppendVarint(data, uint64(msize))
+
+			// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+			data = append(data, 0xa)
+			data = protowire.AppendString(data, mk)
+			// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+			data = append(data, 0x12)
+			data = protowire.AppendVarint(data, uint64(mv.cachedSize()))
+			data, err = mv.marshalCachedTo(data)
+			if err != nil {
+				return
+			}
+		}
+	}
+	if len(x.MTs) > 0 {
+
+		for mk, mv := range x.MTs {
+			// data = protowire.AppendTag(data, 33, protowire.BytesType) => 10001010 00000010
+			data = append(data, 0x8a, 0x2)
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			{
+				wsecs, wnanos := mv.Unix(), int64(mv.Nanosecond())
+				wsize := 0
+				if wsecs != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wsecs))
+				}
+				if wnanos != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wnanos))
+				}
+				// 1 = protowire.SizeTag(2)
+				msize += 1 + protowire.SizeBytes(wsize)
+			}
+			data = protowire.AppendVarint(data, uint64(msize))
+
+			// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+			data = append(data, 0xa)
+			data = protowire.AppendString(data, mk)
+			{
+				wsecs, wnanos := mv.Unix(), int64(mv.Nanosecond())
+				wsize := 0
+				if wsecs != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wsecs))
+				}
+				if wnanos != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wnanos))
+				}
+				// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+				data = append(data, 0x12)
+				data = protowire.AppendVarint(data, uint64(wsize))
+				if wsecs != 0 {
+					data = append(data, 0x8)
+					data = protowire.AppendVarint(data, uint64(wsecs))
+				}
+				if wnanos != 0 {
+					data = append(data, 0x10)
+					data = protowire.AppendVarint(data, uint64(wnanos))
+				}
+			}
+		}
+	}
+	if len(x.MDur) > 0 {
+
+		for mk, mv := range x.MDur {
+			// data = protowire.AppendTag(data, 34, protowire.BytesType) => 10010010 00000010
+			data = append(data, 0x92, 0x2)
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			{
+				wsecs, wnanos := int64(*mv/time.Second), int64(*mv%time.Second)
+				wsize := 0
+				if wsecs != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wsecs))
+				}
+				if wnanos != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wnanos))
+				}
+				// 1 = protowire.SizeTag(2)
+				msize += 1 + protowire.SizeBytes(wsize)
+			}
+			data = protowire.AppendVarint(data, uint64(msize))
+
+			// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+			data = append(data, 0xa)
+			data = protowire.AppendString(data, mk)
+			{
+				wsecs, wnanos := int64(*mv/time.Second), int64(*mv%time.Second)
+				wsize := 0
+				if wsecs != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wsecs))
+				}
+				if wnanos != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wnanos))
+				}
+				// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+				data = append(data, 0x12)
+				data = protowire.AppendVarint(data, uint64(wsize))
+				if wsecs != 0 {
+					data = append(data, 0x8)
+					data = protowire.AppendVarint(data, uint64(wsecs))
+				}
+				if wnanos != 0 {
+					data = append(data, 0x10)
+					data = protowire.AppendVarint(data, uint64(wnanos))
+				}
+			}
+		}
+	}
+	if len(x.MI32) > 0 {
+
+		for mk, mv := range x.MI32 {
+			// data = protowire.AppendTag(data, 35, protowire.BytesType) => 10011010 00000010
+			data = append(data, 0x9a, 0x2)
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			{
+				wsize := 0
+				if *mv != 0 {
+					// 1 = protowire.SizeTag(1)
+					wsize += 1 + protowire.SizeVarint(uint64(*mv))
+				}
+				// 1 = protowire.SizeTag(2)
+				msize += 1 + protowire.SizeBytes(wsize)
+			}
+			data = protowire.AppendVarint(data, uint64(msize))
+
+			// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+			data = append(data, 0xa)
+			data = protowire.AppendString(data, mk)
+			{
+				wsize := 0
+				if *mv != 0 {
+					// 1 = protowire.SizeTag(1)
+					wsize += 1 + protowire.SizeVarint(uint64(*mv))
+				}
+				// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+				data = append(data, 0x12)
+				data = protowire.AppendVarint(data, uint64(wsize))
+				if *mv != 0 {
+					// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+					data = append(data, 0x8)
+					data = protowire.AppendVarint(data, uint64(*mv))
+				}
+			}
+		}
+	}
+	if len(x.MBytes) > 0 {
+
+		for mk, mv := range x.MBytes {
+			// data = protowire.AppendTag(data, 36, protowire.BytesType) => 10100010 00000010
+			data = append(data, 0xa2, 0x2)
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			{
+				wsize := 0
+				if len(mv) > 0 {
+					// 1 = protowire.SizeTag(1)
+					wsize += 1 + protowire.SizeBytes(len(mv))
+				}
+				// 1 = protowire.SizeTag(2)
+				msize += 1 + protowire.SizeBytes(wsize)
+			}
+			data = protowire.AppendVarint(data, uint64(msize))
+
+			// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+			data = append(data, 0xa)
+			data = protowire.AppendString(data, mk)
+			{
+				wsize := 0
+				if len(mv) > 0 {
+					// 1 = protowire.SizeTag(1)
+					wsize += 1 + protowire.SizeBytes(len(mv))
+				}
+				// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+				data = append(data, 0x12)
+				data = protowire.AppendVarint(data, uint64(wsize))
+				if len(mv) > 0 {
+					// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+					data = append(data, 0xa)
+					data = protowire.AppendBytes(data, mv)
+				}
+			}
+		}
+	}
+	if x.O != nil {
+		data, err = x.O.marshalOneofTo(data)
+		if err != nil {
+			return
+		}
+	}
+	if x.FAny != nil {
+		// data = protowire.AppendTag(data, 50, protowire.BytesType) => 10010010 00000011
+		data = append(data, 0x92, 0x3)
+		data = protowire.AppendVarint(data, uint64(x.FAny.MarshalSize()))
+		data, err = x.FAny.MarshalObjectTo(data)
+		if err != nil {
+			return
+		}
+	}
+	if x.FTs != nil {
+		{
+			wsecs, wnanos := x.FTs.Unix(), int64(x.FTs.Nanosecond())
+			wsize := 0
+			if wsecs != 0 {
+				wsize += 1 + protowire.SizeVarint(uint64(wsecs))
+			}
+			if wnanos != 0 {
+				wsize += 1 + protowire.SizeVarint(uint64(wnanos))
+			}
+			// data = protowire.AppendTag(data, 51, protowire.BytesType) => 10011010 00000011
+			data = append(data, 0x9a, 0x3)
+			data = protowire.AppendVarint(data, uint64(wsize))
+			if wsecs != 0 {
+				data = append(data, 0x8)
+				data = protowire.AppendVarint(data, uint64(wsecs))
+			}
+			if wnanos != 0 {
+				data = append(data, 0x10)
+				data = protowire.AppendVarint(data, uint64(wnanos))
+			}
+		}
+	}
+	if x.FDur != nil {
+		{
+			wsecs, wnanos := int64(*x.FDur/time.Second), int64(*x.FDur%time.Second)
+			wsize := 0
+			if wsecs != 0 {
+				wsize += 1 + protowire.SizeVarint(uint64(wsecs))
+			}
+			if wnanos != 0 {
+				wsize += 1 + protowire.SizeVarint(uint64(wnanos))
+			}
+			// data = protowire.AppendTag(data, 52, protowire.BytesType) => 10100010 00000011
+			data = append(data, 0xa2, 0x3)
+			data = protowire.AppendVarint(data, uint64(wsize))
+			if wsecs != 0 {
+				data = append(data, 0x8)
+				data = protowire.AppendVarint(data, uint64(wsecs))
+			}
+			if wnanos != 0 {
+				data = append(data, 0x10)
+				data = protowire.AppendVarint(data, uint64(wnanos))
+			}
+		}
+	}
+	if x.FI64 != nil {
+		{
+			wsize := 0
+			if *x.FI64 != 0 {
+				// 1 = protowire.SizeTag(1)
+				wsize += 1 + protowire.SizeVarint(uint64(*x.FI64))
+			}
+			// data = protowire.AppendTag(data, 53, protowire.BytesType) => 10101010 00000011
+			data = append(data, 0xaa, 0x3)
+			data = protowire.AppendVarint(data, uint64(wsize))
+			if *x.FI64 != 0 {
+				// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+				data = append(data, 0x8)
+				data = protowire.AppendVarint(data, uint64(*x.FI64))
+			}
+		}
+	}
+	if x.FSv != nil {
+		{
+			wsize := 0
+			if len(*x.FSv) > 0 {
+				// 1 = protowire.SizeTag(1)
+				wsize += 1 + protowire.SizeBytes(len(*x.FSv))
+			}
+			// data = protowire.AppendTag(data, 54, protowire.BytesType) => 10110010 00000011
+			data = append(data, 0xb2, 0x3)
+			data = protowire.AppendVarint(data, uint64(wsize))
+			if len(*x.FSv) > 0 {
+
+				// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+				data = append(data, 0xa)
+				data = protowire.AppendString(data, *x.FSv)
+			}
+		}
+	}
+	if x.FBv != nil {
+		{
+			wsize := 0
+			if len(x.FBv) > 0 {
+				// 1 = protowire.SizeTag(1)
+				wsize += 1 + protowire.SizeBytes(len(x.FBv))
+			}
+			// data = protowire.AppendTag(data, 55, protowire.BytesType) => 10111010 00000011
+			data = append(data, 0xba, 0x3)
+			data = protowire.AppendVarint(data, uint64(wsize))
+			if len(x.FBv) > 0 {
+				// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+				data = append(data, 0xa)
+				data = protowire.AppendBytes(data, x.FBv)
+			}
+		}
+	}
+	if x.FBoolv != nil {
+		{
+			wsize := 0
+			if *x.FBoolv {
+				// 1 = protowire.SizeTag(1)
+				wsize += 1 + 1
+			}
+			// data = protowire.AppendTag(data, 56, protowire.BytesType) => 11000010 00000011
+			data = append(data, 0xc2, 0x3)
+			data = protowire.AppendVarint(data, uint64(wsize))
+			if *x.FBoolv {
+				// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+				data = append(data, 0x8)
+				data = protowire.AppendVarint(data, protowire.EncodeBool(*x.FBoolv))
+			}
+		}
+	}
+	if x.FDblv != nil {
+		{
+			wsize := 0
+			if *x.FDblv != 0 {
+				// 1 = protowire.SizeTag(1)
+				wsize += 1 + 8
+			}
+			// data = protowire.AppendTag(data, 57, protowire.BytesType) => 11001010 00000011
+			data = append(data, 0xca, 0x3)
+			data = protowire.AppendVarint(data, uint64(wsize))
+			if *x.FDblv != 0 {
+				// data = protowire.AppendTag(data, 1, protowire.Fixed64Type) => 00001001
+				data = append(data, 0x9)
+				data = protowire.AppendFixed64(data, math.Float64bits(*x.FDblv))
+			}
+		}
+	}
+	if len(x.RTs) > 0 {
+		for _, item := range x.RTs {
+			{
+				wsecs, wnanos := item.Unix(), int64(item.Nanosecond())
+				wsize := 0
+				if wsecs != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wsecs))
+				}
+				if wnanos != 0 {
+					wsize += 1 + protowire.SizeVarint(uint64(wnanos))
+				}
+				// data = protowire.AppendTag(data, 58, protowire.BytesType) => 11010010 00000011
+				data = append(data, 0xd2, 0x3)
+				data = protowire.AppendVarint(data, uint64(wsize))
+				if wsecs != 0 {
+					data = append(data, 0x8)
+					data = protowire.AppendVarint(data, uint64(wsecs))
+				}
+				if wnanos != 0 {
+					data = append(data, 0x10)
+					data = protowire.AppendVarint(data, uint64(wnanos))
+				}
+			}
+		}
+	}
+	if len(x.RU32) > 0 {
+		for _, item := range x.RU32 {
+			{
+				wsize := 0
+				if *item != 0 {
+					// 1 = protowire.SizeTag(1)
+					wsize += 1 + protowire.SizeVarint(uint64(*item))
+				}
+				// data = protowire.AppendTag(data, 59, protowire.BytesType) => 11011010 00000011
+				data = append(data, 0xda, 0x3)
+				data = protowire.AppendVarint(data, uint64(wsize))
+				if *item != 0 {
+					// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+					data = append(data, 0x8)
+					data = protowire.AppendVarint(data, uint64(*item))
+				}
+			}
+		}
+	}
+	if x.PString != nil {
+
+		// data = protowire.AppendTag(data, 60, protowire.BytesType) => 11100010 00000011
+		data = append(data, 0xe2, 0x3)
+		data = protowire.AppendString(data, *x.PString)
+	}
+	if x.PBytes != nil {
+		// data = protowire.AppendTag(data, 61, protowire.BytesType) => 11101010 00000011
+		data = append(data, 0xea, 0x3)
+		data = protowire.AppendBytes(data, x.PBytes)
+	}
+	if x.PEnum != nil {
+		// data = protowire.AppendTag(data, 62, protowire.VarintType) => 11110000 00000011
+		data = append(data, 0xf0, 0x3)
+		data = protowire.AppendVarint(data, uint64(*x.PEnum))
+	}
+	if x.PDouble != nil {
+		// data = protowire.AppendTag(data, 63, protowire.Fixed64Type) => 11111001 00000011
+		data = append(data, 0xf9, 0x3)
+		data = protowire.AppendFixed64(data, math.Float64bits(*x.PDouble))
+	}
+	if x.PUint64 != nil {
+		// data = protowire.AppendTag(data, 64, protowire.VarintType) => 10000000 00000100
+		data = append(data, 0x80, 0x4)
+		data = protowire.AppendVarint(data, uint64(*x.PUint64))
+	}
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *All) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.All", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_int32", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FInt32 = int32(v)
+		case 2:
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_int64", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FInt64 = int64(v)
+		case 3:
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_string", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			if !utf8.ValidString(v) {
+				err = gopb.UTF8Error("gopb.testpb.All", "f_string", 3, cap(data)-cap(data[index:]))
+				return
+			}
+			index += cnt
+			x.FString = v
+		case 4:
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.All", "f_bytes", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FBytes = make([]byte, len(v))
+			copy(x.FBytes, v)
+		case 5:
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_bool", 5, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FBool = protowire.DecodeBool(v)
+		case 6:
+			v, cnt := protowire.ConsumeFixed64(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_double", 6, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FDouble = math.Float64frombits(v)
+		case 7:
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_enum", 7, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FEnum = Color(v)
+		case 8:
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "p_int32", 8, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.PInt32 = &pv
+		case 9:
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.All", "f_msg", 9, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.FMsg == nil {
+				x.FMsg = &Inner{}
+			}
+			err = x.FMsg.UnmarshalObject(v)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.All", "f_msg", -1, cap(data)-cap(v))
+				return
+			}
+		case 10:
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_uint32", 10, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FUint32 = uint32(v)
+		case 11:
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_uint64", 11, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FUint64 = uint64(v)
+		case 12:
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_sint32", 12, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FSint32 = int32(protowire.DecodeZigZag(v))
+		case 13:
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_sint64", 13, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FSint64 = int64(protowire.DecodeZigZag(v))
+		case 14:
+			v, cnt := protowire.ConsumeFixed32(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_fixed32", 14, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FFixed32 = uint32(v)
+		case 15:
+			v, cnt := protowire.ConsumeFixed64(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_fixed64", 15, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FFixed64 = uint64(v)
+		case 16:
+			v, cnt := protowire.ConsumeFixed32(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_sfixed32", 16, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FSfixed32 = int32(v)
+		case 17:
+			v, cnt := protowire.ConsumeFixed64(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_sfixed64", 17, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FSfixed64 = int64(v)
+		case 18:
+			v, cnt := protowire.ConsumeFixed32(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "f_float", 18, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FFloat = math.Float32frombits(v)
+		case 20:
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "r_int32", 20, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				x.RInt32 = append(x.RInt32, int32(v))
+
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "r_int32", 20, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "r_int32", 20, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.RInt32 == nil {
+				x.RInt32 = make([]int32, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "r_int32", 20, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.RInt32 = append(x.RInt32, int32(v))
+
+			}
+		case 21:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "r_string", 21, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "r_string", 21, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			if !utf8.Valid(buf) {
+				err = gopb.UTF8Error("gopb.testpb.All", "r_string", 21, cap(data)-cap(data[index:]))
+				return
+			}
+			index += cnt
+			if x.RString == nil {
+				x.RString = make([]string, 0, 2)
+			}
+			x.RString = append(x.RString, string(buf))
+
+		case 22:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "r_msg", 22, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "r_msg", 22, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.RMsg == nil {
+				x.RMsg = make([]*Inner, 0, 2)
+			}
+			item := &Inner{}
+			err = item.UnmarshalObject(buf)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.All", "r_msg", len(x.RMsg), cap(data)-cap(buf))
+				return
+			}
+			x.RMsg = append(x.RMsg, item)
+
+		case 23:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "r_bytes", 23, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "r_bytes", 23, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.RBytes == nil {
+				x.RBytes = make([][]byte, 0, 2)
+			}
+			item := make([]byte, len(buf))
+			copy(item, buf)
+			x.RBytes = append(x.RBytes, item)
+
+		case 24:
+			// packed=false
+			if typ == protowire.Fixed64Type {
+				v, cnt := protowire.ConsumeFixed64(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "r_double", 24, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+				x.RDouble = append(x.RDouble, math.Float64frombits(v))
+
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "r_double", 24, cap(data)-cap(data[index:]), typ, protowire.Fixed64Type)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "r_double", 24, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.RDouble == nil {
+				x.RDouble = make([]float64, 0, cnt/8)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeFixed64(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "r_double", 24, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.RDouble = append(x.RDouble, math.Float64frombits(v))
+
+			}
+		case 25:
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "r_enum", 25, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				x.REnum = append(x.REnum, Color(v))
+
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "r_enum", 25, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "r_enum", 25, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.REnum == nil {
+				x.REnum = make([]Color, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "r_enum", 25, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.REnum = append(x.REnum, Color(v))
+
+			}
+		case 26:
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "r_sint64", 26, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+				x.RSint64 = append(x.RSint64, int64(protowire.DecodeZigZag(v)))
+
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "r_sint64", 26, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "r_sint64", 26, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.RSint64 == nil {
+				x.RSint64 = make([]int64, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "r_sint64", 26, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.RSint64 = append(x.RSint64, int64(protowire.DecodeZigZag(v)))
+
+			}
+		case 27:
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "r_unpacked", 27, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				x.RUnpacked = append(x.RUnpacked, int32(v))
+
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "r_unpacked", 27, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "r_unpacked", 27, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.RUnpacked == nil {
+				x.RUnpacked = make([]int32, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "r_unpacked", 27, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.RUnpacked = append(x.RUnpacked, int32(v))
+
+			}
+		case 30:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "m_str_int", 30, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "m_str_int", 30, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.MStrInt == nil {
+				x.MStrInt = make(map[string]int32)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk string
+			var mv int32
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "m_str_int", 30, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1 && typ == protowire.BytesType:
+
+					v, cnt := protowire.ConsumeString(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "m_str_int.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					if !utf8.ValidString(v) {
+						err = gopb.UTF8Error("gopb.testpb.All", "m_str_int.key", 1, cap(data)-cap(buf[sindex:]))
+						return
+					}
+					sindex += cnt
+					mk = v
+				case mi == 2 && typ == protowire.VarintType:
+					v, cnt := protowire.ConsumeVarint(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "m_str_int.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					mv = int32(v)
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.All", "m_str_int", 30, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			x.MStrInt[mk] = mv
+
+		case 31:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "m_int_str", 31, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "m_int_str", 31, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.MIntStr == nil {
+				x.MIntStr = make(map[int32]string)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk int32
+			var mv string
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "m_int_str", 31, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1 && typ == protowire.VarintType:
+					v, cnt := protowire.ConsumeVarint(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "m_int_str.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					mk = int32(v)
+				case mi == 2 && typ == protowire.BytesType:
+
+					v, cnt := protowire.ConsumeString(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "m_int_str.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					if !utf8.ValidString(v) {
+						err = gopb.UTF8Error("gopb.testpb.All", "m_int_str.value", 2, cap(data)-cap(buf[sindex:]))
+						return
+					}
+					sindex += cnt
+					mv = v
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.All", "m_int_str", 31, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			x.MIntStr[mk] = mv
+
+		case 32:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "m_str_msg", 32, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "m_str_msg", 32, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.MStrMsg == nil {
+				x.MStrMsg = make(map[string]*Inner)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk string
+			var mv *Inner
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "m_str_msg", 32, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1 && typ == protowire.BytesType:
+
+					v, cnt := protowire.ConsumeString(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "m_str_msg.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					if !utf8.ValidString(v) {
+						err = gopb.UTF8Error("gopb.testpb.All", "m_str_msg.key", 1, cap(data)-cap(buf[sindex:]))
+						return
+					}
+					sindex += cnt
+					mk = v
+				case mi == 2 && typ == protowire.BytesType:
+					v, cnt := protowire.ConsumeBytes(buf[sindex:])
+					if v == nil {
+						err = gopb.ParseError("gopb.testpb.All", "m_str_msg.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					// 多次出现时合并
+					if mv == nil {
+						mv = &Inner{}
+					}
+					err = mv.UnmarshalObject(v)
+					if err != nil {
+						err = gopb.WrapDecodeError(err, "gopb.testpb.All", "m_str_msg.value", -1, cap(data)-cap(v))
+						return
+					}
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.All", "m_str_msg", 32, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			if mv == nil {
+
+				mv = &Inner{}
+			}
+			x.MStrMsg[mk] = mv
+
+		case 33:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "m_ts", 33, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "m_ts", 33, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.MTs == nil {
+				x.MTs = make(map[string]*time.Time)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk string
+			var mv *time.Time
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "m_ts", 33, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1 && typ == protowire.BytesType:
+
+					v, cnt := protowire.ConsumeString(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "m_ts.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					if !utf8.ValidString(v) {
+						err = gopb.UTF8Error("gopb.testpb.All", "m_ts.key", 1, cap(data)-cap(buf[sindex:]))
+						return
+					}
+					sindex += cnt
+					mk = v
+				case mi == 2 && typ == protowire.BytesType:
+					wbuf, cnt := protowire.ConsumeBytes(buf[sindex:])
+					if wbuf == nil {
+						err = gopb.ParseError("gopb.testpb.All", "m_ts.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					var wsecs, wnanos int64
+					for windex := 0; windex < len(wbuf); {
+						wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+						if wcnt < 1 {
+							err = gopb.ParseError("gopb.testpb.All", "m_ts.value", 2, cap(data)-cap(wbuf[windex:]), wcnt)
+							return
+						}
+						windex += wcnt
+						if (wnum == 1 || wnum == 2) && wtyp == protowire.VarintType {
+							v, wcnt := protowire.ConsumeVarint(wbuf[windex:])
+							if wcnt < 1 {
+								err = gopb.ParseError("gopb.testpb.All", "m_ts.value", 2, cap(data)-cap(wbuf[windex:]), wcnt)
+								return
+							}
+							windex += wcnt
+							if wnum == 1 {
+								wsecs = int64(v)
+							} else {
+								wnanos = int64(int32(v))
+							}
+							continue
+						}
+						wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+						if wcnt < 0 {
+							err = gopb.ParseError("gopb.testpb.All", "m_ts.value", 2, cap(data)-cap(wbuf[windex:]), wcnt)
+							return
+						}
+						windex += wcnt
+					}
+					// 超出范围的值(例如超过 time.Duration 的约292年)返回错误, 不能静默溢出
+					if err = gopb.CheckTimestamp(wsecs, wnanos); err != nil {
+						err = gopb.NewDecodeError("gopb.testpb.All", "m_ts.value", 2, cap(data)-cap(wbuf), gopb.KindMalformed, err)
+						return
+					}
+					wt := time.Unix(wsecs, wnanos).UTC()
+					mv = &wt
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.All", "m_ts", 33, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			if mv == nil {
+
+				wt := time.Unix(0, 0).UTC()
+				mv = &wt
+			}
+			x.MTs[mk] = mv
+
+		case 34:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "m_dur", 34, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "m_dur", 34, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.MDur == nil {
+				x.MDur = make(map[string]*time.Duration)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk string
+			var mv *time.Duration
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "m_dur", 34, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1 && typ == protowire.BytesType:
+
+					v, cnt := protowire.ConsumeString(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "m_dur.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					if !utf8.ValidString(v) {
+						err = gopb.UTF8Error("gopb.testpb.All", "m_dur.key", 1, cap(data)-cap(buf[sindex:]))
+						return
+					}
+					sindex += cnt
+					mk = v
+				case mi == 2 && typ == protowire.BytesType:
+					wbuf, cnt := protowire.ConsumeBytes(buf[sindex:])
+					if wbuf == nil {
+						err = gopb.ParseError("gopb.testpb.All", "m_dur.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					var wsecs, wnanos int64
+					for windex := 0; windex < len(wbuf); {
+						wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+						if wcnt < 1 {
+							err = gopb.ParseError("gopb.testpb.All", "m_dur.value", 2, cap(data)-cap(wbuf[windex:]), wcnt)
+							return
+						}
+						windex += wcnt
+						if (wnum == 1 || wnum == 2) && wtyp == protowire.VarintType {
+							v, wcnt := protowire.ConsumeVarint(wbuf[windex:])
+							if wcnt < 1 {
+								err = gopb.ParseError("gopb.testpb.All", "m_dur.value", 2, cap(data)-cap(wbuf[windex:]), wcnt)
+								return
+							}
+							windex += wcnt
+							if wnum == 1 {
+								wsecs = int64(v)
+							} else {
+								wnanos = int64(int32(v))
+							}
+							continue
+						}
+						wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+						if wcnt < 0 {
+							err = gopb.ParseError("gopb.testpb.All", "m_dur.value", 2, cap(data)-cap(wbuf[windex:]), wcnt)
+							return
+						}
+						windex += wcnt
+					}
+					// 超出范围的值(例如超过 time.Duration 的约292年)返回错误, 不能静默溢出
+					if err = gopb.CheckDuration(wsecs, wnanos); err != nil {
+						err = gopb.NewDecodeError("gopb.testpb.All", "m_dur.value", 2, cap(data)-cap(wbuf), gopb.KindMalformed, err)
+						return
+					}
+					wd := time.Duration(wsecs)*time.Second + time.Duration(wnanos)
+					mv = &wd
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.All", "m_dur", 34, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			if mv == nil {
+
+				mv = new(time.Duration)
+			}
+			x.MDur[mk] = mv
+
+		case 35:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "m_i32", 35, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "m_i32", 35, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.MI32 == nil {
+				x.MI32 = make(map[string]*int32)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk string
+			var mv *int32
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "m_i32", 35, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1 && typ == protowire.BytesType:
+
+					v, cnt := protowire.ConsumeString(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "m_i32.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					if !utf8.ValidString(v) {
+						err = gopb.UTF8Error("gopb.testpb.All", "m_i32.key", 1, cap(data)-cap(buf[sindex:]))
+						return
+					}
+					sindex += cnt
+					mk = v
+				case mi == 2 && typ == protowire.BytesType:
+
+					wbuf, cnt := protowire.ConsumeBytes(buf[sindex:])
+					if wbuf == nil {
+						err = gopb.ParseError("gopb.testpb.All", "m_i32.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					var wv int32
+					for windex := 0; windex < len(wbuf); {
+						wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+						if wcnt < 1 {
+							err = gopb.ParseError("gopb.testpb.All", "m_i32.value", 2, cap(data)-cap(wbuf[windex:]), wcnt)
+							return
+						}
+						windex += wcnt
+						if wnum == 1 && wtyp == protowire.VarintType {
+							v, cnt := protowire.ConsumeVarint(wbuf[windex:])
+							if cnt < 1 {
+								err = gopb.ParseError("gopb.testpb.All", "m_i32.value.value", 1, cap(data)-cap(wbuf[windex:]), cnt)
+								return
+							}
+							windex += cnt
+							wv = int32(v)
+							continue
+						}
+						wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+						if wcnt < 0 {
+							err = gopb.ParseError("gopb.testpb.All", "m_i32.value", 2, cap(data)-cap(wbuf[windex:]), wcnt)
+							return
+						}
+						windex += wcnt
+					}
+					mv = &wv
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.All", "m_i32", 35, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			if mv == nil {
+
+				mv = new(int32)
+			}
+			x.MI32[mk] = mv
+
+		case 36:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "m_bytes", 36, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "m_bytes", 36, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.MBytes == nil {
+				x.MBytes = make(map[string][]byte)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk string
+			var mv []byte
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "m_bytes", 36, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1 && typ == protowire.BytesType:
+
+					v, cnt := protowire.ConsumeString(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "m_bytes.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					if !utf8.ValidString(v) {
+						err = gopb.UTF8Error("gopb.testpb.All", "m_bytes.key", 1, cap(data)-cap(buf[sindex:]))
+						return
+					}
+					sindex += cnt
+					mk = v
+				case mi == 2 && typ == protowire.BytesType:
+
+					wbuf, cnt := protowire.ConsumeBytes(buf[sindex:])
+					if wbuf == nil {
+						err = gopb.ParseError("gopb.testpb.All", "m_bytes.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					var wv []byte
+					for windex := 0; windex < len(wbuf); {
+						wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+						if wcnt < 1 {
+							err = gopb.ParseError("gopb.testpb.All", "m_bytes.value", 2, cap(data)-cap(wbuf[windex:]), wcnt)
+							return
+						}
+						windex += wcnt
+						if wnum == 1 && wtyp == protowire.BytesType {
+							v, cnt := protowire.ConsumeBytes(wbuf[windex:])
+							if v == nil {
+								err = gopb.ParseError("gopb.testpb.All", "m_bytes.value.value", 1, cap(data)-cap(wbuf[windex:]), cnt)
+								return
+							}
+							windex += cnt
+							wv = make([]byte, len(v))
+							copy(wv, v)
+							continue
+						}
+						wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+						if wcnt < 0 {
+							err = gopb.ParseError("gopb.testpb.All", "m_bytes.value", 2, cap(data)-cap(wbuf[windex:]), wcnt)
+							return
+						}
+						windex += wcnt
+					}
+					if wv == nil {
+						wv = []byte{}
+					}
+					mv = wv
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.All", "m_bytes", 36, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			if mv == nil {
+
+				mv = []byte{}
+			}
+			x.MBytes[mk] = mv
+
+		case 40:
+			ov, ok := x.O.(*All_OMsg)
+			if !ok {
+				ov = &All_OMsg{}
+			}
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.All", "o_msg", 40, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if ov.OMsg == nil {
+				ov.OMsg = &Inner{}
+			}
+			err = ov.OMsg.UnmarshalObject(v)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.All", "o_msg", -1, cap(data)-cap(v))
+				return
+			}
+			x.O = ov
+		case 41:
+			ov, ok := x.O.(*All_OStr)
+			if !ok {
+				ov = &All_OStr{}
+			}
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "o_str", 41, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			if !utf8.ValidString(v) {
+				err = gopb.UTF8Error("gopb.testpb.All", "o_str", 41, cap(data)-cap(data[index:]))
+				return
+			}
+			index += cnt
+			ov.OStr = v
+			x.O = ov
+		case 42:
+			ov, ok := x.O.(*All_OInt)
+			if !ok {
+				ov = &All_OInt{}
+			}
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "o_int", 42, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			ov.OInt = int32(v)
+			x.O = ov
+		case 50:
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.All", "f_any", 50, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.FAny == nil {
+				x.FAny = &gopb.Any{}
+			}
+			err = x.FAny.UnmarshalObject(v)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.All", "f_any", -1, cap(data)-cap(v))
+				return
+			}
+		case 51:
+			wbuf, cnt := protowire.ConsumeBytes(data[index:])
+			if wbuf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "f_ts", 51, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			var wsecs, wnanos int64
+			for windex := 0; windex < len(wbuf); {
+				wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+				if wcnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "f_ts", 51, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+				if (wnum == 1 || wnum == 2) && wtyp == protowire.VarintType {
+					v, wcnt := protowire.ConsumeVarint(wbuf[windex:])
+					if wcnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "f_ts", 51, cap(data)-cap(wbuf[windex:]), wcnt)
+						return
+					}
+					windex += wcnt
+					if wnum == 1 {
+						wsecs = int64(v)
+					} else {
+						wnanos = int64(int32(v))
+					}
+					continue
+				}
+				wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+				if wcnt < 0 {
+					err = gopb.ParseError("gopb.testpb.All", "f_ts", 51, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+			}
+			// 超出范围的值(例如超过 time.Duration 的约292年)返回错误, 不能静默溢出
+			if err = gopb.CheckTimestamp(wsecs, wnanos); err != nil {
+				err = gopb.NewDecodeError("gopb.testpb.All", "f_ts", 51, cap(data)-cap(wbuf), gopb.KindMalformed, err)
+				return
+			}
+			wt := time.Unix(wsecs, wnanos).UTC()
+			x.FTs = &wt
+		case 52:
+			wbuf, cnt := protowire.ConsumeBytes(data[index:])
+			if wbuf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "f_dur", 52, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			var wsecs, wnanos int64
+			for windex := 0; windex < len(wbuf); {
+				wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+				if wcnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "f_dur", 52, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+				if (wnum == 1 || wnum == 2) && wtyp == protowire.VarintType {
+					v, wcnt := protowire.ConsumeVarint(wbuf[windex:])
+					if wcnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "f_dur", 52, cap(data)-cap(wbuf[windex:]), wcnt)
+						return
+					}
+					windex += wcnt
+					if wnum == 1 {
+						wsecs = int64(v)
+					} else {
+						wnanos = int64(int32(v))
+					}
+					continue
+				}
+				wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+				if wcnt < 0 {
+					err = gopb.ParseError("gopb.testpb.All", "f_dur", 52, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+			}
+			// 超出范围的值(例如超过 time.Duration 的约292年)返回错误, 不能静默溢出
+			if err = gopb.CheckDuration(wsecs, wnanos); err != nil {
+				err = gopb.NewDecodeError("gopb.testpb.All", "f_dur", 52, cap(data)-cap(wbuf), gopb.KindMalformed, err)
+				return
+			}
+			wd := time.Duration(wsecs)*time.Second + time.Duration(wnanos)
+			x.FDur = &wd
+		case 53:
+
+			wbuf, cnt := protowire.ConsumeBytes(data[index:])
+			if wbuf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "f_i64", 53, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			var wv int64
+			for windex := 0; windex < len(wbuf); {
+				wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+				if wcnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "f_i64", 53, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+				if wnum == 1 && wtyp == protowire.VarintType {
+					v, cnt := protowire.ConsumeVarint(wbuf[windex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "f_i64.value", 1, cap(data)-cap(wbuf[windex:]), cnt)
+						return
+					}
+					windex += cnt
+					wv = int64(v)
+					continue
+				}
+				wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+				if wcnt < 0 {
+					err = gopb.ParseError("gopb.testpb.All", "f_i64", 53, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+			}
+			x.FI64 = &wv
+		case 54:
+
+			wbuf, cnt := protowire.ConsumeBytes(data[index:])
+			if wbuf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "f_sv", 54, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			var wv string
+			for windex := 0; windex < len(wbuf); {
+				wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+				if wcnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "f_sv", 54, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+				if wnum == 1 && wtyp == protowire.BytesType {
+
+					v, cnt := protowire.ConsumeString(wbuf[windex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "f_sv.value", 1, cap(data)-cap(wbuf[windex:]), cnt)
+						return
+					}
+					if !utf8.ValidString(v) {
+						err = gopb.UTF8Error("gopb.testpb.All", "f_sv.value", 1, cap(data)-cap(wbuf[windex:]))
+						return
+					}
+					windex += cnt
+					wv = v
+					continue
+				}
+				wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+				if wcnt < 0 {
+					err = gopb.ParseError("gopb.testpb.All", "f_sv", 54, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+			}
+			x.FSv = &wv
+		case 55:
+
+			wbuf, cnt := protowire.ConsumeBytes(data[index:])
+			if wbuf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "f_bv", 55, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			var wv []byte
+			for windex := 0; windex < len(wbuf); {
+				wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+				if wcnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "f_bv", 55, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+				if wnum == 1 && wtyp == protowire.BytesType {
+					v, cnt := protowire.ConsumeBytes(wbuf[windex:])
+					if v == nil {
+						err = gopb.ParseError("gopb.testpb.All", "f_bv.value", 1, cap(data)-cap(wbuf[windex:]), cnt)
+						return
+					}
+					windex += cnt
+					wv = make([]byte, len(v))
+					copy(wv, v)
+					continue
+				}
+				wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+				if wcnt < 0 {
+					err = gopb.ParseError("gopb.testpb.All", "f_bv", 55, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+			}
+			if wv == nil {
+				wv = []byte{}
+			}
+			x.FBv = wv
+		case 56:
+
+			wbuf, cnt := protowire.ConsumeBytes(data[index:])
+			if wbuf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "f_boolv", 56, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			var wv bool
+			for windex := 0; windex < len(wbuf); {
+				wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+				if wcnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "f_boolv", 56, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+				if wnum == 1 && wtyp == protowire.VarintType {
+					v, cnt := protowire.ConsumeVarint(wbuf[windex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "f_boolv.value", 1, cap(data)-cap(wbuf[windex:]), cnt)
+						return
+					}
+					windex += cnt
+					wv = protowire.DecodeBool(v)
+					continue
+				}
+				wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+				if wcnt < 0 {
+					err = gopb.ParseError("gopb.testpb.All", "f_boolv", 56, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+			}
+			x.FBoolv = &wv
+		case 57:
+
+			wbuf, cnt := protowire.ConsumeBytes(data[index:])
+			if wbuf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "f_dblv", 57, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			var wv float64
+			for windex := 0; windex < len(wbuf); {
+				wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+				if wcnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "f_dblv", 57, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+				if wnum == 1 && wtyp == protowire.Fixed64Type {
+					v, cnt := protowire.ConsumeFixed64(wbuf[windex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "f_dblv.value", 1, cap(data)-cap(wbuf[windex:]), cnt)
+						return
+					}
+					windex += cnt
+					wv = math.Float64frombits(v)
+					continue
+				}
+				wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+				if wcnt < 0 {
+					err = gopb.ParseError("gopb.testpb.All", "f_dblv", 57, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+			}
+			x.FDblv = &wv
+		case 58:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "r_ts", 58, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			x.RTs = append(x.RTs, nil)
+
+			wbuf, cnt := protowire.ConsumeBytes(data[index:])
+			if wbuf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "r_ts", 58, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			var wsecs, wnanos int64
+			for windex := 0; windex < len(wbuf); {
+				wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+				if wcnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "r_ts", 58, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+				if (wnum == 1 || wnum == 2) && wtyp == protowire.VarintType {
+					v, wcnt := protowire.ConsumeVarint(wbuf[windex:])
+					if wcnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "r_ts", 58, cap(data)-cap(wbuf[windex:]), wcnt)
+						return
+					}
+					windex += wcnt
+					if wnum == 1 {
+						wsecs = int64(v)
+					} else {
+						wnanos = int64(int32(v))
+					}
+					continue
+				}
+				wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+				if wcnt < 0 {
+					err = gopb.ParseError("gopb.testpb.All", "r_ts", 58, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+			}
+			// 超出范围的值(例如超过 time.Duration 的约292年)返回错误, 不能静默溢出
+			if err = gopb.CheckTimestamp(wsecs, wnanos); err != nil {
+				err = gopb.NewDecodeError("gopb.testpb.All", "r_ts", 58, cap(data)-cap(wbuf), gopb.KindMalformed, err)
+				return
+			}
+			wt := time.Unix(wsecs, wnanos).UTC()
+			x.RTs[len(x.RTs)-1] = &wt
+		case 59:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.All", "r_u32", 59, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			x.RU32 = append(x.RU32, nil)
+
+			wbuf, cnt := protowire.ConsumeBytes(data[index:])
+			if wbuf == nil {
+				err = gopb.ParseError("gopb.testpb.All", "r_u32", 59, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			var wv uint32
+			for windex := 0; windex < len(wbuf); {
+				wnum, wtyp, wcnt := protowire.ConsumeTag(wbuf[windex:])
+				if wcnt < 1 {
+					err = gopb.ParseError("gopb.testpb.All", "r_u32", 59, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+				if wnum == 1 && wtyp == protowire.VarintType {
+					v, cnt := protowire.ConsumeVarint(wbuf[windex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.All", "r_u32.value", 1, cap(data)-cap(wbuf[windex:]), cnt)
+						return
+					}
+					windex += cnt
+					wv = uint32(v)
+					continue
+				}
+				wcnt = protowire.ConsumeFieldValue(wnum, wtyp, wbuf[windex:])
+				if wcnt < 0 {
+					err = gopb.ParseError("gopb.testpb.All", "r_u32", 59, cap(data)-cap(wbuf[windex:]), wcnt)
+					return
+				}
+				windex += wcnt
+			}
+			x.RU32[len(x.RU32)-1] = &wv
+		case 60:
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "p_string", 60, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			if !utf8.ValidString(v) {
+				err = gopb.UTF8Error("gopb.testpb.All", "p_string", 60, cap(data)-cap(data[index:]))
+				return
+			}
+			index += cnt
+			pv = v
+			x.PString = &pv
+		case 61:
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.All", "p_bytes", 61, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.PBytes = make([]byte, len(v))
+			copy(x.PBytes, v)
+		case 62:
+			var pv Color
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "p_enum", 62, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = Color(v)
+			x.PEnum = &pv
+		case 63:
+			var pv float64
+			v, cnt := protowire.ConsumeFixed64(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "p_double", 63, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = math.Float64frombits(v)
+			x.PDouble = &pv
+		case 64:
+			var pv uint64
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.All", "p_uint64", 64, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = uint64(v)
+			x.PUint64 = &pv
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.All", "", num, index, cnt)
+			}
+			index += cnt
+		}
+	}
+
+	return
+}
+
+type Empty struct {
+	sizeCache int32
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+}
+
+// Clone returns a deep copy of x
+func (x *Empty) Clone() *Empty {
+	if x == nil {
+		return nil
+	}
+	y := &Empty{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *Empty) CopyFrom(src *Empty) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *Empty) Equal(other *Empty) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *Empty) Merge(src *Empty) {
+	if src == nil {
+		return
+	}
+}
+
+// MarshalObject marshal data to []byte
+func (x *Empty) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalCachedTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *Empty) MarshalSize() (size int) {
+	atomic.StoreInt32(&x.sizeCache, int32(size))
+	return
+}
+
+// cachedSize returns the size computed by the last MarshalSize
+func (x *Empty) cachedSize() int {
+	return int(atomic.LoadInt32(&x.sizeCache))
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *Empty) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	// 刷新子消息缓存的大小
+	x.MarshalSize()
+	return x.marshalCachedTo(buf)
+}
+
+// marshalCachedTo marshal data to []byte. 子消息使用 MarshalSize 缓存的大小
+func (x *Empty) marshalCachedTo(buf []byte) (data []byte, err error) {
+	data = buf
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *Empty) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.Empty", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.Empty", "", num, index, cnt)
+			}
+			index += cnt
+		}
+	}
+
+	return
+}
+
+// All 的部分字段, 其余字段作为未知字段解析.
+type AllSubset struct {
+	FInt32    int32            `json:"f_int32,omitempty"`
+	FMsg      *Inner           `json:"f_msg,omitempty"`
+	RString   []string         `json:"r_string,omitempty"`
+	MStrInt   map[string]int32 `json:"m_str_int,omitempty"`
+	sizeCache int32
+}
+
+func (x *AllSubset) Reset() {
+	*x = AllSubset{}
+}
+
+func (x *AllSubset) GetFInt32() int32 {
+	if x != nil {
+		return x.FInt32
+	}
+	return 0
+}
+
+func (x *AllSubset) GetFMsg() *Inner {
+	if x != nil {
+		return x.FMsg
+	}
+	return nil
+}
+
+// HasFMsg report whether the field is set
+func (x *AllSubset) HasFMsg() bool {
+	return x != nil && x.FMsg != nil
+}
+
+// ClearFMsg clear the field
+func (x *AllSubset) ClearFMsg() {
+	x.FMsg = nil
+}
+
+func (x *AllSubset) GetRString() []string {
+	if x != nil {
+		return x.RString
+	}
+	return nil
+}
+
+func (x *AllSubset) GetMStrInt() map[string]int32 {
+	if x != nil {
+		return x.MStrInt
+	}
+	return nil
+}
+
+// Clone returns a deep copy of x
+func (x *AllSubset) Clone() *AllSubset {
+	if x == nil {
+		return nil
+	}
+	y := &AllSubset{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *AllSubset) CopyFrom(src *AllSubset) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	x.FInt32 = src.FInt32
+	if src.FMsg != nil {
+		if x.FMsg == nil {
+			x.FMsg = &Inner{}
+		}
+		x.FMsg.CopyFrom(src.FMsg)
+	} else {
+		x.FMsg = nil
+	}
+	x.RString = append(x.RString[:0], src.RString...)
+	if x.MStrInt == nil && src.MStrInt != nil {
+		x.MStrInt = make(map[string]int32, len(src.MStrInt))
+	}
+	for mk := range x.MStrInt {
+		delete(x.MStrInt, mk)
+	}
+	for mk, mv := range src.MStrInt {
+		x.MStrInt[mk] = mv
+	}
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *AllSubset) Equal(other *AllSubset) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if x.FInt32 != other.FInt32 {
+		return false
+	}
+	if !x.FMsg.Equal(other.FMsg) {
+		return false
+	}
+	if len(x.RString) != len(other.RString) {
+		return false
+	}
+	for k := range x.RString {
+		if x.RString[k] != other.RString[k] {
+			return false
+		}
+	}
+	if len(x.MStrInt) != len(other.MStrInt) {
+		return false
+	}
+	for mk, mv := range x.MStrInt {
+		ov, ok := other.MStrInt[mk]
+		if !ok {
+			return false
+		}
+		if mv != ov {
+			return false
+		}
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *AllSubset) Merge(src *AllSubset) {
+	if src == nil {
+		return
+	}
+	if src.FInt32 != 0 {
+		x.FInt32 = src.FInt32
+	}
+	if src.FMsg != nil {
+		if x.FMsg == nil {
+			x.FMsg = &Inner{}
+		}
+		x.FMsg.Merge(src.FMsg)
+	}
+	if len(src.RString) > 0 {
+		x.RString = append(x.RString, src.RString...)
+	}
+	if len(src.MStrInt) > 0 {
+		if x.MStrInt == nil {
+			x.MStrInt = make(map[string]int32, len(src.MStrInt))
+		}
+		for mk, mv := range src.MStrInt {
+			x.MStrInt[mk] = mv
+		}
+	}
+}
+
+// MarshalObject marshal data to []byte
+func (x *AllSubset) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalCachedTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *AllSubset) MarshalSize() (size int) {
+	if x.FInt32 != 0 {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(x.FInt32))
+	}
+	if x.FMsg != nil {
+		// 1 = protowire.SizeTag(9)
+		size += 1 + protowire.SizeBytes(x.FMsg.MarshalSize())
+	}
+	if len(x.RString) > 0 {
+		// 2 = protowire.SizeTag(21)
+		size += 2 * len(x.RString)
+		for k := 0; k < len(x.RString); k++ {
+			size += protowire.SizeBytes(len(x.RString[k]))
+		}
+	}
+	if len(x.MStrInt) > 0 {
+		for mk, mv := range x.MStrInt {
+			_ = mk
+			_ = mv
+			// 2 = protowire.SizeTag(30)
+			size += 2
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeVarint(uint64(mv))
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	atomic.StoreInt32(&x.sizeCache, int32(size))
+	return
+}
+
+// cachedSize returns the size computed by the last MarshalSize
+func (x *AllSubset) cachedSize() int {
+	return int(atomic.LoadInt32(&x.sizeCache))
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *AllSubset) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	// 刷新子消息缓存的大小
+	x.MarshalSize()
+	return x.marshalCachedTo(buf)
+}
+
+// marshalCachedTo marshal data to []byte. 子消息使用 MarshalSize 缓存的大小
+func (x *AllSubset) marshalCachedTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.FInt32 != 0 {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(x.FInt32))
+	}
+	if x.FMsg != nil {
+		// data = protowire.AppendTag(data, 9, protowire.BytesType) => 01001010
+		data = append(data, 0x4a)
+		data = protowire.AppendVarint(data, uint64(x.FMsg.cachedSize()))
+		data, err = x.FMsg.marshalCachedTo(data)
+		if err != nil {
+			return
+		}
+	}
+	if len(x.RString) > 0 {
+		for k := 0; k < len(x.RString); k++ {
+
+			// data = protowire.AppendTag(data, 21, protowire.BytesType) => 10101010 00000001
+			data = append(data, 0xaa, 0x1)
+			data = protowire.AppendString(data, x.RString[k])
+		}
+	}
+	if len(x.MStrInt) > 0 {
+
+		for mk, mv := range x.MStrInt {
+			// data = protowire.AppendTag(data, 30, protowire.BytesType) => 11110010 00000001
+			data = append(data, 0xf2, 0x1)
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeVarint(uint64(mv))
+			data = protowire.AppendVarint(data, uint64(msize))
+
+			// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+			data = append(data, 0xa)
+			data = protowire.AppendString(data, mk)
+			// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+			data = append(data, 0x10)
+			data = protowire.AppendVarint(data, uint64(mv))
+		}
+	}
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *AllSubset) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.AllSubset", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.AllSubset", "f_int32", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.FInt32 = int32(v)
+		case 9:
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.AllSubset", "f_msg", 9, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.FMsg == nil {
+				x.FMsg = &Inner{}
+			}
+			err = x.FMsg.UnmarshalObject(v)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.AllSubset", "f_msg", -1, cap(data)-cap(v))
+				return
+			}
+		case 21:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.AllSubset", "r_string", 21, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.AllSubset", "r_string", 21, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			if !utf8.Valid(buf) {
+				err = gopb.UTF8Error("gopb.testpb.AllSubset", "r_string", 21, cap(data)-cap(data[index:]))
+				return
+			}
+			index += cnt
+			if x.RString == nil {
+				x.RString = make([]string, 0, 2)
+			}
+			x.RString = append(x.RString, string(buf))
+
+		case 30:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.AllSubset", "m_str_int", 30, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.AllSubset", "m_str_int", 30, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.MStrInt == nil {
+				x.MStrInt = make(map[string]int32)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk string
+			var mv int32
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.AllSubset", "m_str_int", 30, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1 && typ == protowire.BytesType:
+
+					v, cnt := protowire.ConsumeString(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.AllSubset", "m_str_int.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					if !utf8.ValidString(v) {
+						err = gopb.UTF8Error("gopb.testpb.AllSubset", "m_str_int.key", 1, cap(data)-cap(buf[sindex:]))
+						return
+					}
+					sindex += cnt
+					mk = v
+				case mi == 2 && typ == protowire.VarintType:
+					v, cnt := protowire.ConsumeVarint(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.AllSubset", "m_str_int.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					mv = int32(v)
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.AllSubset", "m_str_int", 30, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			x.MStrInt[mk] = mv
+
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.AllSubset", "", num, index, cnt)
+			}
+			index += cnt
+		}
+	}
+
+	return
+}
+
+// 树形消息, 用于测试深层嵌套.
+type Node struct {
+	V         int64            `json:"v,omitempty"`
+	Name      string           `json:"name,omitempty"`
+	Child     *Node            `json:"child,omitempty"`
+	Kids      []*Node          `json:"kids,omitempty"`
+	M         map[string]*Node `json:"m,omitempty"`
+	sizeCache int32
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+}
+
+func (x *Node) GetV() int64 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *Node) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Node) GetChild() *Node {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+// HasChild report whether the field is set
+func (x *Node) HasChild() bool {
+	return x != nil && x.Child != nil
+}
+
+// ClearChild clear the field
+func (x *Node) ClearChild() {
+	x.Child = nil
+}
+
+func (x *Node) GetKids() []*Node {
+	if x != nil {
+		return x.Kids
+	}
+	return nil
+}
+
+func (x *Node) GetM() map[string]*Node {
+	if x != nil {
+		return x.M
+	}
+	return nil
+}
+
+// Clone returns a deep copy of x
+func (x *Node) Clone() *Node {
+	if x == nil {
+		return nil
+	}
+	y := &Node{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *Node) CopyFrom(src *Node) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	x.V = src.V
+	x.Name = src.Name
+	if src.Child != nil {
+		if x.Child == nil {
+			x.Child = &Node{}
+		}
+		x.Child.CopyFrom(src.Child)
+	} else {
+		x.Child = nil
+	}
+	// 只复用原来长度内的元素. 超出长度的部分(例如 Release 截断的列表)可能已被其他消息使用, 置为零值后重新分配
+	if n, old := len(src.Kids), len(x.Kids); n <= old {
+		x.Kids = x.Kids[:n]
+	} else {
+		x.Kids = append(x.Kids, make([]*Node, n-old)...)
+	}
+	for k, item := range src.Kids {
+		if item != nil {
+			if x.Kids[k] == nil {
+				x.Kids[k] = &Node{}
+			}
+			x.Kids[k].CopyFrom(item)
+		} else {
+			x.Kids[k] = nil
+		}
+	}
+	if x.M == nil && src.M != nil {
+		x.M = make(map[string]*Node, len(src.M))
+	}
+	for mk := range x.M {
+		delete(x.M, mk)
+	}
+	for mk, mv := range src.M {
+		if mv != nil {
+			if x.M[mk] == nil {
+				x.M[mk] = &Node{}
+			}
+			x.M[mk].CopyFrom(mv)
+		} else {
+			x.M[mk] = nil
+		}
+	}
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *Node) Equal(other *Node) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if x.V != other.V {
+		return false
+	}
+	if x.Name != other.Name {
+		return false
+	}
+	if !x.Child.Equal(other.Child) {
+		return false
+	}
+	if len(x.Kids) != len(other.Kids) {
+		return false
+	}
+	for k := range x.Kids {
+		if !x.Kids[k].Equal(other.Kids[k]) {
+			return false
+		}
+	}
+	if len(x.M) != len(other.M) {
+		return false
+	}
+	for mk, mv := range x.M {
+		ov, ok := other.M[mk]
+		if !ok {
+			return false
+		}
+		if !mv.Equal(ov) {
+			return false
+		}
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *Node) Merge(src *Node) {
+	if src == nil {
+		return
+	}
+	if src.V != 0 {
+		x.V = src.V
+	}
+	if len(src.Name) > 0 {
+		x.Name = src.Name
+	}
+	if src.Child != nil {
+		if x.Child == nil {
+			x.Child = &Node{}
+		}
+		x.Child.Merge(src.Child)
+	}
+	if src.Kids != nil {
+		for _, item := range src.Kids {
+			x.Kids = append(x.Kids, nil)
+			if item != nil {
+				if x.Kids[len(x.Kids)-1] == nil {
+					x.Kids[len(x.Kids)-1] = &Node{}
+				}
+				x.Kids[len(x.Kids)-1].CopyFrom(item)
+			} else {
+				x.Kids[len(x.Kids)-1] = nil
+			}
+		}
+	}
+	if len(src.M) > 0 {
+		if x.M == nil {
+			x.M = make(map[string]*Node, len(src.M))
+		}
+		for mk, mv := range src.M {
+			if mv != nil {
+				if x.M[mk] == nil {
+					x.M[mk] = &Node{}
+				}
+				x.M[mk].CopyFrom(mv)
+			} else {
+				x.M[mk] = nil
+			}
+		}
+	}
+}
+
+// MarshalObject marshal data to []byte
+func (x *Node) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.marshalCachedTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *Node) MarshalSize() (size int) {
+	if x.V != 0 {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(x.V))
+	}
+	if len(x.Name) > 0 {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeBytes(len(x.Name))
+	}
+	if x.Child != nil {
+		// 1 = protowire.SizeTag(3)
+		size += 1 + protowire.SizeBytes(x.Child.MarshalSize())
+	}
+	if x.Kids != nil {
+		// 1 = protowire.SizeTag(4)
+		size += 1 * len(x.Kids)
+		for k := 0; k < len(x.Kids); k++ {
+			size += protowire.SizeBytes(x.Kids[k].MarshalSize())
+		}
+	}
+	if len(x.M) > 0 {
+		for mk, mv := range x.M {
+			_ = mk
+			_ = mv
+			// 1 = protowire.SizeTag(5)
+			size += 1
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeBytes(mv.MarshalSize())
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	atomic.StoreInt32(&x.sizeCache, int32(size))
+	return
+}
+
+// cachedSize returns the size computed by the last MarshalSize
+func (x *Node) cachedSize() int {
+	return int(atomic.LoadInt32(&x.sizeCache))
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *Node) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	// 刷新子消息缓存的大小
+	x.MarshalSize()
+	return x.marshalCachedTo(buf)
+}
+
+// marshalCachedTo marshal data to []byte. 子消息使用 MarshalSize 缓存的大小
+func (x *Node) marshalCachedTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.V != 0 {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(x.V))
+	}
+	if len(x.Name) > 0 {
+
+		// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+		data = append(data, 0x12)
+		data = protowire.AppendString(data, x.Name)
+	}
+	if x.Child != nil {
+		// data = protowire.AppendTag(data, 3, protowire.BytesType) => 00011010
+		data = append(data, 0x1a)
+		data = protowire.AppendVarint(data, uint64(x.Child.cachedSize()))
+		data, err = x.Child.marshalCachedTo(data)
+		if err != nil {
+			return
+		}
+	}
+	if x.Kids != nil {
+		for _, item := range x.Kids {
+			// data = protowire.AppendTag(data, 4, protowire.BytesType) => 00100010
+			data = append(data, 0x22)
+			data = protowire.AppendVarint(data, uint64(item.cachedSize()))
+			data, err = item.marshalCachedTo(data)
+			if err != nil {
+				return
+			}
+		}
+	}
+	if len(x.M) > 0 {
+
+		for mk, mv := range x.M {
+			// data = protowire.AppendTag(data, 5, protowire.BytesType) => 00101010
+			data = append(data, 0x2a)
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeBytes(mv.cachedSize())
+			data = protowire.AppendVarint(data, uint64(msize))
+
+			// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+			data = append(data, 0xa)
+			data = protowire.AppendString(data, mk)
+			// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+			data = append(data, 0x12)
+			data = protowire.AppendVarint(data, uint64(mv.cachedSize()))
+			data, err = mv.marshalCachedTo(data)
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *Node) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.Node", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Node", "v", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.V = int64(v)
+		case 2:
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Node", "name", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			if !utf8.ValidString(v) {
+				err = gopb.UTF8Error("gopb.testpb.Node", "name", 2, cap(data)-cap(data[index:]))
+				return
+			}
+			index += cnt
+			x.Name = v
+		case 3:
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.Node", "child", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.Child == nil {
+				x.Child = &Node{}
+			}
+			err = x.Child.UnmarshalObject(v)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.Node", "child", -1, cap(data)-cap(v))
+				return
+			}
+		case 4:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Node", "kids", 4, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.Node", "kids", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.Kids == nil {
+				x.Kids = make([]*Node, 0, 2)
+			}
+			item := &Node{}
+			err = item.UnmarshalObject(buf)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.Node", "kids", len(x.Kids), cap(data)-cap(buf))
+				return
+			}
+			x.Kids = append(x.Kids, item)
+
+		case 5:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Node", "m", 5, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.Node", "m", 5, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.M == nil {
+				x.M = make(map[string]*Node)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk string
+			var mv *Node
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Node", "m", 5, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1 && typ == protowire.BytesType:
+
+					v, cnt := protowire.ConsumeString(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.Node", "m.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					if !utf8.ValidString(v) {
+						err = gopb.UTF8Error("gopb.testpb.Node", "m.key", 1, cap(data)-cap(buf[sindex:]))
+						return
+					}
+					sindex += cnt
+					mk = v
+				case mi == 2 && typ == protowire.BytesType:
+					v, cnt := protowire.ConsumeBytes(buf[sindex:])
+					if v == nil {
+						err = gopb.ParseError("gopb.testpb.Node", "m.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					// 多次出现时合并
+					if mv == nil {
+						mv = &Node{}
+					}
+					err = mv.UnmarshalObject(v)
+					if err != nil {
+						err = gopb.WrapDecodeError(err, "gopb.testpb.Node", "m.value", -1, cap(data)-cap(v))
+						return
+					}
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.Node", "m", 5, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			if mv == nil {
+
+				mv = &Node{}
+			}
+			x.M[mk] = mv
+
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.Node", "", num, index, cnt)
+			}
+			index += cnt
+		}
+	}
+
+	return
+}
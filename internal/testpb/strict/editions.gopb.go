@@ -0,0 +1,1451 @@
+// Code generated by protoc-gen-gopb. DO NOT EDIT.
+// Code generated by protoc-gen-gopb. DO NOT EDIT.
+// versions:
+// 	protoc-gen-gopb 0.0.5
+// 	protoc          (unknown)
+// source:  editions.proto
+
+package strict
+
+import (
+	bytes "bytes"
+	errors "errors"
+	gopb "github.com/aggronmagi/protoc-gen-gopb/gopb"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	strconv "strconv"
+	strings "strings"
+	utf8 "unicode/utf8"
+)
+
+type EdOpen int32
+
+const (
+	EdOpen_ED_OPEN_ZERO EdOpen = 0
+	EdOpen_ED_OPEN_A    EdOpen = 1
+)
+
+// Enum value maps for EdOpen.
+var (
+	EdOpen_name = map[int32]string{
+		0: "ED_OPEN_ZERO",
+		1: "ED_OPEN_A",
+	}
+	EdOpen_value = map[string]int32{
+		"ED_OPEN_ZERO": 0,
+		"ED_OPEN_A":    1,
+	}
+)
+
+func (x EdOpen) Enum() *EdOpen {
+	p := new(EdOpen)
+	*p = x
+	return p
+}
+
+func (x EdOpen) String() string {
+	if name, ok := EdOpen_name[int32(x)]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(x), 10)
+}
+
+type EdClosed int32
+
+const (
+	EdClosed_ED_CLOSED_ZERO EdClosed = 0
+	EdClosed_ED_CLOSED_B    EdClosed = 1
+	EdClosed_ED_CLOSED_C    EdClosed = 2
+)
+
+// Enum value maps for EdClosed.
+var (
+	EdClosed_name = map[int32]string{
+		0: "ED_CLOSED_ZERO",
+		1: "ED_CLOSED_B",
+		2: "ED_CLOSED_C",
+	}
+	EdClosed_value = map[string]int32{
+		"ED_CLOSED_ZERO": 0,
+		"ED_CLOSED_B":    1,
+		"ED_CLOSED_C":    2,
+	}
+)
+
+func (x EdClosed) Enum() *EdClosed {
+	p := new(EdClosed)
+	*p = x
+	return p
+}
+
+func (x EdClosed) String() string {
+	if name, ok := EdClosed_name[int32(x)]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(x), 10)
+}
+
+type Edition struct {
+	// 默认 EXPLICIT, 标量使用指针
+	Explicit *int32 `json:"explicit,omitempty"`
+	Implicit int32  `json:"implicit,omitempty"`
+	Req      *int32 `json:"req,omitempty"`
+	// 默认 PACKED
+	Packed        []int32            `json:"packed,omitempty"`
+	Expanded      []int32            `json:"expanded,omitempty"`
+	Closed        *EdClosed          `json:"closed,omitempty"`
+	ClosedList    []EdClosed         `json:"closed_list,omitempty"`
+	Checked       *string            `json:"checked,omitempty"`
+	Unchecked     *string            `json:"unchecked,omitempty"`
+	Delimited     *Edition_Child     `json:"delimited,omitempty"`
+	DelimitedList []*Edition_Child   `json:"delimited_list,omitempty"`
+	Open          *EdOpen            `json:"open,omitempty"`
+	ClosedMap     map[int32]EdClosed `json:"closed_map,omitempty"`
+	ImplicitStr   string             `json:"implicit_str,omitempty"`
+	unknownFields []byte
+}
+
+func (x *Edition) Reset() {
+	*x = Edition{}
+}
+
+// 默认 EXPLICIT, 标量使用指针
+func (x *Edition) GetExplicit() int32 {
+	if x != nil && x.Explicit != nil {
+		return *x.Explicit
+	}
+	return 0
+}
+
+// HasExplicit report whether the field is set
+func (x *Edition) HasExplicit() bool {
+	return x != nil && x.Explicit != nil
+}
+
+// ClearExplicit clear the field
+func (x *Edition) ClearExplicit() {
+	x.Explicit = nil
+}
+
+func (x *Edition) GetImplicit() int32 {
+	if x != nil {
+		return x.Implicit
+	}
+	return 0
+}
+
+func (x *Edition) GetReq() int32 {
+	if x != nil && x.Req != nil {
+		return *x.Req
+	}
+	return 0
+}
+
+// HasReq report whether the field is set
+func (x *Edition) HasReq() bool {
+	return x != nil && x.Req != nil
+}
+
+// ClearReq clear the field
+func (x *Edition) ClearReq() {
+	x.Req = nil
+}
+
+// 默认 PACKED
+func (x *Edition) GetPacked() []int32 {
+	if x != nil {
+		return x.Packed
+	}
+	return nil
+}
+
+func (x *Edition) GetExpanded() []int32 {
+	if x != nil {
+		return x.Expanded
+	}
+	return nil
+}
+
+func (x *Edition) GetClosed() EdClosed {
+	if x != nil && x.Closed != nil {
+		return *x.Closed
+	}
+	return EdClosed_ED_CLOSED_ZERO
+}
+
+// HasClosed report whether the field is set
+func (x *Edition) HasClosed() bool {
+	return x != nil && x.Closed != nil
+}
+
+// ClearClosed clear the field
+func (x *Edition) ClearClosed() {
+	x.Closed = nil
+}
+
+func (x *Edition) GetClosedList() []EdClosed {
+	if x != nil {
+		return x.ClosedList
+	}
+	return nil
+}
+
+func (x *Edition) GetChecked() string {
+	if x != nil && x.Checked != nil {
+		return *x.Checked
+	}
+	return ""
+}
+
+// HasChecked report whether the field is set
+func (x *Edition) HasChecked() bool {
+	return x != nil && x.Checked != nil
+}
+
+// ClearChecked clear the field
+func (x *Edition) ClearChecked() {
+	x.Checked = nil
+}
+
+func (x *Edition) GetUnchecked() string {
+	if x != nil && x.Unchecked != nil {
+		return *x.Unchecked
+	}
+	return ""
+}
+
+// HasUnchecked report whether the field is set
+func (x *Edition) HasUnchecked() bool {
+	return x != nil && x.Unchecked != nil
+}
+
+// ClearUnchecked clear the field
+func (x *Edition) ClearUnchecked() {
+	x.Unchecked = nil
+}
+
+func (x *Edition) GetDelimited() *Edition_Child {
+	if x != nil {
+		return x.Delimited
+	}
+	return nil
+}
+
+// HasDelimited report whether the field is set
+func (x *Edition) HasDelimited() bool {
+	return x != nil && x.Delimited != nil
+}
+
+// ClearDelimited clear the field
+func (x *Edition) ClearDelimited() {
+	x.Delimited = nil
+}
+
+func (x *Edition) GetDelimitedList() []*Edition_Child {
+	if x != nil {
+		return x.DelimitedList
+	}
+	return nil
+}
+
+func (x *Edition) GetOpen() EdOpen {
+	if x != nil && x.Open != nil {
+		return *x.Open
+	}
+	return EdOpen_ED_OPEN_ZERO
+}
+
+// HasOpen report whether the field is set
+func (x *Edition) HasOpen() bool {
+	return x != nil && x.Open != nil
+}
+
+// ClearOpen clear the field
+func (x *Edition) ClearOpen() {
+	x.Open = nil
+}
+
+func (x *Edition) GetClosedMap() map[int32]EdClosed {
+	if x != nil {
+		return x.ClosedMap
+	}
+	return nil
+}
+
+func (x *Edition) GetImplicitStr() string {
+	if x != nil {
+		return x.ImplicitStr
+	}
+	return ""
+}
+
+// Clone returns a deep copy of x
+func (x *Edition) Clone() *Edition {
+	if x == nil {
+		return nil
+	}
+	y := &Edition{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *Edition) CopyFrom(src *Edition) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.Explicit != nil {
+		pv := *src.Explicit
+		x.Explicit = &pv
+	} else {
+		x.Explicit = nil
+	}
+	x.Implicit = src.Implicit
+	if src.Req != nil {
+		pv := *src.Req
+		x.Req = &pv
+	} else {
+		x.Req = nil
+	}
+	x.Packed = append(x.Packed[:0], src.Packed...)
+	x.Expanded = append(x.Expanded[:0], src.Expanded...)
+	if src.Closed != nil {
+		pv := *src.Closed
+		x.Closed = &pv
+	} else {
+		x.Closed = nil
+	}
+	x.ClosedList = append(x.ClosedList[:0], src.ClosedList...)
+	if src.Checked != nil {
+		pv := *src.Checked
+		x.Checked = &pv
+	} else {
+		x.Checked = nil
+	}
+	if src.Unchecked != nil {
+		pv := *src.Unchecked
+		x.Unchecked = &pv
+	} else {
+		x.Unchecked = nil
+	}
+	if src.Delimited != nil {
+		if x.Delimited == nil {
+			x.Delimited = &Edition_Child{}
+		}
+		x.Delimited.CopyFrom(src.Delimited)
+	} else {
+		x.Delimited = nil
+	}
+	// 只复用原来长度内的元素. 超出长度的部分(例如 Release 截断的列表)可能已被其他消息使用, 置为零值后重新分配
+	if n, old := len(src.DelimitedList), len(x.DelimitedList); n <= old {
+		x.DelimitedList = x.DelimitedList[:n]
+	} else {
+		x.DelimitedList = append(x.DelimitedList, make([]*Edition_Child, n-old)...)
+	}
+	for k, item := range src.DelimitedList {
+		if item != nil {
+			if x.DelimitedList[k] == nil {
+				x.DelimitedList[k] = &Edition_Child{}
+			}
+			x.DelimitedList[k].CopyFrom(item)
+		} else {
+			x.DelimitedList[k] = nil
+		}
+	}
+	if src.Open != nil {
+		pv := *src.Open
+		x.Open = &pv
+	} else {
+		x.Open = nil
+	}
+	if x.ClosedMap == nil && src.ClosedMap != nil {
+		x.ClosedMap = make(map[int32]EdClosed, len(src.ClosedMap))
+	}
+	for mk := range x.ClosedMap {
+		delete(x.ClosedMap, mk)
+	}
+	for mk, mv := range src.ClosedMap {
+		x.ClosedMap[mk] = mv
+	}
+	x.ImplicitStr = src.ImplicitStr
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *Edition) Equal(other *Edition) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.Explicit == nil) != (other.Explicit == nil) {
+		return false
+	}
+	if x.Explicit != nil {
+		if *x.Explicit != *other.Explicit {
+			return false
+		}
+	}
+	if x.Implicit != other.Implicit {
+		return false
+	}
+	if (x.Req == nil) != (other.Req == nil) {
+		return false
+	}
+	if x.Req != nil {
+		if *x.Req != *other.Req {
+			return false
+		}
+	}
+	if len(x.Packed) != len(other.Packed) {
+		return false
+	}
+	for k := range x.Packed {
+		if x.Packed[k] != other.Packed[k] {
+			return false
+		}
+	}
+	if len(x.Expanded) != len(other.Expanded) {
+		return false
+	}
+	for k := range x.Expanded {
+		if x.Expanded[k] != other.Expanded[k] {
+			return false
+		}
+	}
+	if (x.Closed == nil) != (other.Closed == nil) {
+		return false
+	}
+	if x.Closed != nil {
+		if *x.Closed != *other.Closed {
+			return false
+		}
+	}
+	if len(x.ClosedList) != len(other.ClosedList) {
+		return false
+	}
+	for k := range x.ClosedList {
+		if x.ClosedList[k] != other.ClosedList[k] {
+			return false
+		}
+	}
+	if (x.Checked == nil) != (other.Checked == nil) {
+		return false
+	}
+	if x.Checked != nil {
+		if *x.Checked != *other.Checked {
+			return false
+		}
+	}
+	if (x.Unchecked == nil) != (other.Unchecked == nil) {
+		return false
+	}
+	if x.Unchecked != nil {
+		if *x.Unchecked != *other.Unchecked {
+			return false
+		}
+	}
+	if !x.Delimited.Equal(other.Delimited) {
+		return false
+	}
+	if len(x.DelimitedList) != len(other.DelimitedList) {
+		return false
+	}
+	for k := range x.DelimitedList {
+		if !x.DelimitedList[k].Equal(other.DelimitedList[k]) {
+			return false
+		}
+	}
+	if (x.Open == nil) != (other.Open == nil) {
+		return false
+	}
+	if x.Open != nil {
+		if *x.Open != *other.Open {
+			return false
+		}
+	}
+	if len(x.ClosedMap) != len(other.ClosedMap) {
+		return false
+	}
+	for mk, mv := range x.ClosedMap {
+		ov, ok := other.ClosedMap[mk]
+		if !ok {
+			return false
+		}
+		if mv != ov {
+			return false
+		}
+	}
+	if x.ImplicitStr != other.ImplicitStr {
+		return false
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *Edition) Merge(src *Edition) {
+	if src == nil {
+		return
+	}
+	if src.Explicit != nil {
+		pv := *src.Explicit
+		x.Explicit = &pv
+	}
+	if src.Implicit != 0 {
+		x.Implicit = src.Implicit
+	}
+	if src.Req != nil {
+		pv := *src.Req
+		x.Req = &pv
+	}
+	if len(src.Packed) > 0 {
+		x.Packed = append(x.Packed, src.Packed...)
+	}
+	if len(src.Expanded) > 0 {
+		x.Expanded = append(x.Expanded, src.Expanded...)
+	}
+	if src.Closed != nil {
+		pv := *src.Closed
+		x.Closed = &pv
+	}
+	if len(src.ClosedList) > 0 {
+		x.ClosedList = append(x.ClosedList, src.ClosedList...)
+	}
+	if src.Checked != nil {
+		pv := *src.Checked
+		x.Checked = &pv
+	}
+	if src.Unchecked != nil {
+		pv := *src.Unchecked
+		x.Unchecked = &pv
+	}
+	if src.Delimited != nil {
+		if x.Delimited == nil {
+			x.Delimited = &Edition_Child{}
+		}
+		x.Delimited.Merge(src.Delimited)
+	}
+	if src.DelimitedList != nil {
+		for _, item := range src.DelimitedList {
+			x.DelimitedList = append(x.DelimitedList, nil)
+			if item != nil {
+				if x.DelimitedList[len(x.DelimitedList)-1] == nil {
+					x.DelimitedList[len(x.DelimitedList)-1] = &Edition_Child{}
+				}
+				x.DelimitedList[len(x.DelimitedList)-1].CopyFrom(item)
+			} else {
+				x.DelimitedList[len(x.DelimitedList)-1] = nil
+			}
+		}
+	}
+	if src.Open != nil {
+		pv := *src.Open
+		x.Open = &pv
+	}
+	if len(src.ClosedMap) > 0 {
+		if x.ClosedMap == nil {
+			x.ClosedMap = make(map[int32]EdClosed, len(src.ClosedMap))
+		}
+		for mk, mv := range src.ClosedMap {
+			x.ClosedMap[mk] = mv
+		}
+	}
+	if len(src.ImplicitStr) > 0 {
+		x.ImplicitStr = src.ImplicitStr
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// MarshalObject marshal data to []byte
+func (x *Edition) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.MarshalObjectTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *Edition) MarshalSize() (size int) {
+	if x.Explicit != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(*x.Explicit))
+	}
+	if x.Implicit != 0 {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeVarint(uint64(x.Implicit))
+	}
+	if x.Req != nil {
+		// 1 = protowire.SizeTag(3)
+		size += 1 + protowire.SizeVarint(uint64(*x.Req))
+	}
+	if len(x.Packed) > 0 {
+		size += 1 // size += protowire.SizeTag(4)
+		if len(x.Packed) > 0 {
+			fsize := 0
+			for _, item := range x.Packed {
+				fsize += protowire.SizeVarint(uint64(item))
+			}
+			size += protowire.SizeBytes(fsize)
+		}
+	}
+	if len(x.Expanded) > 0 {
+		// 1 = protowire.SizeTag(5)
+		size += 1 * len(x.Expanded)
+		for k := 0; k < len(x.Expanded); k++ {
+			size += protowire.SizeVarint(uint64(x.Expanded[k]))
+		}
+	}
+	if x.Closed != nil {
+		// 1 = protowire.SizeTag(6)
+		size += 1 + protowire.SizeVarint(uint64(*x.Closed))
+	}
+	if len(x.ClosedList) > 0 {
+		size += 1 // size += protowire.SizeTag(7)
+		if len(x.ClosedList) > 0 {
+			fsize := 0
+			for _, item := range x.ClosedList {
+				fsize += protowire.SizeVarint(uint64(item))
+			}
+			size += protowire.SizeBytes(fsize)
+		}
+	}
+	if x.Checked != nil {
+		// 1 = protowire.SizeTag(8)
+		size += 1 + protowire.SizeBytes(len(*x.Checked))
+	}
+	if x.Unchecked != nil {
+		// 1 = protowire.SizeTag(9)
+		size += 1 + protowire.SizeBytes(len(*x.Unchecked))
+	}
+	if x.Delimited != nil {
+		// 1 = protowire.SizeTag(10)
+		size += 2*1 + x.Delimited.MarshalSize()
+	}
+	if x.DelimitedList != nil {
+		// 1 = protowire.SizeTag(11)
+		size += 2 * 1 * len(x.DelimitedList)
+		for k := 0; k < len(x.DelimitedList); k++ {
+			size += x.DelimitedList[k].MarshalSize()
+		}
+	}
+	if x.Open != nil {
+		// 1 = protowire.SizeTag(12)
+		size += 1 + protowire.SizeVarint(uint64(*x.Open))
+	}
+	if len(x.ClosedMap) > 0 {
+		for mk, mv := range x.ClosedMap {
+			_ = mk
+			_ = mv
+			// 1 = protowire.SizeTag(13)
+			size += 1
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeVarint(uint64(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeVarint(uint64(mv))
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	if len(x.ImplicitStr) > 0 {
+		// 1 = protowire.SizeTag(14)
+		size += 1 + protowire.SizeBytes(len(x.ImplicitStr))
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *Edition) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.Explicit != nil {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(*x.Explicit))
+	}
+	if x.Implicit != 0 {
+		// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+		data = append(data, 0x10)
+		data = protowire.AppendVarint(data, uint64(x.Implicit))
+	}
+	if x.Req != nil {
+		// data = protowire.AppendTag(data, 3, protowire.VarintType) => 00011000
+		data = append(data, 0x18)
+		data = protowire.AppendVarint(data, uint64(*x.Req))
+	}
+	if len(x.Packed) > 0 {
+		// data = protowire.AppendTag(data, 4, protowire.BytesType) => 00100010
+		data = append(data, 0x22)
+		size := 0
+		for _, v := range x.Packed {
+			size += protowire.SizeVarint(uint64(v))
+		}
+		data = protowire.AppendVarint(data, uint64(size))
+		for _, v := range x.Packed {
+			data = protowire.AppendVarint(data, uint64(v))
+		}
+	}
+	if len(x.Expanded) > 0 {
+		for _, item := range x.Expanded {
+			// data = protowire.AppendTag(data, 5, protowire.VarintType) => 00101000
+			data = append(data, 0x28)
+			data = protowire.AppendVarint(data, uint64(item))
+		}
+	}
+	if x.Closed != nil {
+		// data = protowire.AppendTag(data, 6, protowire.VarintType) => 00110000
+		data = append(data, 0x30)
+		data = protowire.AppendVarint(data, uint64(*x.Closed))
+	}
+	if len(x.ClosedList) > 0 {
+		// data = protowire.AppendTag(data, 7, protowire.BytesType) => 00111010
+		data = append(data, 0x3a)
+		size := 0
+		for _, v := range x.ClosedList {
+			size += protowire.SizeVarint(uint64(v))
+		}
+		data = protowire.AppendVarint(data, uint64(size))
+		for _, v := range x.ClosedList {
+			data = protowire.AppendVarint(data, uint64(v))
+		}
+	}
+	if x.Checked != nil {
+
+		// data = protowire.AppendTag(data, 8, protowire.BytesType) => 01000010
+		data = append(data, 0x42)
+		data = protowire.AppendString(data, *x.Checked)
+	}
+	if x.Unchecked != nil {
+
+		// data = protowire.AppendTag(data, 9, protowire.BytesType) => 01001010
+		data = append(data, 0x4a)
+		data = protowire.AppendString(data, *x.Unchecked)
+	}
+	if x.Delimited != nil {
+		// data = protowire.AppendTag(data, 10, protowire.StartGroupType) => 01010011
+		data = append(data, 0x53)
+		data, err = x.Delimited.MarshalObjectTo(data)
+		if err != nil {
+			return
+		}
+		// data = protowire.AppendTag(data, 10, protowire.EndGroupType) => 01010100
+		data = append(data, 0x54)
+	}
+	if x.DelimitedList != nil {
+		for _, item := range x.DelimitedList {
+			// data = protowire.AppendTag(data, 11, protowire.StartGroupType) => 01011011
+			data = append(data, 0x5b)
+			data, err = item.MarshalObjectTo(data)
+			if err != nil {
+				return
+			}
+			// data = protowire.AppendTag(data, 11, protowire.EndGroupType) => 01011100
+			data = append(data, 0x5c)
+		}
+	}
+	if x.Open != nil {
+		// data = protowire.AppendTag(data, 12, protowire.VarintType) => 01100000
+		data = append(data, 0x60)
+		data = protowire.AppendVarint(data, uint64(*x.Open))
+	}
+	if len(x.ClosedMap) > 0 {
+
+		for mk, mv := range x.ClosedMap {
+			// data = protowire.AppendTag(data, 13, protowire.BytesType) => 01101010
+			data = append(data, 0x6a)
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeVarint(uint64(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeVarint(uint64(mv))
+			data = protowire.AppendVarint(data, uint64(msize))
+			// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+			data = append(data, 0x8)
+			data = protowire.AppendVarint(data, uint64(mk))
+			// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+			data = append(data, 0x10)
+			data = protowire.AppendVarint(data, uint64(mv))
+		}
+	}
+	if len(x.ImplicitStr) > 0 {
+
+		// data = protowire.AppendTag(data, 14, protowire.BytesType) => 01110010
+		data = append(data, 0x72)
+		data = protowire.AppendString(data, x.ImplicitStr)
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *Edition) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.Edition", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "explicit", 1, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "explicit", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.Explicit = &pv
+		case 2:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "implicit", 2, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "implicit", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.Implicit = int32(v)
+		case 3:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "req", 3, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "req", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.Req = &pv
+		case 4:
+			if typ != protowire.BytesType && typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "packed", 4, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Edition", "packed", 4, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				x.Packed = append(x.Packed, int32(v))
+
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "packed", 4, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.Edition", "packed", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.Packed == nil {
+				x.Packed = make([]int32, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Edition", "packed", 4, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.Packed = append(x.Packed, int32(v))
+
+			}
+		case 5:
+			if typ != protowire.BytesType && typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "expanded", 5, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Edition", "expanded", 5, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				x.Expanded = append(x.Expanded, int32(v))
+
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "expanded", 5, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.Edition", "expanded", 5, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.Expanded == nil {
+				x.Expanded = make([]int32, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Edition", "expanded", 5, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.Expanded = append(x.Expanded, int32(v))
+
+			}
+		case 6:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "closed", 6, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv EdClosed
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "closed", 6, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if _, ok := EdClosed_name[int32(v)]; !ok {
+				x.unknownFields = protowire.AppendTag(x.unknownFields, 6, protowire.VarintType)
+				x.unknownFields = protowire.AppendVarint(x.unknownFields, v)
+				continue
+			}
+			pv = EdClosed(v)
+			x.Closed = &pv
+		case 7:
+			if typ != protowire.BytesType && typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "closed_list", 7, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Edition", "closed_list", 7, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				if _, ok := EdClosed_name[int32(v)]; !ok {
+					x.unknownFields = protowire.AppendTag(x.unknownFields, 7, protowire.VarintType)
+					x.unknownFields = protowire.AppendVarint(x.unknownFields, v)
+					index += cnt
+					continue
+				}
+				x.ClosedList = append(x.ClosedList, EdClosed(v))
+
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "closed_list", 7, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.Edition", "closed_list", 7, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.ClosedList == nil {
+				x.ClosedList = make([]EdClosed, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Edition", "closed_list", 7, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				if _, ok := EdClosed_name[int32(v)]; !ok {
+					x.unknownFields = protowire.AppendTag(x.unknownFields, 7, protowire.VarintType)
+					x.unknownFields = protowire.AppendVarint(x.unknownFields, v)
+					continue
+				}
+				x.ClosedList = append(x.ClosedList, EdClosed(v))
+
+			}
+		case 8:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "checked", 8, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "checked", 8, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			if !utf8.ValidString(v) {
+				err = gopb.UTF8Error("gopb.testpb.Edition", "checked", 8, cap(data)-cap(data[index:]))
+				return
+			}
+			index += cnt
+			pv = v
+			x.Checked = &pv
+		case 9:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "unchecked", 9, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "unchecked", 9, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = v
+			x.Unchecked = &pv
+		case 10:
+			if typ != protowire.StartGroupType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "delimited", 10, cap(data)-cap(data[index:]), typ, protowire.StartGroupType)
+				return
+			}
+			v, cnt := protowire.ConsumeGroup(10, data[index:])
+			if cnt < 0 {
+				err = gopb.ParseError("gopb.testpb.Edition", "delimited", 10, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.Delimited == nil {
+				x.Delimited = &Edition_Child{}
+			}
+			err = x.Delimited.UnmarshalObject(v)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.Edition", "delimited", -1, cap(data)-cap(v))
+				return
+			}
+		case 11:
+			if typ != protowire.StartGroupType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "delimited_list", 11, cap(data)-cap(data[index:]), typ, protowire.StartGroupType)
+				return
+			}
+			if typ != protowire.StartGroupType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "delimited_list", 11, cap(data)-cap(data[index:]), typ, protowire.StartGroupType)
+				return
+			}
+			buf, cnt := protowire.ConsumeGroup(11, data[index:])
+			if cnt < 0 {
+				err = gopb.ParseError("gopb.testpb.Edition", "delimited_list", 11, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.DelimitedList == nil {
+				x.DelimitedList = make([]*Edition_Child, 0, 2)
+			}
+			item := &Edition_Child{}
+			err = item.UnmarshalObject(buf)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.Edition", "delimited_list", len(x.DelimitedList), cap(data)-cap(buf))
+				return
+			}
+			x.DelimitedList = append(x.DelimitedList, item)
+
+		case 12:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "open", 12, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv EdOpen
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "open", 12, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = EdOpen(v)
+			x.Open = &pv
+		case 13:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "closed_map", 13, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "closed_map", 13, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.Edition", "closed_map", 13, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.ClosedMap == nil {
+				x.ClosedMap = make(map[int32]EdClosed)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk int32
+			var mv EdClosed
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.Edition", "closed_map", 13, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1:
+					if typ != protowire.VarintType {
+						err = gopb.WireTypeMismatch("gopb.testpb.Edition", "closed_map.key", 1, cap(data)-cap(buf[sindex:]), typ, protowire.VarintType)
+						return
+					}
+					v, cnt := protowire.ConsumeVarint(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.Edition", "closed_map.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					mk = int32(v)
+				case mi == 2:
+					if typ != protowire.VarintType {
+						err = gopb.WireTypeMismatch("gopb.testpb.Edition", "closed_map.value", 2, cap(data)-cap(buf[sindex:]), typ, protowire.VarintType)
+						return
+					}
+					v, cnt := protowire.ConsumeVarint(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.Edition", "closed_map.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					mv = EdClosed(v)
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.Edition", "closed_map", 13, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			// 值为封闭枚举的未知值时, 整个条目保存在未知字段中
+			if _, ok := EdClosed_name[int32(mv)]; !ok {
+				x.unknownFields = protowire.AppendTag(x.unknownFields, 13, protowire.BytesType)
+				x.unknownFields = protowire.AppendBytes(x.unknownFields, buf)
+				continue
+			}
+			x.ClosedMap[mk] = mv
+
+		case 14:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition", "implicit_str", 14, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition", "implicit_str", 14, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			if !utf8.ValidString(v) {
+				err = gopb.UTF8Error("gopb.testpb.Edition", "implicit_str", 14, cap(data)-cap(data[index:]))
+				return
+			}
+			index += cnt
+			x.ImplicitStr = v
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.Edition", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+
+	return
+}
+
+// IsInitialized check all required fields are set, including nested messages
+func (x *Edition) IsInitialized() error {
+	if missing := x.AppendMissingRequired(nil, "Edition"); len(missing) > 0 {
+		return errors.New("required fields not set: " + strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// AppendMissingRequired append the path of required fields not set to missing. path is the path of x
+func (x *Edition) AppendMissingRequired(missing []string, path string) []string {
+	if x.Req == nil {
+		missing = append(missing, path+".req")
+	}
+	return missing
+}
+
+// MarshalObjectChecked marshal data to []byte. return error if any required field is not set
+func (x *Edition) MarshalObjectChecked() (data []byte, err error) {
+	if err = x.IsInitialized(); err != nil {
+		return
+	}
+	return x.MarshalObject()
+}
+
+// UnmarshalObjectChecked unmarshal data from []byte. return error if any required field is not set
+func (x *Edition) UnmarshalObjectChecked(data []byte) (err error) {
+	if err = x.UnmarshalObject(data); err != nil {
+		return
+	}
+	return x.IsInitialized()
+}
+
+type Edition_Child struct {
+	A             *int32         `json:"a,omitempty"`
+	Child         *Edition_Child `json:"child,omitempty"`
+	unknownFields []byte
+}
+
+func (x *Edition_Child) Reset() {
+	*x = Edition_Child{}
+}
+
+func (x *Edition_Child) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+// HasA report whether the field is set
+func (x *Edition_Child) HasA() bool {
+	return x != nil && x.A != nil
+}
+
+// ClearA clear the field
+func (x *Edition_Child) ClearA() {
+	x.A = nil
+}
+
+func (x *Edition_Child) GetChild() *Edition_Child {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+// HasChild report whether the field is set
+func (x *Edition_Child) HasChild() bool {
+	return x != nil && x.Child != nil
+}
+
+// ClearChild clear the field
+func (x *Edition_Child) ClearChild() {
+	x.Child = nil
+}
+
+// Clone returns a deep copy of x
+func (x *Edition_Child) Clone() *Edition_Child {
+	if x == nil {
+		return nil
+	}
+	y := &Edition_Child{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *Edition_Child) CopyFrom(src *Edition_Child) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.A != nil {
+		pv := *src.A
+		x.A = &pv
+	} else {
+		x.A = nil
+	}
+	if src.Child != nil {
+		if x.Child == nil {
+			x.Child = &Edition_Child{}
+		}
+		x.Child.CopyFrom(src.Child)
+	} else {
+		x.Child = nil
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *Edition_Child) Equal(other *Edition_Child) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.A == nil) != (other.A == nil) {
+		return false
+	}
+	if x.A != nil {
+		if *x.A != *other.A {
+			return false
+		}
+	}
+	if !x.Child.Equal(other.Child) {
+		return false
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *Edition_Child) Merge(src *Edition_Child) {
+	if src == nil {
+		return
+	}
+	if src.A != nil {
+		pv := *src.A
+		x.A = &pv
+	}
+	if src.Child != nil {
+		if x.Child == nil {
+			x.Child = &Edition_Child{}
+		}
+		x.Child.Merge(src.Child)
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// MarshalObject marshal data to []byte
+func (x *Edition_Child) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.MarshalObjectTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *Edition_Child) MarshalSize() (size int) {
+	if x.A != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(*x.A))
+	}
+	if x.Child != nil {
+		// 1 = protowire.SizeTag(2)
+		size += 2*1 + x.Child.MarshalSize()
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *Edition_Child) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.A != nil {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(*x.A))
+	}
+	if x.Child != nil {
+		// data = protowire.AppendTag(data, 2, protowire.StartGroupType) => 00010011
+		data = append(data, 0x13)
+		data, err = x.Child.MarshalObjectTo(data)
+		if err != nil {
+			return
+		}
+		// data = protowire.AppendTag(data, 2, protowire.EndGroupType) => 00010100
+		data = append(data, 0x14)
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *Edition_Child) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.Edition.Child", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition.Child", "a", 1, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.Edition.Child", "a", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.A = &pv
+		case 2:
+			if typ != protowire.StartGroupType {
+				err = gopb.WireTypeMismatch("gopb.testpb.Edition.Child", "child", 2, cap(data)-cap(data[index:]), typ, protowire.StartGroupType)
+				return
+			}
+			v, cnt := protowire.ConsumeGroup(2, data[index:])
+			if cnt < 0 {
+				err = gopb.ParseError("gopb.testpb.Edition.Child", "child", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.Child == nil {
+				x.Child = &Edition_Child{}
+			}
+			err = x.Child.UnmarshalObject(v)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.Edition.Child", "child", -1, cap(data)-cap(v))
+				return
+			}
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.Edition.Child", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+
+	return
+}
@@ -0,0 +1,58 @@
+package strict
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/gopb"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/wiretest"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// strict 时map键值的 wire type 与定义不一致返回错误
+func TestUnmarshalMapEntryWireType(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  []byte
+		field string
+		num   protowire.Number
+	}{
+		{"value", wiretest.Bytes(30, wiretest.String(1, "a"), wiretest.Fixed32(2, 1)), "m_str_int.value", 2},
+		{"key", wiretest.Bytes(30, wiretest.Varint(1, 1), wiretest.Varint(2, 1)), "m_str_int.key", 1},
+		{"message value", wiretest.Bytes(32, wiretest.String(1, "a"), wiretest.Varint(2, 1)), "m_str_msg.value", 2},
+		{"int key", wiretest.Bytes(31, wiretest.String(1, "1"), wiretest.String(2, "x")), "m_int_str.key", 1},
+		{"wkt value", wiretest.Bytes(33, wiretest.String(1, "a"), wiretest.Varint(2, 1)), "m_ts.value", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&All{}).UnmarshalObject(tt.data)
+			var de *gopb.DecodeError
+			if !errors.As(err, &de) {
+				t.Fatalf("UnmarshalObject = %v, want *gopb.DecodeError", err)
+			}
+			if de.Kind != gopb.KindWireType || de.Field != tt.field || de.Number != tt.num || !errors.Is(err, gopb.ErrWireType) {
+				t.Errorf("UnmarshalObject = %+v, want field %q number %d", de, tt.field, tt.num)
+			}
+		})
+	}
+}
+
+func TestUnmarshalMapEntry(t *testing.T) {
+	var data []byte
+	data = append(data, wiretest.Bytes(30, wiretest.String(1, "a"), wiretest.Varint(3, 9), wiretest.Varint(2, 1))...)
+	data = append(data, wiretest.Bytes(32, wiretest.String(1, "a"))...)
+	data = append(data, wiretest.Bytes(31, wiretest.String(2, "x"))...)
+	data = append(data, wiretest.Bytes(31, wiretest.String(2, "y"))...)
+	got := &All{}
+	if err := got.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	want := &All{
+		MStrInt: map[string]int32{"a": 1},
+		MStrMsg: map[string]*Inner{"a": {}},
+		MIntStr: map[int32]string{0: "y"},
+	}
+	if !got.Equal(want) || got.MStrMsg["a"] == nil {
+		t.Errorf("UnmarshalObject = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,5012 @@
+// Code generated by protoc-gen-gopb. DO NOT EDIT.
+// Code generated by protoc-gen-gopb. DO NOT EDIT.
+// versions:
+// 	protoc-gen-gopb 0.0.5
+// 	protoc          (unknown)
+// source:  proto2.proto
+
+package strict
+
+import (
+	bytes "bytes"
+	errors "errors"
+	fmt "fmt"
+	gopb "github.com/aggronmagi/protoc-gen-gopb/gopb"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	math "math"
+	strconv "strconv"
+	strings "strings"
+)
+
+type Level int32
+
+const (
+	Level_LEVEL_ZERO Level = 0
+	Level_LOW        Level = 1
+	Level_HIGH       Level = 2
+)
+
+// Enum value maps for Level.
+var (
+	Level_name = map[int32]string{
+		0: "LEVEL_ZERO",
+		1: "LOW",
+		2: "HIGH",
+	}
+	Level_value = map[string]int32{
+		"LEVEL_ZERO": 0,
+		"LOW":        1,
+		"HIGH":       2,
+	}
+)
+
+func (x Level) Enum() *Level {
+	p := new(Level)
+	*p = x
+	return p
+}
+
+func (x Level) String() string {
+	if name, ok := Level_name[int32(x)]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(x), 10)
+}
+
+type P2Oneof struct {
+	O             isP2Oneof_O `json:"o,omitempty"`
+	R             []int32     `json:"r,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2Oneof) Reset() {
+	*x = P2Oneof{}
+}
+
+type isP2Oneof_O interface {
+	isP2Oneof_O()
+	marshalOneofSize() int
+	marshalOneofTo(buf []byte) ([]byte, error)
+	copyOneof() isP2Oneof_O
+	equalOneof(other isP2Oneof_O) bool
+	mergeOneof(dst isP2Oneof_O) isP2Oneof_O
+}
+
+type P2Oneof_A struct {
+	A int32 `json:"a,omitempty"`
+}
+
+func (*P2Oneof_A) isP2Oneof_O() {}
+
+func (x *P2Oneof_A) marshalOneofSize() (size int) {
+	// 1 = protowire.SizeTag(1)
+	size += 1 + protowire.SizeVarint(uint64(x.A))
+	return
+}
+
+func (x *P2Oneof_A) marshalOneofTo(buf []byte) (data []byte, err error) {
+	data = buf
+	// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+	data = append(data, 0x8)
+	data = protowire.AppendVarint(data, uint64(x.A))
+	return
+}
+
+func (x *P2Oneof_A) copyOneof() isP2Oneof_O {
+	y := &P2Oneof_A{}
+	y.A = x.A
+	return y
+}
+
+func (x *P2Oneof_A) equalOneof(other isP2Oneof_O) bool {
+	y, ok := other.(*P2Oneof_A)
+	if !ok {
+		return false
+	}
+	if x.A != y.A {
+		return false
+	}
+	return true
+}
+
+func (x *P2Oneof_A) mergeOneof(dst isP2Oneof_O) isP2Oneof_O {
+	y, ok := dst.(*P2Oneof_A)
+	if !ok {
+		y = &P2Oneof_A{}
+	}
+	y.A = x.A
+	return y
+}
+
+type P2Oneof_B struct {
+	B string `json:"b,omitempty"`
+}
+
+func (*P2Oneof_B) isP2Oneof_O() {}
+
+func (x *P2Oneof_B) marshalOneofSize() (size int) {
+	// 1 = protowire.SizeTag(2)
+	size += 1 + protowire.SizeBytes(len(x.B))
+	return
+}
+
+func (x *P2Oneof_B) marshalOneofTo(buf []byte) (data []byte, err error) {
+	data = buf
+
+	// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+	data = append(data, 0x12)
+	data = protowire.AppendString(data, x.B)
+	return
+}
+
+func (x *P2Oneof_B) copyOneof() isP2Oneof_O {
+	y := &P2Oneof_B{}
+	y.B = x.B
+	return y
+}
+
+func (x *P2Oneof_B) equalOneof(other isP2Oneof_O) bool {
+	y, ok := other.(*P2Oneof_B)
+	if !ok {
+		return false
+	}
+	if x.B != y.B {
+		return false
+	}
+	return true
+}
+
+func (x *P2Oneof_B) mergeOneof(dst isP2Oneof_O) isP2Oneof_O {
+	y, ok := dst.(*P2Oneof_B)
+	if !ok {
+		y = &P2Oneof_B{}
+	}
+	y.B = x.B
+	return y
+}
+
+type P2Oneof_Msg struct {
+	Msg *P2Oneof `json:"msg,omitempty"`
+}
+
+func (*P2Oneof_Msg) isP2Oneof_O() {}
+
+func (x *P2Oneof_Msg) marshalOneofSize() (size int) {
+	if x.Msg != nil {
+		// 1 = protowire.SizeTag(3)
+		size += 1 + protowire.SizeBytes(x.Msg.MarshalSize())
+	}
+	return
+}
+
+func (x *P2Oneof_Msg) marshalOneofTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.Msg != nil {
+		// data = protowire.AppendTag(data, 3, protowire.BytesType) => 00011010
+		data = append(data, 0x1a)
+		data = protowire.AppendVarint(data, uint64(x.Msg.MarshalSize()))
+		data, err = x.Msg.MarshalObjectTo(data)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (x *P2Oneof_Msg) copyOneof() isP2Oneof_O {
+	y := &P2Oneof_Msg{}
+	if x.Msg != nil {
+		if y.Msg == nil {
+			y.Msg = &P2Oneof{}
+		}
+		y.Msg.CopyFrom(x.Msg)
+	} else {
+		y.Msg = nil
+	}
+	return y
+}
+
+func (x *P2Oneof_Msg) equalOneof(other isP2Oneof_O) bool {
+	y, ok := other.(*P2Oneof_Msg)
+	if !ok {
+		return false
+	}
+	if !x.Msg.Equal(y.Msg) {
+		return false
+	}
+	return true
+}
+
+func (x *P2Oneof_Msg) mergeOneof(dst isP2Oneof_O) isP2Oneof_O {
+	y, ok := dst.(*P2Oneof_Msg)
+	if !ok {
+		y = &P2Oneof_Msg{}
+	}
+	if x.Msg != nil {
+		if y.Msg == nil {
+			y.Msg = &P2Oneof{}
+		}
+		y.Msg.Merge(x.Msg)
+	}
+	return y
+}
+
+type P2Oneof_Lv struct {
+	Lv Level `json:"lv,omitempty"`
+}
+
+func (*P2Oneof_Lv) isP2Oneof_O() {}
+
+func (x *P2Oneof_Lv) marshalOneofSize() (size int) {
+	// 1 = protowire.SizeTag(4)
+	size += 1 + protowire.SizeVarint(uint64(x.Lv))
+	return
+}
+
+func (x *P2Oneof_Lv) marshalOneofTo(buf []byte) (data []byte, err error) {
+	data = buf
+	// data = protowire.AppendTag(data, 4, protowire.VarintType) => 00100000
+	data = append(data, 0x20)
+	data = protowire.AppendVarint(data, uint64(x.Lv))
+	return
+}
+
+func (x *P2Oneof_Lv) copyOneof() isP2Oneof_O {
+	y := &P2Oneof_Lv{}
+	y.Lv = x.Lv
+	return y
+}
+
+func (x *P2Oneof_Lv) equalOneof(other isP2Oneof_O) bool {
+	y, ok := other.(*P2Oneof_Lv)
+	if !ok {
+		return false
+	}
+	if x.Lv != y.Lv {
+		return false
+	}
+	return true
+}
+
+func (x *P2Oneof_Lv) mergeOneof(dst isP2Oneof_O) isP2Oneof_O {
+	y, ok := dst.(*P2Oneof_Lv)
+	if !ok {
+		y = &P2Oneof_Lv{}
+	}
+	y.Lv = x.Lv
+	return y
+}
+
+func (x *P2Oneof) GetO() isP2Oneof_O {
+	if x != nil {
+		return x.O
+	}
+	return nil
+}
+
+func (x *P2Oneof) GetA() int32 {
+	if x, ok := x.GetO().(*P2Oneof_A); ok {
+		return x.A
+	}
+	return 0
+}
+
+func (x *P2Oneof) GetB() string {
+	if x, ok := x.GetO().(*P2Oneof_B); ok {
+		return x.B
+	}
+	return ""
+}
+
+func (x *P2Oneof) GetMsg() *P2Oneof {
+	if x, ok := x.GetO().(*P2Oneof_Msg); ok {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *P2Oneof) GetLv() Level {
+	if x, ok := x.GetO().(*P2Oneof_Lv); ok {
+		return x.Lv
+	}
+	return Level_LEVEL_ZERO
+}
+
+func (x *P2Oneof) GetR() []int32 {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Oneof) Clone() *P2Oneof {
+	if x == nil {
+		return nil
+	}
+	y := &P2Oneof{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Oneof) CopyFrom(src *P2Oneof) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.O != nil {
+		x.O = src.O.copyOneof()
+	} else {
+		x.O = nil
+	}
+	x.R = append(x.R[:0], src.R...)
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Oneof) Equal(other *P2Oneof) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.O == nil) != (other.O == nil) || x.O != nil && !x.O.equalOneof(other.O) {
+		return false
+	}
+	if len(x.R) != len(other.R) {
+		return false
+	}
+	for k := range x.R {
+		if x.R[k] != other.R[k] {
+			return false
+		}
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Oneof) Merge(src *P2Oneof) {
+	if src == nil {
+		return
+	}
+	if src.O != nil {
+		x.O = src.O.mergeOneof(x.O)
+	}
+	if len(src.R) > 0 {
+		x.R = append(x.R, src.R...)
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Oneof) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.MarshalObjectTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Oneof) MarshalSize() (size int) {
+	if x.O != nil {
+		size += x.O.marshalOneofSize()
+	}
+	if len(x.R) > 0 {
+		// 1 = protowire.SizeTag(5)
+		size += 1 * len(x.R)
+		for k := 0; k < len(x.R); k++ {
+			size += protowire.SizeVarint(uint64(x.R[k]))
+		}
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Oneof) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.O != nil {
+		data, err = x.O.marshalOneofTo(data)
+		if err != nil {
+			return
+		}
+	}
+	if len(x.R) > 0 {
+		for _, item := range x.R {
+			// data = protowire.AppendTag(data, 5, protowire.VarintType) => 00101000
+			data = append(data, 0x28)
+			data = protowire.AppendVarint(data, uint64(item))
+		}
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *P2Oneof) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Oneof", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Oneof", "a", 1, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			ov, ok := x.O.(*P2Oneof_A)
+			if !ok {
+				ov = &P2Oneof_A{}
+			}
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Oneof", "a", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			ov.A = int32(v)
+			x.O = ov
+		case 2:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Oneof", "b", 2, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			ov, ok := x.O.(*P2Oneof_B)
+			if !ok {
+				ov = &P2Oneof_B{}
+			}
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Oneof", "b", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			ov.B = v
+			x.O = ov
+		case 3:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Oneof", "msg", 3, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			ov, ok := x.O.(*P2Oneof_Msg)
+			if !ok {
+				ov = &P2Oneof_Msg{}
+			}
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.P2Oneof", "msg", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if ov.Msg == nil {
+				ov.Msg = &P2Oneof{}
+			}
+			err = ov.Msg.UnmarshalObject(v)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2Oneof", "msg", -1, cap(data)-cap(v))
+				return
+			}
+			x.O = ov
+		case 4:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Oneof", "lv", 4, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			ov, ok := x.O.(*P2Oneof_Lv)
+			if !ok {
+				ov = &P2Oneof_Lv{}
+			}
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Oneof", "lv", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if _, ok := Level_name[int32(v)]; !ok {
+				x.unknownFields = protowire.AppendTag(x.unknownFields, 4, protowire.VarintType)
+				x.unknownFields = protowire.AppendVarint(x.unknownFields, v)
+				continue
+			}
+			ov.Lv = Level(v)
+			x.O = ov
+		case 5:
+			if typ != protowire.BytesType && typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Oneof", "r", 5, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Oneof", "r", 5, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				x.R = append(x.R, int32(v))
+
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Oneof", "r", 5, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.P2Oneof", "r", 5, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.R == nil {
+				x.R = make([]int32, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Oneof", "r", 5, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.R = append(x.R, int32(v))
+
+			}
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Oneof", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+
+	return
+}
+
+type P2Opt struct {
+	I32           *int32           `json:"i32,omitempty"`
+	I64           *int64           `json:"i64,omitempty"`
+	S             *string          `json:"s,omitempty"`
+	B             []byte           `json:"b,omitempty"`
+	Bl            *bool            `json:"bl,omitempty"`
+	D             *float64         `json:"d,omitempty"`
+	F             *float32         `json:"f,omitempty"`
+	Lv            *Level           `json:"lv,omitempty"`
+	Msg           *P2Opt           `json:"msg,omitempty"`
+	R             []int32          `json:"r,omitempty"`
+	Rp            []int32          `json:"rp,omitempty"`
+	M             map[string]Level `json:"m,omitempty"`
+	S32           *int32           `json:"s32,omitempty"`
+	F64           *uint64          `json:"f64,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2Opt) Reset() {
+	*x = P2Opt{}
+}
+
+func (x *P2Opt) GetI32() int32 {
+	if x != nil && x.I32 != nil {
+		return *x.I32
+	}
+	return 0
+}
+
+// HasI32 report whether the field is set
+func (x *P2Opt) HasI32() bool {
+	return x != nil && x.I32 != nil
+}
+
+// ClearI32 clear the field
+func (x *P2Opt) ClearI32() {
+	x.I32 = nil
+}
+
+func (x *P2Opt) GetI64() int64 {
+	if x != nil && x.I64 != nil {
+		return *x.I64
+	}
+	return 0
+}
+
+// HasI64 report whether the field is set
+func (x *P2Opt) HasI64() bool {
+	return x != nil && x.I64 != nil
+}
+
+// ClearI64 clear the field
+func (x *P2Opt) ClearI64() {
+	x.I64 = nil
+}
+
+func (x *P2Opt) GetS() string {
+	if x != nil && x.S != nil {
+		return *x.S
+	}
+	return ""
+}
+
+// HasS report whether the field is set
+func (x *P2Opt) HasS() bool {
+	return x != nil && x.S != nil
+}
+
+// ClearS clear the field
+func (x *P2Opt) ClearS() {
+	x.S = nil
+}
+
+func (x *P2Opt) GetB() []byte {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+// HasB report whether the field is set
+func (x *P2Opt) HasB() bool {
+	return x != nil && x.B != nil
+}
+
+// ClearB clear the field
+func (x *P2Opt) ClearB() {
+	x.B = nil
+}
+
+func (x *P2Opt) GetBl() bool {
+	if x != nil && x.Bl != nil {
+		return *x.Bl
+	}
+	return false
+}
+
+// HasBl report whether the field is set
+func (x *P2Opt) HasBl() bool {
+	return x != nil && x.Bl != nil
+}
+
+// ClearBl clear the field
+func (x *P2Opt) ClearBl() {
+	x.Bl = nil
+}
+
+func (x *P2Opt) GetD() float64 {
+	if x != nil && x.D != nil {
+		return *x.D
+	}
+	return 0
+}
+
+// HasD report whether the field is set
+func (x *P2Opt) HasD() bool {
+	return x != nil && x.D != nil
+}
+
+// ClearD clear the field
+func (x *P2Opt) ClearD() {
+	x.D = nil
+}
+
+func (x *P2Opt) GetF() float32 {
+	if x != nil && x.F != nil {
+		return *x.F
+	}
+	return 0
+}
+
+// HasF report whether the field is set
+func (x *P2Opt) HasF() bool {
+	return x != nil && x.F != nil
+}
+
+// ClearF clear the field
+func (x *P2Opt) ClearF() {
+	x.F = nil
+}
+
+func (x *P2Opt) GetLv() Level {
+	if x != nil && x.Lv != nil {
+		return *x.Lv
+	}
+	return Level_LEVEL_ZERO
+}
+
+// HasLv report whether the field is set
+func (x *P2Opt) HasLv() bool {
+	return x != nil && x.Lv != nil
+}
+
+// ClearLv clear the field
+func (x *P2Opt) ClearLv() {
+	x.Lv = nil
+}
+
+func (x *P2Opt) GetMsg() *P2Opt {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+// HasMsg report whether the field is set
+func (x *P2Opt) HasMsg() bool {
+	return x != nil && x.Msg != nil
+}
+
+// ClearMsg clear the field
+func (x *P2Opt) ClearMsg() {
+	x.Msg = nil
+}
+
+func (x *P2Opt) GetR() []int32 {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *P2Opt) GetRp() []int32 {
+	if x != nil {
+		return x.Rp
+	}
+	return nil
+}
+
+func (x *P2Opt) GetM() map[string]Level {
+	if x != nil {
+		return x.M
+	}
+	return nil
+}
+
+func (x *P2Opt) GetS32() int32 {
+	if x != nil && x.S32 != nil {
+		return *x.S32
+	}
+	return 0
+}
+
+// HasS32 report whether the field is set
+func (x *P2Opt) HasS32() bool {
+	return x != nil && x.S32 != nil
+}
+
+// ClearS32 clear the field
+func (x *P2Opt) ClearS32() {
+	x.S32 = nil
+}
+
+func (x *P2Opt) GetF64() uint64 {
+	if x != nil && x.F64 != nil {
+		return *x.F64
+	}
+	return 0
+}
+
+// HasF64 report whether the field is set
+func (x *P2Opt) HasF64() bool {
+	return x != nil && x.F64 != nil
+}
+
+// ClearF64 clear the field
+func (x *P2Opt) ClearF64() {
+	x.F64 = nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Opt) Clone() *P2Opt {
+	if x == nil {
+		return nil
+	}
+	y := &P2Opt{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Opt) CopyFrom(src *P2Opt) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.I32 != nil {
+		pv := *src.I32
+		x.I32 = &pv
+	} else {
+		x.I32 = nil
+	}
+	if src.I64 != nil {
+		pv := *src.I64
+		x.I64 = &pv
+	} else {
+		x.I64 = nil
+	}
+	if src.S != nil {
+		pv := *src.S
+		x.S = &pv
+	} else {
+		x.S = nil
+	}
+	if src.B != nil {
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入.
+		// 空的值为 []byte{}, 保留存在性
+		x.B = append([]byte{}, src.B...)
+	} else {
+		x.B = nil
+	}
+	if src.Bl != nil {
+		pv := *src.Bl
+		x.Bl = &pv
+	} else {
+		x.Bl = nil
+	}
+	if src.D != nil {
+		pv := *src.D
+		x.D = &pv
+	} else {
+		x.D = nil
+	}
+	if src.F != nil {
+		pv := *src.F
+		x.F = &pv
+	} else {
+		x.F = nil
+	}
+	if src.Lv != nil {
+		pv := *src.Lv
+		x.Lv = &pv
+	} else {
+		x.Lv = nil
+	}
+	if src.Msg != nil {
+		if x.Msg == nil {
+			x.Msg = &P2Opt{}
+		}
+		x.Msg.CopyFrom(src.Msg)
+	} else {
+		x.Msg = nil
+	}
+	x.R = append(x.R[:0], src.R...)
+	x.Rp = append(x.Rp[:0], src.Rp...)
+	if x.M == nil && src.M != nil {
+		x.M = make(map[string]Level, len(src.M))
+	}
+	for mk := range x.M {
+		delete(x.M, mk)
+	}
+	for mk, mv := range src.M {
+		x.M[mk] = mv
+	}
+	if src.S32 != nil {
+		pv := *src.S32
+		x.S32 = &pv
+	} else {
+		x.S32 = nil
+	}
+	if src.F64 != nil {
+		pv := *src.F64
+		x.F64 = &pv
+	} else {
+		x.F64 = nil
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Opt) Equal(other *P2Opt) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.I32 == nil) != (other.I32 == nil) {
+		return false
+	}
+	if x.I32 != nil {
+		if *x.I32 != *other.I32 {
+			return false
+		}
+	}
+	if (x.I64 == nil) != (other.I64 == nil) {
+		return false
+	}
+	if x.I64 != nil {
+		if *x.I64 != *other.I64 {
+			return false
+		}
+	}
+	if (x.S == nil) != (other.S == nil) {
+		return false
+	}
+	if x.S != nil {
+		if *x.S != *other.S {
+			return false
+		}
+	}
+	if (x.B == nil) != (other.B == nil) || !bytes.Equal(x.B, other.B) {
+		return false
+	}
+	if (x.Bl == nil) != (other.Bl == nil) {
+		return false
+	}
+	if x.Bl != nil {
+		if *x.Bl != *other.Bl {
+			return false
+		}
+	}
+	if (x.D == nil) != (other.D == nil) {
+		return false
+	}
+	if x.D != nil {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if *x.D != *other.D && !(math.IsNaN(float64(*x.D)) && math.IsNaN(float64(*other.D))) {
+			return false
+		}
+	}
+	if (x.F == nil) != (other.F == nil) {
+		return false
+	}
+	if x.F != nil {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if *x.F != *other.F && !(math.IsNaN(float64(*x.F)) && math.IsNaN(float64(*other.F))) {
+			return false
+		}
+	}
+	if (x.Lv == nil) != (other.Lv == nil) {
+		return false
+	}
+	if x.Lv != nil {
+		if *x.Lv != *other.Lv {
+			return false
+		}
+	}
+	if !x.Msg.Equal(other.Msg) {
+		return false
+	}
+	if len(x.R) != len(other.R) {
+		return false
+	}
+	for k := range x.R {
+		if x.R[k] != other.R[k] {
+			return false
+		}
+	}
+	if len(x.Rp) != len(other.Rp) {
+		return false
+	}
+	for k := range x.Rp {
+		if x.Rp[k] != other.Rp[k] {
+			return false
+		}
+	}
+	if len(x.M) != len(other.M) {
+		return false
+	}
+	for mk, mv := range x.M {
+		ov, ok := other.M[mk]
+		if !ok {
+			return false
+		}
+		if mv != ov {
+			return false
+		}
+	}
+	if (x.S32 == nil) != (other.S32 == nil) {
+		return false
+	}
+	if x.S32 != nil {
+		if *x.S32 != *other.S32 {
+			return false
+		}
+	}
+	if (x.F64 == nil) != (other.F64 == nil) {
+		return false
+	}
+	if x.F64 != nil {
+		if *x.F64 != *other.F64 {
+			return false
+		}
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Opt) Merge(src *P2Opt) {
+	if src == nil {
+		return
+	}
+	if src.I32 != nil {
+		pv := *src.I32
+		x.I32 = &pv
+	}
+	if src.I64 != nil {
+		pv := *src.I64
+		x.I64 = &pv
+	}
+	if src.S != nil {
+		pv := *src.S
+		x.S = &pv
+	}
+	if src.B != nil {
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入
+		x.B = append([]byte{}, src.B...)
+	}
+	if src.Bl != nil {
+		pv := *src.Bl
+		x.Bl = &pv
+	}
+	if src.D != nil {
+		pv := *src.D
+		x.D = &pv
+	}
+	if src.F != nil {
+		pv := *src.F
+		x.F = &pv
+	}
+	if src.Lv != nil {
+		pv := *src.Lv
+		x.Lv = &pv
+	}
+	if src.Msg != nil {
+		if x.Msg == nil {
+			x.Msg = &P2Opt{}
+		}
+		x.Msg.Merge(src.Msg)
+	}
+	if len(src.R) > 0 {
+		x.R = append(x.R, src.R...)
+	}
+	if len(src.Rp) > 0 {
+		x.Rp = append(x.Rp, src.Rp...)
+	}
+	if len(src.M) > 0 {
+		if x.M == nil {
+			x.M = make(map[string]Level, len(src.M))
+		}
+		for mk, mv := range src.M {
+			x.M[mk] = mv
+		}
+	}
+	if src.S32 != nil {
+		pv := *src.S32
+		x.S32 = &pv
+	}
+	if src.F64 != nil {
+		pv := *src.F64
+		x.F64 = &pv
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Opt) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.MarshalObjectTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Opt) MarshalSize() (size int) {
+	if x.I32 != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(*x.I32))
+	}
+	if x.I64 != nil {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeVarint(uint64(*x.I64))
+	}
+	if x.S != nil {
+		// 1 = protowire.SizeTag(3)
+		size += 1 + protowire.SizeBytes(len(*x.S))
+	}
+	if x.B != nil {
+		// 1 = protowire.SizeTag(4)
+		size += 1 + protowire.SizeBytes(len(x.B))
+	}
+	if x.Bl != nil {
+		// 1 = protowire.SizeTag(5)
+		size += 1 + 1
+	}
+	if x.D != nil {
+		// 1 = protowire.SizeTag(6)
+		size += 1 + 8
+	}
+	if x.F != nil {
+		// 1 = protowire.SizeTag(7)
+		size += 1 + 4
+	}
+	if x.Lv != nil {
+		// 1 = protowire.SizeTag(8)
+		size += 1 + protowire.SizeVarint(uint64(*x.Lv))
+	}
+	if x.Msg != nil {
+		// 1 = protowire.SizeTag(9)
+		size += 1 + protowire.SizeBytes(x.Msg.MarshalSize())
+	}
+	if len(x.R) > 0 {
+		// 1 = protowire.SizeTag(10)
+		size += 1 * len(x.R)
+		for k := 0; k < len(x.R); k++ {
+			size += protowire.SizeVarint(uint64(x.R[k]))
+		}
+	}
+	if len(x.Rp) > 0 {
+		size += 1 // size += protowire.SizeTag(11)
+		if len(x.Rp) > 0 {
+			fsize := 0
+			for _, item := range x.Rp {
+				fsize += protowire.SizeVarint(uint64(item))
+			}
+			size += protowire.SizeBytes(fsize)
+		}
+	}
+	if len(x.M) > 0 {
+		for mk, mv := range x.M {
+			_ = mk
+			_ = mv
+			// 1 = protowire.SizeTag(12)
+			size += 1
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeVarint(uint64(mv))
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	if x.S32 != nil {
+		// 1 = protowire.SizeTag(13)
+		size += 1 + protowire.SizeVarint(protowire.EncodeZigZag(int64(*x.S32)))
+	}
+	if x.F64 != nil {
+		// 1 = protowire.SizeTag(14)
+		size += 1 + 8
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Opt) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.I32 != nil {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(*x.I32))
+	}
+	if x.I64 != nil {
+		// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+		data = append(data, 0x10)
+		data = protowire.AppendVarint(data, uint64(*x.I64))
+	}
+	if x.S != nil {
+
+		// data = protowire.AppendTag(data, 3, protowire.BytesType) => 00011010
+		data = append(data, 0x1a)
+		data = protowire.AppendString(data, *x.S)
+	}
+	if x.B != nil {
+		// data = protowire.AppendTag(data, 4, protowire.BytesType) => 00100010
+		data = append(data, 0x22)
+		data = protowire.AppendBytes(data, x.B)
+	}
+	if x.Bl != nil {
+		// data = protowire.AppendTag(data, 5, protowire.VarintType) => 00101000
+		data = append(data, 0x28)
+		data = protowire.AppendVarint(data, protowire.EncodeBool(*x.Bl))
+	}
+	if x.D != nil {
+		// data = protowire.AppendTag(data, 6, protowire.Fixed64Type) => 00110001
+		data = append(data, 0x31)
+		data = protowire.AppendFixed64(data, math.Float64bits(*x.D))
+	}
+	if x.F != nil {
+		// data = protowire.AppendTag(data, 7, protowire.Fixed32Type) => 00111101
+		data = append(data, 0x3d)
+		data = protowire.AppendFixed32(data, math.Float32bits(*x.F))
+	}
+	if x.Lv != nil {
+		// data = protowire.AppendTag(data, 8, protowire.VarintType) => 01000000
+		data = append(data, 0x40)
+		data = protowire.AppendVarint(data, uint64(*x.Lv))
+	}
+	if x.Msg != nil {
+		// data = protowire.AppendTag(data, 9, protowire.BytesType) => 01001010
+		data = append(data, 0x4a)
+		data = protowire.AppendVarint(data, uint64(x.Msg.MarshalSize()))
+		data, err = x.Msg.MarshalObjectTo(data)
+		if err != nil {
+			return
+		}
+	}
+	if len(x.R) > 0 {
+		for _, item := range x.R {
+			// data = protowire.AppendTag(data, 10, protowire.VarintType) => 01010000
+			data = append(data, 0x50)
+			data = protowire.AppendVarint(data, uint64(item))
+		}
+	}
+	if len(x.Rp) > 0 {
+		// data = protowire.AppendTag(data, 11, protowire.BytesType) => 01011010
+		data = append(data, 0x5a)
+		size := 0
+		for _, v := range x.Rp {
+			size += protowire.SizeVarint(uint64(v))
+		}
+		data = protowire.AppendVarint(data, uint64(size))
+		for _, v := range x.Rp {
+			data = protowire.AppendVarint(data, uint64(v))
+		}
+	}
+	if len(x.M) > 0 {
+
+		for mk, mv := range x.M {
+			// data = protowire.AppendTag(data, 12, protowire.BytesType) => 01100010
+			data = append(data, 0x62)
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeVarint(uint64(mv))
+			data = protowire.AppendVarint(data, uint64(msize))
+
+			// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+			data = append(data, 0xa)
+			data = protowire.AppendString(data, mk)
+			// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+			data = append(data, 0x10)
+			data = protowire.AppendVarint(data, uint64(mv))
+		}
+	}
+	if x.S32 != nil {
+		// data = protowire.AppendTag(data, 13, protowire.VarintType) => 01101000
+		data = append(data, 0x68)
+		data = protowire.AppendVarint(data, protowire.EncodeZigZag(int64(*x.S32)))
+	}
+	if x.F64 != nil {
+		// data = protowire.AppendTag(data, 14, protowire.Fixed64Type) => 01110001
+		data = append(data, 0x71)
+		data = protowire.AppendFixed64(data, uint64(*x.F64))
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *P2Opt) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Opt", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "i32", 1, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "i32", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.I32 = &pv
+		case 2:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "i64", 2, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv int64
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "i64", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int64(v)
+			x.I64 = &pv
+		case 3:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "s", 3, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "s", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = v
+			x.S = &pv
+		case 4:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "b", 4, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "b", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.B = make([]byte, len(v))
+			copy(x.B, v)
+		case 5:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "bl", 5, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv bool
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "bl", 5, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = protowire.DecodeBool(v)
+			x.Bl = &pv
+		case 6:
+			if typ != protowire.Fixed64Type {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "d", 6, cap(data)-cap(data[index:]), typ, protowire.Fixed64Type)
+				return
+			}
+			var pv float64
+			v, cnt := protowire.ConsumeFixed64(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "d", 6, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = math.Float64frombits(v)
+			x.D = &pv
+		case 7:
+			if typ != protowire.Fixed32Type {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "f", 7, cap(data)-cap(data[index:]), typ, protowire.Fixed32Type)
+				return
+			}
+			var pv float32
+			v, cnt := protowire.ConsumeFixed32(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "f", 7, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = math.Float32frombits(v)
+			x.F = &pv
+		case 8:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "lv", 8, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv Level
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "lv", 8, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if _, ok := Level_name[int32(v)]; !ok {
+				x.unknownFields = protowire.AppendTag(x.unknownFields, 8, protowire.VarintType)
+				x.unknownFields = protowire.AppendVarint(x.unknownFields, v)
+				continue
+			}
+			pv = Level(v)
+			x.Lv = &pv
+		case 9:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "msg", 9, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "msg", 9, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.Msg == nil {
+				x.Msg = &P2Opt{}
+			}
+			err = x.Msg.UnmarshalObject(v)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2Opt", "msg", -1, cap(data)-cap(v))
+				return
+			}
+		case 10:
+			if typ != protowire.BytesType && typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "r", 10, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Opt", "r", 10, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				x.R = append(x.R, int32(v))
+
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "r", 10, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "r", 10, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.R == nil {
+				x.R = make([]int32, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Opt", "r", 10, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.R = append(x.R, int32(v))
+
+			}
+		case 11:
+			if typ != protowire.BytesType && typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "rp", 11, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			// packed=false
+			if typ == protowire.VarintType {
+				v, cnt := protowire.ConsumeVarint(data[index:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Opt", "rp", 11, cap(data)-cap(data[index:]), cnt)
+					return
+				}
+
+				x.Rp = append(x.Rp, int32(v))
+
+				index += cnt
+				continue
+			}
+			// packed = true
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "rp", 11, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "rp", 11, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.Rp == nil {
+				x.Rp = make([]int32, 0, 2)
+			}
+			sub := 0
+			for sub < len(buf) {
+				v, cnt := protowire.ConsumeVarint(buf[sub:])
+				if cnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Opt", "rp", 11, cap(data)-cap(buf[sub:]), cnt)
+					return
+				}
+				sub += cnt
+				x.Rp = append(x.Rp, int32(v))
+
+			}
+		case 12:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "m", 12, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "m", 12, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "m", 12, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.M == nil {
+				x.M = make(map[string]Level)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk string
+			var mv Level
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Opt", "m", 12, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1:
+					if typ != protowire.BytesType {
+						err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "m.key", 1, cap(data)-cap(buf[sindex:]), typ, protowire.BytesType)
+						return
+					}
+
+					v, cnt := protowire.ConsumeString(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.P2Opt", "m.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					mk = v
+				case mi == 2:
+					if typ != protowire.VarintType {
+						err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "m.value", 2, cap(data)-cap(buf[sindex:]), typ, protowire.VarintType)
+						return
+					}
+					v, cnt := protowire.ConsumeVarint(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.P2Opt", "m.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					mv = Level(v)
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.P2Opt", "m", 12, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			// 值为封闭枚举的未知值时, 整个条目保存在未知字段中
+			if _, ok := Level_name[int32(mv)]; !ok {
+				x.unknownFields = protowire.AppendTag(x.unknownFields, 12, protowire.BytesType)
+				x.unknownFields = protowire.AppendBytes(x.unknownFields, buf)
+				continue
+			}
+			x.M[mk] = mv
+
+		case 13:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "s32", 13, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "s32", 13, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(protowire.DecodeZigZag(v))
+			x.S32 = &pv
+		case 14:
+			if typ != protowire.Fixed64Type {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Opt", "f64", 14, cap(data)-cap(data[index:]), typ, protowire.Fixed64Type)
+				return
+			}
+			var pv uint64
+			v, cnt := protowire.ConsumeFixed64(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Opt", "f64", 14, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = uint64(v)
+			x.F64 = &pv
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Opt", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+
+	return
+}
+
+type P2Default struct {
+	I32           *int32   `json:"i32,omitempty"`
+	I64           *int64   `json:"i64,omitempty"`
+	U32           *uint32  `json:"u32,omitempty"`
+	S             *string  `json:"s,omitempty"`
+	B             []byte   `json:"b,omitempty"`
+	Bl            *bool    `json:"bl,omitempty"`
+	D             *float64 `json:"d,omitempty"`
+	F             *float32 `json:"f,omitempty"`
+	Dn            *float64 `json:"dn,omitempty"`
+	Lv            *Level   `json:"lv,omitempty"`
+	F2            *float32 `json:"f2,omitempty"`
+	Sf64          *int64   `json:"sf64,omitempty"`
+	U64           *uint64  `json:"u64,omitempty"`
+	Empty         *string  `json:"empty,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2Default) Reset() {
+	*x = P2Default{}
+}
+
+// Default values for P2Default fields.
+const (
+	Default_P2Default_I32   = int32(-7)
+	Default_P2Default_I64   = int64(1099511627776)
+	Default_P2Default_U32   = uint32(42)
+	Default_P2Default_S     = "hi \"there\"\n"
+	Default_P2Default_Bl    = bool(true)
+	Default_P2Default_Lv    = Level_HIGH
+	Default_P2Default_F2    = float32(1.5)
+	Default_P2Default_Sf64  = int64(-9223372036854775808)
+	Default_P2Default_U64   = uint64(18446744073709551615)
+	Default_P2Default_Empty = ""
+)
+
+// Default values for P2Default fields.
+var (
+	Default_P2Default_B  = []byte("a\x00b")
+	Default_P2Default_D  = float64(math.Inf(+1))
+	Default_P2Default_F  = float32(math.NaN())
+	Default_P2Default_Dn = float64(math.Inf(-1))
+)
+
+func (x *P2Default) GetI32() int32 {
+	if x != nil && x.I32 != nil {
+		return *x.I32
+	}
+	return Default_P2Default_I32
+}
+
+// HasI32 report whether the field is set
+func (x *P2Default) HasI32() bool {
+	return x != nil && x.I32 != nil
+}
+
+// ClearI32 clear the field
+func (x *P2Default) ClearI32() {
+	x.I32 = nil
+}
+
+func (x *P2Default) GetI64() int64 {
+	if x != nil && x.I64 != nil {
+		return *x.I64
+	}
+	return Default_P2Default_I64
+}
+
+// HasI64 report whether the field is set
+func (x *P2Default) HasI64() bool {
+	return x != nil && x.I64 != nil
+}
+
+// ClearI64 clear the field
+func (x *P2Default) ClearI64() {
+	x.I64 = nil
+}
+
+func (x *P2Default) GetU32() uint32 {
+	if x != nil && x.U32 != nil {
+		return *x.U32
+	}
+	return Default_P2Default_U32
+}
+
+// HasU32 report whether the field is set
+func (x *P2Default) HasU32() bool {
+	return x != nil && x.U32 != nil
+}
+
+// ClearU32 clear the field
+func (x *P2Default) ClearU32() {
+	x.U32 = nil
+}
+
+func (x *P2Default) GetS() string {
+	if x != nil && x.S != nil {
+		return *x.S
+	}
+	return Default_P2Default_S
+}
+
+// HasS report whether the field is set
+func (x *P2Default) HasS() bool {
+	return x != nil && x.S != nil
+}
+
+// ClearS clear the field
+func (x *P2Default) ClearS() {
+	x.S = nil
+}
+
+func (x *P2Default) GetB() []byte {
+	if x != nil && x.B != nil {
+		return x.B
+	}
+	return append([]byte(nil), Default_P2Default_B...)
+}
+
+// HasB report whether the field is set
+func (x *P2Default) HasB() bool {
+	return x != nil && x.B != nil
+}
+
+// ClearB clear the field
+func (x *P2Default) ClearB() {
+	x.B = nil
+}
+
+func (x *P2Default) GetBl() bool {
+	if x != nil && x.Bl != nil {
+		return *x.Bl
+	}
+	return Default_P2Default_Bl
+}
+
+// HasBl report whether the field is set
+func (x *P2Default) HasBl() bool {
+	return x != nil && x.Bl != nil
+}
+
+// ClearBl clear the field
+func (x *P2Default) ClearBl() {
+	x.Bl = nil
+}
+
+func (x *P2Default) GetD() float64 {
+	if x != nil && x.D != nil {
+		return *x.D
+	}
+	return Default_P2Default_D
+}
+
+// HasD report whether the field is set
+func (x *P2Default) HasD() bool {
+	return x != nil && x.D != nil
+}
+
+// ClearD clear the field
+func (x *P2Default) ClearD() {
+	x.D = nil
+}
+
+func (x *P2Default) GetF() float32 {
+	if x != nil && x.F != nil {
+		return *x.F
+	}
+	return Default_P2Default_F
+}
+
+// HasF report whether the field is set
+func (x *P2Default) HasF() bool {
+	return x != nil && x.F != nil
+}
+
+// ClearF clear the field
+func (x *P2Default) ClearF() {
+	x.F = nil
+}
+
+func (x *P2Default) GetDn() float64 {
+	if x != nil && x.Dn != nil {
+		return *x.Dn
+	}
+	return Default_P2Default_Dn
+}
+
+// HasDn report whether the field is set
+func (x *P2Default) HasDn() bool {
+	return x != nil && x.Dn != nil
+}
+
+// ClearDn clear the field
+func (x *P2Default) ClearDn() {
+	x.Dn = nil
+}
+
+func (x *P2Default) GetLv() Level {
+	if x != nil && x.Lv != nil {
+		return *x.Lv
+	}
+	return Default_P2Default_Lv
+}
+
+// HasLv report whether the field is set
+func (x *P2Default) HasLv() bool {
+	return x != nil && x.Lv != nil
+}
+
+// ClearLv clear the field
+func (x *P2Default) ClearLv() {
+	x.Lv = nil
+}
+
+func (x *P2Default) GetF2() float32 {
+	if x != nil && x.F2 != nil {
+		return *x.F2
+	}
+	return Default_P2Default_F2
+}
+
+// HasF2 report whether the field is set
+func (x *P2Default) HasF2() bool {
+	return x != nil && x.F2 != nil
+}
+
+// ClearF2 clear the field
+func (x *P2Default) ClearF2() {
+	x.F2 = nil
+}
+
+func (x *P2Default) GetSf64() int64 {
+	if x != nil && x.Sf64 != nil {
+		return *x.Sf64
+	}
+	return Default_P2Default_Sf64
+}
+
+// HasSf64 report whether the field is set
+func (x *P2Default) HasSf64() bool {
+	return x != nil && x.Sf64 != nil
+}
+
+// ClearSf64 clear the field
+func (x *P2Default) ClearSf64() {
+	x.Sf64 = nil
+}
+
+func (x *P2Default) GetU64() uint64 {
+	if x != nil && x.U64 != nil {
+		return *x.U64
+	}
+	return Default_P2Default_U64
+}
+
+// HasU64 report whether the field is set
+func (x *P2Default) HasU64() bool {
+	return x != nil && x.U64 != nil
+}
+
+// ClearU64 clear the field
+func (x *P2Default) ClearU64() {
+	x.U64 = nil
+}
+
+func (x *P2Default) GetEmpty() string {
+	if x != nil && x.Empty != nil {
+		return *x.Empty
+	}
+	return Default_P2Default_Empty
+}
+
+// HasEmpty report whether the field is set
+func (x *P2Default) HasEmpty() bool {
+	return x != nil && x.Empty != nil
+}
+
+// ClearEmpty clear the field
+func (x *P2Default) ClearEmpty() {
+	x.Empty = nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Default) Clone() *P2Default {
+	if x == nil {
+		return nil
+	}
+	y := &P2Default{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Default) CopyFrom(src *P2Default) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.I32 != nil {
+		pv := *src.I32
+		x.I32 = &pv
+	} else {
+		x.I32 = nil
+	}
+	if src.I64 != nil {
+		pv := *src.I64
+		x.I64 = &pv
+	} else {
+		x.I64 = nil
+	}
+	if src.U32 != nil {
+		pv := *src.U32
+		x.U32 = &pv
+	} else {
+		x.U32 = nil
+	}
+	if src.S != nil {
+		pv := *src.S
+		x.S = &pv
+	} else {
+		x.S = nil
+	}
+	if src.B != nil {
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入.
+		// 空的值为 []byte{}, 保留存在性
+		x.B = append([]byte{}, src.B...)
+	} else {
+		x.B = nil
+	}
+	if src.Bl != nil {
+		pv := *src.Bl
+		x.Bl = &pv
+	} else {
+		x.Bl = nil
+	}
+	if src.D != nil {
+		pv := *src.D
+		x.D = &pv
+	} else {
+		x.D = nil
+	}
+	if src.F != nil {
+		pv := *src.F
+		x.F = &pv
+	} else {
+		x.F = nil
+	}
+	if src.Dn != nil {
+		pv := *src.Dn
+		x.Dn = &pv
+	} else {
+		x.Dn = nil
+	}
+	if src.Lv != nil {
+		pv := *src.Lv
+		x.Lv = &pv
+	} else {
+		x.Lv = nil
+	}
+	if src.F2 != nil {
+		pv := *src.F2
+		x.F2 = &pv
+	} else {
+		x.F2 = nil
+	}
+	if src.Sf64 != nil {
+		pv := *src.Sf64
+		x.Sf64 = &pv
+	} else {
+		x.Sf64 = nil
+	}
+	if src.U64 != nil {
+		pv := *src.U64
+		x.U64 = &pv
+	} else {
+		x.U64 = nil
+	}
+	if src.Empty != nil {
+		pv := *src.Empty
+		x.Empty = &pv
+	} else {
+		x.Empty = nil
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Default) Equal(other *P2Default) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.I32 == nil) != (other.I32 == nil) {
+		return false
+	}
+	if x.I32 != nil {
+		if *x.I32 != *other.I32 {
+			return false
+		}
+	}
+	if (x.I64 == nil) != (other.I64 == nil) {
+		return false
+	}
+	if x.I64 != nil {
+		if *x.I64 != *other.I64 {
+			return false
+		}
+	}
+	if (x.U32 == nil) != (other.U32 == nil) {
+		return false
+	}
+	if x.U32 != nil {
+		if *x.U32 != *other.U32 {
+			return false
+		}
+	}
+	if (x.S == nil) != (other.S == nil) {
+		return false
+	}
+	if x.S != nil {
+		if *x.S != *other.S {
+			return false
+		}
+	}
+	if (x.B == nil) != (other.B == nil) || !bytes.Equal(x.B, other.B) {
+		return false
+	}
+	if (x.Bl == nil) != (other.Bl == nil) {
+		return false
+	}
+	if x.Bl != nil {
+		if *x.Bl != *other.Bl {
+			return false
+		}
+	}
+	if (x.D == nil) != (other.D == nil) {
+		return false
+	}
+	if x.D != nil {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if *x.D != *other.D && !(math.IsNaN(float64(*x.D)) && math.IsNaN(float64(*other.D))) {
+			return false
+		}
+	}
+	if (x.F == nil) != (other.F == nil) {
+		return false
+	}
+	if x.F != nil {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if *x.F != *other.F && !(math.IsNaN(float64(*x.F)) && math.IsNaN(float64(*other.F))) {
+			return false
+		}
+	}
+	if (x.Dn == nil) != (other.Dn == nil) {
+		return false
+	}
+	if x.Dn != nil {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if *x.Dn != *other.Dn && !(math.IsNaN(float64(*x.Dn)) && math.IsNaN(float64(*other.Dn))) {
+			return false
+		}
+	}
+	if (x.Lv == nil) != (other.Lv == nil) {
+		return false
+	}
+	if x.Lv != nil {
+		if *x.Lv != *other.Lv {
+			return false
+		}
+	}
+	if (x.F2 == nil) != (other.F2 == nil) {
+		return false
+	}
+	if x.F2 != nil {
+		// NaN 与 NaN 相等, 与 proto.Equal 一致
+		if *x.F2 != *other.F2 && !(math.IsNaN(float64(*x.F2)) && math.IsNaN(float64(*other.F2))) {
+			return false
+		}
+	}
+	if (x.Sf64 == nil) != (other.Sf64 == nil) {
+		return false
+	}
+	if x.Sf64 != nil {
+		if *x.Sf64 != *other.Sf64 {
+			return false
+		}
+	}
+	if (x.U64 == nil) != (other.U64 == nil) {
+		return false
+	}
+	if x.U64 != nil {
+		if *x.U64 != *other.U64 {
+			return false
+		}
+	}
+	if (x.Empty == nil) != (other.Empty == nil) {
+		return false
+	}
+	if x.Empty != nil {
+		if *x.Empty != *other.Empty {
+			return false
+		}
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Default) Merge(src *P2Default) {
+	if src == nil {
+		return
+	}
+	if src.I32 != nil {
+		pv := *src.I32
+		x.I32 = &pv
+	}
+	if src.I64 != nil {
+		pv := *src.I64
+		x.I64 = &pv
+	}
+	if src.U32 != nil {
+		pv := *src.U32
+		x.U32 = &pv
+	}
+	if src.S != nil {
+		pv := *src.S
+		x.S = &pv
+	}
+	if src.B != nil {
+		// 总是分配新的内存: 原来的值可能引用解析时的输入数据(alias 参数), 不能写入
+		x.B = append([]byte{}, src.B...)
+	}
+	if src.Bl != nil {
+		pv := *src.Bl
+		x.Bl = &pv
+	}
+	if src.D != nil {
+		pv := *src.D
+		x.D = &pv
+	}
+	if src.F != nil {
+		pv := *src.F
+		x.F = &pv
+	}
+	if src.Dn != nil {
+		pv := *src.Dn
+		x.Dn = &pv
+	}
+	if src.Lv != nil {
+		pv := *src.Lv
+		x.Lv = &pv
+	}
+	if src.F2 != nil {
+		pv := *src.F2
+		x.F2 = &pv
+	}
+	if src.Sf64 != nil {
+		pv := *src.Sf64
+		x.Sf64 = &pv
+	}
+	if src.U64 != nil {
+		pv := *src.U64
+		x.U64 = &pv
+	}
+	if src.Empty != nil {
+		pv := *src.Empty
+		x.Empty = &pv
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Default) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.MarshalObjectTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Default) MarshalSize() (size int) {
+	if x.I32 != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(*x.I32))
+	}
+	if x.I64 != nil {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeVarint(uint64(*x.I64))
+	}
+	if x.U32 != nil {
+		// 1 = protowire.SizeTag(3)
+		size += 1 + protowire.SizeVarint(uint64(*x.U32))
+	}
+	if x.S != nil {
+		// 1 = protowire.SizeTag(4)
+		size += 1 + protowire.SizeBytes(len(*x.S))
+	}
+	if x.B != nil {
+		// 1 = protowire.SizeTag(5)
+		size += 1 + protowire.SizeBytes(len(x.B))
+	}
+	if x.Bl != nil {
+		// 1 = protowire.SizeTag(6)
+		size += 1 + 1
+	}
+	if x.D != nil {
+		// 1 = protowire.SizeTag(7)
+		size += 1 + 8
+	}
+	if x.F != nil {
+		// 1 = protowire.SizeTag(8)
+		size += 1 + 4
+	}
+	if x.Dn != nil {
+		// 1 = protowire.SizeTag(9)
+		size += 1 + 8
+	}
+	if x.Lv != nil {
+		// 1 = protowire.SizeTag(10)
+		size += 1 + protowire.SizeVarint(uint64(*x.Lv))
+	}
+	if x.F2 != nil {
+		// 1 = protowire.SizeTag(11)
+		size += 1 + 4
+	}
+	if x.Sf64 != nil {
+		// 1 = protowire.SizeTag(12)
+		size += 1 + 8
+	}
+	if x.U64 != nil {
+		// 1 = protowire.SizeTag(13)
+		size += 1 + protowire.SizeVarint(uint64(*x.U64))
+	}
+	if x.Empty != nil {
+		// 1 = protowire.SizeTag(14)
+		size += 1 + protowire.SizeBytes(len(*x.Empty))
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Default) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.I32 != nil {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(*x.I32))
+	}
+	if x.I64 != nil {
+		// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+		data = append(data, 0x10)
+		data = protowire.AppendVarint(data, uint64(*x.I64))
+	}
+	if x.U32 != nil {
+		// data = protowire.AppendTag(data, 3, protowire.VarintType) => 00011000
+		data = append(data, 0x18)
+		data = protowire.AppendVarint(data, uint64(*x.U32))
+	}
+	if x.S != nil {
+
+		// data = protowire.AppendTag(data, 4, protowire.BytesType) => 00100010
+		data = append(data, 0x22)
+		data = protowire.AppendString(data, *x.S)
+	}
+	if x.B != nil {
+		// data = protowire.AppendTag(data, 5, protowire.BytesType) => 00101010
+		data = append(data, 0x2a)
+		data = protowire.AppendBytes(data, x.B)
+	}
+	if x.Bl != nil {
+		// data = protowire.AppendTag(data, 6, protowire.VarintType) => 00110000
+		data = append(data, 0x30)
+		data = protowire.AppendVarint(data, protowire.EncodeBool(*x.Bl))
+	}
+	if x.D != nil {
+		// data = protowire.AppendTag(data, 7, protowire.Fixed64Type) => 00111001
+		data = append(data, 0x39)
+		data = protowire.AppendFixed64(data, math.Float64bits(*x.D))
+	}
+	if x.F != nil {
+		// data = protowire.AppendTag(data, 8, protowire.Fixed32Type) => 01000101
+		data = append(data, 0x45)
+		data = protowire.AppendFixed32(data, math.Float32bits(*x.F))
+	}
+	if x.Dn != nil {
+		// data = protowire.AppendTag(data, 9, protowire.Fixed64Type) => 01001001
+		data = append(data, 0x49)
+		data = protowire.AppendFixed64(data, math.Float64bits(*x.Dn))
+	}
+	if x.Lv != nil {
+		// data = protowire.AppendTag(data, 10, protowire.VarintType) => 01010000
+		data = append(data, 0x50)
+		data = protowire.AppendVarint(data, uint64(*x.Lv))
+	}
+	if x.F2 != nil {
+		// data = protowire.AppendTag(data, 11, protowire.Fixed32Type) => 01011101
+		data = append(data, 0x5d)
+		data = protowire.AppendFixed32(data, math.Float32bits(*x.F2))
+	}
+	if x.Sf64 != nil {
+		// data = protowire.AppendTag(data, 12, protowire.Fixed64Type) => 01100001
+		data = append(data, 0x61)
+		data = protowire.AppendFixed64(data, uint64(*x.Sf64))
+	}
+	if x.U64 != nil {
+		// data = protowire.AppendTag(data, 13, protowire.VarintType) => 01101000
+		data = append(data, 0x68)
+		data = protowire.AppendVarint(data, uint64(*x.U64))
+	}
+	if x.Empty != nil {
+
+		// data = protowire.AppendTag(data, 14, protowire.BytesType) => 01110010
+		data = append(data, 0x72)
+		data = protowire.AppendString(data, *x.Empty)
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *P2Default) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Default", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Default", "i32", 1, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "i32", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.I32 = &pv
+		case 2:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Default", "i64", 2, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv int64
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "i64", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int64(v)
+			x.I64 = &pv
+		case 3:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Default", "u32", 3, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv uint32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "u32", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = uint32(v)
+			x.U32 = &pv
+		case 4:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Default", "s", 4, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "s", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = v
+			x.S = &pv
+		case 5:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Default", "b", 5, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.P2Default", "b", 5, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			x.B = make([]byte, len(v))
+			copy(x.B, v)
+		case 6:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Default", "bl", 6, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv bool
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "bl", 6, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = protowire.DecodeBool(v)
+			x.Bl = &pv
+		case 7:
+			if typ != protowire.Fixed64Type {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Default", "d", 7, cap(data)-cap(data[index:]), typ, protowire.Fixed64Type)
+				return
+			}
+			var pv float64
+			v, cnt := protowire.ConsumeFixed64(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "d", 7, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = math.Float64frombits(v)
+			x.D = &pv
+		case 8:
+			if typ != protowire.Fixed32Type {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Default", "f", 8, cap(data)-cap(data[index:]), typ, protowire.Fixed32Type)
+				return
+			}
+			var pv float32
+			v, cnt := protowire.ConsumeFixed32(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "f", 8, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = math.Float32frombits(v)
+			x.F = &pv
+		case 9:
+			if typ != protowire.Fixed64Type {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Default", "dn", 9, cap(data)-cap(data[index:]), typ, protowire.Fixed64Type)
+				return
+			}
+			var pv float64
+			v, cnt := protowire.ConsumeFixed64(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "dn", 9, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = math.Float64frombits(v)
+			x.Dn = &pv
+		case 10:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Default", "lv", 10, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv Level
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "lv", 10, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if _, ok := Level_name[int32(v)]; !ok {
+				x.unknownFields = protowire.AppendTag(x.unknownFields, 10, protowire.VarintType)
+				x.unknownFields = protowire.AppendVarint(x.unknownFields, v)
+				continue
+			}
+			pv = Level(v)
+			x.Lv = &pv
+		case 11:
+			if typ != protowire.Fixed32Type {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Default", "f2", 11, cap(data)-cap(data[index:]), typ, protowire.Fixed32Type)
+				return
+			}
+			var pv float32
+			v, cnt := protowire.ConsumeFixed32(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "f2", 11, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = math.Float32frombits(v)
+			x.F2 = &pv
+		case 12:
+			if typ != protowire.Fixed64Type {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Default", "sf64", 12, cap(data)-cap(data[index:]), typ, protowire.Fixed64Type)
+				return
+			}
+			var pv int64
+			v, cnt := protowire.ConsumeFixed64(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "sf64", 12, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int64(v)
+			x.Sf64 = &pv
+		case 13:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Default", "u64", 13, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv uint64
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "u64", 13, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = uint64(v)
+			x.U64 = &pv
+		case 14:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Default", "empty", 14, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Default", "empty", 14, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = v
+			x.Empty = &pv
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Default", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+
+	return
+}
+
+type P2Ext struct {
+	A               *int32 `json:"a,omitempty"`
+	extensionFields []byte
+	unknownFields   []byte
+}
+
+func (x *P2Ext) Reset() {
+	*x = P2Ext{}
+}
+
+func (x *P2Ext) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+// HasA report whether the field is set
+func (x *P2Ext) HasA() bool {
+	return x != nil && x.A != nil
+}
+
+// ClearA clear the field
+func (x *P2Ext) ClearA() {
+	x.A = nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Ext) Clone() *P2Ext {
+	if x == nil {
+		return nil
+	}
+	y := &P2Ext{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Ext) CopyFrom(src *P2Ext) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.A != nil {
+		pv := *src.A
+		x.A = &pv
+	} else {
+		x.A = nil
+	}
+	x.extensionFields = append(x.extensionFields[:0], src.extensionFields...)
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Ext) Equal(other *P2Ext) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.A == nil) != (other.A == nil) {
+		return false
+	}
+	if x.A != nil {
+		if *x.A != *other.A {
+			return false
+		}
+	}
+	if !gopb.EqualExtensions(x.extensionFields, other.extensionFields) {
+		return false
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Ext) Merge(src *P2Ext) {
+	if src == nil {
+		return
+	}
+	if src.A != nil {
+		pv := *src.A
+		x.A = &pv
+	}
+	x.extensionFields = append(x.extensionFields, src.extensionFields...)
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Ext) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.MarshalObjectTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Ext) MarshalSize() (size int) {
+	if x.A != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(*x.A))
+	}
+	size += len(x.extensionFields)
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Ext) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.A != nil {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(*x.A))
+	}
+	data = append(data, x.extensionFields...)
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *P2Ext) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "a", 1, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Ext", "a", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.A = &pv
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Ext", "", num, index, cnt)
+			}
+			if 100 <= num && num < 200 {
+				x.extensionFields = protowire.AppendTag(x.extensionFields, num, typ)
+				x.extensionFields = append(x.extensionFields, data[index:index+cnt]...)
+				index += cnt
+				continue
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+
+	return
+}
+
+// HasExtension report whether the extension field num is set
+func (x *P2Ext) HasExtension(num protowire.Number) bool {
+	return len(x.GetExtension(num)) > 0
+}
+
+// GetExtension return the encoded records(tag and value) of the extension field num
+func (x *P2Ext) GetExtension(num protowire.Number) (raw []byte) {
+	if x == nil {
+		return
+	}
+	for index := 0; index < len(x.extensionFields); {
+		n, typ, cnt := protowire.ConsumeTag(x.extensionFields[index:])
+		if cnt < 0 {
+			return
+		}
+		vcnt := protowire.ConsumeFieldValue(n, typ, x.extensionFields[index+cnt:])
+		if vcnt < 0 {
+			return
+		}
+		if n == num {
+			raw = append(raw, x.extensionFields[index:index+cnt+vcnt]...)
+		}
+		index += cnt + vcnt
+	}
+	return
+}
+
+// SetExtension replace the encoded records(tag and value) of the extension field num. nil raw clear the field
+func (x *P2Ext) SetExtension(num protowire.Number, raw []byte) {
+	var fields []byte
+	for index := 0; index < len(x.extensionFields); {
+		n, typ, cnt := protowire.ConsumeTag(x.extensionFields[index:])
+		if cnt < 0 {
+			break
+		}
+		vcnt := protowire.ConsumeFieldValue(n, typ, x.extensionFields[index+cnt:])
+		if vcnt < 0 {
+			break
+		}
+		if n != num {
+			fields = append(fields, x.extensionFields[index:index+cnt+vcnt]...)
+		}
+		index += cnt + vcnt
+	}
+	x.extensionFields = append(fields, raw...)
+}
+
+type P2Group struct {
+	G             *P2Group_G    `json:"g,omitempty"`
+	Rg            []*P2Group_RG `json:"rg,omitempty"`
+	After         *int32        `json:"after,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2Group) Reset() {
+	*x = P2Group{}
+}
+
+func (x *P2Group) GetG() *P2Group_G {
+	if x != nil {
+		return x.G
+	}
+	return nil
+}
+
+// HasG report whether the field is set
+func (x *P2Group) HasG() bool {
+	return x != nil && x.G != nil
+}
+
+// ClearG clear the field
+func (x *P2Group) ClearG() {
+	x.G = nil
+}
+
+func (x *P2Group) GetRg() []*P2Group_RG {
+	if x != nil {
+		return x.Rg
+	}
+	return nil
+}
+
+func (x *P2Group) GetAfter() int32 {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return 0
+}
+
+// HasAfter report whether the field is set
+func (x *P2Group) HasAfter() bool {
+	return x != nil && x.After != nil
+}
+
+// ClearAfter clear the field
+func (x *P2Group) ClearAfter() {
+	x.After = nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Group) Clone() *P2Group {
+	if x == nil {
+		return nil
+	}
+	y := &P2Group{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Group) CopyFrom(src *P2Group) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.G != nil {
+		if x.G == nil {
+			x.G = &P2Group_G{}
+		}
+		x.G.CopyFrom(src.G)
+	} else {
+		x.G = nil
+	}
+	// 只复用原来长度内的元素. 超出长度的部分(例如 Release 截断的列表)可能已被其他消息使用, 置为零值后重新分配
+	if n, old := len(src.Rg), len(x.Rg); n <= old {
+		x.Rg = x.Rg[:n]
+	} else {
+		x.Rg = append(x.Rg, make([]*P2Group_RG, n-old)...)
+	}
+	for k, item := range src.Rg {
+		if item != nil {
+			if x.Rg[k] == nil {
+				x.Rg[k] = &P2Group_RG{}
+			}
+			x.Rg[k].CopyFrom(item)
+		} else {
+			x.Rg[k] = nil
+		}
+	}
+	if src.After != nil {
+		pv := *src.After
+		x.After = &pv
+	} else {
+		x.After = nil
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Group) Equal(other *P2Group) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if !x.G.Equal(other.G) {
+		return false
+	}
+	if len(x.Rg) != len(other.Rg) {
+		return false
+	}
+	for k := range x.Rg {
+		if !x.Rg[k].Equal(other.Rg[k]) {
+			return false
+		}
+	}
+	if (x.After == nil) != (other.After == nil) {
+		return false
+	}
+	if x.After != nil {
+		if *x.After != *other.After {
+			return false
+		}
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Group) Merge(src *P2Group) {
+	if src == nil {
+		return
+	}
+	if src.G != nil {
+		if x.G == nil {
+			x.G = &P2Group_G{}
+		}
+		x.G.Merge(src.G)
+	}
+	if src.Rg != nil {
+		for _, item := range src.Rg {
+			x.Rg = append(x.Rg, nil)
+			if item != nil {
+				if x.Rg[len(x.Rg)-1] == nil {
+					x.Rg[len(x.Rg)-1] = &P2Group_RG{}
+				}
+				x.Rg[len(x.Rg)-1].CopyFrom(item)
+			} else {
+				x.Rg[len(x.Rg)-1] = nil
+			}
+		}
+	}
+	if src.After != nil {
+		pv := *src.After
+		x.After = &pv
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Group) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.MarshalObjectTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Group) MarshalSize() (size int) {
+	if x.G != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 2*1 + x.G.MarshalSize()
+	}
+	if x.Rg != nil {
+		// 1 = protowire.SizeTag(4)
+		size += 2 * 1 * len(x.Rg)
+		for k := 0; k < len(x.Rg); k++ {
+			size += x.Rg[k].MarshalSize()
+		}
+	}
+	if x.After != nil {
+		// 1 = protowire.SizeTag(7)
+		size += 1 + protowire.SizeVarint(uint64(*x.After))
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Group) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.G != nil {
+		// data = protowire.AppendTag(data, 1, protowire.StartGroupType) => 00001011
+		data = append(data, 0xb)
+		data, err = x.G.MarshalObjectTo(data)
+		if err != nil {
+			return
+		}
+		// data = protowire.AppendTag(data, 1, protowire.EndGroupType) => 00001100
+		data = append(data, 0xc)
+	}
+	if x.Rg != nil {
+		for _, item := range x.Rg {
+			// data = protowire.AppendTag(data, 4, protowire.StartGroupType) => 00100011
+			data = append(data, 0x23)
+			data, err = item.MarshalObjectTo(data)
+			if err != nil {
+				return
+			}
+			// data = protowire.AppendTag(data, 4, protowire.EndGroupType) => 00100100
+			data = append(data, 0x24)
+		}
+	}
+	if x.After != nil {
+		// data = protowire.AppendTag(data, 7, protowire.VarintType) => 00111000
+		data = append(data, 0x38)
+		data = protowire.AppendVarint(data, uint64(*x.After))
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *P2Group) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Group", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			if typ != protowire.StartGroupType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Group", "g", 1, cap(data)-cap(data[index:]), typ, protowire.StartGroupType)
+				return
+			}
+			v, cnt := protowire.ConsumeGroup(1, data[index:])
+			if cnt < 0 {
+				err = gopb.ParseError("gopb.testpb.P2Group", "g", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.G == nil {
+				x.G = &P2Group_G{}
+			}
+			err = x.G.UnmarshalObject(v)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2Group", "g", -1, cap(data)-cap(v))
+				return
+			}
+		case 4:
+			if typ != protowire.StartGroupType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Group", "rg", 4, cap(data)-cap(data[index:]), typ, protowire.StartGroupType)
+				return
+			}
+			if typ != protowire.StartGroupType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Group", "rg", 4, cap(data)-cap(data[index:]), typ, protowire.StartGroupType)
+				return
+			}
+			buf, cnt := protowire.ConsumeGroup(4, data[index:])
+			if cnt < 0 {
+				err = gopb.ParseError("gopb.testpb.P2Group", "rg", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.Rg == nil {
+				x.Rg = make([]*P2Group_RG, 0, 2)
+			}
+			item := &P2Group_RG{}
+			err = item.UnmarshalObject(buf)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2Group", "rg", len(x.Rg), cap(data)-cap(buf))
+				return
+			}
+			x.Rg = append(x.Rg, item)
+
+		case 7:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Group", "after", 7, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Group", "after", 7, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.After = &pv
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Group", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+
+	return
+}
+
+type P2Group_G struct {
+	A             *int32  `json:"a,omitempty"`
+	B             *string `json:"b,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2Group_G) Reset() {
+	*x = P2Group_G{}
+}
+
+func (x *P2Group_G) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+// HasA report whether the field is set
+func (x *P2Group_G) HasA() bool {
+	return x != nil && x.A != nil
+}
+
+// ClearA clear the field
+func (x *P2Group_G) ClearA() {
+	x.A = nil
+}
+
+func (x *P2Group_G) GetB() string {
+	if x != nil && x.B != nil {
+		return *x.B
+	}
+	return ""
+}
+
+// HasB report whether the field is set
+func (x *P2Group_G) HasB() bool {
+	return x != nil && x.B != nil
+}
+
+// ClearB clear the field
+func (x *P2Group_G) ClearB() {
+	x.B = nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Group_G) Clone() *P2Group_G {
+	if x == nil {
+		return nil
+	}
+	y := &P2Group_G{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Group_G) CopyFrom(src *P2Group_G) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.A != nil {
+		pv := *src.A
+		x.A = &pv
+	} else {
+		x.A = nil
+	}
+	if src.B != nil {
+		pv := *src.B
+		x.B = &pv
+	} else {
+		x.B = nil
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Group_G) Equal(other *P2Group_G) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.A == nil) != (other.A == nil) {
+		return false
+	}
+	if x.A != nil {
+		if *x.A != *other.A {
+			return false
+		}
+	}
+	if (x.B == nil) != (other.B == nil) {
+		return false
+	}
+	if x.B != nil {
+		if *x.B != *other.B {
+			return false
+		}
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Group_G) Merge(src *P2Group_G) {
+	if src == nil {
+		return
+	}
+	if src.A != nil {
+		pv := *src.A
+		x.A = &pv
+	}
+	if src.B != nil {
+		pv := *src.B
+		x.B = &pv
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Group_G) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.MarshalObjectTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Group_G) MarshalSize() (size int) {
+	if x.A != nil {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeVarint(uint64(*x.A))
+	}
+	if x.B != nil {
+		// 1 = protowire.SizeTag(3)
+		size += 1 + protowire.SizeBytes(len(*x.B))
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Group_G) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.A != nil {
+		// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+		data = append(data, 0x10)
+		data = protowire.AppendVarint(data, uint64(*x.A))
+	}
+	if x.B != nil {
+
+		// data = protowire.AppendTag(data, 3, protowire.BytesType) => 00011010
+		data = append(data, 0x1a)
+		data = protowire.AppendString(data, *x.B)
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *P2Group_G) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Group.G", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 2:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Group.G", "a", 2, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Group.G", "a", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.A = &pv
+		case 3:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Group.G", "b", 3, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Group.G", "b", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = v
+			x.B = &pv
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Group.G", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+
+	return
+}
+
+type P2Group_RG struct {
+	C             *int32   `json:"c,omitempty"`
+	Nested        *P2Group `json:"nested,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2Group_RG) Reset() {
+	*x = P2Group_RG{}
+}
+
+func (x *P2Group_RG) GetC() int32 {
+	if x != nil && x.C != nil {
+		return *x.C
+	}
+	return 0
+}
+
+// HasC report whether the field is set
+func (x *P2Group_RG) HasC() bool {
+	return x != nil && x.C != nil
+}
+
+// ClearC clear the field
+func (x *P2Group_RG) ClearC() {
+	x.C = nil
+}
+
+func (x *P2Group_RG) GetNested() *P2Group {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+// HasNested report whether the field is set
+func (x *P2Group_RG) HasNested() bool {
+	return x != nil && x.Nested != nil
+}
+
+// ClearNested clear the field
+func (x *P2Group_RG) ClearNested() {
+	x.Nested = nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Group_RG) Clone() *P2Group_RG {
+	if x == nil {
+		return nil
+	}
+	y := &P2Group_RG{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Group_RG) CopyFrom(src *P2Group_RG) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.C != nil {
+		pv := *src.C
+		x.C = &pv
+	} else {
+		x.C = nil
+	}
+	if src.Nested != nil {
+		if x.Nested == nil {
+			x.Nested = &P2Group{}
+		}
+		x.Nested.CopyFrom(src.Nested)
+	} else {
+		x.Nested = nil
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Group_RG) Equal(other *P2Group_RG) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.C == nil) != (other.C == nil) {
+		return false
+	}
+	if x.C != nil {
+		if *x.C != *other.C {
+			return false
+		}
+	}
+	if !x.Nested.Equal(other.Nested) {
+		return false
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Group_RG) Merge(src *P2Group_RG) {
+	if src == nil {
+		return
+	}
+	if src.C != nil {
+		pv := *src.C
+		x.C = &pv
+	}
+	if src.Nested != nil {
+		if x.Nested == nil {
+			x.Nested = &P2Group{}
+		}
+		x.Nested.Merge(src.Nested)
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Group_RG) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.MarshalObjectTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Group_RG) MarshalSize() (size int) {
+	if x.C != nil {
+		// 1 = protowire.SizeTag(5)
+		size += 1 + protowire.SizeVarint(uint64(*x.C))
+	}
+	if x.Nested != nil {
+		// 1 = protowire.SizeTag(6)
+		size += 1 + protowire.SizeBytes(x.Nested.MarshalSize())
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Group_RG) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.C != nil {
+		// data = protowire.AppendTag(data, 5, protowire.VarintType) => 00101000
+		data = append(data, 0x28)
+		data = protowire.AppendVarint(data, uint64(*x.C))
+	}
+	if x.Nested != nil {
+		// data = protowire.AppendTag(data, 6, protowire.BytesType) => 00110010
+		data = append(data, 0x32)
+		data = protowire.AppendVarint(data, uint64(x.Nested.MarshalSize()))
+		data, err = x.Nested.MarshalObjectTo(data)
+		if err != nil {
+			return
+		}
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *P2Group_RG) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Group.RG", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 5:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Group.RG", "c", 5, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Group.RG", "c", 5, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.C = &pv
+		case 6:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Group.RG", "nested", 6, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.P2Group.RG", "nested", 6, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.Nested == nil {
+				x.Nested = &P2Group{}
+			}
+			err = x.Nested.UnmarshalObject(v)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2Group.RG", "nested", -1, cap(data)-cap(v))
+				return
+			}
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Group.RG", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+
+	return
+}
+
+type P2Req struct {
+	Id            *int32            `json:"id,omitempty"`
+	Name          *string           `json:"name,omitempty"`
+	Child         *P2Req            `json:"child,omitempty"`
+	Items         []*P2Req          `json:"items,omitempty"`
+	M             map[string]*P2Req `json:"m,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2Req) Reset() {
+	*x = P2Req{}
+}
+
+func (x *P2Req) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+// HasId report whether the field is set
+func (x *P2Req) HasId() bool {
+	return x != nil && x.Id != nil
+}
+
+// ClearId clear the field
+func (x *P2Req) ClearId() {
+	x.Id = nil
+}
+
+func (x *P2Req) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// HasName report whether the field is set
+func (x *P2Req) HasName() bool {
+	return x != nil && x.Name != nil
+}
+
+// ClearName clear the field
+func (x *P2Req) ClearName() {
+	x.Name = nil
+}
+
+func (x *P2Req) GetChild() *P2Req {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+// HasChild report whether the field is set
+func (x *P2Req) HasChild() bool {
+	return x != nil && x.Child != nil
+}
+
+// ClearChild clear the field
+func (x *P2Req) ClearChild() {
+	x.Child = nil
+}
+
+func (x *P2Req) GetItems() []*P2Req {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *P2Req) GetM() map[string]*P2Req {
+	if x != nil {
+		return x.M
+	}
+	return nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2Req) Clone() *P2Req {
+	if x == nil {
+		return nil
+	}
+	y := &P2Req{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2Req) CopyFrom(src *P2Req) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.Id != nil {
+		pv := *src.Id
+		x.Id = &pv
+	} else {
+		x.Id = nil
+	}
+	if src.Name != nil {
+		pv := *src.Name
+		x.Name = &pv
+	} else {
+		x.Name = nil
+	}
+	if src.Child != nil {
+		if x.Child == nil {
+			x.Child = &P2Req{}
+		}
+		x.Child.CopyFrom(src.Child)
+	} else {
+		x.Child = nil
+	}
+	// 只复用原来长度内的元素. 超出长度的部分(例如 Release 截断的列表)可能已被其他消息使用, 置为零值后重新分配
+	if n, old := len(src.Items), len(x.Items); n <= old {
+		x.Items = x.Items[:n]
+	} else {
+		x.Items = append(x.Items, make([]*P2Req, n-old)...)
+	}
+	for k, item := range src.Items {
+		if item != nil {
+			if x.Items[k] == nil {
+				x.Items[k] = &P2Req{}
+			}
+			x.Items[k].CopyFrom(item)
+		} else {
+			x.Items[k] = nil
+		}
+	}
+	if x.M == nil && src.M != nil {
+		x.M = make(map[string]*P2Req, len(src.M))
+	}
+	for mk := range x.M {
+		delete(x.M, mk)
+	}
+	for mk, mv := range src.M {
+		if mv != nil {
+			if x.M[mk] == nil {
+				x.M[mk] = &P2Req{}
+			}
+			x.M[mk].CopyFrom(mv)
+		} else {
+			x.M[mk] = nil
+		}
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2Req) Equal(other *P2Req) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if (x.Id == nil) != (other.Id == nil) {
+		return false
+	}
+	if x.Id != nil {
+		if *x.Id != *other.Id {
+			return false
+		}
+	}
+	if (x.Name == nil) != (other.Name == nil) {
+		return false
+	}
+	if x.Name != nil {
+		if *x.Name != *other.Name {
+			return false
+		}
+	}
+	if !x.Child.Equal(other.Child) {
+		return false
+	}
+	if len(x.Items) != len(other.Items) {
+		return false
+	}
+	for k := range x.Items {
+		if !x.Items[k].Equal(other.Items[k]) {
+			return false
+		}
+	}
+	if len(x.M) != len(other.M) {
+		return false
+	}
+	for mk, mv := range x.M {
+		ov, ok := other.M[mk]
+		if !ok {
+			return false
+		}
+		if !mv.Equal(ov) {
+			return false
+		}
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2Req) Merge(src *P2Req) {
+	if src == nil {
+		return
+	}
+	if src.Id != nil {
+		pv := *src.Id
+		x.Id = &pv
+	}
+	if src.Name != nil {
+		pv := *src.Name
+		x.Name = &pv
+	}
+	if src.Child != nil {
+		if x.Child == nil {
+			x.Child = &P2Req{}
+		}
+		x.Child.Merge(src.Child)
+	}
+	if src.Items != nil {
+		for _, item := range src.Items {
+			x.Items = append(x.Items, nil)
+			if item != nil {
+				if x.Items[len(x.Items)-1] == nil {
+					x.Items[len(x.Items)-1] = &P2Req{}
+				}
+				x.Items[len(x.Items)-1].CopyFrom(item)
+			} else {
+				x.Items[len(x.Items)-1] = nil
+			}
+		}
+	}
+	if len(src.M) > 0 {
+		if x.M == nil {
+			x.M = make(map[string]*P2Req, len(src.M))
+		}
+		for mk, mv := range src.M {
+			if mv != nil {
+				if x.M[mk] == nil {
+					x.M[mk] = &P2Req{}
+				}
+				x.M[mk].CopyFrom(mv)
+			} else {
+				x.M[mk] = nil
+			}
+		}
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2Req) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.MarshalObjectTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2Req) MarshalSize() (size int) {
+	if x.Id != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeVarint(uint64(*x.Id))
+	}
+	if x.Name != nil {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeBytes(len(*x.Name))
+	}
+	if x.Child != nil {
+		// 1 = protowire.SizeTag(3)
+		size += 1 + protowire.SizeBytes(x.Child.MarshalSize())
+	}
+	if x.Items != nil {
+		// 1 = protowire.SizeTag(4)
+		size += 1 * len(x.Items)
+		for k := 0; k < len(x.Items); k++ {
+			size += protowire.SizeBytes(x.Items[k].MarshalSize())
+		}
+	}
+	if len(x.M) > 0 {
+		for mk, mv := range x.M {
+			_ = mk
+			_ = mv
+			// 1 = protowire.SizeTag(5)
+			size += 1
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeBytes(mv.MarshalSize())
+			size += protowire.SizeBytes(msize)
+		}
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2Req) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.Id != nil {
+		// data = protowire.AppendTag(data, 1, protowire.VarintType) => 00001000
+		data = append(data, 0x8)
+		data = protowire.AppendVarint(data, uint64(*x.Id))
+	}
+	if x.Name != nil {
+
+		// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+		data = append(data, 0x12)
+		data = protowire.AppendString(data, *x.Name)
+	}
+	if x.Child != nil {
+		// data = protowire.AppendTag(data, 3, protowire.BytesType) => 00011010
+		data = append(data, 0x1a)
+		data = protowire.AppendVarint(data, uint64(x.Child.MarshalSize()))
+		data, err = x.Child.MarshalObjectTo(data)
+		if err != nil {
+			return
+		}
+	}
+	if x.Items != nil {
+		for _, item := range x.Items {
+			// data = protowire.AppendTag(data, 4, protowire.BytesType) => 00100010
+			data = append(data, 0x22)
+			data = protowire.AppendVarint(data, uint64(item.MarshalSize()))
+			data, err = item.MarshalObjectTo(data)
+			if err != nil {
+				return
+			}
+		}
+	}
+	if len(x.M) > 0 {
+
+		for mk, mv := range x.M {
+			// data = protowire.AppendTag(data, 5, protowire.BytesType) => 00101010
+			data = append(data, 0x2a)
+			msize := 0
+			// 1 = protowire.SizeTag(1)
+			msize += 1 + protowire.SizeBytes(len(mk))
+			// 1 = protowire.SizeTag(2)
+			msize += 1 + protowire.SizeBytes(mv.MarshalSize())
+			data = protowire.AppendVarint(data, uint64(msize))
+
+			// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+			data = append(data, 0xa)
+			data = protowire.AppendString(data, mk)
+			// data = protowire.AppendTag(data, 2, protowire.BytesType) => 00010010
+			data = append(data, 0x12)
+			data = protowire.AppendVarint(data, uint64(mv.MarshalSize()))
+			data, err = mv.MarshalObjectTo(data)
+			if err != nil {
+				return
+			}
+		}
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *P2Req) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Req", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Req", "id", 1, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Req", "id", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.Id = &pv
+		case 2:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Req", "name", 2, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			var pv string
+
+			v, cnt := protowire.ConsumeString(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Req", "name", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = v
+			x.Name = &pv
+		case 3:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Req", "child", 3, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.P2Req", "child", 3, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.Child == nil {
+				x.Child = &P2Req{}
+			}
+			err = x.Child.UnmarshalObject(v)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2Req", "child", -1, cap(data)-cap(v))
+				return
+			}
+		case 4:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Req", "items", 4, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Req", "items", 4, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.P2Req", "items", 4, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.Items == nil {
+				x.Items = make([]*P2Req, 0, 2)
+			}
+			item := &P2Req{}
+			err = item.UnmarshalObject(buf)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2Req", "items", len(x.Items), cap(data)-cap(buf))
+				return
+			}
+			x.Items = append(x.Items, item)
+
+		case 5:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Req", "m", 5, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2Req", "m", 5, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			buf, cnt := protowire.ConsumeBytes(data[index:])
+			if buf == nil {
+				err = gopb.ParseError("gopb.testpb.P2Req", "m", 5, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			if x.M == nil {
+				x.M = make(map[string]*P2Req)
+			}
+
+			// 每个条目的键值从零值开始. 缺少的键值为零值, 消息为空消息
+			var mk string
+			var mv *P2Req
+			for sindex := 0; sindex < len(buf); {
+				mi, typ, scnt := protowire.ConsumeTag(buf[sindex:])
+				if scnt < 1 {
+					err = gopb.ParseError("gopb.testpb.P2Req", "m", 5, cap(data)-cap(buf[sindex:]), scnt)
+					return
+				}
+				sindex += scnt
+				// 严格模式 wire type 不一致时返回错误, 否则与未知字段一样跳过
+				switch {
+				case mi == 1:
+					if typ != protowire.BytesType {
+						err = gopb.WireTypeMismatch("gopb.testpb.P2Req", "m.key", 1, cap(data)-cap(buf[sindex:]), typ, protowire.BytesType)
+						return
+					}
+
+					v, cnt := protowire.ConsumeString(buf[sindex:])
+					if cnt < 1 {
+						err = gopb.ParseError("gopb.testpb.P2Req", "m.key", 1, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					mk = v
+				case mi == 2:
+					if typ != protowire.BytesType {
+						err = gopb.WireTypeMismatch("gopb.testpb.P2Req", "m.value", 2, cap(data)-cap(buf[sindex:]), typ, protowire.BytesType)
+						return
+					}
+					v, cnt := protowire.ConsumeBytes(buf[sindex:])
+					if v == nil {
+						err = gopb.ParseError("gopb.testpb.P2Req", "m.value", 2, cap(data)-cap(buf[sindex:]), cnt)
+						return
+					}
+					sindex += cnt
+					// 多次出现时合并
+					if mv == nil {
+						mv = &P2Req{}
+					}
+					err = mv.UnmarshalObject(v)
+					if err != nil {
+						err = gopb.WrapDecodeError(err, "gopb.testpb.P2Req", "m.value", -1, cap(data)-cap(v))
+						return
+					}
+				default:
+					scnt = protowire.ConsumeFieldValue(mi, typ, buf[sindex:])
+					if scnt < 0 {
+						err = gopb.ParseError("gopb.testpb.P2Req", "m", 5, cap(data)-cap(buf[sindex:]), scnt)
+						return
+					}
+					sindex += scnt
+				}
+			}
+			if mv == nil {
+
+				mv = &P2Req{}
+			}
+			x.M[mk] = mv
+
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2Req", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+
+	return
+}
+
+// IsInitialized check all required fields are set, including nested messages
+func (x *P2Req) IsInitialized() error {
+	if missing := x.AppendMissingRequired(nil, "P2Req"); len(missing) > 0 {
+		return errors.New("required fields not set: " + strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// AppendMissingRequired append the path of required fields not set to missing. path is the path of x
+func (x *P2Req) AppendMissingRequired(missing []string, path string) []string {
+	if x.Id == nil {
+		missing = append(missing, path+".id")
+	}
+	if x.Child != nil {
+		missing = x.Child.AppendMissingRequired(missing, path+".child")
+	}
+	for k, item := range x.Items {
+		if item != nil {
+			missing = item.AppendMissingRequired(missing, path+".items["+strconv.Itoa(k)+"]")
+		}
+	}
+	for k, item := range x.M {
+		if item != nil {
+			missing = item.AppendMissingRequired(missing, path+".m["+fmt.Sprint(k)+"]")
+		}
+	}
+	return missing
+}
+
+// MarshalObjectChecked marshal data to []byte. return error if any required field is not set
+func (x *P2Req) MarshalObjectChecked() (data []byte, err error) {
+	if err = x.IsInitialized(); err != nil {
+		return
+	}
+	return x.MarshalObject()
+}
+
+// UnmarshalObjectChecked unmarshal data from []byte. return error if any required field is not set
+func (x *P2Req) UnmarshalObjectChecked(data []byte) (err error) {
+	if err = x.UnmarshalObject(data); err != nil {
+		return
+	}
+	return x.IsInitialized()
+}
+
+// 不直接包含 required 字段, 通过子消息间接包含.
+type P2ReqHolder struct {
+	Req           *P2Req `json:"req,omitempty"`
+	N             *int32 `json:"n,omitempty"`
+	unknownFields []byte
+}
+
+func (x *P2ReqHolder) Reset() {
+	*x = P2ReqHolder{}
+}
+
+func (x *P2ReqHolder) GetReq() *P2Req {
+	if x != nil {
+		return x.Req
+	}
+	return nil
+}
+
+// HasReq report whether the field is set
+func (x *P2ReqHolder) HasReq() bool {
+	return x != nil && x.Req != nil
+}
+
+// ClearReq clear the field
+func (x *P2ReqHolder) ClearReq() {
+	x.Req = nil
+}
+
+func (x *P2ReqHolder) GetN() int32 {
+	if x != nil && x.N != nil {
+		return *x.N
+	}
+	return 0
+}
+
+// HasN report whether the field is set
+func (x *P2ReqHolder) HasN() bool {
+	return x != nil && x.N != nil
+}
+
+// ClearN clear the field
+func (x *P2ReqHolder) ClearN() {
+	x.N = nil
+}
+
+// Clone returns a deep copy of x
+func (x *P2ReqHolder) Clone() *P2ReqHolder {
+	if x == nil {
+		return nil
+	}
+	y := &P2ReqHolder{}
+	y.CopyFrom(x)
+	return y
+}
+
+// CopyFrom deep copy src to x. reuse the slices, maps and sub-messages of x
+func (x *P2ReqHolder) CopyFrom(src *P2ReqHolder) {
+	if x == src {
+		return
+	}
+	if src == nil {
+		x.Reset()
+		return
+	}
+	if src.Req != nil {
+		if x.Req == nil {
+			x.Req = &P2Req{}
+		}
+		x.Req.CopyFrom(src.Req)
+	} else {
+		x.Req = nil
+	}
+	if src.N != nil {
+		pv := *src.N
+		x.N = &pv
+	} else {
+		x.N = nil
+	}
+	x.unknownFields = append(x.unknownFields[:0], src.unknownFields...)
+}
+
+// Equal reports whether x and other are equal, following the semantics of proto.Equal.
+// NaN is equal to NaN, nil and empty are equal for repeated, map and implicit presence bytes fields.
+func (x *P2ReqHolder) Equal(other *P2ReqHolder) bool {
+	if x == other {
+		return true
+	}
+	if x == nil || other == nil {
+		return false
+	}
+	if !x.Req.Equal(other.Req) {
+		return false
+	}
+	if (x.N == nil) != (other.N == nil) {
+		return false
+	}
+	if x.N != nil {
+		if *x.N != *other.N {
+			return false
+		}
+	}
+	if !bytes.Equal(x.unknownFields, other.unknownFields) {
+		return false
+	}
+	return true
+}
+
+// Merge merges src into x following the protobuf merge rules.
+// 设置了的标量字段覆盖, 列表追加, map按键覆盖, 子消息递归合并. 与不调用 Reset 的 UnmarshalObject 一致
+func (x *P2ReqHolder) Merge(src *P2ReqHolder) {
+	if src == nil {
+		return
+	}
+	if src.Req != nil {
+		if x.Req == nil {
+			x.Req = &P2Req{}
+		}
+		x.Req.Merge(src.Req)
+	}
+	if src.N != nil {
+		pv := *src.N
+		x.N = &pv
+	}
+	x.unknownFields = append(x.unknownFields, src.unknownFields...)
+}
+
+// MarshalObject marshal data to []byte
+func (x *P2ReqHolder) MarshalObject() (data []byte, err error) {
+	data = make([]byte, 0, x.MarshalSize())
+	return x.MarshalObjectTo(data)
+}
+
+// MarshalSize calc marshal data need space
+func (x *P2ReqHolder) MarshalSize() (size int) {
+	if x.Req != nil {
+		// 1 = protowire.SizeTag(1)
+		size += 1 + protowire.SizeBytes(x.Req.MarshalSize())
+	}
+	if x.N != nil {
+		// 1 = protowire.SizeTag(2)
+		size += 1 + protowire.SizeVarint(uint64(*x.N))
+	}
+	size += len(x.unknownFields)
+	return
+}
+
+// MarshalObjectTo marshal data to []byte
+func (x *P2ReqHolder) MarshalObjectTo(buf []byte) (data []byte, err error) {
+	data = buf
+	if x.Req != nil {
+		// data = protowire.AppendTag(data, 1, protowire.BytesType) => 00001010
+		data = append(data, 0xa)
+		data = protowire.AppendVarint(data, uint64(x.Req.MarshalSize()))
+		data, err = x.Req.MarshalObjectTo(data)
+		if err != nil {
+			return
+		}
+	}
+	if x.N != nil {
+		// data = protowire.AppendTag(data, 2, protowire.VarintType) => 00010000
+		data = append(data, 0x10)
+		data = protowire.AppendVarint(data, uint64(*x.N))
+	}
+	data = append(data, x.unknownFields...)
+	return
+}
+
+// UnmarshalObject unmarshal data from []byte
+func (x *P2ReqHolder) UnmarshalObject(data []byte) (err error) {
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2ReqHolder", "", 0, index, cnt)
+			return
+		}
+
+		index += cnt
+		switch num {
+		case 1:
+			if typ != protowire.BytesType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2ReqHolder", "req", 1, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+				return
+			}
+			v, cnt := protowire.ConsumeBytes(data[index:])
+			if v == nil {
+				err = gopb.ParseError("gopb.testpb.P2ReqHolder", "req", 1, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			// 多次出现时合并
+			if x.Req == nil {
+				x.Req = &P2Req{}
+			}
+			err = x.Req.UnmarshalObject(v)
+			if err != nil {
+				err = gopb.WrapDecodeError(err, "gopb.testpb.P2ReqHolder", "req", -1, cap(data)-cap(v))
+				return
+			}
+		case 2:
+			if typ != protowire.VarintType {
+				err = gopb.WireTypeMismatch("gopb.testpb.P2ReqHolder", "n", 2, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+				return
+			}
+			var pv int32
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2ReqHolder", "n", 2, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+			index += cnt
+			pv = int32(v)
+			x.N = &pv
+		default: // skip fields
+			cnt = protowire.ConsumeFieldValue(num, typ, data[index:])
+			if cnt < 0 {
+				return gopb.ParseError("gopb.testpb.P2ReqHolder", "", num, index, cnt)
+			}
+			x.unknownFields = protowire.AppendTag(x.unknownFields, num, typ)
+			x.unknownFields = append(x.unknownFields, data[index:index+cnt]...)
+			index += cnt
+		}
+	}
+
+	return
+}
+
+// IsInitialized check all required fields are set, including nested messages
+func (x *P2ReqHolder) IsInitialized() error {
+	if missing := x.AppendMissingRequired(nil, "P2ReqHolder"); len(missing) > 0 {
+		return errors.New("required fields not set: " + strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// AppendMissingRequired append the path of required fields not set to missing. path is the path of x
+func (x *P2ReqHolder) AppendMissingRequired(missing []string, path string) []string {
+	if x.Req != nil {
+		missing = x.Req.AppendMissingRequired(missing, path+".req")
+	}
+	return missing
+}
+
+// MarshalObjectChecked marshal data to []byte. return error if any required field is not set
+func (x *P2ReqHolder) MarshalObjectChecked() (data []byte, err error) {
+	if err = x.IsInitialized(); err != nil {
+		return
+	}
+	return x.MarshalObject()
+}
+
+// UnmarshalObjectChecked unmarshal data from []byte. return error if any required field is not set
+func (x *P2ReqHolder) UnmarshalObjectChecked(data []byte) (err error) {
+	if err = x.UnmarshalObject(data); err != nil {
+		return
+	}
+	return x.IsInitialized()
+}
+
+// extensionDesc_EInt is the descriptor type of extension gopb.testpb.e_int
+type extensionDesc_EInt struct{}
+
+var E_EInt extensionDesc_EInt
+
+// Number return the field number of extension
+func (extensionDesc_EInt) Number() protowire.Number {
+	return 100
+}
+
+// Name return the full name of extension
+func (extensionDesc_EInt) Name() string {
+	return "gopb.testpb.e_int"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_EInt) Has(x *P2Ext) bool {
+	return x.HasExtension(100)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_EInt) Clear(x *P2Ext) {
+	x.SetExtension(100, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_EInt) Get(x *P2Ext) (val int32, err error) {
+	data := x.GetExtension(100)
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_int]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.VarintType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_int]", 100, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+			return
+		}
+		v, cnt := protowire.ConsumeVarint(data[index:])
+		if cnt < 1 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_int]", 100, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		val = int32(v)
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_EInt) Set(x *P2Ext, val int32) (err error) {
+	var data []byte
+	// data = protowire.AppendTag(data, 100, protowire.VarintType) => 10100000 00000110
+	data = append(data, 0xa0, 0x6)
+	data = protowire.AppendVarint(data, uint64(val))
+	x.SetExtension(100, data)
+	return
+}
+
+// extensionDesc_EStr is the descriptor type of extension gopb.testpb.e_str
+type extensionDesc_EStr struct{}
+
+var E_EStr extensionDesc_EStr
+
+// Number return the field number of extension
+func (extensionDesc_EStr) Number() protowire.Number {
+	return 101
+}
+
+// Name return the full name of extension
+func (extensionDesc_EStr) Name() string {
+	return "gopb.testpb.e_str"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_EStr) Has(x *P2Ext) bool {
+	return x.HasExtension(101)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_EStr) Clear(x *P2Ext) {
+	x.SetExtension(101, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_EStr) Get(x *P2Ext) (val string, err error) {
+	data := x.GetExtension(101)
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_str]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.BytesType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_str]", 101, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+			return
+		}
+
+		v, cnt := protowire.ConsumeString(data[index:])
+		if cnt < 1 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_str]", 101, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		val = v
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_EStr) Set(x *P2Ext, val string) (err error) {
+	var data []byte
+
+	// data = protowire.AppendTag(data, 101, protowire.BytesType) => 10101010 00000110
+	data = append(data, 0xaa, 0x6)
+	data = protowire.AppendString(data, val)
+	x.SetExtension(101, data)
+	return
+}
+
+// extensionDesc_ERep is the descriptor type of extension gopb.testpb.e_rep
+type extensionDesc_ERep struct{}
+
+var E_ERep extensionDesc_ERep
+
+// Number return the field number of extension
+func (extensionDesc_ERep) Number() protowire.Number {
+	return 102
+}
+
+// Name return the full name of extension
+func (extensionDesc_ERep) Name() string {
+	return "gopb.testpb.e_rep"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_ERep) Has(x *P2Ext) bool {
+	return x.HasExtension(102)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_ERep) Clear(x *P2Ext) {
+	x.SetExtension(102, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_ERep) Get(x *P2Ext) (val []int32, err error) {
+	data := x.GetExtension(102)
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_rep]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.BytesType && typ != protowire.VarintType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_rep]", 102, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+			return
+		}
+		// packed=false
+		if typ == protowire.VarintType {
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_rep]", 102, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+
+			val = append(val, int32(v))
+
+			index += cnt
+			continue
+		}
+		// packed = true
+		if typ != protowire.BytesType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_rep]", 102, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+			return
+		}
+		buf, cnt := protowire.ConsumeBytes(data[index:])
+		if buf == nil {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_rep]", 102, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		if val == nil {
+			val = make([]int32, 0, 2)
+		}
+		sub := 0
+		for sub < len(buf) {
+			v, cnt := protowire.ConsumeVarint(buf[sub:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_rep]", 102, cap(data)-cap(buf[sub:]), cnt)
+				return
+			}
+			sub += cnt
+			val = append(val, int32(v))
+
+		}
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_ERep) Set(x *P2Ext, val []int32) (err error) {
+	var data []byte
+	if len(val) > 0 {
+		for _, item := range val {
+			// data = protowire.AppendTag(data, 102, protowire.VarintType) => 10110000 00000110
+			data = append(data, 0xb0, 0x6)
+			data = protowire.AppendVarint(data, uint64(item))
+		}
+	}
+	x.SetExtension(102, data)
+	return
+}
+
+// extensionDesc_EMsg is the descriptor type of extension gopb.testpb.e_msg
+type extensionDesc_EMsg struct{}
+
+var E_EMsg extensionDesc_EMsg
+
+// Number return the field number of extension
+func (extensionDesc_EMsg) Number() protowire.Number {
+	return 103
+}
+
+// Name return the full name of extension
+func (extensionDesc_EMsg) Name() string {
+	return "gopb.testpb.e_msg"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_EMsg) Has(x *P2Ext) bool {
+	return x.HasExtension(103)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_EMsg) Clear(x *P2Ext) {
+	x.SetExtension(103, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_EMsg) Get(x *P2Ext) (val *P2Opt, err error) {
+	data := x.GetExtension(103)
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_msg]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.BytesType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_msg]", 103, cap(data)-cap(data[index:]), typ, protowire.BytesType)
+			return
+		}
+		v, cnt := protowire.ConsumeBytes(data[index:])
+		if v == nil {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_msg]", 103, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		// 多次出现时合并
+		if val == nil {
+			val = &P2Opt{}
+		}
+		err = val.UnmarshalObject(v)
+		if err != nil {
+			err = gopb.WrapDecodeError(err, "gopb.testpb.P2Ext", "[gopb.testpb.e_msg]", -1, cap(data)-cap(v))
+			return
+		}
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_EMsg) Set(x *P2Ext, val *P2Opt) (err error) {
+	var data []byte
+	if val != nil {
+		// data = protowire.AppendTag(data, 103, protowire.BytesType) => 10111010 00000110
+		data = append(data, 0xba, 0x6)
+		data = protowire.AppendVarint(data, uint64(val.MarshalSize()))
+		data, err = val.MarshalObjectTo(data)
+		if err != nil {
+			return
+		}
+	}
+	x.SetExtension(103, data)
+	return
+}
+
+// extensionDesc_EPacked is the descriptor type of extension gopb.testpb.e_packed
+type extensionDesc_EPacked struct{}
+
+var E_EPacked extensionDesc_EPacked
+
+// Number return the field number of extension
+func (extensionDesc_EPacked) Number() protowire.Number {
+	return 104
+}
+
+// Name return the full name of extension
+func (extensionDesc_EPacked) Name() string {
+	return "gopb.testpb.e_packed"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_EPacked) Has(x *P2Ext) bool {
+	return x.HasExtension(104)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_EPacked) Clear(x *P2Ext) {
+	x.SetExtension(104, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_EPacked) Get(x *P2Ext) (val []int32, err error) {
+	data := x.GetExtension(104)
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_packed]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.BytesType && typ != protowire.VarintType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_packed]", 104, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+			return
+		}
+		// packed=false
+		if typ == protowire.VarintType {
+			v, cnt := protowire.ConsumeVarint(data[index:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_packed]", 104, cap(data)-cap(data[index:]), cnt)
+				return
+			}
+
+			val = append(val, int32(v))
+
+			index += cnt
+			continue
+		}
+		// packed = true
+		if typ != protowire.BytesType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_packed]", 104, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+			return
+		}
+		buf, cnt := protowire.ConsumeBytes(data[index:])
+		if buf == nil {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_packed]", 104, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		if val == nil {
+			val = make([]int32, 0, 2)
+		}
+		sub := 0
+		for sub < len(buf) {
+			v, cnt := protowire.ConsumeVarint(buf[sub:])
+			if cnt < 1 {
+				err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_packed]", 104, cap(data)-cap(buf[sub:]), cnt)
+				return
+			}
+			sub += cnt
+			val = append(val, int32(v))
+
+		}
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_EPacked) Set(x *P2Ext, val []int32) (err error) {
+	var data []byte
+	if len(val) > 0 {
+		// data = protowire.AppendTag(data, 104, protowire.BytesType) => 11000010 00000110
+		data = append(data, 0xc2, 0x6)
+		size := 0
+		for _, v := range val {
+			size += protowire.SizeVarint(uint64(v))
+		}
+		data = protowire.AppendVarint(data, uint64(size))
+		for _, v := range val {
+			data = protowire.AppendVarint(data, uint64(v))
+		}
+	}
+	x.SetExtension(104, data)
+	return
+}
+
+// extensionDesc_ELv is the descriptor type of extension gopb.testpb.e_lv
+type extensionDesc_ELv struct{}
+
+var E_ELv extensionDesc_ELv
+
+// Number return the field number of extension
+func (extensionDesc_ELv) Number() protowire.Number {
+	return 105
+}
+
+// Name return the full name of extension
+func (extensionDesc_ELv) Name() string {
+	return "gopb.testpb.e_lv"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_ELv) Has(x *P2Ext) bool {
+	return x.HasExtension(105)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_ELv) Clear(x *P2Ext) {
+	x.SetExtension(105, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_ELv) Get(x *P2Ext) (val Level, err error) {
+	data := x.GetExtension(105)
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_lv]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.VarintType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_lv]", 105, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+			return
+		}
+		v, cnt := protowire.ConsumeVarint(data[index:])
+		if cnt < 1 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_lv]", 105, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		val = Level(v)
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_ELv) Set(x *P2Ext, val Level) (err error) {
+	var data []byte
+	// data = protowire.AppendTag(data, 105, protowire.VarintType) => 11001000 00000110
+	data = append(data, 0xc8, 0x6)
+	data = protowire.AppendVarint(data, uint64(val))
+	x.SetExtension(105, data)
+	return
+}
+
+// extensionDesc_ESint is the descriptor type of extension gopb.testpb.e_sint
+type extensionDesc_ESint struct{}
+
+var E_ESint extensionDesc_ESint
+
+// Number return the field number of extension
+func (extensionDesc_ESint) Number() protowire.Number {
+	return 106
+}
+
+// Name return the full name of extension
+func (extensionDesc_ESint) Name() string {
+	return "gopb.testpb.e_sint"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_ESint) Has(x *P2Ext) bool {
+	return x.HasExtension(106)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_ESint) Clear(x *P2Ext) {
+	x.SetExtension(106, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_ESint) Get(x *P2Ext) (val int64, err error) {
+	data := x.GetExtension(106)
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_sint]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.VarintType {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_sint]", 106, cap(data)-cap(data[index:]), typ, protowire.VarintType)
+			return
+		}
+		v, cnt := protowire.ConsumeVarint(data[index:])
+		if cnt < 1 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_sint]", 106, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		val = int64(protowire.DecodeZigZag(v))
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_ESint) Set(x *P2Ext, val int64) (err error) {
+	var data []byte
+	// data = protowire.AppendTag(data, 106, protowire.VarintType) => 11010000 00000110
+	data = append(data, 0xd0, 0x6)
+	data = protowire.AppendVarint(data, protowire.EncodeZigZag(int64(val)))
+	x.SetExtension(106, data)
+	return
+}
+
+// extensionDesc_EFixed is the descriptor type of extension gopb.testpb.e_fixed
+type extensionDesc_EFixed struct{}
+
+var E_EFixed extensionDesc_EFixed
+
+// Number return the field number of extension
+func (extensionDesc_EFixed) Number() protowire.Number {
+	return 107
+}
+
+// Name return the full name of extension
+func (extensionDesc_EFixed) Name() string {
+	return "gopb.testpb.e_fixed"
+}
+
+// Has report whether the extension is set in x
+func (extensionDesc_EFixed) Has(x *P2Ext) bool {
+	return x.HasExtension(107)
+}
+
+// Clear clear the extension in x
+func (extensionDesc_EFixed) Clear(x *P2Ext) {
+	x.SetExtension(107, nil)
+}
+
+// Get decode the extension value from x
+func (extensionDesc_EFixed) Get(x *P2Ext) (val uint32, err error) {
+	data := x.GetExtension(107)
+	index := 0
+	for index < len(data) {
+		num, typ, cnt := protowire.ConsumeTag(data[index:])
+		if num == 0 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_fixed]", 0, index, cnt)
+			return
+		}
+		index += cnt
+		if typ != protowire.Fixed32Type {
+			err = gopb.WireTypeMismatch("gopb.testpb.P2Ext", "[gopb.testpb.e_fixed]", 107, cap(data)-cap(data[index:]), typ, protowire.Fixed32Type)
+			return
+		}
+		v, cnt := protowire.ConsumeFixed32(data[index:])
+		if cnt < 1 {
+			err = gopb.ParseError("gopb.testpb.P2Ext", "[gopb.testpb.e_fixed]", 107, cap(data)-cap(data[index:]), cnt)
+			return
+		}
+		index += cnt
+		val = uint32(v)
+	}
+	return
+}
+
+// Set encode the extension value to x
+func (extensionDesc_EFixed) Set(x *P2Ext, val uint32) (err error) {
+	var data []byte
+	// data = protowire.AppendTag(data, 107, protowire.Fixed32Type) => 11011101 00000110
+	data = append(data, 0xdd, 0x6)
+	data = protowire.AppendFixed32(data, uint32(val))
+	x.SetExtension(107, data)
+	return
+}
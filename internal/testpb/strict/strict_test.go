@@ -0,0 +1,152 @@
+package strict
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/gopb"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/wiretest"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 严格模式解析 protobuf-go 序列化的数据, 结果与 protobuf-go 一致
+func TestGoldenStrict(t *testing.T) {
+	type message interface {
+		UnmarshalObject([]byte) error
+		MarshalObject() ([]byte, error)
+	}
+	type test struct {
+		name string
+		want proto.Message
+		x    message
+	}
+	var tests []test
+	add := func(name string, want proto.Message, x message) {
+		tests = append(tests, test{name, want, x})
+	}
+	add("all", &golden.All{
+		FInt32: -1, FInt64: 2, FString: "s", FBytes: []byte{1}, FBool: true, FDouble: 1.5, FEnum: golden.Color_GREEN,
+		PInt32: proto.Int32(0), FMsg: &golden.Inner{Id: 1, Nums: []int32{1, 2}}, FFixed32: 3, FSfixed64: -4, FFloat: 2.5,
+		RInt32: []int32{1, -1}, RString: []string{"a", ""}, RMsg: []*golden.Inner{{}, {Id: 2}}, RDouble: []float64{1},
+		RUnpacked: []int32{3, 4}, MStrInt: map[string]int32{"a": 1}, MIntStr: map[int32]string{1: "b"},
+		MStrMsg: map[string]*golden.Inner{"m": {Id: 3}}, MTs: map[string]*timestamppb.Timestamp{"t": timestamppb.Now()},
+		O: &golden.All_OInt{OInt: 5}, FDur: durationpb.New(7),
+	}, &All{})
+	add("proto2", &golden.P2Opt{
+		I32: proto.Int32(1), S: proto.String("s"), Lv: golden.Level_HIGH.Enum(), Msg: &golden.P2Opt{I64: proto.Int64(1)},
+		R: []int32{1, 2}, Rp: []int32{3, 4}, M: map[string]golden.Level{"a": golden.Level_LOW}, S32: proto.Int32(-2), F64: proto.Uint64(9),
+	}, &P2Opt{})
+	add("group", &golden.P2Group{
+		G:  &golden.P2Group_G{A: proto.Int32(1), B: proto.String("b")},
+		Rg: []*golden.P2Group_RG{{C: proto.Int32(2)}, {Nested: &golden.P2Group{After: proto.Int32(3)}}}, After: proto.Int32(4),
+	}, &P2Group{})
+	add("editions", &golden.Edition{
+		Req: proto.Int32(1), Packed: []int32{1, 2}, Expanded: []int32{3},
+		Delimited: &golden.Edition_Child{A: proto.Int32(5), Child: &golden.Edition_Child{}}, DelimitedList: []*golden.Edition_Child{{}},
+	}, &Edition{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := proto.Marshal(tt.want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.x.UnmarshalObject(data); err != nil {
+				t.Fatal(err)
+			}
+			data, err = tt.x.MarshalObject()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := tt.want.ProtoReflect().New().Interface()
+			if err := proto.Unmarshal(data, got); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("round trip = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// wire type 与定义不一致时返回 Kind 为 gopb.KindWireType 的 *gopb.DecodeError
+func TestUnmarshalWireType(t *testing.T) {
+	tests := []struct {
+		name    string
+		x       interface{ UnmarshalObject([]byte) error }
+		data    []byte
+		message string
+		field   string
+		num     protowire.Number
+		typ     protowire.Type
+	}{
+		{"varint as fixed32", &All{}, wiretest.Fixed32(1, 1), "gopb.testpb.All", "f_int32", 1, protowire.Fixed32Type},
+		{"string as varint", &All{}, wiretest.Varint(3, 1), "gopb.testpb.All", "f_string", 3, protowire.VarintType},
+		{"message as varint", &All{}, wiretest.Varint(9, 1), "gopb.testpb.All", "f_msg", 9, protowire.VarintType},
+		{"repeated string as varint", &All{}, wiretest.Varint(21, 1), "gopb.testpb.All", "r_string", 21, protowire.VarintType},
+		{"packed as fixed64", &All{}, wiretest.Fixed64(20, 1), "gopb.testpb.All", "r_int32", 20, protowire.Fixed64Type},
+		{"map as varint", &All{}, wiretest.Varint(30, 1), "gopb.testpb.All", "m_str_int", 30, protowire.VarintType},
+		{"oneof as bytes", &All{}, wiretest.Bytes(42), "gopb.testpb.All", "o_int", 42, protowire.BytesType},
+		{"wkt as varint", &All{}, wiretest.Varint(51, 1), "gopb.testpb.All", "f_ts", 51, protowire.VarintType},
+		{"nested", &All{}, wiretest.Bytes(9, wiretest.Varint(2, 1)), "gopb.testpb.All", "f_msg.name", 2, protowire.VarintType},
+		{"group as bytes", &P2Group{}, wiretest.Bytes(1), "gopb.testpb.P2Group", "g", 1, protowire.BytesType},
+		{"delimited as bytes", &Edition{}, wiretest.Bytes(10), "gopb.testpb.Edition", "delimited", 10, protowire.BytesType},
+		{"proto2 enum as bytes", &P2Opt{}, wiretest.Bytes(8), "gopb.testpb.P2Opt", "lv", 8, protowire.BytesType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.x.UnmarshalObject(tt.data)
+			var de *gopb.DecodeError
+			var we *gopb.WireTypeError
+			if !errors.As(err, &de) || !errors.As(err, &we) || !errors.Is(err, gopb.ErrWireType) {
+				t.Fatalf("UnmarshalObject = %v, want *gopb.DecodeError", err)
+			}
+			if de.Kind != gopb.KindWireType || de.Message != tt.message || de.Field != tt.field || de.Number != tt.num || we.Type != tt.typ {
+				t.Errorf("UnmarshalObject = %+v, want %s.%s number %d type %d", de, tt.message, tt.field, tt.num, tt.typ)
+			}
+			if want := tt.message + "." + tt.field + " ID:"; !strings.Contains(err.Error(), want) {
+				t.Errorf("Error() = %q, want %q", err.Error(), want)
+			}
+		})
+	}
+
+}
+
+// 标量列表同时接受 packed 和非 packed 的数据
+func TestUnmarshalPackedAndUnpacked(t *testing.T) {
+	packed := func(num protowire.Number, vs ...uint64) []byte {
+		var buf []byte
+		for _, v := range vs {
+			buf = protowire.AppendVarint(buf, v)
+		}
+		return wiretest.Bytes(num, buf)
+	}
+	var data []byte
+	data = append(data, wiretest.Varint(20, 1)...)
+	data = append(data, packed(20, 2, 3)...)
+	data = append(data, packed(27, 4, 5)...)
+	data = append(data, wiretest.Varint(27, 6)...)
+	x := &All{}
+	if err := x.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	if !x.Equal(&All{RInt32: []int32{1, 2, 3}, RUnpacked: []int32{4, 5, 6}}) {
+		t.Errorf("UnmarshalObject = %+v", x)
+	}
+
+	data = append(packed(4, 1), wiretest.Varint(4, 2)...)
+	data = append(data, packed(5, 3)...)
+	data = append(data, wiretest.Varint(5, 4)...)
+	data = append(data, wiretest.Varint(3, 0)...)
+	y := &Edition{}
+	if err := y.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	if len(y.Packed) != 2 || len(y.Expanded) != 2 || y.Expanded[1] != 4 {
+		t.Errorf("UnmarshalObject = %+v", y)
+	}
+}
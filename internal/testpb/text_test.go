@@ -0,0 +1,175 @@
+package testpb
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/gopb"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+type textMessage interface {
+	message
+	String() string
+	UnmarshalText(data []byte) error
+}
+
+// checkGoldenText 检查 x 与 protobuf-go 的消息 want 的 text 格式互通
+func checkGoldenText(t *testing.T, x, y textMessage, want proto.Message) {
+	t.Helper()
+	s := x.String()
+	got := want.ProtoReflect().New().Interface()
+	if err := prototext.Unmarshal([]byte(s), got); err != nil {
+		t.Fatalf("prototext.Unmarshal(%s): %v", s, err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("String = %s, want %v", s, want)
+	}
+
+	for _, opts := range []prototext.MarshalOptions{{}, {Multiline: true}} {
+		data, err := opts.Marshal(want)
+		if err != nil {
+			t.Fatalf("prototext.Marshal: %v", err)
+		}
+		if err := y.UnmarshalText(data); err != nil {
+			t.Fatalf("UnmarshalText(%s): %v", data, err)
+		}
+		if got := toGolden(t, y, want); !proto.Equal(got, want) {
+			t.Errorf("UnmarshalText(%s) = %v, want %v", data, got, want)
+		}
+	}
+}
+
+func TestGoldenText(t *testing.T) {
+	// prototext 把 Any 输出为展开的形式
+	x, want := sampleAll(t)
+	checkGoldenText(t, x, &All{}, want)
+
+	special := &All{
+		FInt64: math.MinInt64, FUint64: math.MaxUint64, FDouble: math.Inf(-1), FFloat: float32(math.NaN()),
+		FString: "\"\\\n\x01é", FBytes: []byte{0, 0xff, '\''}, FEnum: 9, RDouble: []float64{math.Inf(1), -0.5},
+		MIntStr: map[int32]string{-1: "a"}, O: &All_OInt{},
+	}
+	checkGoldenText(t, special, &All{}, &golden.All{
+		FInt64: math.MinInt64, FUint64: math.MaxUint64, FDouble: math.Inf(-1), FFloat: float32(math.NaN()),
+		FString: "\"\\\n\x01é", FBytes: []byte{0, 0xff, '\''}, FEnum: 9, RDouble: []float64{math.Inf(1), -0.5},
+		MIntStr: map[int32]string{-1: "a"}, O: &golden.All_OInt{},
+	})
+
+	checkGoldenText(t, &P2Opt{I32: ptr[int32](0), Lv: ptr(Level_HIGH), M: map[string]Level{"a": Level_LOW}, Msg: &P2Opt{}},
+		&P2Opt{}, &golden.P2Opt{I32: proto.Int32(0), Lv: golden.Level_HIGH.Enum(), M: map[string]golden.Level{"a": golden.Level_LOW}, Msg: &golden.P2Opt{}})
+	checkGoldenText(t, &Edition{Req: ptr[int32](1), Closed: ptr(EdClosed_ED_CLOSED_B), Delimited: &Edition_Child{A: ptr[int32](1)}},
+		&Edition{}, &golden.Edition{Req: proto.Int32(1), Closed: golden.EdClosed_ED_CLOSED_B.Enum(), Delimited: &golden.Edition_Child{A: proto.Int32(1)}})
+	checkGoldenText(t, &Empty{}, &Empty{}, &golden.Empty{})
+}
+
+// prototext 接受的其他输入格式
+func TestUnmarshalTextInput(t *testing.T) {
+	for _, data := range []string{
+		`f_int64: 0x10 f_double: inf f_bytes: "\x01\101" f_enum: RED r_int32: [1, 2] r_int32: 3 f_msg <id: 1>`,
+		"# comment\nf_string: 'a' \"b\" f_enum: 1; m_str_int [{key: \"a\" value: 1}, {key: \"b\"}] f_float: -1.5f",
+	} {
+		want := &golden.All{}
+		if err := prototext.Unmarshal([]byte(data), want); err != nil {
+			t.Fatalf("prototext.Unmarshal(%s): %v", data, err)
+		}
+		x := &All{}
+		if err := x.UnmarshalText([]byte(data)); err != nil {
+			t.Fatalf("UnmarshalText(%s): %v", data, err)
+		}
+		if got := toGolden(t, x, want); !proto.Equal(got, want) {
+			t.Errorf("UnmarshalText(%s) = %v, want %v", data, got, want)
+		}
+	}
+}
+
+func TestUnmarshalTextReject(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{"duplicate scalar", `f_int32: 1 f_int32: 2`, `non-repeated field "f_int32" is repeated`},
+		{"duplicate optional", `p_int32: 1 p_int32: 1`, `non-repeated field "p_int32" is repeated`},
+		{"duplicate message", `f_msg {} f_msg {id: 1}`, `non-repeated field "f_msg" is repeated`},
+		{"duplicate timestamp", `f_ts {seconds: 1} f_ts {}`, `non-repeated field "f_ts" is repeated`},
+		{"duplicate seconds", `f_ts {seconds: 1 seconds: 2}`, `non-repeated field "seconds" is repeated`},
+		{"duplicate any", `f_any {type_url: "a" type_url: "b"}`, `non-repeated field "type_url" is repeated`},
+		{"duplicate oneof member", `o_str: "a" o_str: "b"`, `non-repeated field "o_str" is repeated`},
+		{"oneof conflict", `o_str: "a" o_int: 1`, `oneof gopb.testpb.All.o is already set`},
+		{"oneof conflict message", `o_msg {} o_str: "a"`, `oneof gopb.testpb.All.o is already set`},
+		{"duplicate map key", `m_str_int {key: "a" key: "b" value: 1}`, `non-repeated field "key" is repeated`},
+		{"duplicate map value", `m_str_int {key: "a" value: 1 value: 2}`, `non-repeated field "value" is repeated`},
+		{"duplicate wrapper value", `m_i32 {key: "a" value {value: 1 value: 2}}`, `non-repeated field "value" is repeated`},
+		{"nested duplicate", `f_msg {id: 1 id: 2}`, `non-repeated field "id" is repeated`},
+		{"expanded any twice", `f_any {[type.googleapis.com/gopb.testpb.Inner] {} [type.googleapis.com/gopb.testpb.Inner] {}}`, `Any is already set`},
+		{"expanded any and type_url", `f_any {type_url: "type.googleapis.com/gopb.testpb.Inner" [type.googleapis.com/gopb.testpb.Inner] {}}`, `Any is already set`},
+		{"type_url after expanded any", `f_any {[type.googleapis.com/gopb.testpb.Inner] {} value: ""}`, `Any is already set by the expanded form`},
+		{"expanded any not registered", `f_any {[type.googleapis.com/gopb.testpb.Unknown] {}}`, `message gopb.testpb.Unknown is not registered`},
+		{"expanded any unknown field", `f_any {[type.googleapis.com/gopb.testpb.Inner] {x: 1}}`, `unknown field "x"`},
+		{"extension", `[gopb.testpb.ext]: 1`, `extension [gopb.testpb.ext] is not supported`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&All{}).UnmarshalText([]byte(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("UnmarshalText(%s) = %v, want %q", tt.data, err, tt.err)
+			}
+			if err := prototext.Unmarshal([]byte(tt.data), &golden.All{}); err == nil {
+				t.Errorf("prototext.Unmarshal(%s) = nil, want error", tt.data)
+			}
+		})
+	}
+}
+
+func TestUnmarshalTextAccept(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want *All
+	}{
+		{"repeated", `r_int32: 1 r_int32: [2, 3] r_msg {id: 1} r_msg {id: 2}`, &All{RInt32: []int32{1, 2, 3}, RMsg: []*Inner{{Id: 1}, {Id: 2}}}},
+		{"map entries", `m_str_int {key: "a" value: 1} m_str_int {key: "b" value: 2}`, &All{MStrInt: map[string]int32{"a": 1, "b": 2}}},
+		{"expanded any", `f_any {[type.googleapis.com/gopb.testpb.Inner] {id: 1 name: "a"}}`, &All{FAny: &gopb.Any{TypeUrl: "type.googleapis.com/gopb.testpb.Inner", Value: []byte{8, 1, 18, 1, 'a'}}}},
+		{"expanded any wkt", `f_any {[type.googleapis.com/google.protobuf.Duration]: {seconds: 1 nanos: 2}}`,
+			&All{FAny: &gopb.Any{TypeUrl: "type.googleapis.com/google.protobuf.Duration", Value: []byte{8, 1, 16, 2}}}},
+		{"expanded any wrapper", `f_any {[ type.googleapis.com/google.protobuf.Int32Value ] {value: -1}}`,
+			&All{FAny: &gopb.Any{TypeUrl: "type.googleapis.com/google.protobuf.Int32Value", Value: []byte{8, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 1}}}},
+		{"fields", `f_int32: 1 f_msg {id: 2 nums: 1 nums: 2} o_msg {name: "x"}`, &All{FInt32: 1, FMsg: &Inner{Id: 2, Nums: []int32{1, 2}}, O: &All_OMsg{OMsg: &Inner{Name: "x"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &All{}
+			if err := got.UnmarshalText([]byte(tt.data)); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("UnmarshalText(%s) = %v, want %v", tt.data, got, tt.want)
+			}
+			want := &golden.All{}
+			if err := prototext.Unmarshal([]byte(tt.data), want); err != nil {
+				t.Fatal(err)
+			}
+			if m := toGolden(t, got, want); !proto.Equal(m, want) {
+				t.Errorf("UnmarshalText(%s) = %v, want %v", tt.data, m, want)
+			}
+		})
+	}
+}
+
+func TestTextRoundTrip(t *testing.T) {
+	x := &All{FInt32: 1, FString: "a", FMsg: &Inner{Id: 2}, RInt32: []int32{1, 2}, MStrInt: map[string]int32{"a": 1}, O: &All_OInt{OInt: 3}}
+	y := &All{}
+	if err := y.UnmarshalText([]byte(x.String())); err != nil {
+		t.Fatal(err)
+	}
+	if !y.Equal(x) {
+		t.Errorf("UnmarshalText(%s) = %v", x, y)
+	}
+	if err := (&Empty{}).UnmarshalText([]byte(`x: 1`)); err == nil {
+		t.Error("Empty.UnmarshalText accepted unknown field")
+	}
+}
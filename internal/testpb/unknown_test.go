@@ -0,0 +1,59 @@
+package testpb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGoldenUnknown(t *testing.T) {
+	want := &golden.All{
+		FInt32: 1, FInt64: -2, FString: "s", FBytes: []byte{0}, FFixed32: 3, FFixed64: 4, FFloat: 0.5,
+		FMsg:    &golden.Inner{Id: 1, Nums: []int32{1, 2}},
+		RString: []string{"a", "b"}, RMsg: []*golden.Inner{{}, {Name: "n"}}, RUnpacked: []int32{1, 2},
+		MStrInt: map[string]int32{"a": 1}, MIntStr: map[int32]string{1: "x"},
+		O: &golden.All_OStr{OStr: "o"},
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 全部是未知字段时原样写回
+	x := &Empty{}
+	if err := x.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	got, err := x.MarshalObject()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) || x.MarshalSize() != len(data) {
+		t.Errorf("MarshalObject = %x, want %x", got, data)
+	}
+	x.Reset()
+	if size := x.MarshalSize(); size != 0 {
+		t.Errorf("MarshalSize after Reset = %d, want 0", size)
+	}
+
+	// 部分字段已知, 其余字段保留后由 protobuf-go 解析结果不变
+	y := &AllSubset{}
+	if err := y.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	if y.FInt32 != 1 || y.FMsg.GetId() != 1 || len(y.RString) != 2 || y.MStrInt["a"] != 1 {
+		t.Errorf("UnmarshalObject = %+v", y)
+	}
+	if got, err = y.MarshalObject(); err != nil {
+		t.Fatal(err)
+	}
+	back := &golden.All{}
+	if err := proto.Unmarshal(got, back); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(back, want) {
+		t.Errorf("MarshalObject = %v, want %v", back, want)
+	}
+}
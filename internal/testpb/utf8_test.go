@@ -0,0 +1,90 @@
+package testpb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aggronmagi/protoc-gen-gopb/gopb"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// 校验 utf8 的字段与 protobuf-go 一致: proto3 及 editions 默认校验, proto2 及 utf8_validation = NONE 不校验
+func TestGoldenUTF8(t *testing.T) {
+	bytesField := func(num protowire.Number, v []byte) []byte {
+		return protowire.AppendBytes(protowire.AppendTag(nil, num, protowire.BytesType), v)
+	}
+	invalid := []byte{'a', 0xff}
+	// Edition.req 为 LEGACY_REQUIRED
+	req := func(b []byte) []byte {
+		return append(protowire.AppendVarint(protowire.AppendTag(nil, 3, protowire.VarintType), 0), b...)
+	}
+	tests := []struct {
+		name  string
+		x     message
+		want  proto.Message
+		data  []byte
+		field string // 为空时不返回错误
+	}{
+		{"string", &All{}, &golden.All{}, bytesField(3, invalid), "f_string"},
+		{"list", &All{}, &golden.All{}, append(bytesField(21, nil), bytesField(21, invalid)...), "r_string"},
+		{"map key", &All{}, &golden.All{}, bytesField(30, bytesField(1, invalid)), "m_str_int.key"},
+		{"map value", &All{}, &golden.All{}, bytesField(31, bytesField(2, invalid)), "m_int_str.value"},
+		{"oneof", &All{}, &golden.All{}, bytesField(41, invalid), "o_str"},
+		{"nested", &All{}, &golden.All{}, bytesField(9, bytesField(4, bytesField(2, invalid))), "f_msg.child.name"},
+		{"string value", &All{}, &golden.All{}, bytesField(54, bytesField(1, invalid)), "f_sv.value"},
+		{"bytes", &All{}, &golden.All{}, bytesField(4, invalid), ""},
+		{"proto2", &P2Opt{}, &golden.P2Opt{}, bytesField(3, invalid), ""},
+		{"editions checked", &Edition{}, &golden.Edition{}, req(bytesField(8, invalid)), "checked"},
+		{"editions unchecked", &Edition{}, &golden.Edition{}, req(bytesField(9, invalid)), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			goldenErr := proto.Unmarshal(tt.data, tt.want)
+			err := tt.x.UnmarshalObject(tt.data)
+			if tt.field == "" {
+				if err != nil || goldenErr != nil {
+					t.Fatalf("UnmarshalObject = %v, proto.Unmarshal = %v, want nil", err, goldenErr)
+				}
+				checkGolden(t, tt.x, tt.x, tt.want)
+				return
+			}
+			if goldenErr == nil {
+				t.Fatal("proto.Unmarshal = nil, want error")
+			}
+			var de *gopb.DecodeError
+			if !errors.As(err, &de) || !errors.Is(err, gopb.ErrInvalidUTF8) {
+				t.Fatalf("UnmarshalObject = %v, want *gopb.DecodeError", err)
+			}
+			if de.Kind != gopb.KindInvalidUTF8 || de.Field != tt.field {
+				t.Errorf("UnmarshalObject = %+v, want field %q", de, tt.field)
+			}
+		})
+	}
+}
+
+// utf8_marshal 序列化时同样校验
+func TestMarshalUTF8(t *testing.T) {
+	invalid := string([]byte{'a', 0xff})
+	for _, x := range []message{
+		&All{FString: invalid},
+		&All{RString: []string{"", invalid}},
+		&All{MStrInt: map[string]int32{invalid: 1}},
+		&All{O: &All_OStr{OStr: invalid}},
+		&All{FMsg: &Inner{Name: invalid}},
+		&Edition{Req: ptr[int32](0), Checked: &invalid},
+	} {
+		if _, err := x.MarshalObject(); !errors.Is(err, gopb.ErrInvalidUTF8) {
+			t.Errorf("MarshalObject(%v) = %v, want ErrInvalidUTF8", x, err)
+		}
+	}
+	for _, x := range []message{
+		&P2Opt{S: &invalid},
+		&Edition{Req: ptr[int32](0), Unchecked: &invalid},
+	} {
+		if _, err := x.MarshalObject(); err != nil {
+			t.Errorf("MarshalObject(%v) = %v, want nil", x, err)
+		}
+	}
+}
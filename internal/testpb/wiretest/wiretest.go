@@ -0,0 +1,34 @@
+// Package wiretest 构造测试使用的 wire 数据, 用于生成 protobuf-go 无法序列化的数据
+// (例如 wire type 不一致, 缺少键值的 map 条目, 未知字段).
+package wiretest
+
+import "google.golang.org/protobuf/encoding/protowire"
+
+// Varint returns a varint field.
+func Varint(num protowire.Number, v uint64) []byte {
+	return protowire.AppendVarint(protowire.AppendTag(nil, num, protowire.VarintType), v)
+}
+
+// Fixed32 returns a fixed32 field.
+func Fixed32(num protowire.Number, v uint32) []byte {
+	return protowire.AppendFixed32(protowire.AppendTag(nil, num, protowire.Fixed32Type), v)
+}
+
+// Fixed64 returns a fixed64 field.
+func Fixed64(num protowire.Number, v uint64) []byte {
+	return protowire.AppendFixed64(protowire.AppendTag(nil, num, protowire.Fixed64Type), v)
+}
+
+// Bytes returns a length-delimited field whose value is the concatenation of fields.
+func Bytes(num protowire.Number, fields ...[]byte) []byte {
+	var v []byte
+	for _, f := range fields {
+		v = append(v, f...)
+	}
+	return protowire.AppendBytes(protowire.AppendTag(nil, num, protowire.BytesType), v)
+}
+
+// String returns a length-delimited field with the value s.
+func String(num protowire.Number, s string) []byte {
+	return Bytes(num, []byte(s))
+}
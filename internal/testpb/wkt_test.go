@@ -0,0 +1,153 @@
+package testpb
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aggronmagi/protoc-gen-gopb/gopb"
+	"github.com/aggronmagi/protoc-gen-gopb/internal/testpb/golden"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestGoldenWKT(t *testing.T) {
+	ts := time.Unix(-1, 5).UTC()
+	epoch := time.Unix(0, 0).UTC()
+	dur := -1500 * time.Millisecond
+	var zero time.Duration
+	tests := []struct {
+		name string
+		x    *All
+		want *golden.All
+	}{
+		{
+			"values",
+			&All{
+				FTs: &ts, FDur: &dur, FI64: ptr[int64](-1), FSv: ptr("s"), FBv: []byte{1}, FBoolv: ptr(true), FDblv: ptr(0.5),
+				RTs: []*time.Time{&epoch, &ts}, RU32: []*uint32{ptr[uint32](1), ptr[uint32](0)},
+				MTs: map[string]*time.Time{"a": &ts}, MDur: map[string]*time.Duration{"a": &dur},
+				MI32: map[string]*int32{"a": ptr[int32](-2)}, MBytes: map[string][]byte{"a": {2}},
+			},
+			&golden.All{
+				FTs: timestamppb.New(ts), FDur: durationpb.New(dur), FI64: wrapperspb.Int64(-1), FSv: wrapperspb.String("s"),
+				FBv: wrapperspb.Bytes([]byte{1}), FBoolv: wrapperspb.Bool(true), FDblv: wrapperspb.Double(0.5),
+				RTs: []*timestamppb.Timestamp{timestamppb.New(epoch), timestamppb.New(ts)}, RU32: []*wrapperspb.UInt32Value{wrapperspb.UInt32(1), wrapperspb.UInt32(0)},
+				MTs: map[string]*timestamppb.Timestamp{"a": timestamppb.New(ts)}, MDur: map[string]*durationpb.Duration{"a": durationpb.New(dur)},
+				MI32: map[string]*wrapperspb.Int32Value{"a": wrapperspb.Int32(-2)}, MBytes: map[string]*wrapperspb.BytesValue{"a": wrapperspb.Bytes([]byte{2})},
+			},
+		},
+		{
+			// 零值与未设置不同, 序列化为空消息
+			"zero values",
+			&All{FTs: &epoch, FDur: &zero, FI64: ptr[int64](0), FSv: ptr(""), FBv: []byte{}, FBoolv: ptr(false), FDblv: ptr(0.0)},
+			&golden.All{
+				FTs: &timestamppb.Timestamp{}, FDur: &durationpb.Duration{}, FI64: &wrapperspb.Int64Value{}, FSv: &wrapperspb.StringValue{},
+				FBv: &wrapperspb.BytesValue{}, FBoolv: &wrapperspb.BoolValue{}, FDblv: &wrapperspb.DoubleValue{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkGolden(t, tt.x, &All{}, tt.want)
+		})
+	}
+}
+
+func TestUnmarshalWKT(t *testing.T) {
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+	data, err := proto.Marshal(&golden.All{
+		FTs: timestamppb.New(ts), FDur: durationpb.New(-time.Nanosecond), FSv: &wrapperspb.StringValue{}, FBv: &wrapperspb.BytesValue{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := &All{}
+	if err := x.UnmarshalObject(data); err != nil {
+		t.Fatal(err)
+	}
+	if x.FTs == nil || !x.FTs.Equal(ts) || x.FTs.Location() != time.UTC {
+		t.Errorf("FTs = %v, want %v", x.FTs, ts)
+	}
+	if x.FDur == nil || *x.FDur != -time.Nanosecond {
+		t.Errorf("FDur = %v, want -1ns", x.FDur)
+	}
+	if x.FSv == nil || *x.FSv != "" || x.FBv == nil || len(x.FBv) != 0 || x.FI64 != nil {
+		t.Errorf("wrappers = %v %v %v, want set empty values", x.FSv, x.FBv, x.FI64)
+	}
+}
+
+// 超出范围的 Timestamp/Duration 返回 Kind 为 gopb.KindMalformed 的错误, 边界与 CheckValid 一致
+func TestUnmarshalWKTRange(t *testing.T) {
+	const maxDur = math.MaxInt64 / int64(time.Second)
+	tests := []struct {
+		name  string
+		ts    *timestamppb.Timestamp
+		dur   *durationpb.Duration
+		valid bool
+	}{
+		{"min timestamp", &timestamppb.Timestamp{Seconds: -62135596800}, nil, true},
+		{"max timestamp", &timestamppb.Timestamp{Seconds: 253402300799, Nanos: 999999999}, nil, true},
+		{"timestamp before min", &timestamppb.Timestamp{Seconds: -62135596801}, nil, false},
+		{"timestamp after max", &timestamppb.Timestamp{Seconds: 253402300800}, nil, false},
+		{"timestamp negative nanos", &timestamppb.Timestamp{Nanos: -1}, nil, false},
+		{"timestamp nanos overflow", &timestamppb.Timestamp{Nanos: 1e9}, nil, false},
+		{"max duration", nil, &durationpb.Duration{Seconds: maxDur, Nanos: 854775807}, true},
+		{"min duration", nil, &durationpb.Duration{Seconds: -maxDur, Nanos: -854775808}, true},
+		{"duration nanos", nil, &durationpb.Duration{Seconds: 1, Nanos: 999999999}, true},
+		{"duration overflow", nil, &durationpb.Duration{Seconds: maxDur, Nanos: 854775808}, false},
+		{"duration underflow", nil, &durationpb.Duration{Seconds: -maxDur - 1}, false},
+		{"duration nanos overflow", nil, &durationpb.Duration{Nanos: 1e9}, false},
+		{"duration nanos underflow", nil, &durationpb.Duration{Nanos: -1e9}, false},
+		{"duration signs", nil, &durationpb.Duration{Seconds: 1, Nanos: -1}, false},
+		{"duration negative signs", nil, &durationpb.Duration{Seconds: -1, Nanos: 1}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// time.Duration 的范围小于 Duration, 超出 time.Duration 时 CheckValid 仍然通过
+			if tt.ts != nil && (tt.ts.CheckValid() == nil) != tt.valid {
+				t.Fatalf("timestamppb.CheckValid = %v, want valid %v", tt.ts.CheckValid(), tt.valid)
+			}
+			if tt.dur != nil && tt.valid && tt.dur.CheckValid() != nil {
+				t.Fatalf("durationpb.CheckValid = %v, want nil", tt.dur.CheckValid())
+			}
+			data, err := proto.Marshal(&golden.All{FTs: tt.ts, FDur: tt.dur})
+			if err != nil {
+				t.Fatal(err)
+			}
+			x := &All{}
+			err = x.UnmarshalObject(data)
+			if tt.valid {
+				if err != nil {
+					t.Fatal(err)
+				}
+				checkGolden(t, x, &All{}, &golden.All{FTs: tt.ts, FDur: tt.dur})
+				return
+			}
+			var de *gopb.DecodeError
+			if !errors.As(err, &de) || !errors.Is(err, gopb.ErrInvalidTime) || de.Kind != gopb.KindMalformed {
+				t.Fatalf("UnmarshalObject = %v, want *gopb.DecodeError", err)
+			}
+			if field := map[bool]string{true: "f_ts", false: "f_dur"}[tt.ts != nil]; de.Field != field {
+				t.Errorf("UnmarshalObject = %+v, want field %q", de, field)
+			}
+		})
+	}
+
+	// map 及列表中的值同样检查
+	for _, want := range []*golden.All{
+		{RTs: []*timestamppb.Timestamp{{}, {Nanos: -1}}},
+		{MDur: map[string]*durationpb.Duration{"a": {Seconds: 1, Nanos: -1}}},
+	} {
+		data, err := proto.Marshal(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := (&All{}).UnmarshalObject(data); !errors.Is(err, gopb.ErrInvalidTime) {
+			t.Errorf("UnmarshalObject(%v) = %v, want ErrInvalidTime", want, err)
+		}
+	}
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/aggronmagi/protoc-gen-gopb/genparse"
 	"go.uber.org/multierr"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 const (
@@ -33,14 +35,80 @@ func setupConfigOption(flags *flag.FlagSet) {
 	if env != "" {
 		genparse.Zap, _ = strconv.ParseBool(env)
 	}
-	// env = os.Getenv("GOPB_GEN_DEBUG")
-	// if env != "" {
-	// 	cfg.debug, _ = strconv.ParseBool(env)
-	// }
+	env = os.Getenv("GOPB_GEN_UNKNOWN")
+	if env != "" {
+		genparse.Unknown, _ = strconv.ParseBool(env)
+	}
+	env = os.Getenv("GOPB_GEN_WKT")
+	if env != "" {
+		genparse.WKT, _ = strconv.ParseBool(env)
+	}
+	env = os.Getenv("GOPB_GEN_REGISTRY")
+	if env != "" {
+		genparse.Registry, _ = strconv.ParseBool(env)
+	}
+	env = os.Getenv("GOPB_GEN_JSON")
+	if env != "" {
+		genparse.JSON, _ = strconv.ParseBool(env)
+	}
+	env = os.Getenv("GOPB_GEN_TEXT")
+	if env != "" {
+		genparse.Text, _ = strconv.ParseBool(env)
+	}
+	env = os.Getenv("GOPB_GEN_REFLECT")
+	if env != "" {
+		genparse.Reflect, _ = strconv.ParseBool(env)
+	}
+	env = os.Getenv("GOPB_GEN_ALIAS")
+	if env != "" {
+		genparse.Alias, _ = strconv.ParseBool(env)
+	}
+	env = os.Getenv("GOPB_GEN_POOL")
+	if env != "" {
+		genparse.Pool, _ = strconv.ParseBool(env)
+	}
+	env = os.Getenv("GOPB_GEN_SIZECACHE")
+	if env != "" {
+		genparse.SizeCache, _ = strconv.ParseBool(env)
+	}
+	env = os.Getenv("GOPB_GEN_DETERMINISTIC")
+	if env != "" {
+		genparse.Deterministic, _ = strconv.ParseBool(env)
+	}
+	env = os.Getenv("GOPB_GEN_STRICT")
+	if env != "" {
+		genparse.Strict, _ = strconv.ParseBool(env)
+	}
+	env = os.Getenv("GOPB_GEN_UTF8")
+	if env != "" {
+		genparse.UTF8, _ = strconv.ParseBool(env)
+	}
+	env = os.Getenv("GOPB_GEN_UTF8_MARSHAL")
+	if env != "" {
+		genparse.UTF8Marshal, _ = strconv.ParseBool(env)
+	}
+	env = os.Getenv("GOPB_GEN_LIMIT")
+	if env != "" {
+		genparse.Limit, _ = strconv.ParseBool(env)
+	}
 
 	flags.BoolVar(&genparse.Zap, "zap", genparse.Zap, "generate zap log interface")
 	flags.BoolVar(&genparse.Getter, "get", genparse.Getter, "generate message getter method")
 	flags.StringVar(&genparse.WirePkg, "pbwire", genparse.WirePkg, "use protobuf wire package")
+	flags.BoolVar(&genparse.Unknown, "unknown", genparse.Unknown, "preserve unknown fields")
+	flags.BoolVar(&genparse.WKT, "wkt", genparse.WKT, "map well-known types to native go types")
+	flags.BoolVar(&genparse.Registry, "registry", genparse.Registry, "register messages to gopb type registry")
+	flags.BoolVar(&genparse.JSON, "json", genparse.JSON, "generate protojson compatible MarshalJSON/UnmarshalJSON")
+	flags.BoolVar(&genparse.Text, "text", genparse.Text, "generate protobuf text format String/UnmarshalText")
+	flags.BoolVar(&genparse.Reflect, "reflect", genparse.Reflect, "embed the file descriptor and implement proto.Message by ProtoReflect")
+	flags.BoolVar(&genparse.Alias, "alias", genparse.Alias, "reference the input buffer for bytes/string fields when unmarshaling")
+	flags.BoolVar(&genparse.Pool, "pool", genparse.Pool, "generate Acquire/Release backed by sync.Pool, unmarshal sub-messages from the pool")
+	flags.BoolVar(&genparse.SizeCache, "sizecache", genparse.SizeCache, "cache the size of messages computed by MarshalSize and reuse it when marshaling")
+	flags.BoolVar(&genparse.Deterministic, "deterministic", genparse.Deterministic, "generate MarshalObjectDeterministic which sorts map entries by key")
+	flags.BoolVar(&genparse.Strict, "strict", genparse.Strict, "check the wire type of fields when unmarshaling, return *gopb.DecodeError on mismatch")
+	flags.BoolVar(&genparse.UTF8, "utf8", genparse.UTF8, "validate string fields with utf8_validation = VERIFY (proto3 default) when unmarshaling")
+	flags.BoolVar(&genparse.UTF8Marshal, "utf8_marshal", genparse.UTF8Marshal, "also validate string fields when marshaling, requires utf8")
+	flags.BoolVar(&genparse.Limit, "limit", genparse.Limit, "generate UnmarshalObjectWith which limits the depth, elements and list/map length by gopb.UnmarshalOptions")
 }
 
 func main() {
@@ -62,6 +130,10 @@ func main() {
 		if *plugins != "" {
 			return errors.New("protoc-gen-gopb: plugins are not supported; ")
 		}
+		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL |
+			pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+		gen.SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
+		gen.SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2023
 		// 生成消息
 		for _, f := range gen.Files {
 			if !f.Generate {
@@ -69,7 +141,6 @@ func main() {
 			}
 			err = multierr.Append(err, genProtobuf(gen, f))
 		}
-		//gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 		return
 	})
 }
@@ -138,6 +209,12 @@ func genProtobuf(gen *protogen.Plugin, f *protogen.File) (err error) {
 	for _, m := range f.Messages {
 		err = multierr.Append(err, genparse.ParseMessage(data, g, f, m))
 	}
+	for _, ext := range f.Extensions {
+		err = multierr.Append(err, genparse.ParseExtension(data, g, f, ext))
+	}
+	if genparse.Reflect {
+		err = multierr.Append(err, genparse.ParseReflect(data, g, f))
+	}
 	if err != nil {
 		return
 	}